@@ -33,6 +33,12 @@ func main() {
 		Handler:           cmd.SetupRouter(resources),
 		ReadHeaderTimeout: 5 * time.Second,
 	}
+	// Shutdown does not wait out open event streams: end them so it can finish
+	srv.RegisterOnShutdown(resources.Streams.Close)
+
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	workers := cmd.SetupWorkers(resources)
+	workers.Start(workersCtx)
 
 	go func() {
 		resources.Logger.Info("server started", "addr", srv.Addr)
@@ -56,5 +62,16 @@ func main() {
 		resources.Logger.Error("graceful shutdown failed", "err", err)
 	}
 
+	stopWorkers()
+	workers.Wait()
+
+	// the shutdown may have used up ctx; the last views get a fresh deadline
+	flushCtx, cancelFlush := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
+	defer cancelFlush()
+
+	if err := cmd.FlushViews(flushCtx, resources); err != nil {
+		resources.Logger.Error("flush question views failed", "err", err)
+	}
+
 	resources.Logger.Info("server exited")
 }
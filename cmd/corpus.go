@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"test-question/internal/infra"
+	"test-question/internal/repository/corpus"
+
+	ucCExport "test-question/internal/usecase/corpus/export"
+	ucCImport "test-question/internal/usecase/corpus/import_corpus"
+)
+
+const (
+	exportPageSize      = 500
+	importProgressEvery = 1000
+)
+
+func SetupExport(resources *infra.Resources) *ucCExport.UseCase {
+	return ucCExport.NewUseCase(corpus.NewRepository(resources.DB), resources.Logger, ucCExport.Config{
+		PageSize: exportPageSize,
+	})
+}
+
+func SetupImport(resources *infra.Resources) *ucCImport.UseCase {
+	return ucCImport.NewUseCase(corpus.NewRepository(resources.DB), resources.Logger, ucCImport.Config{
+		ProgressEvery: importProgressEvery,
+	})
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"test-question/cmd"
+	"test-question/internal/infra"
+)
+
+const initResourcesTimeout = 10 * time.Second
+
+// export writes the Q&A corpus as JSONL to a file. Logs go to stdout, so
+// the corpus does not.
+func main() {
+	out := flag.String("out", "", "path of the JSONL file to write")
+	flag.Parse()
+
+	if *out == "" {
+		fmt.Fprintln(os.Stderr, "usage: export -out corpus.jsonl")
+		os.Exit(2)
+	}
+
+	infraCtx, cancel := context.WithTimeout(context.Background(), initResourcesTimeout)
+	defer cancel()
+
+	resources, err := infra.Init(infraCtx)
+	if err != nil {
+		panic(err)
+	}
+
+	f, err := os.Create(*out)
+	if err != nil {
+		resources.Logger.Error("create export file", "err", err)
+		os.Exit(1)
+	}
+
+	_, err = cmd.SetupExport(resources).Export(context.Background(), f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		resources.Logger.Error("export failed", "err", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"test-question/cmd"
+	"test-question/internal/infra"
+)
+
+const (
+	initResourcesTimeout = 10 * time.Second
+	// maxReportedErrors bounds the invalid lines printed after a failed import.
+	maxReportedErrors = 50
+)
+
+// import loads a JSONL corpus written by export. With -dry-run it only
+// validates the file.
+func main() {
+	in := flag.String("in", "", "path of the JSONL file to load")
+	dryRun := flag.Bool("dry-run", false, "validate the file without writing")
+	flag.Parse()
+
+	if *in == "" {
+		fmt.Fprintln(os.Stderr, "usage: import -in corpus.jsonl [-dry-run]")
+		os.Exit(2)
+	}
+
+	infraCtx, cancel := context.WithTimeout(context.Background(), initResourcesTimeout)
+	defer cancel()
+
+	resources, err := infra.Init(infraCtx)
+	if err != nil {
+		panic(err)
+	}
+
+	f, err := os.Open(*in)
+	if err != nil {
+		resources.Logger.Error("open import file", "err", err)
+		os.Exit(1)
+	}
+	report, err := cmd.SetupImport(resources).Import(context.Background(), f, *dryRun)
+	_ = f.Close()
+
+	fmt.Printf("lines: %d, questions: %d, answers: %d, dropped duplicate marks: %d, dry run: %t\n",
+		report.Lines, report.Questions, report.Answers, report.DroppedRefs, report.DryRun)
+	for i, le := range report.Errors {
+		if i == maxReportedErrors {
+			fmt.Printf("... and %d more invalid lines\n", len(report.Errors)-i)
+			break
+		}
+		fmt.Println(le.Error())
+	}
+
+	if err != nil {
+		resources.Logger.Error("import failed", "err", err)
+		os.Exit(1)
+	}
+}
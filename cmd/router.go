@@ -3,32 +3,161 @@ package cmd
 import (
 	"net/http"
 
+	entB "test-question/internal/entity/bounty"
+	entD "test-question/internal/entity/draft"
+	entQ "test-question/internal/entity/question"
+	entRp "test-question/internal/entity/report"
+	entU "test-question/internal/entity/user"
+	entV "test-question/internal/entity/vote"
 	"test-question/internal/infra"
+	"test-question/internal/pkg/ratelimit"
 	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/pkg/rpc/rpc_i18n"
+	"test-question/internal/pkg/rpc/rpc_idempotency"
+	"test-question/internal/pkg/rpc/rpc_ratelimit"
+	"test-question/internal/pkg/rpc/rpc_workspace"
 	"test-question/internal/pkg/timer"
 
+	rpcQAnswers "test-question/internal/rpc/question/answers"
 	rpcQCreate "test-question/internal/rpc/question/create_question"
 	rpcQDelete "test-question/internal/rpc/question/delete_question"
+	rpcQEvents "test-question/internal/rpc/question/events"
 	rpcQGet "test-question/internal/rpc/question/get"
+	rpcQHistory "test-question/internal/rpc/question/history"
 	rpcQList "test-question/internal/rpc/question/list"
+	rpcQMarkDup "test-question/internal/rpc/question/mark_duplicate"
+	rpcQRestore "test-question/internal/rpc/question/restore"
+	rpcQTransition "test-question/internal/rpc/question/transition"
+	rpcQTrending "test-question/internal/rpc/question/trending"
+	rpcQUnmarkDup "test-question/internal/rpc/question/unmark_duplicate"
 
+	rpcAAccept "test-question/internal/rpc/answer/accept"
 	rpcACreate "test-question/internal/rpc/answer/create"
 	rpcADelete "test-question/internal/rpc/answer/delete"
 	rpcAGet "test-question/internal/rpc/answer/get"
+	rpcARestore "test-question/internal/rpc/answer/restore"
+
+	rpcRGet "test-question/internal/rpc/reputation/get"
+	rpcRLeaderboard "test-question/internal/rpc/reputation/leaderboard"
+	rpcVCast "test-question/internal/rpc/vote/cast"
+	rpcVRetract "test-question/internal/rpc/vote/retract"
+
+	rpcQFollow "test-question/internal/rpc/question/follow"
+	rpcQUnfollow "test-question/internal/rpc/question/unfollow"
+
+	rpcNGetSettings "test-question/internal/rpc/notification/get_settings"
+	rpcNList "test-question/internal/rpc/notification/list"
+	rpcNMarkAllRead "test-question/internal/rpc/notification/mark_all_read"
+	rpcNMarkRead "test-question/internal/rpc/notification/mark_read"
+	rpcNUpdateSettings "test-question/internal/rpc/notification/update_settings"
+
+	rpcMnList "test-question/internal/rpc/mention/list"
+
+	rpcBoAward "test-question/internal/rpc/bounty/award"
+	rpcBoFeatured "test-question/internal/rpc/bounty/featured"
+	rpcBoOffer "test-question/internal/rpc/bounty/offer"
+
+	rpcWsAddMember "test-question/internal/rpc/workspace/add_member"
+	rpcWsCreate "test-question/internal/rpc/workspace/create"
+	rpcWsList "test-question/internal/rpc/workspace/list"
+	rpcWsRemoveMember "test-question/internal/rpc/workspace/remove_member"
+
+	rpcDDelete "test-question/internal/rpc/draft/delete"
+	rpcDGet "test-question/internal/rpc/draft/get"
+	rpcDPublish "test-question/internal/rpc/draft/publish"
+	rpcDSave "test-question/internal/rpc/draft/save"
+
+	rpcMQueue "test-question/internal/rpc/moderation/queue"
+	rpcMReport "test-question/internal/rpc/moderation/report"
+	rpcMResolve "test-question/internal/rpc/moderation/resolve"
+
+	rpcBRun "test-question/internal/rpc/batch/run"
+
+	rpcGraphQL "test-question/internal/rpc/graphql/exec"
+
+	rpcAtDownload "test-question/internal/rpc/attachment/download"
+	rpcAtUpload "test-question/internal/rpc/attachment/upload"
+
+	rpcWCreate "test-question/internal/rpc/webhook/create"
+	rpcWDelete "test-question/internal/rpc/webhook/delete"
+	rpcWList "test-question/internal/rpc/webhook/list"
+	rpcWListDeliveries "test-question/internal/rpc/webhook/list_deliveries"
 
 	"test-question/internal/repository/answer"
+	"test-question/internal/repository/attachment"
+	"test-question/internal/repository/bounty"
+	"test-question/internal/repository/draft"
+	"test-question/internal/repository/follow"
+	"test-question/internal/repository/idempotency"
+	"test-question/internal/repository/mention"
+	"test-question/internal/repository/notification"
+	"test-question/internal/repository/outbox"
 	"test-question/internal/repository/question"
+	"test-question/internal/repository/report"
+	"test-question/internal/repository/reputation"
 	"test-question/internal/repository/user"
+	"test-question/internal/repository/vote"
+	"test-question/internal/repository/webhook"
+	"test-question/internal/repository/workspace"
 
 	ucAuth "test-question/internal/usecase/auth"
+	ucIGuard "test-question/internal/usecase/idempotency/guard"
 	ucQCreate "test-question/internal/usecase/question/create"
 	ucQDelete "test-question/internal/usecase/question/delete"
+	ucQDuplicate "test-question/internal/usecase/question/duplicate"
 	ucQGet "test-question/internal/usecase/question/get_with_answers"
 	ucQGetAll "test-question/internal/usecase/question/list"
+	ucQRestore "test-question/internal/usecase/question/restore"
+	ucQTransition "test-question/internal/usecase/question/transition"
+	ucQView "test-question/internal/usecase/question/view"
 
+	ucAAccept "test-question/internal/usecase/answer/accept"
 	ucACreate "test-question/internal/usecase/answer/create"
 	ucADelete "test-question/internal/usecase/answer/delete"
 	ucAGet "test-question/internal/usecase/answer/get_by_id"
+	ucARestore "test-question/internal/usecase/answer/restore"
+
+	ucRGet "test-question/internal/usecase/reputation/get_by_user"
+	ucRLeaderboard "test-question/internal/usecase/reputation/leaderboard"
+	ucVCast "test-question/internal/usecase/vote/cast"
+
+	ucNList "test-question/internal/usecase/notification/list"
+	ucNMarkRead "test-question/internal/usecase/notification/mark_read"
+	ucNSettings "test-question/internal/usecase/notification/settings"
+	ucQFollow "test-question/internal/usecase/question/follow"
+
+	ucMQueue "test-question/internal/usecase/moderation/queue"
+	ucMReport "test-question/internal/usecase/moderation/report"
+	ucMResolve "test-question/internal/usecase/moderation/resolve"
+
+	ucWCreate "test-question/internal/usecase/webhook/create_subscription"
+	ucWDelete "test-question/internal/usecase/webhook/delete_subscription"
+	ucWListDeliveries "test-question/internal/usecase/webhook/list_deliveries"
+	ucWList "test-question/internal/usecase/webhook/list_subscriptions"
+
+	ucBRun "test-question/internal/usecase/batch/run"
+
+	ucLByIDs "test-question/internal/usecase/lookup/list_by_ids"
+
+	ucAtDownload "test-question/internal/usecase/attachment/download"
+	ucAtUpload "test-question/internal/usecase/attachment/upload"
+
+	ucMnList "test-question/internal/usecase/mention/list"
+	ucMnRecord "test-question/internal/usecase/mention/record"
+
+	ucBoAward "test-question/internal/usecase/bounty/award"
+	ucBoFeatured "test-question/internal/usecase/bounty/featured"
+	ucBoOffer "test-question/internal/usecase/bounty/offer"
+
+	ucWsCreate "test-question/internal/usecase/workspace/create"
+	ucWsList "test-question/internal/usecase/workspace/list"
+	ucWsMembers "test-question/internal/usecase/workspace/members"
+	ucWsResolve "test-question/internal/usecase/workspace/resolve"
+
+	ucDManage "test-question/internal/usecase/draft/manage"
+	ucDPublish "test-question/internal/usecase/draft/publish"
+
+	ucSSubscribe "test-question/internal/usecase/stream/subscribe"
 
 	"test-question/internal/pkg/uow"
 )
@@ -40,43 +169,256 @@ func SetupRouter(resources *infra.Resources) http.Handler {
 	userRepo := user.NewRepository(resources.DB)
 	questionRepo := question.NewRepository(resources.DB)
 	answerRepo := answer.NewRepository(resources.DB)
+	voteRepo := vote.NewRepository(resources.DB)
+	reputationRepo := reputation.NewRepository(resources.DB)
+	followRepo := follow.NewRepository(resources.DB)
+	notificationRepo := notification.NewRepository(resources.DB)
+	webhookRepo := webhook.NewRepository(resources.DB)
+	outboxRepo := outbox.NewRepository(resources.DB)
+	reportRepo := report.NewRepository(resources.DB)
+	idempotencyRepo := idempotency.NewRepository(resources.DB)
+	attachmentRepo := attachment.NewRepository(resources.DB)
+	mentionRepo := mention.NewRepository(resources.DB)
+	draftRepo := draft.NewRepository(resources.DB)
+	bountyRepo := bounty.NewRepository(resources.DB)
+	workspaceRepo := workspace.NewRepository(resources.DB)
 	uowManager := uow.NewGormUoW(resources.DB)
 
 	// ==========================
 	// UseCases
 	// ==========================
 	authUseCase := ucAuth.NewUseCase(userRepo, resources.Logger)
+	ucRecordMentions := ucMnRecord.NewUseCase(userRepo, mentionRepo)
 	tm := timer.NewTimer()
 
-	ucCreateQuestion := ucQCreate.NewUseCase(questionRepo, tm, resources.Logger)
+	ucCreateQuestion := ucQCreate.NewUseCase(questionRepo, attachmentRepo, ucRecordMentions, outboxRepo, uowManager, resources.Policy, tm, resources.Logger, ucQCreate.Config{
+		DuplicateThreshold: resources.Env.DuplicateThreshold,
+		DuplicateLimit:     resources.Env.DuplicateLimit,
+	})
 	ucListQuestions := ucQGetAll.NewUseCase(questionRepo, resources.Logger)
-	ucGetQuestion := ucQGet.NewUseCase(questionRepo, answerRepo, resources.Logger)
-	ucDeleteQuestion := ucQDelete.NewUseCase(questionRepo, answerRepo, uowManager, resources.Logger)
+	ucGetQuestion := ucQGet.NewUseCase(questionRepo, answerRepo, attachmentRepo, mentionRepo, resources.Logger)
+	ucViews := ucQView.NewUseCase(questionRepo, resources.Views, tm, resources.Logger)
+	ucSubscribe := ucSSubscribe.NewUseCase(questionRepo, resources.Streams, resources.Logger)
+	ucDeleteQuestion := ucQDelete.NewUseCase(questionRepo, answerRepo, reputationRepo, outboxRepo, uowManager, tm, resources.Logger)
+	ucDuplicate := ucQDuplicate.NewUseCase(questionRepo, resources.Logger)
+	ucTransition := ucQTransition.NewUseCase(questionRepo, uowManager, tm, resources.Logger)
+	ucRestoreQuestion := ucQRestore.NewUseCase(questionRepo, answerRepo, reputationRepo, uowManager, tm, resources.Logger, resources.Env.TrashRestorePeriod)
+
+	ucCreateAnswer := ucACreate.NewUseCase(answerRepo, questionRepo, attachmentRepo, ucRecordMentions, outboxRepo, uowManager, resources.Policy, tm, resources.Logger)
+	ucDeleteAnswer := ucADelete.NewUseCase(answerRepo, reputationRepo, outboxRepo, uowManager, tm, resources.Logger)
+	ucRestoreAnswer := ucARestore.NewUseCase(answerRepo, questionRepo, reputationRepo, uowManager, tm, resources.Logger, resources.Env.TrashRestorePeriod)
+	ucGetAnswer := ucAGet.NewUseCase(answerRepo, mentionRepo, resources.Logger)
+	ucAwardBounty := ucBoAward.NewUseCase(bountyRepo, answerRepo, questionRepo, reputationRepo, uowManager, tm, resources.Logger, ucBoAward.Config{})
+	ucAcceptAnswer := ucAAccept.NewUseCase(answerRepo, questionRepo, reputationRepo, ucAwardBounty, uowManager, tm, resources.Logger)
+
+	ucVote := ucVCast.NewUseCase(questionRepo, answerRepo, voteRepo, reputationRepo, uowManager, tm, resources.Logger)
+	ucGetReputation := ucRGet.NewUseCase(userRepo, reputationRepo, resources.Logger)
+	ucLeaderboard := ucRLeaderboard.NewUseCase(reputationRepo, tm, resources.Logger)
+
+	ucOfferBounty := ucBoOffer.NewUseCase(questionRepo, bountyRepo, reputationRepo, uowManager, tm, resources.Logger, entB.Config{
+		MinAmount: resources.Env.BountyMinAmount,
+		MaxAmount: resources.Env.BountyMaxAmount,
+		Duration:  resources.Env.BountyDuration,
+	})
+	ucFeaturedBounties := ucBoFeatured.NewUseCase(bountyRepo, tm, resources.Logger)
 
-	ucCreateAnswer := ucACreate.NewUseCase(answerRepo, questionRepo, tm, resources.Logger)
-	ucDeleteAnswer := ucADelete.NewUseCase(answerRepo, resources.Logger)
-	ucGetAnswer := ucAGet.NewUseCase(answerRepo, resources.Logger)
+	ucCreateWorkspace := ucWsCreate.NewUseCase(workspaceRepo, uowManager, resources.Logger)
+	ucListWorkspaces := ucWsList.NewUseCase(workspaceRepo, resources.Logger)
+	ucMembers := ucWsMembers.NewUseCase(workspaceRepo, userRepo, resources.Logger)
+	ucResolveWorkspace := ucWsResolve.NewUseCase(workspaceRepo)
+
+	ucFollow := ucQFollow.NewUseCase(questionRepo, followRepo, tm, resources.Logger)
+	ucListNotifications := ucNList.NewUseCase(notificationRepo, resources.Logger)
+	ucListMentions := ucMnList.NewUseCase(mentionRepo, resources.Logger)
+	ucMarkRead := ucNMarkRead.NewUseCase(notificationRepo, tm, resources.Logger)
+	ucSettings := ucNSettings.NewUseCase(notificationRepo, resources.Logger)
+
+	ucReport := ucMReport.NewUseCase(questionRepo, answerRepo, reportRepo, uowManager, tm, resources.Logger, ucMReport.Config{
+		HideThreshold: resources.Env.ReportHideThreshold,
+	})
+	ucModerationQueue := ucMQueue.NewUseCase(reportRepo, resources.Logger)
+	ucResolve := ucMResolve.NewUseCase(questionRepo, answerRepo, ucDeleteQuestion, ucDeleteAnswer,
+		reportRepo, notificationRepo, uowManager, tm, resources.Logger)
+
+	// Buckets of the rate limits, shared by the middleware, the GraphQL
+	// mutations that create posts and the operations of batches.
+	rateStore := ratelimit.NewMemoryStore(tm)
+	createQuota := rpc_ratelimit.NewQuota(rateStore, map[string]ratelimit.Limit{
+		"questions": resources.Env.RateLimitQuestions,
+		"answers":   resources.Env.RateLimitAnswers,
+	})
+	batchQuota := rpc_ratelimit.NewQuota(rateStore, map[string]ratelimit.Limit{
+		"batch_operations": resources.Env.RateLimitBatchOperations,
+	})
+
+	ucBatch := ucBRun.NewUseCase(ucCreateQuestion, ucCreateAnswer, ucDeleteQuestion, ucDeleteAnswer, batchQuota, uowManager, resources.Logger, ucBRun.Config{
+		MaxOperations: resources.Env.BatchMaxOperations,
+	})
+
+	ucLookup := ucLByIDs.NewUseCase(questionRepo, answerRepo, userRepo, reputationRepo, resources.Logger)
+
+	ucIdempotency := ucIGuard.NewUseCase(idempotencyRepo, tm, resources.Logger, ucIGuard.Config{
+		TTL:   resources.Env.IdempotencyTTL,
+		Lease: resources.Env.IdempotencyLease,
+	})
+
+	ucUpload := ucAtUpload.NewUseCase(attachmentRepo, resources.Storage, tm, resources.Logger, ucAtUpload.Config{
+		MaxSize:       resources.Env.AttachmentMaxSize,
+		AllowedTypes:  resources.Env.AttachmentAllowedTypes,
+		ThumbnailSize: resources.Env.AttachmentThumbnailSize,
+	})
+	ucDownload := ucAtDownload.NewUseCase(attachmentRepo, resources.Storage, resources.Logger)
+
+	ucDrafts := ucDManage.NewUseCase(draftRepo, tm, resources.Logger, ucDManage.Config{
+		TTL: resources.Env.DraftTTL,
+	})
+	ucPublishDraft := ucDPublish.NewUseCase(draftRepo, ucCreateQuestion, ucCreateAnswer, uowManager, tm, resources.Logger)
+
+	ucCreateWebhook := ucWCreate.NewUseCase(webhookRepo, tm, resources.Logger)
+	ucListWebhooks := ucWList.NewUseCase(webhookRepo)
+	ucDeleteWebhook := ucWDelete.NewUseCase(webhookRepo, resources.Logger)
+	ucListDeliveries := ucWListDeliveries.NewUseCase(webhookRepo)
 
 	// ==========================
 	// HTTP Router (stdlib)
 	// ==========================
 	mux := http.NewServeMux()
 
+	// --- Rate limits of write endpoints ---
+	questionsLimit := rpc_ratelimit.Limit(rateStore, "questions", resources.Env.RateLimitQuestions)
+	answersLimit := rpc_ratelimit.Limit(rateStore, "answers", resources.Env.RateLimitAnswers)
+	votesLimit := rpc_ratelimit.Limit(rateStore, "votes", resources.Env.RateLimitVotes)
+	reportsLimit := rpc_ratelimit.Limit(rateStore, "reports", resources.Env.RateLimitReports)
+	batchLimit := rpc_ratelimit.Limit(rateStore, "batch", resources.Env.RateLimitBatch)
+	attachmentsLimit := rpc_ratelimit.Limit(rateStore, "attachments", resources.Env.RateLimitAttachments)
+	graphqlLimit := rpc_ratelimit.Limit(rateStore, "graphql", resources.Env.RateLimitGraphQL)
+
+	// Publishing a draft creates a question or an answer and counts as one.
+	draftsLimit := func(next http.Handler) http.Handler {
+		question, answer := questionsLimit(next), answersLimit(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.PathValue("key") == entD.QuestionKey.String() {
+				question.ServeHTTP(w, r)
+				return
+			}
+			answer.ServeHTTP(w, r)
+		})
+	}
+
+	// --- Idempotency-Key support of create and batch endpoints ---
+	idempotent := rpc_idempotency.Middleware(ucIdempotency)
+
 	// --- Question handlers ---
-	mux.Handle("POST /questions", rpcQCreate.NewHandler(ucCreateQuestion))
+	mux.Handle("POST /questions", questionsLimit(idempotent(rpcQCreate.NewHandler(ucCreateQuestion))))
 	mux.Handle("GET /questions", rpcQList.NewHandler(ucListQuestions))
-	mux.Handle("GET /questions/{id}", rpcQGet.NewHandler(ucGetQuestion))
+	mux.Handle("GET /questions/trending", rpcQTrending.NewHandler(ucListQuestions))
+	mux.Handle("GET /questions/{id}", rpcQGet.NewHandler(ucGetQuestion, ucViews))
 	mux.Handle("DELETE /questions/{id}", rpcQDelete.NewHandler(ucDeleteQuestion))
+	mux.Handle("POST /questions/{id}/restore", rpcQRestore.NewHandler(ucRestoreQuestion))
+	mux.Handle("POST /questions/{id}/close", rpcQTransition.NewHandler(ucTransition, entQ.ActionClose))
+	mux.Handle("POST /questions/{id}/reopen", rpcQTransition.NewHandler(ucTransition, entQ.ActionReopen))
+	mux.Handle("POST /questions/{id}/lock", rpcQTransition.NewHandler(ucTransition, entQ.ActionLock))
+	mux.Handle("POST /questions/{id}/unlock", rpcQTransition.NewHandler(ucTransition, entQ.ActionUnlock))
+	mux.Handle("GET /questions/{id}/history", rpcQHistory.NewHandler(ucTransition))
+	mux.Handle("GET /questions/{id}/events", rpcQEvents.NewHandler(ucSubscribe, resources.Env.StreamHeartbeatInterval))
 
 	// --- Answer handlers ---
-	mux.Handle("POST /questions/{id}/answers", rpcACreate.NewHandler(ucCreateAnswer))
+	mux.Handle("POST /questions/{id}/answers", answersLimit(idempotent(rpcACreate.NewHandler(ucCreateAnswer))))
+	mux.Handle("GET /questions/{id}/answers", rpcQAnswers.NewHandler(ucGetQuestion))
 	mux.Handle("GET /answers/{id}", rpcAGet.NewHandler(ucGetAnswer))
 	mux.Handle("DELETE /answers/{id}", rpcADelete.NewHandler(ucDeleteAnswer))
+	mux.Handle("POST /answers/{id}/restore", rpcARestore.NewHandler(ucRestoreAnswer))
+	mux.Handle("POST /answers/{id}/accept", rpcAAccept.NewHandler(ucAcceptAnswer))
+
+	// --- Vote handlers ---
+	mux.Handle("PUT /questions/{id}/vote", votesLimit(rpcVCast.NewHandler(ucVote, entV.TargetQuestion)))
+	mux.Handle("DELETE /questions/{id}/vote", votesLimit(rpcVRetract.NewHandler(ucVote, entV.TargetQuestion)))
+	mux.Handle("PUT /answers/{id}/vote", votesLimit(rpcVCast.NewHandler(ucVote, entV.TargetAnswer)))
+	mux.Handle("DELETE /answers/{id}/vote", votesLimit(rpcVRetract.NewHandler(ucVote, entV.TargetAnswer)))
+
+	// --- Report handlers ---
+	mux.Handle("POST /questions/{id}/report", reportsLimit(rpcMReport.NewHandler(ucReport, entRp.TargetQuestion)))
+	mux.Handle("POST /answers/{id}/report", reportsLimit(rpcMReport.NewHandler(ucReport, entRp.TargetAnswer)))
+
+	// --- Batch handler ---
+	mux.Handle("POST /batch", batchLimit(idempotent(rpcBRun.NewHandler(ucBatch))))
+
+	// --- GraphQL handler ---
+	mux.Handle("POST /graphql", graphqlLimit(rpcGraphQL.NewHandler(rpcGraphQL.UseCases{
+		GetQuestion:    ucGetQuestion,
+		ListQuestions:  ucListQuestions,
+		CreateQuestion: ucCreateQuestion,
+		DeleteQuestion: ucDeleteQuestion,
+		GetAnswer:      ucGetAnswer,
+		CreateAnswer:   ucCreateAnswer,
+		DeleteAnswer:   ucDeleteAnswer,
+		Lookup:         ucLookup,
+	}, createQuota, rpcGraphQL.Config{
+		MaxDepth:      resources.Env.GraphQLMaxDepth,
+		MaxComplexity: resources.Env.GraphQLMaxComplexity,
+	})))
+
+	// --- Attachment handlers ---
+	mux.Handle("POST /attachments", attachmentsLimit(rpcAtUpload.NewHandler(ucUpload, resources.Env.AttachmentMaxSize)))
+	mux.Handle("GET /attachments/{id}", rpcAtDownload.NewHandler(ucDownload, false))
+	mux.Handle("GET /attachments/{id}/thumbnail", rpcAtDownload.NewHandler(ucDownload, true))
+
+	// --- Reputation handlers ---
+	mux.Handle("GET /users/{id}/reputation", rpcRGet.NewHandler(ucGetReputation))
+	mux.Handle("GET /leaderboard", rpcRLeaderboard.NewHandler(ucLeaderboard))
+
+	// --- Bounty handlers ---
+	mux.Handle("POST /questions/{id}/bounty", rpcBoOffer.NewHandler(ucOfferBounty))
+	mux.Handle("POST /answers/{id}/bounty", rpcBoAward.NewHandler(ucAwardBounty))
+	mux.Handle("GET /questions/bounties", rpcBoFeatured.NewHandler(ucFeaturedBounties))
+
+	// --- Workspace handlers ---
+	mux.Handle("POST /workspaces", rpcWsCreate.NewHandler(ucCreateWorkspace))
+	mux.Handle("GET /me/workspaces", rpcWsList.NewHandler(ucListWorkspaces))
+	mux.Handle("PUT /workspaces/{slug}/members/{user_id}", rpcWsAddMember.NewHandler(ucMembers))
+	mux.Handle("DELETE /workspaces/{slug}/members/{user_id}", rpcWsRemoveMember.NewHandler(ucMembers))
+
+	// --- Follow & notification handlers ---
+	mux.Handle("POST /questions/{id}/follow", rpcQFollow.NewHandler(ucFollow))
+	mux.Handle("DELETE /questions/{id}/follow", rpcQUnfollow.NewHandler(ucFollow))
+	mux.Handle("GET /me/notifications", rpcNList.NewHandler(ucListNotifications))
+	mux.Handle("POST /me/notifications/{id}/read", rpcNMarkRead.NewHandler(ucMarkRead))
+	mux.Handle("POST /me/notifications/read-all", rpcNMarkAllRead.NewHandler(ucMarkRead))
+	mux.Handle("GET /me/notification-settings", rpcNGetSettings.NewHandler(ucSettings))
+	mux.Handle("PUT /me/notification-settings", rpcNUpdateSettings.NewHandler(ucSettings))
+	mux.Handle("GET /me/mentions", rpcMnList.NewHandler(ucListMentions))
+
+	// --- Draft handlers ---
+	mux.Handle("PUT /me/drafts/{key}", rpcDSave.NewHandler(ucDrafts))
+	mux.Handle("GET /me/drafts/{key}", rpcDGet.NewHandler(ucDrafts))
+	mux.Handle("DELETE /me/drafts/{key}", rpcDDelete.NewHandler(ucDrafts))
+	mux.Handle("POST /me/drafts/{key}/publish", draftsLimit(idempotent(rpcDPublish.NewHandler(ucPublishDraft))))
+
+	// --- Moderator handlers ---
+	moderatorOnly := rpc_auth.RequireRole(entU.RoleModerator, entU.RoleAdmin)
+
+	mux.Handle("POST /questions/{id}/duplicate", moderatorOnly(rpcQMarkDup.NewHandler(ucDuplicate)))
+	mux.Handle("DELETE /questions/{id}/duplicate", moderatorOnly(rpcQUnmarkDup.NewHandler(ucDuplicate)))
+	mux.Handle("GET /moderation/queue", moderatorOnly(rpcMQueue.NewHandler(ucModerationQueue)))
+	mux.Handle("POST /moderation/questions/{id}/resolve", moderatorOnly(rpcMResolve.NewHandler(ucResolve, entRp.TargetQuestion)))
+	mux.Handle("POST /moderation/answers/{id}/resolve", moderatorOnly(rpcMResolve.NewHandler(ucResolve, entRp.TargetAnswer)))
+
+	// --- Admin handlers ---
+	adminOnly := rpc_auth.RequireRole(entU.RoleAdmin)
+
+	mux.Handle("POST /admin/webhooks", adminOnly(rpcWCreate.NewHandler(ucCreateWebhook)))
+	mux.Handle("GET /admin/webhooks", adminOnly(rpcWList.NewHandler(ucListWebhooks)))
+	mux.Handle("DELETE /admin/webhooks/{id}", adminOnly(rpcWDelete.NewHandler(ucDeleteWebhook)))
+	mux.Handle("GET /admin/webhooks/{id}/deliveries", adminOnly(rpcWListDeliveries.NewHandler(ucListDeliveries)))
 
 	// ==========================
 	// Wrap with middleware
 	// ==========================
-	handler := rpc_auth.BasicAuthMiddleware(authUseCase)(mux)
+	// every route is served in a workspace, /w/{slug}/... included
+	handler := rpc_workspace.Middleware(ucResolveWorkspace)(mux)
+	handler = rpc_auth.BasicAuthMiddleware(authUseCase)(handler)
+	// outermost, so every error is written in the client's language
+	handler = rpc_i18n.Middleware(handler)
 
 	return handler
 }
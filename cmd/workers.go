@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	entR "test-question/internal/entity/ranking"
+	entS "test-question/internal/entity/stream"
+	"test-question/internal/infra"
+	"test-question/internal/pkg/pgnotify"
+	"test-question/internal/pkg/timer"
+	"test-question/internal/pkg/uow"
+	"test-question/internal/pkg/worker"
+
+	"test-question/internal/repository/answer"
+	"test-question/internal/repository/attachment"
+	"test-question/internal/repository/bounty"
+	"test-question/internal/repository/draft"
+	"test-question/internal/repository/follow"
+	"test-question/internal/repository/idempotency"
+	"test-question/internal/repository/notification"
+	"test-question/internal/repository/outbox"
+	"test-question/internal/repository/question"
+	"test-question/internal/repository/ranking"
+	"test-question/internal/repository/reputation"
+	"test-question/internal/repository/stream"
+	"test-question/internal/repository/webhook"
+
+	ucAtCollect "test-question/internal/usecase/attachment/collect"
+	ucBoAward "test-question/internal/usecase/bounty/award"
+	ucDManage "test-question/internal/usecase/draft/manage"
+	ucIGuard "test-question/internal/usecase/idempotency/guard"
+	ucNNotify "test-question/internal/usecase/notification/notify"
+	ucORelay "test-question/internal/usecase/outbox/relay"
+	ucQRank "test-question/internal/usecase/question/rank"
+	ucQView "test-question/internal/usecase/question/view"
+	ucSFanout "test-question/internal/usecase/stream/fanout"
+	ucSPublish "test-question/internal/usecase/stream/publish"
+	ucTPurge "test-question/internal/usecase/trash/purge"
+	ucWDeliver "test-question/internal/usecase/webhook/deliver"
+	ucWPublish "test-question/internal/usecase/webhook/publish"
+)
+
+const (
+	outboxCleanupInterval    = time.Hour
+	trashPurgeInterval       = time.Hour
+	idempotencyPurgeInterval = time.Hour
+	attachmentGCInterval     = time.Hour
+	attachmentGCBatchSize    = 500
+	draftPurgeInterval       = time.Hour
+	bountyExpiryBatchSize    = 100
+	streamCleanupInterval    = time.Hour
+	streamFanoutBatchSize    = 500
+	streamListenRetryDelay   = 5 * time.Second
+)
+
+func SetupWorkers(resources *infra.Resources) *worker.Group {
+	// ==========================
+	// Repositories
+	// ==========================
+	webhookRepo := webhook.NewRepository(resources.DB)
+	followRepo := follow.NewRepository(resources.DB)
+	notificationRepo := notification.NewRepository(resources.DB)
+	outboxRepo := outbox.NewRepository(resources.DB)
+	questionRepo := question.NewRepository(resources.DB)
+	answerRepo := answer.NewRepository(resources.DB)
+	idempotencyRepo := idempotency.NewRepository(resources.DB)
+	attachmentRepo := attachment.NewRepository(resources.DB)
+	rankingRepo := ranking.NewRepository(resources.DB)
+	draftRepo := draft.NewRepository(resources.DB)
+	bountyRepo := bounty.NewRepository(resources.DB)
+	reputationRepo := reputation.NewRepository(resources.DB)
+	streamRepo := stream.NewRepository(resources.DB)
+	uowManager := uow.NewGormUoW(resources.DB)
+
+	// ==========================
+	// UseCases
+	// ==========================
+	tm := timer.NewTimer()
+
+	ucDeliver := ucWDeliver.NewUseCase(webhookRepo, &http.Client{}, tm, resources.Logger, ucWDeliver.Config{
+		MaxAttempts: resources.Env.WebhookMaxAttempts,
+		BackoffBase: resources.Env.WebhookBackoffBase,
+		Timeout:     resources.Env.WebhookTimeout,
+		BatchSize:   resources.Env.WebhookBatchSize,
+	})
+
+	ucNotify := ucNNotify.NewUseCase(followRepo, notificationRepo, resources.Logger)
+	ucPublish := ucWPublish.NewUseCase(webhookRepo, tm, resources.Logger)
+	ucStream := ucSPublish.NewUseCase(streamRepo, resources.Logger)
+	ucFanout := ucSFanout.NewUseCase(streamRepo, resources.Streams, tm, resources.Logger, ucSFanout.Config{
+		Backlog:   resources.Env.StreamBacklog,
+		BatchSize: streamFanoutBatchSize,
+		Retention: resources.Env.StreamRetention,
+	})
+
+	ucRelay := ucORelay.NewUseCase(outboxRepo, uowManager, tm, resources.Logger, ucORelay.Config{
+		BatchSize:   resources.Env.OutboxBatchSize,
+		BackoffBase: resources.Env.OutboxBackoffBase,
+		Retention:   resources.Env.OutboxRetention,
+	})
+
+	ucPurge := ucTPurge.NewUseCase(questionRepo, answerRepo, tm, resources.Logger, resources.Env.TrashRetention)
+	ucIdempotency := ucIGuard.NewUseCase(idempotencyRepo, tm, resources.Logger, ucIGuard.Config{
+		TTL:   resources.Env.IdempotencyTTL,
+		Lease: resources.Env.IdempotencyLease,
+	})
+
+	ucCollect := ucAtCollect.NewUseCase(attachmentRepo, resources.Storage, tm, resources.Logger, ucAtCollect.Config{
+		OrphanTTL: resources.Env.AttachmentOrphanTTL,
+		BatchSize: attachmentGCBatchSize,
+	})
+
+	ucDrafts := ucDManage.NewUseCase(draftRepo, tm, resources.Logger, ucDManage.Config{
+		TTL: resources.Env.DraftTTL,
+	})
+
+	ucBounties := ucBoAward.NewUseCase(bountyRepo, answerRepo, questionRepo, reputationRepo, uowManager, tm, resources.Logger, ucBoAward.Config{
+		BatchSize: bountyExpiryBatchSize,
+	})
+
+	ucViews := ucQView.NewUseCase(questionRepo, resources.Views, tm, resources.Logger)
+	ucRank := ucQRank.NewUseCase(rankingRepo, uowManager, tm, resources.Logger, entR.Config{
+		Weights: entR.Weights{
+			Answer: resources.Env.RankingWeightAnswer,
+			Vote:   resources.Env.RankingWeightVote,
+			View:   resources.Env.RankingWeightView,
+		},
+		HalfLife: resources.Env.RankingHalfLife,
+	})
+
+	// ==========================
+	// Outbox subscriptions
+	// ==========================
+	ucORelay.Subscribe(ucRelay, "notifications", ucNotify.QuestionCreated)
+	ucORelay.Subscribe(ucRelay, "notifications", ucNotify.AnswerCreated)
+	ucORelay.Subscribe(ucRelay, "webhooks", ucPublish.QuestionCreated)
+	ucORelay.Subscribe(ucRelay, "webhooks", ucPublish.QuestionDeleted)
+	ucORelay.Subscribe(ucRelay, "webhooks", ucPublish.AnswerCreated)
+	ucORelay.Subscribe(ucRelay, "webhooks", ucPublish.AnswerDeleted)
+	ucORelay.Subscribe(ucRelay, "streams", ucStream.AnswerCreated)
+	ucORelay.Subscribe(ucRelay, "streams", ucStream.AnswerDeleted)
+
+	// ==========================
+	// Workers
+	// ==========================
+	streamEvents := pgnotify.New(resources.Env.DbDSN, entS.Channel, streamListenRetryDelay, resources.Logger)
+
+	return worker.NewGroup(
+		worker.NewPeriodic("outbox_relay", resources.Env.OutboxPollInterval, func(ctx context.Context) error {
+			_, err := ucRelay.RelayDue(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("outbox_cleanup", outboxCleanupInterval, func(ctx context.Context) error {
+			_, err := ucRelay.Cleanup(ctx)
+			return err
+		}, resources.Logger),
+		// every replica reads the stream events relayed by any of them
+		streamEvents,
+		worker.NewPeriodic("stream_fanout", resources.Env.StreamPollInterval, func(ctx context.Context) error {
+			_, err := ucFanout.Fanout(ctx)
+			return err
+		}, resources.Logger).WakeOn(streamEvents.C()),
+		worker.NewPeriodic("stream_cleanup", streamCleanupInterval, func(ctx context.Context) error {
+			_, err := ucFanout.Cleanup(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("webhook_delivery", resources.Env.WebhookPollInterval, func(ctx context.Context) error {
+			_, err := ucDeliver.DeliverDue(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("trash_purge", trashPurgeInterval, func(ctx context.Context) error {
+			_, err := ucPurge.Purge(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("idempotency_purge", idempotencyPurgeInterval, func(ctx context.Context) error {
+			_, err := ucIdempotency.Purge(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("attachment_gc", attachmentGCInterval, func(ctx context.Context) error {
+			_, err := ucCollect.Collect(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("draft_purge", draftPurgeInterval, func(ctx context.Context) error {
+			_, err := ucDrafts.Purge(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("bounty_expiry", resources.Env.BountyExpiryInterval, func(ctx context.Context) error {
+			_, err := ucBounties.Expire(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("view_flush", resources.Env.ViewFlushInterval, func(ctx context.Context) error {
+			_, err := ucViews.Flush(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("question_ranking", resources.Env.RankingInterval, func(ctx context.Context) error {
+			_, err := ucRank.Rank(ctx)
+			return err
+		}, resources.Logger),
+		worker.NewPeriodic("content_policy_reload", resources.Env.ContentPolicyReloadInterval, func(ctx context.Context) error {
+			reloaded, err := resources.Policy.Reload()
+			if reloaded {
+				resources.Logger.InfoContext(ctx, "content policy reloaded", "path", resources.Env.ContentPolicyPath)
+			}
+			return err
+		}, resources.Logger),
+	)
+}
+
+// FlushViews writes the views still buffered in memory. It runs on shutdown,
+// once the server has stopped serving reads and the workers have returned.
+func FlushViews(ctx context.Context, resources *infra.Resources) error {
+	ucViews := ucQView.NewUseCase(question.NewRepository(resources.DB), resources.Views, timer.NewTimer(), resources.Logger)
+	_, err := ucViews.Flush(ctx)
+	return err
+}
@@ -0,0 +1,81 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type answersPage struct {
+	Items []struct {
+		ID    int    `json:"id"`
+		Text  string `json:"text"`
+		Score int    `json:"score"`
+	} `json:"items"`
+	NextCursor string `json:"next_cursor"`
+}
+
+func (f *FullE2ESuite) Test_AnswerPages() {
+	resp := f.IAmAlice().POST("/questions", map[string]any{"text": "which regex engine backtracks least", "force": true})
+	f.Require().Equal(201, resp.StatusCode)
+
+	var q FullFlowResponse
+	json.NewDecoder(resp.Body).Decode(&q)
+	path := "/questions/" + strconv.Itoa(q.ID)
+
+	ids := make([]int, 25)
+	for i := range ids {
+		resp = f.IAmBob().POST(path+"/answers", map[string]any{"text": "answer " + strconv.Itoa(i)})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var a struct {
+			ID int `json:"id"`
+		}
+		json.NewDecoder(resp.Body).Decode(&a)
+		ids[i] = a.ID
+	}
+
+	// ==== The question embeds the first page ====
+	resp = f.IAmAlice().GET(path)
+	f.Require().Equal(200, resp.StatusCode)
+
+	var shown struct {
+		Answers     []struct{ ID int } `json:"answers"`
+		AnswerCount int                `json:"answer_count"`
+		AnswersNext string             `json:"answers_next"`
+	}
+	json.NewDecoder(resp.Body).Decode(&shown)
+	f.Len(shown.Answers, 20)
+	f.Equal(25, shown.AnswerCount)
+	f.Require().NotEmpty(shown.AnswersNext)
+
+	// ==== The link leads to the rest ====
+	resp = f.IAmAlice().GET(shown.AnswersNext)
+	f.Require().Equal(200, resp.StatusCode)
+
+	var rest answersPage
+	json.NewDecoder(resp.Body).Decode(&rest)
+	f.Require().Len(rest.Items, 5)
+	f.Equal(ids[20], rest.Items[0].ID)
+	f.Empty(rest.NextCursor)
+
+	// ==== Highest score first ====
+	resp = f.IAmAlice().PUT("/answers/"+strconv.Itoa(ids[24])+"/vote", map[string]any{"value": 1})
+	f.Require().Equal(204, resp.StatusCode)
+
+	resp = f.IAmAlice().GET(path + "/answers?sort=score&limit=2")
+	f.Require().Equal(200, resp.StatusCode)
+
+	var top answersPage
+	json.NewDecoder(resp.Body).Decode(&top)
+	f.Require().Len(top.Items, 2)
+	f.Equal(ids[24], top.Items[0].ID)
+	f.Equal(1, top.Items[0].Score)
+	f.Equal(ids[0], top.Items[1].ID)
+
+	// ==== A cursor belongs to its sort ====
+	resp = f.IAmAlice().GET(path + "/answers?sort=newest&cursor=" + top.NextCursor)
+	f.Equal(400, resp.StatusCode)
+}
@@ -0,0 +1,84 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"image"
+	"image/png"
+	"io"
+	"strconv"
+)
+
+type attachmentResponse struct {
+	ID           int    `json:"id"`
+	ContentType  string `json:"content_type"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url"`
+}
+
+func (f *FullE2ESuite) Test_Attachments() {
+	var img bytes.Buffer
+	f.Require().NoError(png.Encode(&img, image.NewGray(image.Rect(0, 0, 600, 300))))
+
+	// ==== Uploads are sniffed and checked against the allowlist ====
+	resp := f.IAmAlice().Upload("screenshot.png", img.Bytes())
+	f.Require().Equal(201, resp.StatusCode)
+
+	var shot attachmentResponse
+	json.NewDecoder(resp.Body).Decode(&shot)
+	f.Equal("image/png", shot.ContentType)
+	f.Require().NotEmpty(shot.ThumbnailURL)
+
+	resp = f.IAmAlice().Upload("script.sh", []byte("\x7fELF\x02\x01\x01"))
+	f.Equal(415, resp.StatusCode)
+
+	// ==== An orphan upload is private to its uploader ====
+	resp = f.IAmBob().GET(shot.URL)
+	f.Equal(404, resp.StatusCode)
+
+	// ==== Only the uploader can attach it ====
+	resp = f.IAmBob().POST("/questions", map[string]any{
+		"text": "is this stack trace familiar", "attachment_ids": []int{shot.ID},
+	})
+	f.Require().Equal(422, resp.StatusCode)
+
+	resp = f.IAmAlice().POST("/questions", map[string]any{
+		"text": "is this stack trace familiar", "attachment_ids": []int{shot.ID},
+	})
+	f.Require().Equal(201, resp.StatusCode)
+
+	var q FullFlowResponse
+	json.NewDecoder(resp.Body).Decode(&q)
+
+	// ==== Published with the question ====
+	resp = f.IAmBob().GET("/questions/" + strconv.Itoa(q.ID))
+	f.Require().Equal(200, resp.StatusCode)
+
+	var got struct {
+		Attachments []attachmentResponse `json:"attachments"`
+	}
+	json.NewDecoder(resp.Body).Decode(&got)
+	f.Require().Len(got.Attachments, 1)
+	f.Equal(shot.ID, got.Attachments[0].ID)
+
+	resp = f.IAmBob().GET(shot.URL)
+	f.Require().Equal(200, resp.StatusCode)
+	body, _ := io.ReadAll(resp.Body)
+	f.Equal(img.Bytes(), body)
+
+	resp = f.IAmBob().GET(shot.ThumbnailURL)
+	f.Require().Equal(200, resp.StatusCode)
+	thumb, err := png.DecodeConfig(resp.Body)
+	f.Require().NoError(err)
+	f.Equal(256, thumb.Width)
+	f.Equal(128, thumb.Height)
+
+	// ==== An attachment cannot move to another post ====
+	resp = f.IAmAlice().POST("/questions/"+strconv.Itoa(q.ID)+"/answers", map[string]any{
+		"text": "answering with the same screenshot", "attachment_ids": []int{shot.ID},
+	})
+	f.Equal(422, resp.StatusCode)
+}
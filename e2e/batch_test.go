@@ -0,0 +1,101 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type batchResponse struct {
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+	Results   []struct {
+		Status int    `json:"status"`
+		ID     int    `json:"id"`
+		Code   string `json:"code"`
+	} `json:"results"`
+}
+
+func (f *FullE2ESuite) Test_Batch() {
+	// ==== A question with its answers in one atomic batch ====
+	var questionID int
+	{
+		resp := f.IAmAlice().POST("/batch", map[string]any{
+			"atomic": true,
+			"operations": []map[string]any{
+				{"op": "create_question", "text": "imported question about batches", "force": true},
+				{"op": "create_answer", "text": "imported answer one", "question_ref": 0},
+				{"op": "create_answer", "text": "imported answer two", "question_ref": 0},
+			},
+		})
+		f.Require().Equal(200, resp.StatusCode)
+
+		var out batchResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal(3, out.Succeeded)
+		f.Equal(201, out.Results[0].Status)
+		questionID = out.Results[0].ID
+
+		resp = f.IAmBob().GET("/questions/" + strconv.Itoa(questionID))
+		f.Require().Equal(200, resp.StatusCode)
+
+		var q struct {
+			Answers []FullFlowResponse `json:"answers"`
+		}
+		json.NewDecoder(resp.Body).Decode(&q)
+		f.Len(q.Answers, 2)
+	}
+
+	// ==== A failure rolls the atomic batch back ====
+	{
+		resp := f.IAmAlice().POST("/batch", map[string]any{
+			"atomic": true,
+			"operations": []map[string]any{
+				{"op": "create_question", "text": "this one must not survive", "force": true},
+				{"op": "create_answer", "text": "no such question", "question_id": 999999},
+				{"op": "delete_question", "question_id": questionID},
+			},
+		})
+		f.Require().Equal(200, resp.StatusCode)
+
+		var out batchResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal(0, out.Succeeded)
+		f.Equal(424, out.Results[0].Status)
+		f.Equal(404, out.Results[1].Status)
+		f.Equal("skipped", out.Results[2].Code)
+
+		resp = f.IAmBob().GET("/questions")
+		f.Require().Equal(200, resp.StatusCode)
+
+		var list []struct {
+			Text string `json:"text"`
+		}
+		json.NewDecoder(resp.Body).Decode(&list)
+		for _, q := range list {
+			f.NotEqual("this one must not survive", q.Text)
+		}
+
+		resp = f.IAmBob().GET("/questions/" + strconv.Itoa(questionID))
+		f.Equal(200, resp.StatusCode)
+	}
+
+	// ==== Without atomic every operation stands alone ====
+	{
+		resp := f.IAmBob().POST("/batch", map[string]any{
+			"operations": []map[string]any{
+				{"op": "delete_question", "question_id": questionID},
+				{"op": "create_answer", "text": "bob keeps answering", "question_id": questionID},
+			},
+		})
+		f.Require().Equal(200, resp.StatusCode)
+
+		var out batchResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal(403, out.Results[0].Status)
+		f.Equal(201, out.Results[1].Status)
+		f.Equal(1, out.Failed)
+	}
+}
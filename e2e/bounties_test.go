@@ -0,0 +1,105 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+func (f *FullE2ESuite) reputationOf(user string) int {
+	resp := f.IAmAdmin().GET("/users/" + f.Users[user].UserID + "/reputation")
+	f.Require().Equal(200, resp.StatusCode)
+
+	var out reputationResponse
+	json.NewDecoder(resp.Body).Decode(&out)
+	return out.Total
+}
+
+func (f *FullE2ESuite) featuredBountyQuestions() []int {
+	resp := f.IAmAlice().GET("/questions/bounties?limit=100")
+	f.Require().Equal(200, resp.StatusCode)
+
+	var items []struct {
+		QuestionID int `json:"question_id"`
+	}
+	json.NewDecoder(resp.Body).Decode(&items)
+
+	ids := make([]int, len(items))
+	for i, item := range items {
+		ids[i] = item.QuestionID
+	}
+	return ids
+}
+
+func (f *FullE2ESuite) Test_Bounties() {
+	var qID, aID int
+	{
+		resp := f.IAmBob().POST("/questions", map[string]any{"text": "how do I bisect a flaky test?", "force": true})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		qID = out.ID
+	}
+	path := "/questions/" + strconv.Itoa(qID)
+
+	// ==== Bob earns enough reputation to fund a bounty ====
+	resp := f.IAmAlice().PUT(path+"/vote", map[string]any{"value": 1})
+	f.Require().Equal(204, resp.StatusCode)
+
+	bobStart := f.reputationOf("bob")
+
+	// ==== Only the asker can offer, within the configured range ====
+	{
+		resp := f.IAmAlice().POST(path+"/bounty", map[string]any{"amount": 5})
+		f.Require().Equal(403, resp.StatusCode)
+
+		resp = f.IAmBob().POST(path+"/bounty", map[string]any{"amount": 100000})
+		f.Require().Equal(422, resp.StatusCode)
+	}
+
+	// ==== The offer escrows the points and features the question ====
+	{
+		resp := f.IAmBob().POST(path+"/bounty", map[string]any{"amount": 5})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out struct {
+			Amount int    `json:"amount"`
+			Status string `json:"status"`
+		}
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal(5, out.Amount)
+		f.Equal("open", out.Status)
+
+		f.Equal(bobStart-5, f.reputationOf("bob"))
+		f.Contains(f.featuredBountyQuestions(), qID)
+
+		resp = f.IAmBob().POST(path+"/bounty", map[string]any{"amount": 5})
+		f.Require().Equal(409, resp.StatusCode)
+	}
+
+	{
+		resp := f.IAmAlice().POST(path+"/answers", map[string]any{"text": "run it in a loop with -count and git bisect run"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		aID = out.ID
+	}
+
+	aliceStart := f.reputationOf("alice")
+
+	// ==== Accepting the answer pays the bounty to its author ====
+	{
+		resp := f.IAmBob().POST("/answers/"+strconv.Itoa(aID)+"/accept", nil)
+		f.Require().Equal(204, resp.StatusCode)
+
+		f.Equal(aliceStart+15+5, f.reputationOf("alice"))
+		f.NotContains(f.featuredBountyQuestions(), qID)
+
+		resp = f.IAmBob().POST("/answers/"+strconv.Itoa(aID)+"/bounty", nil)
+		f.Require().Equal(404, resp.StatusCode)
+	}
+}
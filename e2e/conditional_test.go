@@ -0,0 +1,45 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+func (f *FullE2ESuite) Test_ConditionalGet() {
+	resp := f.IAmAlice().POST("/questions", map[string]any{"text": "do etags save bandwidth"})
+	f.Require().Equal(201, resp.StatusCode)
+
+	var q FullFlowResponse
+	json.NewDecoder(resp.Body).Decode(&q)
+	path := "/questions/" + strconv.Itoa(q.ID)
+
+	// ==== The same representation is not sent twice ====
+	resp = f.IAmBob().GET(path)
+	f.Require().Equal(200, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	f.Require().NotEmpty(etag)
+	f.Equal("private, no-cache", resp.Header.Get("Cache-Control"))
+
+	resp = f.IAmBob().GETIfNoneMatch(path, etag)
+	f.Require().Equal(304, resp.StatusCode)
+
+	// ==== A new answer changes the tag ====
+	resp = f.IAmBob().POST(path+"/answers", map[string]any{"text": "they do when nothing changed"})
+	f.Require().Equal(201, resp.StatusCode)
+
+	resp = f.IAmBob().GETIfNoneMatch(path, etag)
+	f.Require().Equal(200, resp.StatusCode)
+	answered := resp.Header.Get("ETag")
+	f.NotEqual(etag, answered)
+
+	// ==== So does a status change ====
+	resp = f.IAmAlice().POST(path+"/close", map[string]any{"reason": "answered"})
+	f.Require().Equal(200, resp.StatusCode)
+
+	resp = f.IAmBob().GETIfNoneMatch(path, answered)
+	f.Require().Equal(200, resp.StatusCode)
+	f.NotEqual(answered, resp.Header.Get("ETag"))
+}
@@ -0,0 +1,90 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type draftResponse struct {
+	Key           string `json:"key"`
+	Text          string `json:"text"`
+	AttachmentIDs []int  `json:"attachment_ids"`
+}
+
+func (f *FullE2ESuite) Test_Drafts() {
+	// ==== A draft is private to its author ====
+	resp := f.IAmAlice().PUT("/me/drafts/question", map[string]any{"text": "how are drafts kept"})
+	f.Require().Equal(200, resp.StatusCode)
+
+	resp = f.IAmAlice().PUT("/me/drafts/question", map[string]any{"text": "how are drafts kept between sessions"})
+	f.Require().Equal(200, resp.StatusCode)
+
+	resp = f.IAmAlice().GET("/me/drafts/question")
+	f.Require().Equal(200, resp.StatusCode)
+
+	var d draftResponse
+	json.NewDecoder(resp.Body).Decode(&d)
+	f.Equal(draftResponse{Key: "question", Text: "how are drafts kept between sessions", AttachmentIDs: []int{}}, d)
+
+	resp = f.IAmBob().GET("/me/drafts/question")
+	f.Equal(404, resp.StatusCode)
+
+	resp = f.IAmAlice().GET("/me/drafts/answer-0")
+	f.Equal(400, resp.StatusCode)
+
+	// ==== Publishing creates the question and drops the draft ====
+	resp = f.IAmAlice().POST("/me/drafts/question/publish", map[string]any{"force": true})
+	f.Require().Equal(201, resp.StatusCode)
+
+	var q FullFlowResponse
+	json.NewDecoder(resp.Body).Decode(&q)
+	path := "/questions/" + strconv.Itoa(q.ID)
+
+	resp = f.IAmAlice().GET("/me/drafts/question")
+	f.Equal(404, resp.StatusCode)
+
+	resp = f.IAmAlice().POST("/me/drafts/question/publish", map[string]any{})
+	f.Equal(404, resp.StatusCode)
+
+	// ==== An answer draft becomes an answer ====
+	key := "/me/drafts/answer-" + strconv.Itoa(q.ID)
+
+	resp = f.IAmBob().PUT(key, map[string]any{"text": "they live in the drafts table"})
+	f.Require().Equal(200, resp.StatusCode)
+
+	resp = f.IAmBob().POST(key+"/publish", map[string]any{})
+	f.Require().Equal(201, resp.StatusCode)
+
+	var a struct {
+		ID         int `json:"id"`
+		QuestionID int `json:"question_id"`
+	}
+	json.NewDecoder(resp.Body).Decode(&a)
+	f.Equal(q.ID, a.QuestionID)
+
+	resp = f.IAmBob().GET("/answers/" + strconv.Itoa(a.ID))
+	f.Equal(200, resp.StatusCode)
+
+	// ==== A refused publish keeps the draft ====
+	resp = f.IAmBob().PUT(key, map[string]any{"text": "and expire after a while"})
+	f.Require().Equal(200, resp.StatusCode)
+
+	resp = f.IAmAlice().POST(path+"/close", map[string]any{"reason": "answered"})
+	f.Require().Equal(200, resp.StatusCode)
+
+	resp = f.IAmBob().POST(key+"/publish", map[string]any{})
+	f.Equal(409, resp.StatusCode)
+
+	resp = f.IAmBob().GET(key)
+	f.Require().Equal(200, resp.StatusCode)
+
+	// ==== Deleting ====
+	resp = f.IAmBob().DELETE(key)
+	f.Equal(204, resp.StatusCode)
+
+	resp = f.IAmBob().DELETE(key)
+	f.Equal(404, resp.StatusCode)
+}
@@ -0,0 +1,86 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type duplicatesResponse struct {
+	ID                 int `json:"id"`
+	PossibleDuplicates []struct {
+		ID int `json:"id"`
+	} `json:"possible_duplicates"`
+	Warnings *struct {
+		PossibleDuplicates []struct {
+			ID int `json:"id"`
+		} `json:"possible_duplicates"`
+	} `json:"warnings"`
+}
+
+func (f *FullE2ESuite) Test_DuplicateFlow() {
+	var canonicalID, dupID int
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": "how do I close a channel twice safely"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out duplicatesResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		canonicalID = out.ID
+	}
+
+	// ==== A similar question is rejected with the candidates ====
+	{
+		resp := f.IAmBob().POST("/questions", map[string]any{"text": "how do I close a channel twice safely?"})
+		f.Require().Equal(409, resp.StatusCode)
+
+		var out duplicatesResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Require().NotEmpty(out.PossibleDuplicates)
+		f.Equal(canonicalID, out.PossibleDuplicates[0].ID)
+	}
+
+	// ==== With force it is created, the candidates come back as a warning ====
+	{
+		resp := f.IAmBob().POST("/questions", map[string]any{"text": "how do I close a channel twice safely?", "force": true})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out duplicatesResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Require().NotNil(out.Warnings)
+		f.Equal(canonicalID, out.Warnings.PossibleDuplicates[0].ID)
+		dupID = out.ID
+	}
+
+	// ==== Only moderators mark duplicates ====
+	{
+		resp := f.IAmAlice().POST("/questions/"+strconv.Itoa(dupID)+"/duplicate", map[string]any{"duplicate_of": canonicalID})
+		f.Require().Equal(403, resp.StatusCode)
+
+		resp = f.IAmAdmin().POST("/questions/"+strconv.Itoa(dupID)+"/duplicate", map[string]any{"duplicate_of": canonicalID})
+		f.Require().Equal(204, resp.StatusCode)
+	}
+
+	// ==== Readers of the duplicate land on the canonical question ====
+	{
+		resp := f.IAmBob().GET("/questions/" + strconv.Itoa(dupID))
+		f.Require().Equal(200, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal(canonicalID, out.ID)
+	}
+
+	// ==== Marked duplicates are not suggested again ====
+	{
+		resp := f.IAmBob().POST("/questions", map[string]any{"text": "how do I close a channel twice safely?!"})
+		f.Require().Equal(409, resp.StatusCode)
+
+		var out duplicatesResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Require().Len(out.PossibleDuplicates, 1)
+		f.Equal(canonicalID, out.PossibleDuplicates[0].ID)
+	}
+}
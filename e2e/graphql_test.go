@@ -0,0 +1,120 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+)
+
+type graphqlResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func (f *FullE2ESuite) graphql(query string, variables map[string]any) graphqlResponse {
+	resp := f.POST("/graphql", map[string]any{"query": query, "variables": variables})
+	f.Require().Equal(200, resp.StatusCode)
+
+	var out graphqlResponse
+	f.Require().NoError(json.NewDecoder(resp.Body).Decode(&out))
+	return out
+}
+
+func (f *FullE2ESuite) Test_GraphQL() {
+	// ==== Mutations act as the user the request is authenticated as ====
+	var questionID string
+	{
+		f.IAmAlice()
+		out := f.graphql(`mutation($text: String!) {
+			createQuestion(text: $text, force: true) { question { id author { username } } }
+		}`, map[string]any{"text": "how do graphql resolvers batch their loads?"})
+		f.Require().Empty(out.Errors)
+
+		var data struct {
+			CreateQuestion struct {
+				Question struct {
+					ID     string `json:"id"`
+					Author struct {
+						Username string `json:"username"`
+					} `json:"author"`
+				} `json:"question"`
+			} `json:"createQuestion"`
+		}
+		f.Require().NoError(json.Unmarshal(out.Data, &data))
+		f.Equal("alice", data.CreateQuestion.Question.Author.Username)
+		questionID = data.CreateQuestion.Question.ID
+
+		f.IAmBob()
+		out = f.graphql(`mutation($id: ID!) {
+			createAnswer(questionId: $id, text: "one query per kind of thing") { id question { id } }
+		}`, map[string]any{"id": questionID})
+		f.Require().Empty(out.Errors)
+	}
+
+	// ==== A question reads with its answers and their authors ====
+	{
+		f.IAmBob()
+		out := f.graphql(`query($id: ID!) {
+			question(id: $id) {
+				text status answerCount
+				author { username }
+				answers { text author { username reputation } }
+			}
+		}`, map[string]any{"id": questionID})
+		f.Require().Empty(out.Errors)
+
+		var data struct {
+			Question struct {
+				Text        string `json:"text"`
+				Status      string `json:"status"`
+				AnswerCount int    `json:"answerCount"`
+				Author      struct {
+					Username string `json:"username"`
+				} `json:"author"`
+				Answers []struct {
+					Text   string `json:"text"`
+					Author struct {
+						Username string `json:"username"`
+					} `json:"author"`
+				} `json:"answers"`
+			} `json:"question"`
+		}
+		f.Require().NoError(json.Unmarshal(out.Data, &data))
+		f.Equal("OPEN", data.Question.Status)
+		f.Equal(1, data.Question.AnswerCount)
+		f.Equal("alice", data.Question.Author.Username)
+		f.Require().Len(data.Question.Answers, 1)
+		f.Equal("bob", data.Question.Answers[0].Author.Username)
+
+		out = f.graphql(`{ question(id: "999999") { id } }`, nil)
+		f.Empty(out.Errors)
+		f.JSONEq(`{"question":null}`, string(out.Data))
+	}
+
+	// ==== Only the author deletes ====
+	{
+		f.IAmBob()
+		out := f.graphql(`mutation($id: ID!) { deleteQuestion(id: $id) }`, map[string]any{"id": questionID})
+		f.Require().Len(out.Errors, 1)
+		f.Equal("access_denied", out.Errors[0].Extensions["code"])
+
+		f.IAmAlice()
+		out = f.graphql(`mutation($id: ID!) { deleteQuestion(id: $id) }`, map[string]any{"id": questionID})
+		f.Require().Empty(out.Errors)
+		f.JSONEq(`{"deleteQuestion":true}`, string(out.Data))
+	}
+
+	// ==== Queries beyond the limits are refused ====
+	{
+		f.IAmBob()
+		out := f.graphql(`{
+			questions(first: 100) { answers(first: 100) { author { username } } }
+		}`, nil)
+		f.Require().Len(out.Errors, 1)
+		f.Equal("query_too_complex", out.Errors[0].Extensions["code"])
+	}
+}
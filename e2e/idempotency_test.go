@@ -0,0 +1,63 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+func (f *FullE2ESuite) Test_IdempotencyKey() {
+	body := map[string]any{"text": "how do idempotency keys work"}
+
+	// ==== A retry replays the first response ====
+	resp := f.IAmAlice().POSTWithKey("/questions", "q-retry-1", body)
+	f.Require().Equal(201, resp.StatusCode)
+
+	var first FullFlowResponse
+	json.NewDecoder(resp.Body).Decode(&first)
+
+	resp = f.IAmAlice().POSTWithKey("/questions", "q-retry-1", body)
+	f.Require().Equal(201, resp.StatusCode)
+	f.Equal("true", resp.Header.Get("Idempotent-Replayed"))
+
+	var retried FullFlowResponse
+	json.NewDecoder(resp.Body).Decode(&retried)
+	f.Equal(first.ID, retried.ID)
+
+	// ==== Keys are per user ====
+	resp = f.IAmBob().POSTWithKey("/questions", "q-retry-1", body)
+	f.Require().Equal(201, resp.StatusCode)
+	f.Empty(resp.Header.Get("Idempotent-Replayed"))
+
+	// ==== Reusing a key for another payload is refused ====
+	resp = f.IAmAlice().POSTWithKey("/questions", "q-retry-1", map[string]any{"text": "a different question"})
+	f.Require().Equal(422, resp.StatusCode)
+
+	// ==== Answers are covered too ====
+	path := "/questions/" + strconv.Itoa(first.ID) + "/answers"
+	answer := map[string]any{"text": "the first response is stored"}
+
+	resp = f.IAmBob().POSTWithKey(path, "a-retry-1", answer)
+	f.Require().Equal(201, resp.StatusCode)
+
+	var a1 FullFlowResponse
+	json.NewDecoder(resp.Body).Decode(&a1)
+
+	resp = f.IAmBob().POSTWithKey(path, "a-retry-1", answer)
+	f.Require().Equal(201, resp.StatusCode)
+
+	var a2 FullFlowResponse
+	json.NewDecoder(resp.Body).Decode(&a2)
+	f.Equal(a1.ID, a2.ID)
+
+	resp = f.IAmAlice().GET("/questions/" + strconv.Itoa(first.ID))
+	f.Require().Equal(200, resp.StatusCode)
+
+	var q struct {
+		Answers []FullFlowResponse `json:"answers"`
+	}
+	json.NewDecoder(resp.Body).Decode(&q)
+	f.Len(q.Answers, 1)
+}
@@ -0,0 +1,91 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type transitionItem struct {
+	Action  string `json:"action"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	ActorID string `json:"actor_id"`
+	Reason  string `json:"reason"`
+}
+
+func (f *FullE2ESuite) Test_LifecycleFlow() {
+	var qID int
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": "what is the lifecycle of a goroutine"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		qID = out.ID
+	}
+	path := "/questions/" + strconv.Itoa(qID)
+
+	// ==== Closing needs a reason and the owner or a moderator ====
+	{
+		resp := f.IAmAlice().POST(path+"/close", nil)
+		f.Require().Equal(400, resp.StatusCode)
+
+		resp = f.IAmBob().POST(path+"/close", map[string]any{"reason": "not mine"})
+		f.Require().Equal(403, resp.StatusCode)
+
+		resp = f.IAmAlice().POST(path+"/close", map[string]any{"reason": "figured it out"})
+		f.Require().Equal(200, resp.StatusCode)
+	}
+
+	// ==== A closed question takes no answers ====
+	{
+		resp := f.IAmBob().POST(path+"/answers", map[string]any{"text": "too late"})
+		f.Require().Equal(409, resp.StatusCode)
+
+		resp = f.IAmAlice().POST(path+"/close", map[string]any{"reason": "again"})
+		f.Require().Equal(409, resp.StatusCode)
+	}
+
+	// ==== Reopened, then locked by a moderator: no answers, no votes ====
+	{
+		resp := f.IAmAlice().POST(path+"/reopen", nil)
+		f.Require().Equal(200, resp.StatusCode)
+
+		resp = f.IAmAlice().POST(path+"/lock", map[string]any{"reason": "heated"})
+		f.Require().Equal(403, resp.StatusCode)
+
+		resp = f.IAmAdmin().POST(path+"/lock", map[string]any{"reason": "heated"})
+		f.Require().Equal(200, resp.StatusCode)
+
+		resp = f.IAmBob().POST(path+"/answers", map[string]any{"text": "still here"})
+		f.Require().Equal(409, resp.StatusCode)
+
+		resp = f.IAmBob().PUT(path+"/vote", map[string]any{"value": 1})
+		f.Require().Equal(409, resp.StatusCode)
+
+		resp = f.IAmAlice().GET(path)
+		f.Require().Equal(200, resp.StatusCode)
+
+		var out struct {
+			Status string `json:"status"`
+		}
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal("locked", out.Status)
+	}
+
+	// ==== Every transition is in the history ====
+	{
+		resp := f.IAmBob().GET(path + "/history")
+		f.Require().Equal(200, resp.StatusCode)
+
+		var history []transitionItem
+		json.NewDecoder(resp.Body).Decode(&history)
+		f.Require().Len(history, 3)
+		f.Equal(transitionItem{Action: "close", From: "open", To: "closed", ActorID: f.Users["alice"].UserID, Reason: "figured it out"}, history[0])
+		f.Equal("reopen", history[1].Action)
+		f.Equal(transitionItem{Action: "lock", From: "open", To: "locked", ActorID: f.Users["admin"].UserID, Reason: "heated"}, history[2])
+	}
+}
@@ -0,0 +1,60 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+)
+
+type localizedError struct {
+	Code          string            `json:"code"`
+	Message       string            `json:"message"`
+	Fields        map[string]string `json:"fields"`
+	FieldMessages map[string]string `json:"field_messages"`
+}
+
+func (f *FullE2ESuite) Test_LocalizedErrors() {
+	// ==== The code stays, the message follows Accept-Language ====
+	{
+		resp := f.IAmAlice().GETInLanguage("/questions/999999", "ru-RU,ru;q=0.9,en;q=0.8")
+		f.Require().Equal(404, resp.StatusCode)
+		f.Equal("ru", resp.Header.Get("Content-Language"))
+
+		var out localizedError
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal("question_not_found", out.Code)
+		f.Equal("Вопрос не найден.", out.Message)
+
+		resp = f.IAmAlice().GETInLanguage("/questions/999999", "de-DE")
+		f.Require().Equal(404, resp.StatusCode)
+		f.Equal("en", resp.Header.Get("Content-Language"))
+
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal("question_not_found", out.Code)
+		f.Equal("Question not found.", out.Message)
+	}
+
+	// ==== Validator errors are translated with their params ====
+	{
+		resp := f.IAmAdmin().POSTInLanguage("/admin/webhooks", "ru", map[string]any{
+			"url":    "https://example.com/hook",
+			"events": []string{"answer.created"},
+			"secret": "short",
+		})
+		f.Require().Equal(422, resp.StatusCode)
+
+		var out localizedError
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal("validation_failed", out.Code)
+		f.Equal("min", out.Fields["Secret"])
+		f.Equal("должно содержать не менее 16 символов", out.FieldMessages["Secret"])
+
+		resp = f.IAmAlice().POSTInLanguage("/questions", "en", map[string]any{"text": ""})
+		f.Require().Equal(422, resp.StatusCode)
+
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal("required", out.Fields["Text"])
+		f.Equal("is required", out.FieldMessages["Text"])
+	}
+}
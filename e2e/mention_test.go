@@ -0,0 +1,99 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+type mentionsResponse struct {
+	Items []struct {
+		ID         int    `json:"id"`
+		AuthorID   string `json:"author_id"`
+		QuestionID int    `json:"question_id"`
+		AnswerID   int    `json:"answer_id"`
+	} `json:"items"`
+}
+
+type mentionDTO struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+func (f *FullE2ESuite) Test_Mentions() {
+	// ==== Unknown names are ignored ====
+	resp := f.IAmAlice().POST("/questions", map[string]any{
+		"text": "@bob and @nobody-here, is the cache warmed on deploy?", "force": true,
+	})
+	f.Require().Equal(201, resp.StatusCode)
+
+	var q struct {
+		ID       int          `json:"id"`
+		Mentions []mentionDTO `json:"mentions"`
+	}
+	json.NewDecoder(resp.Body).Decode(&q)
+	f.Equal([]mentionDTO{{UserID: f.Users["bob"].UserID, Username: "bob"}}, q.Mentions)
+
+	path := "/questions/" + strconv.Itoa(q.ID)
+
+	// ==== Bob is notified through the outbox ====
+	f.Require().Eventually(func() bool {
+		for _, n := range f.notifications(f.IAmBob(), "?unread=true").Items {
+			if n.Type == "mention" && n.QuestionID == q.ID {
+				return true
+			}
+		}
+		return false
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// ==== An answer mentioning the asker ====
+	resp = f.IAmBob().POST(path+"/answers", map[string]any{"text": "it is, @alice."})
+	f.Require().Equal(201, resp.StatusCode)
+
+	var a struct {
+		ID       int          `json:"id"`
+		Mentions []mentionDTO `json:"mentions"`
+	}
+	json.NewDecoder(resp.Body).Decode(&a)
+	f.Equal([]mentionDTO{{UserID: f.Users["alice"].UserID, Username: "alice"}}, a.Mentions)
+
+	resp = f.IAmAdmin().GET(path)
+	f.Require().Equal(200, resp.StatusCode)
+
+	var shown struct {
+		Mentions []mentionDTO `json:"mentions"`
+		Answers  []struct {
+			ID       int          `json:"id"`
+			Mentions []mentionDTO `json:"mentions"`
+		} `json:"answers"`
+	}
+	json.NewDecoder(resp.Body).Decode(&shown)
+	f.Len(shown.Mentions, 1)
+	f.Require().Len(shown.Answers, 1)
+	f.Equal(a.Mentions, shown.Answers[0].Mentions)
+
+	// ==== Both show up in the mentioned users' lists ====
+	resp = f.IAmAlice().GET("/me/mentions?limit=1")
+	f.Require().Equal(200, resp.StatusCode)
+
+	var mine mentionsResponse
+	json.NewDecoder(resp.Body).Decode(&mine)
+	f.Require().Len(mine.Items, 1)
+	f.Equal(a.ID, mine.Items[0].AnswerID)
+	f.Equal(f.Users["bob"].UserID, mine.Items[0].AuthorID)
+
+	// ==== A trashed answer drops out ====
+	resp = f.IAmBob().DELETE("/answers/" + strconv.Itoa(a.ID))
+	f.Require().Equal(204, resp.StatusCode)
+
+	resp = f.IAmAlice().GET("/me/mentions")
+	f.Require().Equal(200, resp.StatusCode)
+	mine = mentionsResponse{}
+	json.NewDecoder(resp.Body).Decode(&mine)
+	for _, m := range mine.Items {
+		f.NotEqual(a.ID, m.AnswerID)
+	}
+}
@@ -0,0 +1,128 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type moderationQueueResponse struct {
+	Items []struct {
+		TargetType string   `json:"target_type"`
+		TargetID   int      `json:"target_id"`
+		Hidden     bool     `json:"hidden"`
+		Reports    int      `json:"reports"`
+		Reasons    []string `json:"reasons"`
+	} `json:"items"`
+}
+
+func (f *FullE2ESuite) Test_ModerationFlow() {
+	var qID int
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": "cheap watches visit my shop"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		qID = out.ID
+	}
+	path := "/questions/" + strconv.Itoa(qID)
+
+	// ==== Authors cannot report themselves, others report once ====
+	{
+		resp := f.IAmAlice().POST(path+"/report", map[string]any{"reason": "spam"})
+		f.Require().Equal(403, resp.StatusCode)
+
+		resp = f.IAmBob().POST(path+"/report", map[string]any{"reason": "boring"})
+		f.Require().Equal(400, resp.StatusCode)
+
+		resp = f.IAmBob().POST(path+"/report", map[string]any{"reason": "spam", "note": "ads"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		resp = f.IAmBob().POST(path+"/report", map[string]any{"reason": "spam"})
+		f.Require().Equal(409, resp.StatusCode)
+
+		resp = f.IAmBob().GET(path)
+		f.Require().Equal(200, resp.StatusCode)
+	}
+
+	// ==== Reaching the threshold hides the question ====
+	{
+		resp := f.IAmAdmin().POST(path+"/report", map[string]any{"reason": "off_topic"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		resp = f.IAmBob().GET(path)
+		f.Require().Equal(404, resp.StatusCode)
+	}
+
+	// ==== Moderators see it in the queue ====
+	{
+		resp := f.IAmBob().GET("/moderation/queue")
+		f.Require().Equal(403, resp.StatusCode)
+
+		resp = f.IAmAdmin().GET("/moderation/queue")
+		f.Require().Equal(200, resp.StatusCode)
+
+		var out moderationQueueResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Require().NotEmpty(out.Items)
+		f.Equal("question", out.Items[0].TargetType)
+		f.Equal(qID, out.Items[0].TargetID)
+		f.Equal(2, out.Items[0].Reports)
+		f.True(out.Items[0].Hidden)
+		f.ElementsMatch([]string{"off_topic", "spam"}, out.Items[0].Reasons)
+	}
+
+	// ==== A warning shows the question again and notifies the author ====
+	{
+		resp := f.IAmAdmin().POST("/moderation/questions/"+strconv.Itoa(qID)+"/resolve", map[string]any{"action": "warn"})
+		f.Require().Equal(200, resp.StatusCode)
+
+		resp = f.IAmAdmin().POST("/moderation/questions/"+strconv.Itoa(qID)+"/resolve", map[string]any{"action": "warn"})
+		f.Require().Equal(409, resp.StatusCode)
+
+		resp = f.IAmBob().GET(path)
+		f.Require().Equal(200, resp.StatusCode)
+
+		resp = f.IAmAlice().GET("/me/notifications")
+		f.Require().Equal(200, resp.StatusCode)
+
+		var out struct {
+			Items []struct {
+				Type       string `json:"type"`
+				QuestionID int    `json:"question_id"`
+			} `json:"items"`
+		}
+		json.NewDecoder(resp.Body).Decode(&out)
+
+		warned := false
+		for _, n := range out.Items {
+			warned = warned || (n.Type == "warning" && n.QuestionID == qID)
+		}
+		f.True(warned)
+	}
+
+	// ==== Reported again and deleted by a moderator ====
+	{
+		resp := f.IAmBob().POST(path+"/report", map[string]any{"reason": "spam"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		resp = f.IAmAdmin().POST("/moderation/questions/"+strconv.Itoa(qID)+"/resolve", map[string]any{"action": "delete"})
+		f.Require().Equal(200, resp.StatusCode)
+
+		resp = f.IAmBob().GET(path)
+		f.Require().Equal(404, resp.StatusCode)
+
+		// the author cannot take it back out of the trash
+		resp = f.IAmAlice().POST(path+"/restore", nil)
+		f.Require().Equal(403, resp.StatusCode)
+
+		var out struct {
+			Code string `json:"code"`
+		}
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal("removed_by_moderator", out.Code)
+	}
+}
@@ -0,0 +1,115 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	e2e "test-question/internal/tests/e2esuite"
+)
+
+type notificationsResponse struct {
+	Items []struct {
+		ID         int     `json:"id"`
+		Type       string  `json:"type"`
+		ActorID    string  `json:"actor_id"`
+		QuestionID int     `json:"question_id"`
+		ReadAt     *string `json:"read_at"`
+	} `json:"items"`
+	NextCursor int `json:"next_cursor"`
+}
+
+func (f *FullE2ESuite) notifications(as *e2e.E2ESuite, query string) notificationsResponse {
+	resp := as.GET("/me/notifications" + query)
+	f.Require().Equal(200, resp.StatusCode)
+
+	var out notificationsResponse
+	json.NewDecoder(resp.Body).Decode(&out)
+	return out
+}
+
+func (f *FullE2ESuite) Test_NotificationFlow() {
+	var qID int
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": "who will answer?"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		qID = out.ID
+	}
+
+	// ==== Bob follows and answers: only Alice is notified ====
+	{
+		resp := f.IAmBob().POST("/questions/"+strconv.Itoa(qID)+"/follow", nil)
+		f.Require().Equal(204, resp.StatusCode)
+
+		resp = f.IAmBob().POST("/questions/"+strconv.Itoa(qID)+"/answers", map[string]any{"text": "me"})
+		f.Require().Equal(201, resp.StatusCode)
+	}
+
+	// notifications are fanned out by the outbox relay, after the answer commits
+	var out notificationsResponse
+	f.Require().Eventually(func() bool {
+		out = f.notifications(f.IAmAlice(), "?unread=true&limit=1")
+		return len(out.Items) == 1 && out.Items[0].QuestionID == qID
+	}, 5*time.Second, 50*time.Millisecond)
+
+	notificationID := out.Items[0].ID
+	f.Equal("new_answer", out.Items[0].Type)
+	f.Equal(f.Users["bob"].UserID, out.Items[0].ActorID)
+
+	for _, n := range f.notifications(f.IAmBob(), "?unread=true").Items {
+		f.NotEqual(qID, n.QuestionID)
+	}
+
+	// ==== Only the recipient can mark it read ====
+	{
+		resp := f.IAmBob().POST("/me/notifications/"+strconv.Itoa(notificationID)+"/read", nil)
+		f.Require().Equal(404, resp.StatusCode)
+
+		resp = f.IAmAlice().POST("/me/notifications/"+strconv.Itoa(notificationID)+"/read", nil)
+		f.Require().Equal(204, resp.StatusCode)
+	}
+
+	// ==== Alice mutes new answers ====
+	{
+		resp := f.IAmAlice().PUT("/me/notification-settings", map[string]any{
+			"settings": map[string]bool{"new_answer": false},
+		})
+		f.Require().Equal(200, resp.StatusCode)
+
+		resp = f.IAmAlice().PUT("/me/notification-settings", map[string]any{
+			"settings": map[string]bool{"carrier_pigeon": true},
+		})
+		f.Require().Equal(400, resp.StatusCode)
+
+		// the admin follows too, so there is a relayed answer to wait for
+		resp = f.IAmAdmin().POST("/questions/"+strconv.Itoa(qID)+"/follow", nil)
+		f.Require().Equal(204, resp.StatusCode)
+
+		resp = f.IAmBob().POST("/questions/"+strconv.Itoa(qID)+"/answers", map[string]any{"text": "me again"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		f.Require().Eventually(func() bool {
+			for _, n := range f.notifications(f.IAmAdmin(), "?unread=true").Items {
+				if n.QuestionID == qID {
+					return true
+				}
+			}
+			return false
+		}, 5*time.Second, 50*time.Millisecond)
+
+		for _, n := range f.notifications(f.IAmAlice(), "?unread=true").Items {
+			f.NotEqual(qID, n.QuestionID)
+		}
+
+		resp = f.IAmAlice().PUT("/me/notification-settings", map[string]any{
+			"settings": map[string]bool{"new_answer": true},
+		})
+		f.Require().Equal(200, resp.StatusCode)
+	}
+}
@@ -0,0 +1,38 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+func (f *FullE2ESuite) Test_ContentPolicy() {
+	// ==== Default rules cap the text length ====
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": strings.Repeat("long question ", 1000)})
+		f.Require().Equal(422, resp.StatusCode)
+
+		var out struct {
+			Code   string            `json:"code"`
+			Fields map[string]string `json:"fields"`
+		}
+		json.NewDecoder(resp.Body).Decode(&out)
+		f.Equal("validation_failed", out.Code)
+		f.Equal("max_length", out.Fields["Text"])
+	}
+
+	// ==== Repeated words are refused in answers too ====
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": "is a policy engine worth it"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var q FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&q)
+
+		resp = f.IAmBob().POST("/questions/"+strconv.Itoa(q.ID)+"/answers", map[string]any{"text": strings.Repeat("yes ", 20)})
+		f.Require().Equal(422, resp.StatusCode)
+	}
+}
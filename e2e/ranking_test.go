@@ -0,0 +1,63 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"time"
+)
+
+func (f *FullE2ESuite) rankedIDs(path string) []int {
+	resp := f.IAmBob().GET(path)
+	f.Require().Equal(200, resp.StatusCode)
+
+	var items []struct {
+		ID int `json:"id"`
+	}
+	json.NewDecoder(resp.Body).Decode(&items)
+
+	ids := make([]int, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	return ids
+}
+
+func (f *FullE2ESuite) Test_Ranking() {
+	create := func(text string) int {
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": text, "force": true})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var q FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&q)
+		return q.ID
+	}
+
+	// the quiet question is newer: unranked, it would be listed first
+	busy := create("why does the linker drop my init function")
+	quiet := create("does anyone still use cgi-bin")
+	path := "/questions/" + strconv.Itoa(busy)
+
+	resp := f.IAmBob().POST(path+"/answers", map[string]any{"text": "it is unreferenced, blank-import the package"})
+	f.Require().Equal(201, resp.StatusCode)
+	resp = f.IAmBob().PUT(path+"/vote", map[string]any{"value": 1})
+	f.Require().Equal(204, resp.StatusCode)
+
+	// ==== The active question is ranked above the quiet one ====
+	f.Require().Eventually(func() bool {
+		hot := f.rankedIDs("/questions?sort=hot")
+		b, q := slices.Index(hot, busy), slices.Index(hot, quiet)
+		return b >= 0 && q >= 0 && b < q
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// ==== Only questions with activity are trending ====
+	trending := f.rankedIDs("/questions/trending?limit=100")
+	f.Contains(trending, busy)
+	f.NotContains(trending, quiet)
+
+	resp = f.IAmBob().GET("/questions?sort=random")
+	f.Equal(400, resp.StatusCode)
+}
@@ -0,0 +1,94 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type reputationResponse struct {
+	Total   int `json:"total"`
+	History []struct {
+		Reason     string `json:"reason"`
+		Delta      int    `json:"delta"`
+		ReversalOf int    `json:"reversal_of"`
+	} `json:"history"`
+}
+
+func (f *FullE2ESuite) bobReputation() reputationResponse {
+	resp := f.IAmAlice().GET("/users/" + f.Users["bob"].UserID + "/reputation")
+	f.Require().Equal(200, resp.StatusCode)
+
+	var out reputationResponse
+	json.NewDecoder(resp.Body).Decode(&out)
+	return out
+}
+
+func (f *FullE2ESuite) Test_ReputationFlow() {
+	start := f.bobReputation().Total
+
+	var qID, aID int
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": "how to earn reputation?"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		qID = out.ID
+	}
+	{
+		resp := f.IAmBob().POST("/questions/"+strconv.Itoa(qID)+"/answers", map[string]any{"text": "answer well"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		aID = out.ID
+	}
+
+	// ==== Bob cannot vote for his own answer ====
+	{
+		resp := f.IAmBob().PUT("/answers/"+strconv.Itoa(aID)+"/vote", map[string]any{"value": 1})
+		f.Require().Equal(403, resp.StatusCode)
+	}
+
+	// ==== Alice upvotes and accepts: +10 +15 ====
+	{
+		resp := f.IAmAlice().PUT("/answers/"+strconv.Itoa(aID)+"/vote", map[string]any{"value": 1})
+		f.Require().Equal(204, resp.StatusCode)
+
+		resp = f.IAmAlice().POST("/answers/"+strconv.Itoa(aID)+"/accept", nil)
+		f.Require().Equal(204, resp.StatusCode)
+
+		f.Equal(start+25, f.bobReputation().Total)
+	}
+
+	// ==== Alice retracts her vote: upvote is reversed ====
+	{
+		resp := f.IAmAlice().DELETE("/answers/" + strconv.Itoa(aID) + "/vote")
+		f.Require().Equal(204, resp.StatusCode)
+
+		rep := f.bobReputation()
+		f.Equal(start+15, rep.Total)
+		f.Equal(-10, rep.History[0].Delta)
+		f.NotZero(rep.History[0].ReversalOf)
+	}
+
+	// ==== Bob deletes his answer: acceptance is reversed too ====
+	{
+		resp := f.IAmBob().DELETE("/answers/" + strconv.Itoa(aID))
+		f.Require().Equal(204, resp.StatusCode)
+
+		f.Equal(start, f.bobReputation().Total)
+	}
+
+	// ==== Leaderboard accepts only known periods ====
+	{
+		resp := f.IAmAlice().GET("/leaderboard?period=week")
+		f.Require().Equal(200, resp.StatusCode)
+
+		resp = f.IAmAlice().GET("/leaderboard?period=year")
+		f.Require().Equal(400, resp.StatusCode)
+	}
+}
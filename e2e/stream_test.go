@@ -0,0 +1,127 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type sseEvent struct {
+	ID    string
+	Event string
+	Data  string
+}
+
+// readEvents parses an SSE body into events; comments such as heartbeats are skipped.
+func readEvents(body io.Reader) <-chan sseEvent {
+	ch := make(chan sseEvent, 16)
+
+	go func() {
+		defer close(ch)
+
+		var e sseEvent
+		sc := bufio.NewScanner(body)
+		for sc.Scan() {
+			line := sc.Text()
+			switch {
+			case line == "":
+				if e.Event != "" {
+					ch <- e
+				}
+				e = sseEvent{}
+			case strings.HasPrefix(line, "id: "):
+				e.ID = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				e.Event = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				e.Data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+
+	return ch
+}
+
+func (f *FullE2ESuite) nextEvent(ch <-chan sseEvent) sseEvent {
+	select {
+	case e, ok := <-ch:
+		f.Require().True(ok, "stream closed")
+		return e
+	case <-time.After(5 * time.Second):
+		f.FailNow("no stream event within 5s")
+		return sseEvent{}
+	}
+}
+
+func (f *FullE2ESuite) Test_StreamFlow() {
+	var qID int
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": "watch me live"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		qID = out.ID
+	}
+	path := "/questions/" + strconv.Itoa(qID) + "/events"
+
+	// ==== Unknown question and per-user limit ====
+	{
+		resp := f.IAmBob().OpenStream("/questions/999999/events", "")
+		f.Equal(404, resp.StatusCode)
+		resp.Body.Close()
+
+		s1 := f.IAmBob().OpenStream(path, "")
+		s2 := f.IAmBob().OpenStream(path, "")
+		s3 := f.IAmBob().OpenStream(path, "")
+		f.Equal(200, s1.StatusCode)
+		f.Equal(200, s2.StatusCode)
+		f.Equal(429, s3.StatusCode)
+		s1.Body.Close()
+		s2.Body.Close()
+		s3.Body.Close()
+	}
+
+	// ==== Alice watches Bob answer and delete ====
+	stream := f.IAmAlice().OpenStream(path, "")
+	f.Require().Equal(200, stream.StatusCode)
+	f.Equal("text/event-stream", stream.Header.Get("Content-Type"))
+	events := readEvents(stream.Body)
+
+	var answerID int
+	{
+		resp := f.IAmBob().POST("/questions/"+strconv.Itoa(qID)+"/answers", map[string]any{"text": "live answer"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		answerID = out.ID
+	}
+
+	created := f.nextEvent(events)
+	f.Equal("answer.created", created.Event)
+	f.Contains(created.Data, "live answer")
+	f.Contains(created.Data, `"id":`+strconv.Itoa(answerID))
+
+	resp := f.IAmBob().DELETE("/answers/" + strconv.Itoa(answerID))
+	f.Require().Equal(204, resp.StatusCode)
+
+	deleted := f.nextEvent(events)
+	f.Equal("answer.deleted", deleted.Event)
+	stream.Body.Close()
+
+	// ==== Reconnecting with Last-Event-ID replays what came after it ====
+	resumed := f.IAmAlice().OpenStream(path, created.ID)
+	defer resumed.Body.Close()
+	f.Require().Equal(200, resumed.StatusCode)
+
+	replayed := f.nextEvent(readEvents(resumed.Body))
+	f.Equal(deleted.ID, replayed.ID)
+	f.Equal("answer.deleted", replayed.Event)
+}
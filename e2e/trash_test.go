@@ -0,0 +1,103 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+type trashListItem struct {
+	ID        int     `json:"id"`
+	DeletedAt *string `json:"deleted_at"`
+}
+
+func (f *FullE2ESuite) Test_TrashFlow() {
+	var qID, keptID, droppedID int
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": "will this survive the trash?"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		qID = out.ID
+	}
+	for _, id := range []*int{&keptID, &droppedID} {
+		resp := f.IAmBob().POST("/questions/"+strconv.Itoa(qID)+"/answers", map[string]any{"text": "an answer"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		*id = out.ID
+	}
+
+	// ==== Bob deletes one answer on his own, then Alice deletes the question ====
+	{
+		resp := f.IAmBob().DELETE("/answers/" + strconv.Itoa(droppedID))
+		f.Require().Equal(204, resp.StatusCode)
+
+		resp = f.IAmAlice().DELETE("/questions/" + strconv.Itoa(qID))
+		f.Require().Equal(204, resp.StatusCode)
+
+		resp = f.IAmAlice().GET("/questions/" + strconv.Itoa(qID))
+		f.Require().Equal(404, resp.StatusCode)
+	}
+
+	// ==== A cascaded answer cannot be restored while its question is in the trash ====
+	{
+		resp := f.IAmBob().POST("/answers/"+strconv.Itoa(keptID)+"/restore", nil)
+		f.Require().Equal(409, resp.StatusCode)
+	}
+
+	// ==== Only admins see deleted questions ====
+	{
+		resp := f.IAmAlice().GET("/questions?include_deleted=true")
+		f.Require().Equal(403, resp.StatusCode)
+
+		resp = f.IAmAdmin().GET("/questions?include_deleted=true")
+		f.Require().Equal(200, resp.StatusCode)
+
+		var items []trashListItem
+		json.NewDecoder(resp.Body).Decode(&items)
+
+		found := false
+		for _, it := range items {
+			if it.ID == qID {
+				found = true
+				f.NotNil(it.DeletedAt)
+			}
+		}
+		f.True(found)
+	}
+
+	// ==== Only the owner restores; a live question cannot be restored ====
+	{
+		resp := f.IAmBob().POST("/questions/"+strconv.Itoa(qID)+"/restore", nil)
+		f.Require().Equal(403, resp.StatusCode)
+
+		resp = f.IAmAlice().POST("/questions/"+strconv.Itoa(qID)+"/restore", nil)
+		f.Require().Equal(204, resp.StatusCode)
+
+		resp = f.IAmAlice().POST("/questions/"+strconv.Itoa(qID)+"/restore", nil)
+		f.Require().Equal(409, resp.StatusCode)
+	}
+
+	// ==== The cascaded answer is back, the one deleted earlier is not ====
+	{
+		resp := f.IAmAlice().GET("/answers/" + strconv.Itoa(keptID))
+		f.Require().Equal(200, resp.StatusCode)
+
+		resp = f.IAmAlice().GET("/answers/" + strconv.Itoa(droppedID))
+		f.Require().Equal(404, resp.StatusCode)
+	}
+
+	// ==== Bob restores his own answer separately ====
+	{
+		resp := f.IAmBob().POST("/answers/"+strconv.Itoa(droppedID)+"/restore", nil)
+		f.Require().Equal(204, resp.StatusCode)
+
+		resp = f.IAmAlice().GET("/answers/" + strconv.Itoa(droppedID))
+		f.Require().Equal(200, resp.StatusCode)
+	}
+}
@@ -0,0 +1,51 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+func (f *FullE2ESuite) Test_Views() {
+	resp := f.IAmAlice().POST("/questions", map[string]any{"text": "how are views counted", "force": true})
+	f.Require().Equal(201, resp.StatusCode)
+
+	var q FullFlowResponse
+	json.NewDecoder(resp.Body).Decode(&q)
+	path := "/questions/" + strconv.Itoa(q.ID)
+
+	// ==== Repeated reads of one viewer count once ====
+	for range 3 {
+		f.Require().Equal(200, f.IAmBob().GET(path).StatusCode)
+	}
+	f.Require().Equal(200, f.IAmAlice().GET(path).StatusCode)
+
+	// ==== The count shows up once the buffer is flushed ====
+	f.Require().Eventually(func() bool {
+		resp := f.IAmAdmin().GET("/questions")
+		var list []struct {
+			ID        int `json:"id"`
+			ViewCount int `json:"view_count"`
+		}
+		json.NewDecoder(resp.Body).Decode(&list)
+		for _, item := range list {
+			if item.ID == q.ID {
+				return item.ViewCount == 2
+			}
+		}
+		return false
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// listing is not a view, and bob has been counted already
+	resp = f.IAmBob().GET(path)
+	f.Require().Equal(200, resp.StatusCode)
+
+	var got struct {
+		ViewCount int `json:"view_count"`
+	}
+	json.NewDecoder(resp.Body).Decode(&got)
+	f.Equal(2, got.ViewCount)
+}
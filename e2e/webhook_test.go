@@ -0,0 +1,105 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+)
+
+type receivedHook struct {
+	event     string
+	signature string
+	body      []byte
+}
+
+func (f *FullE2ESuite) Test_WebhookFlow() {
+	var (
+		mu       sync.Mutex
+		received []receivedHook
+	)
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		received = append(received, receivedHook{
+			event:     r.Header.Get(entW.EventHeader),
+			signature: r.Header.Get(entW.SignatureHeader),
+			body:      body,
+		})
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer receiver.Close()
+
+	// ==== Only admins manage webhooks ====
+	{
+		resp := f.IAmBob().GET("/admin/webhooks")
+		f.Require().Equal(403, resp.StatusCode)
+	}
+
+	var hookID int
+	{
+		resp := f.IAmAdmin().POST("/admin/webhooks", map[string]any{
+			"url":    receiver.URL,
+			"events": []string{"question.created"},
+			"secret": "e2e-secret-0123456789",
+		})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out struct {
+			ID int `json:"id"`
+		}
+		json.NewDecoder(resp.Body).Decode(&out)
+		hookID = out.ID
+	}
+	defer func() {
+		resp := f.IAmAdmin().DELETE("/admin/webhooks/" + strconv.Itoa(hookID))
+		f.Equal(204, resp.StatusCode)
+	}()
+
+	// ==== A new question is delivered, signed ====
+	{
+		resp := f.IAmAlice().POST("/questions", map[string]any{"text": "is anyone listening?"})
+		f.Require().Equal(201, resp.StatusCode)
+	}
+
+	f.Require().Eventually(func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(received) == 1
+	}, 5*time.Second, 50*time.Millisecond)
+
+	mu.Lock()
+	hook := received[0]
+	mu.Unlock()
+
+	f.Equal("question.created", hook.event)
+	f.Equal(entW.Sign("e2e-secret-0123456789", hook.body), hook.signature)
+	f.Contains(string(hook.body), "is anyone listening?")
+
+	// ==== The attempt is listed once the worker has saved it ====
+	f.Require().Eventually(func() bool {
+		resp := f.IAmAdmin().GET("/admin/webhooks/" + strconv.Itoa(hookID) + "/deliveries")
+		if resp.StatusCode != 200 {
+			return false
+		}
+
+		var out []struct {
+			Status   string `json:"status"`
+			Attempts int    `json:"attempts"`
+		}
+		json.NewDecoder(resp.Body).Decode(&out)
+		return len(out) == 1 && out[0].Status == "delivered" && out[0].Attempts == 1
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -0,0 +1,102 @@
+//go:build e2e
+// +build e2e
+
+package e2e
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+func (f *FullE2ESuite) Test_Workspaces() {
+	// ==== Alice opens a workspace and asks in it ====
+	{
+		resp := f.IAmAlice().POST("/workspaces", map[string]any{"slug": "isolation", "name": "Isolation team"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		resp = f.IAmBob().POST("/workspaces", map[string]any{"slug": "isolation", "name": "Taken"})
+		f.Require().Equal(409, resp.StatusCode)
+	}
+
+	var qID int
+	{
+		resp := f.IAmAlice().POST("/w/isolation/questions", map[string]any{"text": "who owns the staging database", "force": true})
+		f.Require().Equal(201, resp.StatusCode)
+
+		var out FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&out)
+		qID = out.ID
+	}
+	path := "/questions/" + strconv.Itoa(qID)
+
+	resp := f.IAmAlice().GET("/w/isolation" + path)
+	f.Require().Equal(200, resp.StatusCode)
+	resp = f.IAmAlice().GETInWorkspace(path, "isolation")
+	f.Require().Equal(200, resp.StatusCode)
+
+	// ==== Outside the workspace the question does not exist ====
+	{
+		resp := f.IAmAlice().GET(path)
+		f.Require().Equal(404, resp.StatusCode)
+
+		resp = f.IAmAlice().GET("/questions")
+		f.Require().Equal(200, resp.StatusCode)
+
+		var list []FullFlowResponse
+		json.NewDecoder(resp.Body).Decode(&list)
+		for _, q := range list {
+			f.NotEqual(qID, q.ID)
+		}
+
+		resp = f.IAmBob().POST(path+"/answers", map[string]any{"text": "the data team"})
+		f.Require().Equal(404, resp.StatusCode)
+	}
+
+	// ==== Non-members cannot enter the workspace ====
+	{
+		resp := f.IAmBob().GET("/w/isolation" + path)
+		f.Require().Equal(404, resp.StatusCode)
+
+		resp = f.IAmBob().GETInWorkspace(path, "isolation")
+		f.Require().Equal(404, resp.StatusCode)
+	}
+
+	// ==== Once added, Bob works in it ====
+	bobPath := "/workspaces/isolation/members/" + f.Users["bob"].UserID
+	{
+		resp := f.IAmBob().PUT(bobPath, nil)
+		f.Require().Equal(404, resp.StatusCode)
+
+		resp = f.IAmAlice().PUT(bobPath, nil)
+		f.Require().Equal(204, resp.StatusCode)
+
+		resp = f.IAmBob().POST("/w/isolation"+path+"/answers", map[string]any{"text": "the data team"})
+		f.Require().Equal(201, resp.StatusCode)
+
+		resp = f.IAmBob().GET("/me/workspaces")
+		f.Require().Equal(200, resp.StatusCode)
+
+		var mine []struct {
+			Slug string `json:"slug"`
+			Role string `json:"role"`
+		}
+		json.NewDecoder(resp.Body).Decode(&mine)
+		f.Contains(mine, struct {
+			Slug string `json:"slug"`
+			Role string `json:"role"`
+		}{"isolation", "member"})
+
+		// a member cannot manage members
+		resp = f.IAmBob().DELETE("/workspaces/isolation/members/" + f.Users["alice"].UserID)
+		f.Require().Equal(403, resp.StatusCode)
+	}
+
+	// ==== Removed, Bob is out again ====
+	{
+		resp := f.IAmAlice().DELETE(bobPath)
+		f.Require().Equal(204, resp.StatusCode)
+
+		resp = f.IAmBob().GET("/w/isolation" + path)
+		f.Require().Equal(404, resp.StatusCode)
+	}
+}
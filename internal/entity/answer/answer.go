@@ -1,8 +1,14 @@
 package answer
 
 import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"test-question/internal/entity/mention"
+
 	"github.com/pkg/errors"
 )
 
@@ -10,12 +16,125 @@ var (
 	ErrAnswerNotFound            = errors.New("answer not found")
 	ErrRequestedQuestionNotFound = errors.New("requested question not found")
 	ErrAccessDenied              = errors.New("access denied")
+	ErrNotDeleted                = errors.New("answer is not deleted")
+	ErrRestoreExpired            = errors.New("answer restore period expired")
+	ErrRemovedByModerator        = errors.New("answer was removed by a moderator")
+
+	ErrInvalidSort   = errors.New("invalid answer sort")
+	ErrInvalidCursor = errors.New("invalid answer cursor")
+)
+
+const (
+	// DefaultPageSize answers are shown with a question and listed per page
+	// unless a client asks for another size, up to MaxPageSize.
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Sort is the order answers of a question are listed in.
+type Sort string
+
+const (
+	SortOldest Sort = "oldest"
+	SortNewest Sort = "newest"
+	// SortScore lists the highest voted answers first, older ones first on a tie.
+	SortScore Sort = "score"
 )
 
+func ParseSort(s string) (Sort, error) {
+	switch sort := Sort(s); sort {
+	case "":
+		return SortOldest, nil
+	case SortOldest, SortNewest, SortScore:
+		return sort, nil
+	default:
+		return "", ErrInvalidSort
+	}
+}
+
+// Cursor is the position after the last answer of a page in a given sort.
+type Cursor struct {
+	Sort      Sort
+	CreatedAt time.Time
+	Score     int
+	ID        int
+}
+
+// CursorAfter is the position following the answer in the sort.
+func CursorAfter(a *Answer, sort Sort) Cursor {
+	return Cursor{Sort: sort, CreatedAt: a.CreatedAt, Score: a.Score, ID: a.ID}
+}
+
+// String encodes the cursor as an opaque, URL-safe token.
+func (c Cursor) String() string {
+	raw := fmt.Sprintf("%s:%d:%d:%d", c.Sort, c.CreatedAt.UnixMicro(), c.Score, c.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// ParseCursor decodes a token made by Cursor.String.
+func ParseCursor(token string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	parts := strings.Split(string(raw), ":")
+	if len(parts) != 4 {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	sort, err := ParseSort(parts[0])
+	if err != nil || parts[0] == "" {
+		return Cursor{}, ErrInvalidCursor
+	}
+
+	nums := make([]int64, 3)
+	for i, p := range parts[1:] {
+		if nums[i], err = strconv.ParseInt(p, 10, 64); err != nil {
+			return Cursor{}, ErrInvalidCursor
+		}
+	}
+
+	return Cursor{
+		Sort:      sort,
+		CreatedAt: time.UnixMicro(nums[0]).UTC(),
+		Score:     int(nums[1]),
+		ID:        int(nums[2]),
+	}, nil
+}
+
+// Filter selects a page of a question's answers.
+type Filter struct {
+	QuestionID int
+	Sort       Sort
+	// After is the cursor of the previous page, nil for the first one.
+	After *Cursor
+	Limit int
+}
+
+type Page struct {
+	Items []*Answer
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
 type Answer struct {
 	ID         int
 	QuestionID int
 	UserID     string
 	Text       string
-	CreatedAt  time.Time
+	// Revision is bumped whenever the answer as shown to readers changes.
+	Revision int
+	// Score is the sum of the answer's votes, loaded with pages of answers.
+	Score     int
+	CreatedAt time.Time
+	// DeletedAt is set for an answer in the trash.
+	DeletedAt *time.Time
+	// HiddenAt is set while reports keep the answer from readers.
+	HiddenAt *time.Time
+	// RemovedByModerator marks an answer in the trash that its author
+	// cannot restore.
+	RemovedByModerator bool
+	// Mentions are loaded only where a post is shown with them.
+	Mentions []*mention.Mention
 }
@@ -0,0 +1,84 @@
+package attachment
+
+import (
+	"mime"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	ErrEmptyFile          = errors.New("attachment file is empty")
+	ErrTooLarge           = errors.New("attachment file too large")
+	ErrUnsupportedType    = errors.New("attachment type not allowed")
+	// ErrUnavailable means some of the referenced attachments do not exist,
+	// belong to someone else or are attached elsewhere already.
+	ErrUnavailable = errors.New("attachments unavailable")
+)
+
+// MaxPerPost caps the attachments referenced by one question or answer.
+const MaxPerPost = 10
+
+type Attachment struct {
+	ID     int
+	UserID string
+	// Filename is the name the file was uploaded with, without directories.
+	Filename string
+	// ContentType is sniffed from the content, never taken from the client.
+	ContentType string
+	Size        int64
+	StorageKey  string
+	// ThumbnailKey is empty when no thumbnail was generated.
+	ThumbnailKey string
+	// QuestionID or AnswerID is set once a post references the upload;
+	// until then the attachment is an orphan.
+	QuestionID *int
+	AnswerID   *int
+	// Published reports whether the attachment belongs to a question or
+	// answer readers can see: not in the trash and not hidden by reports.
+	Published bool
+	CreatedAt time.Time
+}
+
+func (a *Attachment) HasThumbnail() bool {
+	return a.ThumbnailKey != ""
+}
+
+// VisibleTo reports whether userID may download the attachment: uploaders
+// always can, everyone else once it is published.
+func (a *Attachment) VisibleTo(userID string) bool {
+	return a.UserID == userID || a.Published
+}
+
+// IsImage reports whether the content type is one thumbnails are made for.
+func IsImage(contentType string) bool {
+	switch MediaType(contentType) {
+	case "image/png", "image/jpeg", "image/gif":
+		return true
+	}
+	return false
+}
+
+// MediaType strips parameters such as charset from a content type.
+func MediaType(contentType string) string {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return contentType
+	}
+	return mt
+}
+
+// UniqueIDs drops repeated IDs, keeping the order of first appearance.
+func UniqueIDs(ids []int) []int {
+	seen := make(map[int]struct{}, len(ids))
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	return out
+}
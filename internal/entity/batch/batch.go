@@ -0,0 +1,105 @@
+package batch
+
+import (
+	"fmt"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrTooManyOperations = errors.New("too many operations in batch")
+	ErrInvalidOperation  = errors.New("invalid batch operation")
+	ErrInvalidRef        = errors.New("question ref must point to an earlier create_question operation")
+	ErrRefFailed         = errors.New("referenced operation failed")
+	ErrRolledBack        = errors.New("operation rolled back with the batch")
+	ErrSkipped           = errors.New("operation skipped after a failure")
+	ErrRateLimited       = errors.New("operation over the rate limit")
+)
+
+type OpType string
+
+const (
+	OpCreateQuestion OpType = "create_question"
+	OpCreateAnswer   OpType = "create_answer"
+	OpDeleteQuestion OpType = "delete_question"
+	OpDeleteAnswer   OpType = "delete_answer"
+)
+
+// Operation is one call of an existing use case within a batch.
+type Operation struct {
+	Type  OpType
+	Text  string
+	Force bool
+	// QuestionID is the target of create_answer and delete_question.
+	QuestionID int
+	// QuestionRef makes create_answer target the question created by an
+	// earlier operation of the batch, given by its index.
+	QuestionRef *int
+	AnswerID    int
+	// AttachmentIDs are the user's uploads a created post references.
+	AttachmentIDs []int
+}
+
+// Validate checks that the operation carries the fields its type needs.
+// index is the operation's position in the batch.
+func (o Operation) Validate(index int) error {
+	var ok bool
+
+	switch o.Type {
+	case OpCreateQuestion:
+		ok = o.Text != ""
+	case OpCreateAnswer:
+		ok = o.Text != "" && (o.QuestionID != 0) != (o.QuestionRef != nil)
+		if ok && o.QuestionRef != nil && (*o.QuestionRef < 0 || *o.QuestionRef >= index) {
+			return ErrInvalidRef
+		}
+	case OpDeleteQuestion:
+		ok = o.QuestionID != 0 && len(o.AttachmentIDs) == 0
+	case OpDeleteAnswer:
+		ok = o.AnswerID != 0 && len(o.AttachmentIDs) == 0
+	}
+
+	if !ok {
+		return ErrInvalidOperation
+	}
+	return nil
+}
+
+// Result is the outcome of one operation. Err is nil on success.
+type Result struct {
+	Question *entQ.Question
+	// Similar lists the possible duplicates of a created question.
+	Similar []*entQ.SimilarQuestion
+	Answer  *entA.Answer
+	Err     error
+}
+
+// OperationError tells which operation of a batch is invalid.
+type OperationError struct {
+	Index int
+	Err   error
+}
+
+func (e *OperationError) Error() string {
+	return fmt.Sprintf("operation %d: %v", e.Index, e.Err)
+}
+
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// TooManyOperationsError tells the most operations a batch may hold.
+type TooManyOperationsError struct {
+	Max int
+}
+
+func (e *TooManyOperationsError) Error() string {
+	return fmt.Sprintf("%v: at most %d", ErrTooManyOperations, e.Max)
+}
+
+func (e *TooManyOperationsError) Unwrap() error {
+	return ErrTooManyOperations
+}
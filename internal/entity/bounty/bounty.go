@@ -0,0 +1,59 @@
+package bounty
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrBountyNotFound = errors.New("bounty not found")
+	ErrBountyExists   = errors.New("question already has an open bounty")
+	ErrInvalidAmount  = errors.New("invalid bounty amount")
+	ErrAccessDenied   = errors.New("access denied")
+	ErrOwnAnswer      = errors.New("bounty cannot go to the asker's own answer")
+)
+
+type Status string
+
+const (
+	StatusOpen     Status = "open"
+	StatusAwarded  Status = "awarded"
+	StatusRefunded Status = "refunded"
+)
+
+// Bounty is reputation the asker put in escrow for answers to a question.
+// While open the points are taken from the asker; closing it pays them to
+// the author of AnswerID or, with no answer worth it, back to the asker.
+type Bounty struct {
+	ID         int
+	QuestionID int
+	UserID     string
+	Amount     int
+	Status     Status
+	AnswerID   int
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	ClosedAt   *time.Time
+}
+
+// Featured is an open bounty listed with its question.
+type Featured struct {
+	Bounty
+	QuestionText string
+}
+
+type Config struct {
+	// MinAmount and MaxAmount bound the points of one bounty.
+	MinAmount int
+	MaxAmount int
+	// Duration is how long a bounty stays open.
+	Duration time.Duration
+}
+
+func (c Config) CheckAmount(amount int) error {
+	if amount < c.MinAmount || amount > c.MaxAmount {
+		return ErrInvalidAmount
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package corpus
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidRecord   = errors.New("invalid corpus record")
+	ErrUnknownAuthor   = errors.New("unknown author")
+	ErrDuplicateID     = errors.New("id already used in corpus")
+	ErrInvalidAccepted = errors.New("accepted answer is not one of the question's answers")
+	ErrInvalidCorpus   = errors.New("corpus has invalid records")
+)
+
+// Question is one line of a corpus file: a question with its answers and
+// their authors' usernames. IDs are those of the source environment; an
+// import assigns new ones and remaps the references.
+type Question struct {
+	ID               int       `json:"id"`
+	Text             string    `json:"text"`
+	Author           string    `json:"author"`
+	Status           string    `json:"status"`
+	AcceptedAnswerID int       `json:"accepted_answer_id,omitempty"`
+	DuplicateOf      int       `json:"duplicate_of,omitempty"`
+	CreatedAt        time.Time `json:"created_at"`
+	Answers          []Answer  `json:"answers"`
+}
+
+type Answer struct {
+	ID        int       `json:"id"`
+	Text      string    `json:"text"`
+	Author    string    `json:"author"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Plan is a validated corpus ready to load. References point at positions
+// in Questions and Answers, -1 meaning none, so IDs can be assigned at load.
+type Plan struct {
+	Questions []PlannedQuestion
+	Answers   []PlannedAnswer
+}
+
+type PlannedQuestion struct {
+	Text           string
+	UserID         string
+	Status         string
+	CreatedAt      time.Time
+	AcceptedAnswer int
+	DuplicateOf    int
+}
+
+type PlannedAnswer struct {
+	Question  int
+	UserID    string
+	Text      string
+	CreatedAt time.Time
+}
+
+// Report sums up an import.
+type Report struct {
+	Lines     int
+	Questions int
+	Answers   int
+	// DroppedRefs counts duplicate marks pointing outside the corpus.
+	DroppedRefs int
+	Errors      []LineError
+	DryRun      bool
+}
+
+// LineError is a problem with one line of the corpus file.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e LineError) Unwrap() error {
+	return e.Err
+}
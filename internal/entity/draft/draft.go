@@ -0,0 +1,75 @@
+package draft
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidKey    = errors.New("invalid draft key")
+	ErrDraftNotFound = errors.New("draft not found")
+)
+
+const (
+	questionKey     = "question"
+	answerKeyPrefix = "answer-"
+)
+
+// Key names what a draft becomes once published: a new question, or an
+// answer to the question QuestionID.
+type Key struct {
+	QuestionID int
+}
+
+// QuestionKey is the key of the user's draft of a new question.
+var QuestionKey = Key{}
+
+// AnswerKey is the key of the user's draft answer to a question.
+func AnswerKey(questionID int) Key {
+	return Key{QuestionID: questionID}
+}
+
+// ParseKey reads "question" or "answer-<question id>".
+func ParseKey(s string) (Key, error) {
+	if s == questionKey {
+		return QuestionKey, nil
+	}
+
+	raw, ok := strings.CutPrefix(s, answerKeyPrefix)
+	if !ok {
+		return Key{}, ErrInvalidKey
+	}
+
+	id, err := strconv.Atoi(raw)
+	if err != nil || id <= 0 || strconv.Itoa(id) != raw {
+		return Key{}, ErrInvalidKey
+	}
+
+	return AnswerKey(id), nil
+}
+
+// IsAnswer reports whether the draft is an answer rather than a question.
+func (k Key) IsAnswer() bool {
+	return k.QuestionID != 0
+}
+
+func (k Key) String() string {
+	if k.IsAnswer() {
+		return answerKeyPrefix + strconv.Itoa(k.QuestionID)
+	}
+	return questionKey
+}
+
+// Draft is unpublished text of a user. Saving it again replaces it and
+// pushes ExpiresAt back; once expired it is gone.
+type Draft struct {
+	UserID        string
+	Key           Key
+	Text          string
+	AttachmentIDs []int
+	UpdatedAt     time.Time
+	ExpiresAt     time.Time
+}
@@ -0,0 +1,38 @@
+package idempotency
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidKey    = errors.New("invalid idempotency key")
+	ErrKeyReused     = errors.New("idempotency key reused with a different request")
+	ErrKeyInProgress = errors.New("request with idempotency key is in progress")
+	ErrKeyNotFound   = errors.New("idempotency key not found")
+)
+
+// MaxKeyLength bounds the Idempotency-Key header value.
+const MaxKeyLength = 255
+
+// Record is the first request a user made with a key. While the request
+// is in flight StatusCode is zero and the key is held until LockedUntil;
+// afterwards it holds the response that is replayed to retries until the
+// record expires.
+type Record struct {
+	UserID      string
+	Key         string
+	RequestHash string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	LockedUntil time.Time
+}
+
+// Completed reports whether the response of the first request is stored.
+func (r *Record) Completed() bool {
+	return r.StatusCode != 0
+}
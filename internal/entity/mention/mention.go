@@ -0,0 +1,62 @@
+package mention
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// MaxPerPost caps the users one question or answer can mention; further
+// mentions stay plain text.
+const MaxPerPost = 20
+
+// Mention links a question or answer to a user its text mentions as
+// @username. AnswerID is zero for a mention in the question itself.
+type Mention struct {
+	ID         int
+	UserID     string
+	Username   string
+	AuthorID   string
+	QuestionID int
+	AnswerID   int
+	CreatedAt  time.Time
+}
+
+// Filter selects a page of the mentions of a user, newest first.
+// BeforeID is the cursor: only mentions with a smaller id are returned.
+type Filter struct {
+	UserID   string
+	BeforeID int
+	Limit    int
+}
+
+type Page struct {
+	Items      []*Mention
+	NextCursor int
+}
+
+// an @ that does not continue a word or an e-mail address
+var pattern = regexp.MustCompile(`(?:^|[^\w@.])@(\w[\w.-]*)`)
+
+// Parse returns the usernames mentioned in text in order of appearance,
+// each once and at most MaxPerPost of them. Trailing dots and dashes are
+// punctuation, not part of the name.
+func Parse(text string) []string {
+	var out []string
+	seen := map[string]struct{}{}
+
+	for _, m := range pattern.FindAllStringSubmatch(text, -1) {
+		name := strings.TrimRight(m[1], ".-")
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		out = append(out, name)
+		if len(out) == MaxPerPost {
+			break
+		}
+	}
+
+	return out
+}
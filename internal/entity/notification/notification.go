@@ -0,0 +1,68 @@
+package notification
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrNotificationNotFound = errors.New("notification not found")
+	ErrUnknownType          = errors.New("unknown notification type")
+)
+
+type Type string
+
+const (
+	TypeNewAnswer Type = "new_answer"
+	// TypeMention is sent to users mentioned as @username in a post.
+	TypeMention Type = "mention"
+	// TypeWarning is a moderator's warning about reported content. It cannot
+	// be switched off, so it is not in Types.
+	TypeWarning Type = "warning"
+)
+
+// Types lists every event type a user can switch on or off.
+var Types = []Type{TypeNewAnswer, TypeMention} //nolint:gochecknoglobals
+
+func (t Type) Valid() bool {
+	for _, known := range Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+type Notification struct {
+	ID         int
+	UserID     string
+	Type       Type
+	ActorID    string
+	QuestionID int
+	AnswerID   int
+	ReadAt     *time.Time
+	CreatedAt  time.Time
+}
+
+// Filter selects a page of a user's inbox, newest first.
+// BeforeID is the cursor: only notifications with a smaller id are returned.
+type Filter struct {
+	UserID     string
+	UnreadOnly bool
+	BeforeID   int
+	Limit      int
+}
+
+type Page struct {
+	Items      []*Notification
+	NextCursor int
+}
+
+// Settings holds per-type switches. Types missing from the map are enabled.
+type Settings map[Type]bool
+
+func (s Settings) Enabled(t Type) bool {
+	enabled, ok := s[t]
+	return !ok || enabled
+}
@@ -0,0 +1,87 @@
+package outbox
+
+import (
+	"fmt"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+)
+
+type EventType string
+
+const (
+	TypeQuestionCreated EventType = "question.created"
+	TypeQuestionDeleted EventType = "question.deleted"
+	TypeAnswerCreated   EventType = "answer.created"
+	TypeAnswerDeleted   EventType = "answer.deleted"
+)
+
+// Event is a domain event recorded by a use case in the same transaction
+// as the change it describes.
+type Event interface {
+	Type() EventType
+	// Key deduplicates the event: recording an event whose key is
+	// already in the outbox is a no-op.
+	Key() string
+}
+
+// Message is an event as stored in the outbox.
+type Message struct {
+	ID            int64
+	Type          EventType
+	Key           string
+	Payload       []byte
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	ProcessedAt   *time.Time
+	CreatedAt     time.Time
+}
+
+type QuestionCreated struct {
+	Question   entQ.Question `json:"question"`
+	OccurredAt time.Time     `json:"occurred_at"`
+}
+
+func (QuestionCreated) Type() EventType { return TypeQuestionCreated }
+
+func (e QuestionCreated) Key() string {
+	return fmt.Sprintf("%s:%d", TypeQuestionCreated, e.Question.ID)
+}
+
+type QuestionDeleted struct {
+	Question   entQ.Question `json:"question"`
+	OccurredAt time.Time     `json:"occurred_at"`
+}
+
+func (QuestionDeleted) Type() EventType { return TypeQuestionDeleted }
+
+// Key includes the time because a question can be deleted again after a restore.
+func (e QuestionDeleted) Key() string {
+	return fmt.Sprintf("%s:%d:%d", TypeQuestionDeleted, e.Question.ID, e.OccurredAt.UnixNano())
+}
+
+type AnswerCreated struct {
+	Question   entQ.Question `json:"question"`
+	Answer     entA.Answer   `json:"answer"`
+	OccurredAt time.Time     `json:"occurred_at"`
+}
+
+func (AnswerCreated) Type() EventType { return TypeAnswerCreated }
+
+func (e AnswerCreated) Key() string {
+	return fmt.Sprintf("%s:%d", TypeAnswerCreated, e.Answer.ID)
+}
+
+type AnswerDeleted struct {
+	Answer     entA.Answer `json:"answer"`
+	OccurredAt time.Time   `json:"occurred_at"`
+}
+
+func (AnswerDeleted) Type() EventType { return TypeAnswerDeleted }
+
+// Key includes the time because an answer can be deleted again after a restore.
+func (e AnswerDeleted) Key() string {
+	return fmt.Sprintf("%s:%d:%d", TypeAnswerDeleted, e.Answer.ID, e.OccurredAt.UnixNano())
+}
@@ -0,0 +1,65 @@
+package policy
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var ErrViolation = errors.New("content policy violation")
+
+// Rule names reported for a violating field.
+const (
+	RuleMaxLength     = "max_length"
+	RuleBannedWord    = "banned_word"
+	RuleBannedPattern = "banned_pattern"
+	RuleMaxLinks      = "max_links"
+	RuleBlockedDomain = "blocked_domain"
+	RuleRepeatedText  = "repeated_text"
+)
+
+// Violation is a broken rule; Param holds the limit of rules that have one,
+// such as the length for RuleMaxLength.
+type Violation struct {
+	Rule  string
+	Param string
+}
+
+// Violations maps each offending field to the first rule it broke.
+type Violations map[string]Violation
+
+// Rules maps each offending field to the name of the rule it broke.
+func (v Violations) Rules() map[string]string {
+	rules := make(map[string]string, len(v))
+	for field, violation := range v {
+		rules[field] = violation.Rule
+	}
+	return rules
+}
+
+// Params maps each offending field to the limit of the rule it broke, for
+// rules that have one.
+func (v Violations) Params() map[string]string {
+	params := make(map[string]string, len(v))
+	for field, violation := range v {
+		if violation.Param != "" {
+			params[field] = violation.Param
+		}
+	}
+	return params
+}
+
+func (v Violations) Error() string {
+	parts := make([]string, 0, len(v))
+	for field, violation := range v {
+		parts = append(parts, field+": "+violation.Rule)
+	}
+	sort.Strings(parts)
+
+	return ErrViolation.Error() + " (" + strings.Join(parts, ", ") + ")"
+}
+
+func (v Violations) Unwrap() error {
+	return ErrViolation
+}
@@ -3,17 +3,85 @@ package question
 import (
 	"time"
 
+	"test-question/internal/entity/mention"
+
 	"github.com/pkg/errors"
 )
 
 var (
-	ErrQuestionNotFound = errors.New("question not found")
-	ErrAccessDenied     = errors.New("access denied")
+	ErrQuestionNotFound   = errors.New("question not found")
+	ErrAccessDenied       = errors.New("access denied")
+	ErrNotDeleted         = errors.New("question is not deleted")
+	ErrRestoreExpired     = errors.New("question restore period expired")
+	ErrRemovedByModerator = errors.New("question was removed by a moderator")
+
+	ErrPossibleDuplicates = errors.New("possible duplicate questions found")
+	ErrCanonicalNotFound  = errors.New("canonical question not found")
+	ErrSelfDuplicate      = errors.New("question cannot duplicate itself")
+
+	ErrQuestionClosed    = errors.New("question is closed")
+	ErrQuestionLocked    = errors.New("question is locked")
+	ErrInvalidTransition = errors.New("invalid question status transition")
+	ErrReasonRequired    = errors.New("transition reason required")
+)
+
+type Status string
+
+const (
+	StatusOpen   Status = "open"
+	StatusClosed Status = "closed"
+	StatusLocked Status = "locked"
+)
+
+// Action is a lifecycle transition requested by a user.
+type Action string
+
+const (
+	ActionClose  Action = "close"
+	ActionReopen Action = "reopen"
+	ActionLock   Action = "lock"
+	ActionUnlock Action = "unlock"
 )
 
 type Question struct {
-	ID        int
-	Text      string
-	UserID    string
+	ID               int
+	Text             string
+	UserID           string
+	Status           Status
+	AcceptedAnswerID int
+	// DuplicateOfID points to the canonical question readers are sent to.
+	DuplicateOfID int
+	// Revision grows with every change of the question readers can see.
+	Revision int
+	// ViewCount lags behind reads: views are buffered and added in batches.
+	ViewCount int
 	CreatedAt time.Time
+	// DeletedAt is set for a question in the trash.
+	DeletedAt *time.Time
+	// HiddenAt is set while reports keep the question from readers.
+	HiddenAt *time.Time
+	// RemovedByModerator marks a question in the trash that its author
+	// cannot restore.
+	RemovedByModerator bool
+	// Mentions are loaded only where a post is shown with them.
+	Mentions []*mention.Mention
+}
+
+// SimilarQuestion is an existing question whose text resembles a new one.
+type SimilarQuestion struct {
+	ID         int
+	Text       string
+	Similarity float64
+}
+
+// Transition is a recorded change of a question's status.
+type Transition struct {
+	ID         int
+	QuestionID int
+	Action     Action
+	From       Status
+	To         Status
+	ActorID    string
+	Reason     string
+	CreatedAt  time.Time
 }
@@ -0,0 +1,97 @@
+package ranking
+
+import (
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidOrder = errors.New("invalid ranking order")
+
+// Order is the score questions are ranked by.
+type Order string
+
+const (
+	// OrderHot favours questions that gathered a lot of activity while young.
+	OrderHot Order = "hot"
+	// OrderTrending favours questions with recent activity, however old they are.
+	OrderTrending Order = "trending"
+)
+
+// Weights are the points an answer, a vote and a view are worth. Votes
+// count by value, so a downvote takes points away.
+type Weights struct {
+	Answer float64
+	Vote   float64
+	View   float64
+}
+
+type Config struct {
+	Weights Weights
+	// HalfLife is how long it takes a score, or a piece of activity, to lose
+	// half its weight.
+	HalfLife time.Duration
+}
+
+// Activity is what a question's scores are computed from.
+type Activity struct {
+	QuestionID int
+	CreatedAt  time.Time
+	Answers    int
+	// Votes is the sum of the question's vote values.
+	Votes int
+	Views int
+	// DecayedAnswers and DecayedVotes weigh every answer and vote by its own age.
+	DecayedAnswers float64
+	DecayedVotes   float64
+	// Prev is the question's score from the previous ranking, nil on its first.
+	Prev *Score
+}
+
+// Score is a question's place in the rankings as of ComputedAt.
+type Score struct {
+	QuestionID int
+	Hot        float64
+	Trending   float64
+	// ViewHeat is the question's views, each decayed by the time since the
+	// ranking that first saw it: views carry no timestamps of their own.
+	ViewHeat float64
+	// ViewsSeen is the view count ViewHeat accounts for.
+	ViewsSeen  int
+	ComputedAt time.Time
+}
+
+// Decay is the weight left after age: 1 when new, half after one half-life.
+func Decay(age, halfLife time.Duration) float64 {
+	if age <= 0 {
+		return 1
+	}
+	return math.Exp2(-age.Seconds() / halfLife.Seconds())
+}
+
+// Compute scores a question at now. Hot is the question's total activity
+// decayed by the question's age; Trending adds up its activity decayed by the
+// age of each piece. The result depends on now only, never on the clock.
+func (c Config) Compute(a *Activity, now time.Time) *Score {
+	w := c.Weights
+
+	total := w.Answer*float64(a.Answers) + w.Vote*float64(a.Votes) + w.View*float64(a.Views)
+	age := Decay(now.Sub(a.CreatedAt), c.HalfLife)
+
+	// on its first ranking a question's views are taken as old as the question
+	heat := float64(a.Views) * age
+	if a.Prev != nil {
+		heat = a.Prev.ViewHeat*Decay(now.Sub(a.Prev.ComputedAt), c.HalfLife) +
+			float64(max(a.Views-a.Prev.ViewsSeen, 0))
+	}
+
+	return &Score{
+		QuestionID: a.QuestionID,
+		Hot:        total * age,
+		Trending:   w.Answer*a.DecayedAnswers + w.Vote*a.DecayedVotes + w.View*heat,
+		ViewHeat:   heat,
+		ViewsSeen:  a.Views,
+		ComputedAt: now,
+	}
+}
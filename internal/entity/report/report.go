@@ -0,0 +1,95 @@
+package report
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidReason     = errors.New("invalid report reason")
+	ErrInvalidResolution = errors.New("invalid report resolution")
+	ErrSelfReport        = errors.New("cannot report own content")
+	ErrAlreadyReported   = errors.New("content already reported by user")
+	ErrNoOpenReports     = errors.New("no open reports for content")
+)
+
+type TargetType string
+
+const (
+	TargetQuestion TargetType = "question"
+	TargetAnswer   TargetType = "answer"
+)
+
+type Reason string
+
+const (
+	ReasonSpam      Reason = "spam"
+	ReasonOffensive Reason = "offensive"
+	ReasonOffTopic  Reason = "off_topic"
+)
+
+func (r Reason) Valid() bool {
+	switch r {
+	case ReasonSpam, ReasonOffensive, ReasonOffTopic:
+		return true
+	}
+	return false
+}
+
+type Status string
+
+const (
+	StatusOpen      Status = "open"
+	StatusDismissed Status = "dismissed"
+	StatusDeleted   Status = "deleted"
+	StatusWarned    Status = "warned"
+)
+
+// Resolution is what a moderator does about the reported content.
+type Resolution string
+
+const (
+	ResolutionDismiss Resolution = "dismiss"
+	ResolutionDelete  Resolution = "delete"
+	ResolutionWarn    Resolution = "warn"
+)
+
+// Status returns the status reports get when resolved this way.
+func (r Resolution) Status() (Status, bool) {
+	switch r {
+	case ResolutionDismiss:
+		return StatusDismissed, true
+	case ResolutionDelete:
+		return StatusDeleted, true
+	case ResolutionWarn:
+		return StatusWarned, true
+	}
+	return "", false
+}
+
+type Report struct {
+	ID         int
+	TargetType TargetType
+	TargetID   int
+	ReporterID string
+	Reason     Reason
+	Note       string
+	Status     Status
+	ResolvedBy string
+	ResolvedAt *time.Time
+	CreatedAt  time.Time
+}
+
+// QueueItem is reported content awaiting review with its open reports
+// folded together.
+type QueueItem struct {
+	TargetType      TargetType
+	TargetID        int
+	AuthorID        string
+	Text            string
+	Hidden          bool
+	Reports         int
+	Reasons         []Reason
+	FirstReportedAt time.Time
+}
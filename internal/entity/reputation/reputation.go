@@ -0,0 +1,104 @@
+package reputation
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidPeriod          = errors.New("invalid leaderboard period")
+	ErrInsufficientReputation = errors.New("insufficient reputation")
+)
+
+type Reason string
+
+const (
+	ReasonQuestionUpvoted   Reason = "question_upvoted"
+	ReasonQuestionDownvoted Reason = "question_downvoted"
+	ReasonAnswerUpvoted     Reason = "answer_upvoted"
+	ReasonAnswerDownvoted   Reason = "answer_downvoted"
+	ReasonAnswerAccepted    Reason = "answer_accepted"
+
+	// Bounty points move between users through these; their amount is set
+	// by the bounty, and trashing a post never reverses them.
+	ReasonBountyOffered  Reason = "bounty_offered"
+	ReasonBountyAwarded  Reason = "bounty_awarded"
+	ReasonBountyRefunded Reason = "bounty_refunded"
+)
+
+// BountyReasons are the reasons of bounty points.
+var BountyReasons = []Reason{ReasonBountyOffered, ReasonBountyAwarded, ReasonBountyRefunded}
+
+type SubjectType string
+
+const (
+	SubjectQuestion SubjectType = "question"
+	SubjectAnswer   SubjectType = "answer"
+)
+
+type Period string
+
+const (
+	PeriodWeek  Period = "week"
+	PeriodMonth Period = "month"
+	PeriodAll   Period = "all"
+)
+
+// Entry is an immutable ledger record. A retracted entry is never updated,
+// instead a reversal entry with the opposite delta is written and points
+// to the original through ReversalOf.
+type Entry struct {
+	ID          int
+	UserID      string
+	ActorID     string
+	Reason      Reason
+	Delta       int
+	SubjectType SubjectType
+	SubjectID   int
+	ReversalOf  int
+	CreatedAt   time.Time
+}
+
+// ReverseFilter selects live (not yet reversed) entries of a subject.
+// Empty ActorID and Reasons match any.
+type ReverseFilter struct {
+	SubjectType SubjectType
+	SubjectID   int
+	ActorID     string
+	Reasons     []Reason
+}
+
+type LeaderboardItem struct {
+	UserID   string
+	Username string
+	Points   int
+}
+
+func PointsFor(r Reason) int {
+	switch r {
+	case ReasonQuestionUpvoted:
+		return 5
+	case ReasonAnswerUpvoted:
+		return 10
+	case ReasonQuestionDownvoted, ReasonAnswerDownvoted:
+		return -2
+	case ReasonAnswerAccepted:
+		return 15
+	}
+	return 0
+}
+
+// Since returns the lower bound of the period relative to now.
+// Zero time means no lower bound.
+func (p Period) Since(now time.Time) (time.Time, error) {
+	switch p {
+	case PeriodWeek:
+		return now.AddDate(0, 0, -7), nil
+	case PeriodMonth:
+		return now.AddDate(0, -1, 0), nil
+	case PeriodAll:
+		return time.Time{}, nil
+	}
+	return time.Time{}, ErrInvalidPeriod
+}
@@ -0,0 +1,50 @@
+package stream
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrTooManyStreams = errors.New("too many open streams")
+	ErrStreamsClosed  = errors.New("streams closed")
+)
+
+const (
+	TypeAnswerCreated = "answer.created"
+	TypeAnswerDeleted = "answer.deleted"
+)
+
+// Channel is the Postgres channel notified when events are appended, so
+// every replica reads them into its broker without waiting for a poll.
+const Channel = "stream_events"
+
+// Event is a message pushed to live subscribers of a topic. IDs are assigned
+// by the shared event log, grow monotonically across replicas and are used
+// for Last-Event-ID resume.
+type Event struct {
+	ID   int64
+	Type string
+	Data []byte
+}
+
+// Published is an event of the log with the topic it was published to.
+type Published struct {
+	Topic string
+	Event Event
+}
+
+// QuestionTopic is the topic carrying live updates of a question.
+func QuestionTopic(questionID int) string {
+	return "question:" + strconv.Itoa(questionID)
+}
+
+type AnswerData struct {
+	ID         int       `json:"id"`
+	QuestionID int       `json:"question_id"`
+	UserID     string    `json:"user_id"`
+	Text       string    `json:"text,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
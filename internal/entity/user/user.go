@@ -8,11 +8,26 @@ import (
 
 var (
 	ErrUsernameOrPasswordIncorrect = errors.New("username or password incorrect")
+	ErrUserNotFound                = errors.New("user not found")
 )
 
+type Role string
+
+const (
+	RoleUser      Role = "user"
+	RoleModerator Role = "moderator"
+	RoleAdmin     Role = "admin"
+)
+
+// CanModerate reports whether the role has moderator rights.
+func (r Role) CanModerate() bool {
+	return r == RoleModerator || r == RoleAdmin
+}
+
 type User struct {
 	ID        string
 	Username  string
 	Password  string
+	Role      Role
 	CreatedAt time.Time
 }
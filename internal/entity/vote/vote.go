@@ -0,0 +1,33 @@
+package vote
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidValue = errors.New("invalid vote value")
+	ErrSelfVote     = errors.New("cannot vote for own content")
+	ErrVoteNotFound = errors.New("vote not found")
+)
+
+type TargetType string
+
+const (
+	TargetQuestion TargetType = "question"
+	TargetAnswer   TargetType = "answer"
+)
+
+const (
+	Up   = 1
+	Down = -1
+)
+
+type Vote struct {
+	UserID     string
+	TargetType TargetType
+	TargetID   int
+	Value      int
+	CreatedAt  time.Time
+}
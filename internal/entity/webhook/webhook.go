@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+	ErrInvalidURL           = errors.New("invalid webhook url")
+	ErrUnknownEvent         = errors.New("unknown webhook event")
+	ErrNoEvents             = errors.New("webhook must subscribe to at least one event")
+)
+
+const (
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+type Event string
+
+const (
+	EventQuestionCreated Event = "question.created"
+	EventQuestionDeleted Event = "question.deleted"
+	EventAnswerCreated   Event = "answer.created"
+	EventAnswerDeleted   Event = "answer.deleted"
+)
+
+// Events lists every event a subscription can filter on.
+var Events = []Event{ //nolint:gochecknoglobals
+	EventQuestionCreated,
+	EventQuestionDeleted,
+	EventAnswerCreated,
+	EventAnswerDeleted,
+}
+
+func (e Event) Valid() bool {
+	for _, known := range Events {
+		if e == known {
+			return true
+		}
+	}
+	return false
+}
+
+type Subscription struct {
+	ID        int
+	URL       string
+	Events    []Event
+	Secret    string
+	Active    bool
+	CreatedBy string
+	CreatedAt time.Time
+}
+
+type DeliveryStatus string
+
+const (
+	StatusPending   DeliveryStatus = "pending"
+	StatusDelivered DeliveryStatus = "delivered"
+	StatusDead      DeliveryStatus = "dead"
+)
+
+// Delivery is one event payload queued for one subscription.
+// It stays pending, with NextAttemptAt pushed back on every failure,
+// until it is either delivered or moved to the dead-letter state.
+type Delivery struct {
+	ID             int
+	SubscriptionID int
+	Event          Event
+	Payload        []byte
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
+
+// Sign returns the value of SignatureHeader for body:
+// "sha256=" followed by the hex HMAC-SHA256 of body keyed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
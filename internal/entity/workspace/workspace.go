@@ -0,0 +1,66 @@
+package workspace
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrWorkspaceNotFound = errors.New("workspace not found")
+	ErrInvalidSlug       = errors.New("invalid workspace slug")
+	ErrSlugTaken         = errors.New("workspace slug taken")
+	ErrAccessDenied      = errors.New("access denied")
+	ErrMemberNotFound    = errors.New("member not found")
+	ErrOwner             = errors.New("workspace owner cannot be removed")
+	ErrDefaultWorkspace  = errors.New("default workspace has no members")
+)
+
+// DefaultSlug names the workspace of content posted outside any other.
+// Every user works in it without being a member.
+const DefaultSlug = "default"
+
+// slugPattern allows 3 to 32 lower-case letters, digits and inner hyphens,
+// so a slug is safe as a path segment.
+var slugPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,30}[a-z0-9]$`)
+
+// ValidateSlug fails with ErrInvalidSlug unless s can name a workspace.
+func ValidateSlug(s string) error {
+	if !slugPattern.MatchString(s) {
+		return ErrInvalidSlug
+	}
+	return nil
+}
+
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleMember Role = "member"
+)
+
+type Workspace struct {
+	ID        int
+	Slug      string
+	Name      string
+	CreatedAt time.Time
+}
+
+// IsDefault reports whether w is the workspace open to every user.
+func (w *Workspace) IsDefault() bool {
+	return w.Slug == DefaultSlug
+}
+
+type Member struct {
+	WorkspaceID int
+	UserID      string
+	Role        Role
+	CreatedAt   time.Time
+}
+
+// Membership is a workspace as one of its members sees it.
+type Membership struct {
+	Workspace
+	Role Role
+}
@@ -3,6 +3,9 @@ package infra
 import (
 	"fmt"
 	"os"
+	"time"
+
+	"test-question/internal/pkg/ratelimit"
 
 	"github.com/caarlos0/env/v7"
 	"github.com/joho/godotenv"
@@ -17,6 +20,80 @@ type Env struct {
 	ListenPort    string `env:"LISTEN_PORT" envDefault:":8000"`
 	DbDSN         string `env:"DB_DSN,required"`
 	MigrationPath string `env:"MIGRATION_PATH" envDefault:"./migration"`
+
+	WebhookPollInterval time.Duration `env:"WEBHOOK_POLL_INTERVAL" envDefault:"5s"`
+	WebhookBatchSize    int           `env:"WEBHOOK_BATCH_SIZE" envDefault:"100"`
+	WebhookMaxAttempts  int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"8"`
+	WebhookBackoffBase  time.Duration `env:"WEBHOOK_BACKOFF_BASE" envDefault:"30s"`
+	WebhookTimeout      time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"10s"`
+
+	OutboxPollInterval time.Duration `env:"OUTBOX_POLL_INTERVAL" envDefault:"1s"`
+	OutboxBatchSize    int           `env:"OUTBOX_BATCH_SIZE" envDefault:"100"`
+	OutboxBackoffBase  time.Duration `env:"OUTBOX_BACKOFF_BASE" envDefault:"5s"`
+	OutboxRetention    time.Duration `env:"OUTBOX_RETENTION" envDefault:"168h"`
+
+	StreamBacklog           int           `env:"STREAM_BACKLOG" envDefault:"1000"`
+	StreamMaxPerUser        int           `env:"STREAM_MAX_PER_USER" envDefault:"5"`
+	StreamHeartbeatInterval time.Duration `env:"STREAM_HEARTBEAT_INTERVAL" envDefault:"15s"`
+	StreamPollInterval      time.Duration `env:"STREAM_POLL_INTERVAL" envDefault:"5s"`
+	StreamRetention         time.Duration `env:"STREAM_RETENTION" envDefault:"24h"`
+
+	DuplicateThreshold float64 `env:"DUPLICATE_THRESHOLD" envDefault:"0.6"`
+	DuplicateLimit     int     `env:"DUPLICATE_LIMIT" envDefault:"5"`
+
+	TrashRestorePeriod time.Duration `env:"TRASH_RESTORE_PERIOD" envDefault:"168h"`
+	TrashRetention     time.Duration `env:"TRASH_RETENTION" envDefault:"720h"`
+
+	ReportHideThreshold int `env:"REPORT_HIDE_THRESHOLD" envDefault:"3"`
+
+	ContentPolicyPath           string        `env:"CONTENT_POLICY_PATH"`
+	ContentPolicyReloadInterval time.Duration `env:"CONTENT_POLICY_RELOAD_INTERVAL" envDefault:"30s"`
+
+	RateLimitQuestions ratelimit.Limit `env:"RATE_LIMIT_QUESTIONS" envDefault:"5/1m"`
+	RateLimitAnswers   ratelimit.Limit `env:"RATE_LIMIT_ANSWERS" envDefault:"20/1m"`
+	RateLimitVotes     ratelimit.Limit `env:"RATE_LIMIT_VOTES" envDefault:"60/1m"`
+	RateLimitReports   ratelimit.Limit `env:"RATE_LIMIT_REPORTS" envDefault:"10/1m"`
+	RateLimitBatch     ratelimit.Limit `env:"RATE_LIMIT_BATCH" envDefault:"10/1m"`
+
+	IdempotencyTTL   time.Duration `env:"IDEMPOTENCY_TTL" envDefault:"24h"`
+	IdempotencyLease time.Duration `env:"IDEMPOTENCY_LEASE" envDefault:"1m"`
+
+	BatchMaxOperations int `env:"BATCH_MAX_OPERATIONS" envDefault:"100"`
+	// RateLimitBatchOperations counts the operations of batches, not requests.
+	RateLimitBatchOperations ratelimit.Limit `env:"RATE_LIMIT_BATCH_OPERATIONS" envDefault:"1000/1m"`
+
+	AttachmentStorage       string          `env:"ATTACHMENT_STORAGE" envDefault:"local"`
+	AttachmentLocalDir      string          `env:"ATTACHMENT_LOCAL_DIR" envDefault:"./data/attachments"`
+	AttachmentS3Endpoint    string          `env:"ATTACHMENT_S3_ENDPOINT"`
+	AttachmentS3Region      string          `env:"ATTACHMENT_S3_REGION" envDefault:"us-east-1"`
+	AttachmentS3Bucket      string          `env:"ATTACHMENT_S3_BUCKET"`
+	AttachmentS3AccessKey   string          `env:"ATTACHMENT_S3_ACCESS_KEY"`
+	AttachmentS3SecretKey   string          `env:"ATTACHMENT_S3_SECRET_KEY"`
+	AttachmentMaxSize       int64           `env:"ATTACHMENT_MAX_SIZE" envDefault:"10485760"`
+	AttachmentAllowedTypes  []string        `env:"ATTACHMENT_ALLOWED_TYPES" envDefault:"image/png,image/jpeg,image/gif,image/webp,text/plain,application/pdf"`
+	AttachmentThumbnailSize int             `env:"ATTACHMENT_THUMBNAIL_SIZE" envDefault:"256"`
+	AttachmentOrphanTTL     time.Duration   `env:"ATTACHMENT_ORPHAN_TTL" envDefault:"24h"`
+	RateLimitAttachments    ratelimit.Limit `env:"RATE_LIMIT_ATTACHMENTS" envDefault:"20/1m"`
+
+	ViewDedupWindow   time.Duration `env:"VIEW_DEDUP_WINDOW" envDefault:"1h"`
+	ViewFlushInterval time.Duration `env:"VIEW_FLUSH_INTERVAL" envDefault:"10s"`
+
+	RankingInterval     time.Duration `env:"RANKING_INTERVAL" envDefault:"5m"`
+	RankingHalfLife     time.Duration `env:"RANKING_HALF_LIFE" envDefault:"12h"`
+	RankingWeightAnswer float64       `env:"RANKING_WEIGHT_ANSWER" envDefault:"3"`
+	RankingWeightVote   float64       `env:"RANKING_WEIGHT_VOTE" envDefault:"2"`
+	RankingWeightView   float64       `env:"RANKING_WEIGHT_VIEW" envDefault:"0.1"`
+
+	DraftTTL time.Duration `env:"DRAFT_TTL" envDefault:"720h"`
+
+	BountyMinAmount      int           `env:"BOUNTY_MIN_AMOUNT" envDefault:"50"`
+	BountyMaxAmount      int           `env:"BOUNTY_MAX_AMOUNT" envDefault:"500"`
+	BountyDuration       time.Duration `env:"BOUNTY_DURATION" envDefault:"168h"`
+	BountyExpiryInterval time.Duration `env:"BOUNTY_EXPIRY_INTERVAL" envDefault:"1m"`
+
+	GraphQLMaxDepth      int             `env:"GRAPHQL_MAX_DEPTH" envDefault:"8"`
+	GraphQLMaxComplexity int             `env:"GRAPHQL_MAX_COMPLEXITY" envDefault:"500"`
+	RateLimitGraphQL     ratelimit.Limit `env:"RATE_LIMIT_GRAPHQL" envDefault:"60/1m"`
 }
 
 func (r *Resources) initEnv() error {
@@ -28,5 +105,17 @@ func (r *Resources) initEnv() error {
 		return fmt.Errorf("env parse: %w", err)
 	}
 
+	if r.Env.RankingHalfLife <= 0 {
+		return fmt.Errorf("env parse: RANKING_HALF_LIFE must be positive")
+	}
+
+	if r.Env.BountyMinAmount <= 0 || r.Env.BountyMaxAmount < r.Env.BountyMinAmount {
+		return fmt.Errorf("env parse: BOUNTY_MIN_AMOUNT must be positive and not above BOUNTY_MAX_AMOUNT")
+	}
+
+	if r.Env.GraphQLMaxDepth <= 0 || r.Env.GraphQLMaxComplexity <= 0 {
+		return fmt.Errorf("env parse: GRAPHQL_MAX_DEPTH and GRAPHQL_MAX_COMPLEXITY must be positive")
+	}
+
 	return nil
 }
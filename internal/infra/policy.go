@@ -0,0 +1,17 @@
+package infra
+
+import (
+	"fmt"
+
+	"test-question/internal/pkg/policy"
+)
+
+func (r *Resources) initPolicy() error {
+	p, err := policy.New(r.Env.ContentPolicyPath)
+	if err != nil {
+		return fmt.Errorf("content policy: %w", err)
+	}
+
+	r.Policy = p
+	return nil
+}
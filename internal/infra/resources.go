@@ -4,6 +4,11 @@ import (
 	"context"
 	"log/slog"
 
+	"test-question/internal/pkg/broker"
+	"test-question/internal/pkg/policy"
+	"test-question/internal/pkg/storage"
+	"test-question/internal/pkg/viewcount"
+
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 )
@@ -12,6 +17,14 @@ type Resources struct {
 	Env    Env
 	DB     *gorm.DB
 	Logger *slog.Logger
+	// Streams fans live events out to SSE clients of this process.
+	Streams *broker.Broker
+	// Policy checks user-written text; its rules are reloaded by a worker.
+	Policy *policy.Engine
+	// Storage keeps the files of attachments.
+	Storage storage.Storage
+	// Views buffers question views of this process until a worker flushes them.
+	Views *viewcount.Counter
 }
 
 func Init(ctx context.Context) (*Resources, error) {
@@ -25,6 +38,16 @@ func Init(ctx context.Context) (*Resources, error) {
 	}
 
 	r.initLogger()
+	r.initStreams()
+	r.initViews()
+
+	if err = r.initPolicy(); err != nil {
+		return nil, err
+	}
+
+	if err = r.initStorage(); err != nil {
+		return nil, err
+	}
 
 	errGrp.Go(func() error {
 		r.Logger.Info("starting db connection")
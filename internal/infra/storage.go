@@ -0,0 +1,27 @@
+package infra
+
+import (
+	"fmt"
+
+	"test-question/internal/pkg/storage"
+)
+
+func (r *Resources) initStorage() error {
+	s, err := storage.New(storage.Config{
+		Backend:  r.Env.AttachmentStorage,
+		LocalDir: r.Env.AttachmentLocalDir,
+		S3: storage.S3Config{
+			Endpoint:  r.Env.AttachmentS3Endpoint,
+			Region:    r.Env.AttachmentS3Region,
+			Bucket:    r.Env.AttachmentS3Bucket,
+			AccessKey: r.Env.AttachmentS3AccessKey,
+			SecretKey: r.Env.AttachmentS3SecretKey,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("attachment storage: %w", err)
+	}
+
+	r.Storage = s
+	return nil
+}
@@ -0,0 +1,16 @@
+package infra
+
+import (
+	"test-question/internal/pkg/broker"
+)
+
+// events queued per stream subscriber before it is disconnected
+const streamBufferSize = 64
+
+func (r *Resources) initStreams() {
+	r.Streams = broker.New(broker.Config{
+		Backlog:    r.Env.StreamBacklog,
+		Buffer:     streamBufferSize,
+		MaxPerUser: r.Env.StreamMaxPerUser,
+	})
+}
@@ -0,0 +1,16 @@
+package infra
+
+import (
+	"test-question/internal/pkg/viewcount"
+)
+
+// (question, viewer) pairs remembered for deduplication; beyond it views
+// of new pairs are dropped until a flush forgets the expired ones
+const viewMaxViewers = 100_000
+
+func (r *Resources) initViews() {
+	r.Views = viewcount.New(viewcount.Config{
+		Window:     r.Env.ViewDedupWindow,
+		MaxViewers: viewMaxViewers,
+	})
+}
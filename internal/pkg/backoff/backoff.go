@@ -0,0 +1,18 @@
+// Package backoff computes the delays between attempts of background jobs
+// that retry failed work, such as webhook deliveries and outbox messages.
+package backoff
+
+import (
+	"time"
+)
+
+// Exponential returns the delay before the next attempt after the given
+// number of failures: base after the first one, doubling with each further
+// failure up to limit.
+func Exponential(base, limit time.Duration, failures int) time.Duration {
+	d := base
+	for i := 1; i < failures && d < limit; i++ {
+		d *= 2
+	}
+	return min(d, limit)
+}
@@ -0,0 +1,26 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExponential(t *testing.T) {
+	cases := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 10 * time.Second},
+		{1, 10 * time.Second},
+		{2, 20 * time.Second},
+		{3, 40 * time.Second},
+		{6, 5 * time.Minute},
+		{1000, 5 * time.Minute},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, tc.want, Exponential(10*time.Second, 5*time.Minute, tc.failures), tc.failures)
+	}
+}
@@ -0,0 +1,165 @@
+// Package broker is an in-process pub/sub hub for live streams. It keeps a
+// bounded backlog of recent events so reconnecting subscribers can resume.
+// Events come from the shared event log, which assigns their IDs, so every
+// replica's broker carries the same events under the same IDs.
+package broker
+
+import (
+	"sync"
+
+	entS "test-question/internal/entity/stream"
+)
+
+type Config struct {
+	// Backlog is how many recent events, across all topics, are kept for resume.
+	Backlog int
+	// Buffer is how many events may queue up for a subscriber; a subscriber
+	// that falls further behind is disconnected and has to resume.
+	Buffer int
+	// MaxPerUser limits concurrent subscriptions of one user.
+	MaxPerUser int
+}
+
+type published struct {
+	topic string
+	event entS.Event
+}
+
+type Broker struct {
+	cfg Config
+
+	mu      sync.Mutex
+	seq     int64
+	backlog []published
+	topics  map[string]map[*Subscription]struct{}
+	users   map[string]int
+	closed  bool
+}
+
+func New(cfg Config) *Broker {
+	return &Broker{
+		cfg:    cfg,
+		topics: make(map[string]map[*Subscription]struct{}),
+		users:  make(map[string]int),
+	}
+}
+
+type Subscription struct {
+	b      *Broker
+	topic  string
+	userID string
+	ch     chan entS.Event
+}
+
+// Events is closed when the subscription ends: on Close, when the
+// subscriber falls behind, or when the broker shuts down.
+func (s *Subscription) Events() <-chan entS.Event {
+	return s.ch
+}
+
+func (s *Subscription) Close() {
+	s.b.mu.Lock()
+	defer s.b.mu.Unlock()
+
+	s.b.remove(s)
+}
+
+// Publish fans an event out to the topic's subscribers. Events must come in
+// the order of their IDs; one not newer than the last published is dropped.
+func (b *Broker) Publish(topic string, e entS.Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed || e.ID <= b.seq {
+		return
+	}
+	b.seq = e.ID
+
+	b.backlog = append(b.backlog, published{topic: topic, event: e})
+	if len(b.backlog) > b.cfg.Backlog {
+		b.backlog = b.backlog[len(b.backlog)-b.cfg.Backlog:]
+	}
+
+	for s := range b.topics[topic] {
+		select {
+		case s.ch <- e:
+		default:
+			b.remove(s)
+		}
+	}
+}
+
+// Subscribe opens a subscription to topic and returns the backlogged events
+// published after lastID. A lastID of 0 means a fresh subscription with no
+// replay; a lastID newer than anything published, as on a replica that has
+// not caught up yet, replays nothing, the events after it arrive live.
+func (b *Broker) Subscribe(topic, userID string, lastID int64) (*Subscription, []entS.Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, nil, entS.ErrStreamsClosed
+	}
+
+	if b.users[userID] >= b.cfg.MaxPerUser {
+		return nil, nil, entS.ErrTooManyStreams
+	}
+
+	s := &Subscription{
+		b:      b,
+		topic:  topic,
+		userID: userID,
+		ch:     make(chan entS.Event, b.cfg.Buffer),
+	}
+
+	if b.topics[topic] == nil {
+		b.topics[topic] = make(map[*Subscription]struct{})
+	}
+	b.topics[topic][s] = struct{}{}
+	b.users[userID]++
+
+	var missed []entS.Event
+	if lastID > 0 {
+		for _, p := range b.backlog {
+			if p.topic == topic && p.event.ID > lastID {
+				missed = append(missed, p.event)
+			}
+		}
+	}
+
+	return s, missed, nil
+}
+
+// Close ends all subscriptions and rejects new ones.
+func (b *Broker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for _, subs := range b.topics {
+		for s := range subs {
+			b.remove(s)
+		}
+	}
+}
+
+// remove must be called with b.mu held. It is a no-op for a subscription
+// that was already removed.
+func (b *Broker) remove(s *Subscription) {
+	subs := b.topics[s.topic]
+	if _, ok := subs[s]; !ok {
+		return
+	}
+
+	delete(subs, s)
+	if len(subs) == 0 {
+		delete(b.topics, s.topic)
+	}
+
+	b.users[s.userID]--
+	if b.users[s.userID] == 0 {
+		delete(b.users, s.userID)
+	}
+
+	close(s.ch)
+}
@@ -0,0 +1,158 @@
+// Package i18n holds the message catalogs API errors are written with and
+// picks the one a client asked for.
+package i18n
+
+import (
+	"embed"
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// Languages the catalogs are written in; the first one is the fallback.
+const (
+	English = "en"
+	Russian = "ru"
+)
+
+//go:embed locales/*.json
+var locales embed.FS
+
+var (
+	catalogs  = mustLoad(English, Russian)                                              //nolint:gochecknoglobals
+	supported = []string{English, Russian}                                              //nolint:gochecknoglobals
+	matcher   = language.NewMatcher([]language.Tag{language.English, language.Russian}) //nolint:gochecknoglobals
+
+	pluralRe = regexp.MustCompile(`\{param:(\w+)\}`)
+)
+
+// Catalog maps error and field codes to the text of one language.
+type Catalog struct {
+	lang string
+
+	Errors  map[string]string   `json:"errors"`
+	Fields  map[string]string   `json:"fields"`
+	Plurals map[string][]string `json:"plurals"`
+}
+
+func mustLoad(langs ...string) map[string]*Catalog {
+	out := make(map[string]*Catalog, len(langs))
+	for _, lang := range langs {
+		raw, err := locales.ReadFile("locales/" + lang + ".json")
+		if err != nil {
+			panic(err)
+		}
+
+		c := &Catalog{lang: lang}
+		if err := json.Unmarshal(raw, c); err != nil {
+			panic("i18n: " + lang + ": " + err.Error())
+		}
+		out[lang] = c
+	}
+	return out
+}
+
+// Negotiate returns the language of the catalog that best serves an
+// Accept-Language header, English when none does.
+func Negotiate(acceptLanguage string) string {
+	_, i := language.MatchStrings(matcher, acceptLanguage)
+	return supported[i]
+}
+
+// Lookup returns the catalog of lang, the English one for languages without
+// a catalog.
+func Lookup(lang string) *Catalog {
+	if c, ok := catalogs[lang]; ok {
+		return c
+	}
+	return catalogs[English]
+}
+
+// Lang returns the language the catalog is written in.
+func (c *Catalog) Lang() string {
+	return c.lang
+}
+
+// Error returns the message of an error code. Codes missing from the
+// catalog fall back to English, then to the code itself.
+func (c *Catalog) Error(code string) string {
+	if msg, ok := c.Errors[code]; ok {
+		return msg
+	}
+	if msg, ok := catalogs[English].Errors[code]; ok {
+		return msg
+	}
+	return code
+}
+
+// Field returns the message of a field code, e.g. a validator tag. kind
+// (string, slice or number) picks a wording like "min.string" over the plain
+// "min", and param fills in {param}; {param:noun} is the noun in the plural
+// form agreeing with param. Codes without a message, or whose message needs
+// a param that is not given, read as the "invalid" one.
+func (c *Catalog) Field(code, kind, param string) string {
+	tmpl, ok := c.template(code, kind)
+	if !ok || (param == "" && strings.Contains(tmpl, "{param")) {
+		tmpl, _ = c.template("invalid", "")
+	}
+
+	tmpl = pluralRe.ReplaceAllStringFunc(tmpl, func(m string) string {
+		return c.plural(pluralRe.FindStringSubmatch(m)[1], param)
+	})
+	return strings.ReplaceAll(tmpl, "{param}", param)
+}
+
+func (c *Catalog) template(code, kind string) (string, bool) {
+	if kind != "" {
+		if tmpl, ok := c.Fields[code+"."+kind]; ok {
+			return tmpl, true
+		}
+	}
+	tmpl, ok := c.Fields[code]
+	return tmpl, ok
+}
+
+func (c *Catalog) plural(noun, param string) string {
+	forms := c.Plurals[noun]
+	if len(forms) == 0 {
+		return noun
+	}
+
+	n, err := strconv.Atoi(param)
+	if err != nil {
+		return forms[len(forms)-1]
+	}
+
+	i := pluralForm(c.lang, n)
+	if i >= len(forms) {
+		i = len(forms) - 1
+	}
+	return forms[i]
+}
+
+// pluralForm returns the index of the form n takes: one/other in English,
+// one/few/many in Russian.
+func pluralForm(lang string, n int) int {
+	if n < 0 {
+		n = -n
+	}
+
+	if lang != Russian {
+		if n == 1 {
+			return 0
+		}
+		return 1
+	}
+
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return 0
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return 1
+	default:
+		return 2
+	}
+}
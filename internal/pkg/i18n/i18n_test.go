@@ -0,0 +1,76 @@
+package i18n
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogs_SameKeys(t *testing.T) {
+	en, ru := Lookup(English), Lookup(Russian)
+
+	for code := range en.Errors {
+		require.Contains(t, ru.Errors, code)
+	}
+	for code := range ru.Errors {
+		require.Contains(t, en.Errors, code)
+	}
+	for code := range en.Fields {
+		require.Contains(t, ru.Fields, code)
+	}
+	for code := range ru.Fields {
+		require.Contains(t, en.Fields, code)
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	require.Equal(t, Russian, Negotiate("ru"))
+	require.Equal(t, Russian, Negotiate("ru-RU,en;q=0.5"))
+	require.Equal(t, English, Negotiate("en-GB,ru;q=0.5"))
+	require.Equal(t, English, Negotiate("fr"))
+	require.Equal(t, English, Negotiate(""))
+	require.Equal(t, English, Negotiate("not a header"))
+}
+
+func TestCatalog_Error(t *testing.T) {
+	require.Equal(t, "Question not found.", Lookup(English).Error("question_not_found"))
+	require.Equal(t, "Вопрос не найден.", Lookup(Russian).Error("question_not_found"))
+	require.Equal(t, "Question not found.", Lookup("de").Error("question_not_found"))
+	require.Equal(t, "some_new_code", Lookup(Russian).Error("some_new_code"))
+}
+
+func TestCatalog_Field(t *testing.T) {
+	en, ru := Lookup(English), Lookup(Russian)
+
+	tests := []struct {
+		c           *Catalog
+		code, kind  string
+		param, want string
+	}{
+		{en, "min", "string", "1", "must be at least 1 character"},
+		{en, "min", "string", "16", "must be at least 16 characters"},
+		{en, "max", "slice", "10", "must contain at most 10 items"},
+		{en, "min", "number", "3", "must be at least 3"},
+		{en, "required", "string", "", "is required"},
+		{en, "banned_word", "", "", "contains a banned word"},
+		{en, "unknown_rule", "", "", "is invalid"},
+		{en, "max", "", "", "is invalid"},
+		{ru, "min", "string", "1", "должно содержать не менее 1 символа"},
+		{ru, "min", "string", "3", "должно содержать не менее 3 символов"},
+		{ru, "min", "string", "21", "должно содержать не менее 21 символа"},
+		{ru, "max", "string", "100", "должно содержать не более 100 символов"},
+		{ru, "oneof", "string", "-1 1", "должно быть одним из: -1 1"},
+	}
+
+	for _, tt := range tests {
+		require.Equal(t, tt.want, tt.c.Field(tt.code, tt.kind, tt.param))
+	}
+}
+
+func TestPluralForm(t *testing.T) {
+	for n, want := range map[int]int{1: 0, 2: 1, 4: 1, 5: 2, 11: 2, 12: 2, 21: 0, 22: 1, 111: 2, 0: 2} {
+		require.Equal(t, want, pluralForm(Russian, n), n)
+	}
+	require.Equal(t, 0, pluralForm(English, 1))
+	require.Equal(t, 1, pluralForm(English, 0))
+}
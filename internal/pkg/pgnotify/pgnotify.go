@@ -0,0 +1,85 @@
+// Package pgnotify turns Postgres notifications on a channel into wake-ups,
+// so workers polling a table react to new rows without waiting for a tick.
+package pgnotify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+type logger interface {
+	ErrorContext(ctx context.Context, msg string, args ...any)
+}
+
+// Listener holds its own connection, outside the gorm pool, since LISTEN is
+// bound to the session that ran it.
+type Listener struct {
+	dsn        string
+	channel    string
+	retryDelay time.Duration
+	logger     logger
+	c          chan struct{}
+}
+
+func New(dsn, channel string, retryDelay time.Duration, logger logger) *Listener {
+	return &Listener{
+		dsn:        dsn,
+		channel:    channel,
+		retryDelay: retryDelay,
+		logger:     logger,
+		c:          make(chan struct{}, 1),
+	}
+}
+
+// C receives after notifications; several of them close together may be
+// merged into one receive. It also receives after every (re)connect, since
+// notifications sent while disconnected are lost.
+func (l *Listener) C() <-chan struct{} {
+	return l.c
+}
+
+// Run listens until ctx is cancelled, reconnecting after retryDelay when the
+// connection fails.
+func (l *Listener) Run(ctx context.Context) {
+	for {
+		if err := l.listen(ctx); err != nil && ctx.Err() == nil {
+			l.logger.ErrorContext(ctx, "listen failed", "channel", l.channel, "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(l.retryDelay):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context) error {
+	conn, err := pgx.Connect(ctx, l.dsn)
+	if err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close(context.WithoutCancel(ctx)) //nolint:errcheck
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("listen: %w", err)
+	}
+
+	for {
+		l.signal()
+
+		if _, err := conn.WaitForNotification(ctx); err != nil {
+			return fmt.Errorf("wait for notification: %w", err)
+		}
+	}
+}
+
+func (l *Listener) signal() {
+	select {
+	case l.c <- struct{}{}:
+	default:
+	}
+}
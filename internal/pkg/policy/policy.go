@@ -0,0 +1,244 @@
+// Package policy checks user-written text against content rules. The rules
+// come from a JSON file and can be reloaded while the service runs.
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	entP "test-question/internal/entity/policy"
+)
+
+// Rules is the content policy as written in the rules file. Zero limits are
+// not enforced.
+type Rules struct {
+	// MaxLength is the longest text allowed, in characters.
+	MaxLength int `json:"max_length"`
+	// BannedWords are matched as whole words, case-insensitively.
+	BannedWords []string `json:"banned_words"`
+	// BannedPatterns are regular expressions the text must not match.
+	BannedPatterns []string `json:"banned_patterns"`
+	MaxLinks       int      `json:"max_links"`
+	// BlockedDomains may not be linked to; subdomains are blocked too.
+	BlockedDomains []string `json:"blocked_domains"`
+	// MaxRepeats is how many times in a row a word or a line may appear.
+	MaxRepeats int `json:"max_repeats"`
+}
+
+// DefaultRules apply when no rules file is configured.
+var DefaultRules = Rules{MaxLength: 10000, MaxRepeats: 10} //nolint:gochecknoglobals
+
+var linkRe = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']+`)
+
+type ruleSet struct {
+	rules       Rules
+	bannedWords *regexp.Regexp
+	patterns    []*regexp.Regexp
+	domains     []string
+}
+
+func compile(r Rules) (*ruleSet, error) {
+	rs := &ruleSet{rules: r}
+
+	quoted := make([]string, 0, len(r.BannedWords))
+	for _, w := range r.BannedWords {
+		// a blank entry would match between any two words
+		if strings.TrimSpace(w) != "" {
+			quoted = append(quoted, regexp.QuoteMeta(w))
+		}
+	}
+	if len(quoted) > 0 {
+		// \b only knows ASCII letters, so word edges are spelled out to
+		// match words in any script
+		rs.bannedWords = regexp.MustCompile(`(?i)(?:^|[^\p{L}\p{N}_])(?:` + strings.Join(quoted, "|") + `)(?:$|[^\p{L}\p{N}_])`)
+	}
+
+	for _, p := range r.BannedPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("banned pattern %q: %w", p, err)
+		}
+		rs.patterns = append(rs.patterns, re)
+	}
+
+	for _, d := range r.BlockedDomains {
+		rs.domains = append(rs.domains, strings.TrimPrefix(strings.ToLower(d), "www."))
+	}
+
+	return rs, nil
+}
+
+// Engine holds the current rule set. Check is safe to call while the rules
+// are being reloaded.
+type Engine struct {
+	path string
+
+	current atomic.Pointer[ruleSet]
+
+	mu      sync.Mutex
+	modTime time.Time
+}
+
+// New builds an engine from the rules file at path, or from DefaultRules
+// when path is empty.
+func New(path string) (*Engine, error) {
+	e := &Engine{path: path}
+
+	if path == "" {
+		rs, err := compile(DefaultRules)
+		if err != nil {
+			return nil, err
+		}
+		e.current.Store(rs)
+		return e, nil
+	}
+
+	if _, err := e.Reload(); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// Reload reads the rules file again if it changed since the last load and
+// reports whether new rules took effect. A broken file leaves the current
+// rules in place.
+func (e *Engine) Reload() (bool, error) {
+	if e.path == "" {
+		return false, nil
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	info, err := os.Stat(e.path)
+	if err != nil {
+		return false, fmt.Errorf("stat content policy: %w", err)
+	}
+
+	if e.current.Load() != nil && info.ModTime().Equal(e.modTime) {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(e.path)
+	if err != nil {
+		return false, fmt.Errorf("read content policy: %w", err)
+	}
+
+	var r Rules
+	if err = json.Unmarshal(data, &r); err != nil {
+		return false, fmt.Errorf("parse content policy: %w", err)
+	}
+
+	rs, err := compile(r)
+	if err != nil {
+		return false, fmt.Errorf("compile content policy: %w", err)
+	}
+
+	e.current.Store(rs)
+	e.modTime = info.ModTime()
+
+	return true, nil
+}
+
+// Check returns entity Violations naming the field and the first rule its
+// text breaks, with the limit of the rule, or nil.
+func (e *Engine) Check(field, text string) error {
+	if v, ok := e.current.Load().violation(text); ok {
+		return entP.Violations{field: v}
+	}
+	return nil
+}
+
+func (rs *ruleSet) violation(text string) (entP.Violation, bool) {
+	r := rs.rules
+
+	if r.MaxLength > 0 && utf8.RuneCountInString(text) > r.MaxLength {
+		return entP.Violation{Rule: entP.RuleMaxLength, Param: strconv.Itoa(r.MaxLength)}, true
+	}
+
+	if rs.bannedWords != nil && rs.bannedWords.MatchString(text) {
+		return entP.Violation{Rule: entP.RuleBannedWord}, true
+	}
+
+	for _, re := range rs.patterns {
+		if re.MatchString(text) {
+			return entP.Violation{Rule: entP.RuleBannedPattern}, true
+		}
+	}
+
+	links := linkRe.FindAllString(text, -1)
+	if r.MaxLinks > 0 && len(links) > r.MaxLinks {
+		return entP.Violation{Rule: entP.RuleMaxLinks, Param: strconv.Itoa(r.MaxLinks)}, true
+	}
+
+	for _, link := range links {
+		if rs.blocked(link) {
+			return entP.Violation{Rule: entP.RuleBlockedDomain}, true
+		}
+	}
+
+	if r.MaxRepeats > 0 && (maxRun(strings.Fields(strings.ToLower(text))) > r.MaxRepeats ||
+		maxRun(nonEmptyLines(text)) > r.MaxRepeats) {
+		return entP.Violation{Rule: entP.RuleRepeatedText, Param: strconv.Itoa(r.MaxRepeats)}, true
+	}
+
+	return entP.Violation{}, false
+}
+
+func (rs *ruleSet) blocked(link string) bool {
+	if len(rs.domains) == 0 {
+		return false
+	}
+
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+
+	u, err := url.Parse(link)
+	if err != nil {
+		return false
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	for _, d := range rs.domains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// maxRun returns the length of the longest run of equal items.
+func maxRun(items []string) int {
+	longest, run := 0, 0
+	for i, item := range items {
+		if i > 0 && item == items[i-1] {
+			run++
+		} else {
+			run = 1
+		}
+		longest = max(longest, run)
+	}
+	return longest
+}
+
+func nonEmptyLines(text string) []string {
+	var out []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			out = append(out, line)
+		}
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	entP "test-question/internal/entity/policy"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeRules(t *testing.T, path, rules string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(rules), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestCheck_Rules(t *testing.T) {
+	rs, err := compile(Rules{
+		MaxLength:      50,
+		BannedWords:    []string{"casino", "спам", " "},
+		BannedPatterns: []string{`\d{4}-\d{4}-\d{4}-\d{4}`},
+		MaxLinks:       1,
+		BlockedDomains: []string{"spam.example"},
+		MaxRepeats:     2,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		text string
+		want entP.Violation
+	}{
+		{name: "ok", text: "how do I read a file"},
+		{name: "too long", text: strings.Repeat("a", 51), want: entP.Violation{Rule: entP.RuleMaxLength, Param: "50"}},
+		{name: "banned word", text: "best CASINO here", want: entP.Violation{Rule: entP.RuleBannedWord}},
+		{name: "word inside another", text: "casinos are fine"},
+		{name: "non-ASCII banned word", text: "это СПАМ тут", want: entP.Violation{Rule: entP.RuleBannedWord}},
+		{name: "non-ASCII word inside another", text: "спамеры тут"},
+		{name: "banned pattern", text: "card 1234-5678-1234-5678", want: entP.Violation{Rule: entP.RuleBannedPattern}},
+		{name: "too many links", text: "http://a.io http://b.io", want: entP.Violation{Rule: entP.RuleMaxLinks, Param: "1"}},
+		{name: "blocked domain", text: "see www.spam.example/x", want: entP.Violation{Rule: entP.RuleBlockedDomain}},
+		{name: "blocked subdomain", text: "https://go.spam.example", want: entP.Violation{Rule: entP.RuleBlockedDomain}},
+		{name: "repeated words", text: "buy buy Buy now", want: entP.Violation{Rule: entP.RuleRepeatedText, Param: "2"}},
+		{name: "repeated lines", text: "hi\nhi\n\nhi", want: entP.Violation{Rule: entP.RuleRepeatedText, Param: "2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, ok := rs.violation(tt.text)
+			require.Equal(t, tt.want.Rule != "", ok)
+			require.Equal(t, tt.want, v)
+		})
+	}
+}
+
+func TestEngine_CheckReturnsViolations(t *testing.T) {
+	e, err := New("")
+	require.NoError(t, err)
+
+	require.NoError(t, e.Check("Text", "short"))
+
+	err = e.Check("Text", strings.Repeat("x", DefaultRules.MaxLength+1))
+	require.ErrorIs(t, err, entP.ErrViolation)
+	require.Equal(t, entP.Violations{"Text": {Rule: entP.RuleMaxLength, Param: strconv.Itoa(DefaultRules.MaxLength)}}, err)
+}
+
+func TestEngine_Reload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	start := time.Now().Add(-time.Hour)
+	writeRules(t, path, `{"banned_words": ["foo"]}`, start)
+
+	e, err := New(path)
+	require.NoError(t, err)
+	require.Error(t, e.Check("Text", "foo"))
+
+	reloaded, err := e.Reload()
+	require.NoError(t, err)
+	require.False(t, reloaded)
+
+	writeRules(t, path, `{"banned_words": ["bar"]}`, start.Add(time.Minute))
+
+	reloaded, err = e.Reload()
+	require.NoError(t, err)
+	require.True(t, reloaded)
+	require.NoError(t, e.Check("Text", "foo"))
+	require.Error(t, e.Check("Text", "bar"))
+
+	// a broken file keeps the last good rules
+	writeRules(t, path, `{"banned_patterns": ["("]}`, start.Add(2*time.Minute))
+
+	_, err = e.Reload()
+	require.Error(t, err)
+	require.Error(t, e.Check("Text", "bar"))
+}
+
+func TestNew_InvalidFile(t *testing.T) {
+	_, err := New(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
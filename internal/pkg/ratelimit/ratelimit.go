@@ -0,0 +1,161 @@
+// Package ratelimit implements token buckets. A bucket holds up to Requests
+// tokens and refills them evenly over Per; every request takes one token, or
+// one per action for a request that stands for several.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Limit allows Requests per Per with bursts of up to Requests. A zero Limit
+// does not limit anything.
+type Limit struct {
+	Requests int
+	Per      time.Duration
+}
+
+// UnmarshalText parses limits written as "5/1m"; "0" or "" switches
+// limiting off.
+func (l *Limit) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	if s == "" || s == "0" {
+		*l = Limit{}
+		return nil
+	}
+
+	requests, per, ok := strings.Cut(s, "/")
+	if !ok {
+		return fmt.Errorf("rate limit %q: want requests/duration", s)
+	}
+
+	n, err := strconv.Atoi(requests)
+	if err != nil || n < 0 {
+		return fmt.Errorf("rate limit %q: invalid request count", s)
+	}
+
+	d, err := time.ParseDuration(per)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("rate limit %q: invalid duration", s)
+	}
+
+	*l = Limit{Requests: n, Per: d}
+	return nil
+}
+
+func (l Limit) Enabled() bool {
+	return l.Requests > 0 && l.Per > 0
+}
+
+// Result is the state of a bucket after a request took, or failed to take,
+// its tokens.
+type Result struct {
+	Allowed   bool
+	Limit     int
+	Remaining int
+	// Reset is how long until the bucket is full again.
+	Reset time.Duration
+	// RetryAfter is how long until the tokens asked for are back, set when
+	// not Allowed.
+	RetryAfter time.Duration
+}
+
+type bucket struct {
+	tokens float64
+	at     time.Time
+	// per is how long the bucket takes to refill from empty.
+	per time.Duration
+}
+
+// take refills the bucket up to now and takes n tokens if they are all there.
+func (b *bucket) take(l Limit, now time.Time, n int) Result {
+	capacity := float64(l.Requests)
+	rate := capacity / l.Per.Seconds()
+
+	if elapsed := now.Sub(b.at).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(capacity, b.tokens+elapsed*rate)
+	}
+	b.at = now
+	b.per = l.Per
+
+	res := Result{Limit: l.Requests}
+	if b.tokens >= float64(n) {
+		b.tokens -= float64(n)
+		res.Allowed = true
+	} else {
+		res.RetryAfter = seconds((float64(n) - b.tokens) / rate)
+	}
+
+	res.Remaining = int(b.tokens)
+	res.Reset = seconds((capacity - b.tokens) / rate)
+
+	return res
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+type timer interface {
+	Now() time.Time
+}
+
+// MemoryStore keeps buckets in process memory, so every replica limits on
+// its own. Buckets that have refilled completely are dropped from time to
+// time.
+type MemoryStore struct {
+	timer timer
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	swept   time.Time
+}
+
+// idle buckets are looked for at most this often
+const sweepInterval = time.Minute
+
+func NewMemoryStore(timer timer) *MemoryStore {
+	return &MemoryStore{
+		timer:   timer,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Take takes n tokens from the bucket of key, or none when fewer are left.
+// A bucket seen for the first time starts full.
+func (s *MemoryStore) Take(_ context.Context, key string, l Limit, n int) (Result, error) {
+	now := s.timer.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(l.Requests), at: now}
+		s.buckets[key] = b
+	}
+
+	res := b.take(l, now, n)
+
+	if now.Sub(s.swept) >= sweepInterval {
+		s.sweep(now)
+		s.swept = now
+	}
+
+	return res, nil
+}
+
+// sweep drops buckets untouched for longer than a full refill takes; they
+// would start full anyway.
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, b := range s.buckets {
+		if now.Sub(b.at) > b.per {
+			delete(s.buckets, key)
+		}
+	}
+}
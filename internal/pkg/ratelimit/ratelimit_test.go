@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTimer struct {
+	now time.Time
+}
+
+func (t *fakeTimer) Now() time.Time {
+	return t.now
+}
+
+func TestLimit_UnmarshalText(t *testing.T) {
+	var l Limit
+
+	require.NoError(t, l.UnmarshalText([]byte("5/1m")))
+	require.Equal(t, Limit{Requests: 5, Per: time.Minute}, l)
+	require.True(t, l.Enabled())
+
+	require.NoError(t, l.UnmarshalText([]byte("0")))
+	require.False(t, l.Enabled())
+
+	for _, bad := range []string{"5", "x/1m", "5/x", "5/0s", "-1/1m"} {
+		require.Error(t, l.UnmarshalText([]byte(bad)), bad)
+	}
+}
+
+func TestMemoryStore_TokenBucket(t *testing.T) {
+	ctx := context.Background()
+	tm := &fakeTimer{now: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)}
+	s := NewMemoryStore(tm)
+	l := Limit{Requests: 5, Per: time.Minute}
+
+	// the burst is the whole bucket
+	for i := 4; i >= 0; i-- {
+		res, err := s.Take(ctx, "u1", l, 1)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+		require.Equal(t, i, res.Remaining)
+	}
+
+	res, err := s.Take(ctx, "u1", l, 1)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, 12*time.Second, res.RetryAfter)
+	require.Equal(t, time.Minute, res.Reset)
+
+	// other keys have their own bucket
+	res, err = s.Take(ctx, "u2", l, 1)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+
+	// one token comes back every 12s
+	tm.now = tm.now.Add(12 * time.Second)
+	res, err = s.Take(ctx, "u1", l, 1)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+
+	// never more than the bucket holds
+	tm.now = tm.now.Add(time.Hour)
+	res, err = s.Take(ctx, "u1", l, 1)
+	require.NoError(t, err)
+	require.Equal(t, 4, res.Remaining)
+}
+
+func TestMemoryStore_TakesManyAtOnce(t *testing.T) {
+	ctx := context.Background()
+	tm := &fakeTimer{now: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)}
+	s := NewMemoryStore(tm)
+	l := Limit{Requests: 5, Per: time.Minute}
+
+	res, err := s.Take(ctx, "u1", l, 3)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, 2, res.Remaining)
+
+	// all or nothing
+	res, err = s.Take(ctx, "u1", l, 3)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, 2, res.Remaining)
+	require.Equal(t, 12*time.Second, res.RetryAfter)
+
+	res, err = s.Take(ctx, "u1", l, 2)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, 0, res.Remaining)
+}
+
+func TestMemoryStore_SweepsIdleBuckets(t *testing.T) {
+	ctx := context.Background()
+	tm := &fakeTimer{now: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)}
+	s := NewMemoryStore(tm)
+
+	_, err := s.Take(ctx, "idle", Limit{Requests: 1, Per: time.Second}, 1)
+	require.NoError(t, err)
+
+	tm.now = tm.now.Add(2 * time.Minute)
+	_, err = s.Take(ctx, "active", Limit{Requests: 1, Per: time.Hour}, 1)
+	require.NoError(t, err)
+
+	require.NotContains(t, s.buckets, "idle")
+	require.Contains(t, s.buckets, "active")
+}
@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+)
+
+// CacheControl lets clients keep responses but revalidate them on every
+// use; responses depend on the authenticated user, so shared caches don't.
+const CacheControl = "private, no-cache"
+
+// StrongETag builds a quoted strong entity tag from the parts that
+// determine a representation, e.g. ids and revisions.
+func StrongETag(parts ...string) string {
+	sum := sha256.Sum256([]byte(strings.Join(parts, "\n")))
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// WeakETag builds a weak entity tag for representations that may differ
+// in details, such as approximate counters, while the tag stays the same.
+func WeakETag(parts ...string) string {
+	return "W/" + StrongETag(parts...)
+}
+
+// WriteNotModified sets the ETag and Cache-Control headers and answers 304
+// when If-None-Match matches the tag. It reports whether it answered, in
+// which case the caller writes nothing else.
+func WriteNotModified(w http.ResponseWriter, r *http.Request, etag string) bool {
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", CacheControl)
+
+	if !matchTag(r.Header.Get("If-None-Match"), etag, true) {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// WritePreconditionFailed answers 412 when the request has an If-Match that
+// does not match the current tag of the resource, i.e. it changed since the
+// client read it. It reports whether it answered.
+func WritePreconditionFailed(w http.ResponseWriter, r *http.Request, etag string) bool {
+	ifMatch := r.Header.Get("If-Match")
+	if ifMatch == "" || matchTag(ifMatch, etag, false) {
+		return false
+	}
+
+	w.Header().Set("ETag", etag)
+	WriteJSON(w, http.StatusPreconditionFailed, NewBaseHTTPError("precondition_failed"))
+	return true
+}
+
+// matchTag reports whether the comma-separated list of entity tags in a
+// conditional header matches etag. If-None-Match compares weakly, If-Match
+// strongly, so a weak tag on either side never satisfies it.
+func matchTag(header, etag string, weak bool) bool {
+	etagWeak := strings.HasPrefix(etag, "W/")
+	etag = strings.TrimPrefix(etag, "W/")
+
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if strings.HasPrefix(tag, "W/") {
+			if !weak {
+				continue
+			}
+			tag = tag[2:]
+		}
+		if tag == etag && (weak || !etagWeak) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,113 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStrongETag(t *testing.T) {
+	tag := StrongETag("q", "1", "2")
+
+	require.Equal(t, tag, StrongETag("q", "1", "2"))
+	require.NotEqual(t, tag, StrongETag("q", "1", "3"))
+	require.NotEqual(t, tag, StrongETag("q", "12"))
+	require.Regexp(t, `^"[0-9a-f]{32}"$`, tag)
+}
+
+func TestWeakETag(t *testing.T) {
+	require.Equal(t, "W/"+StrongETag("q", "1"), WeakETag("q", "1"))
+}
+
+func TestWriteNotModified_WeakTag(t *testing.T) {
+	etag := WeakETag("q", "1")
+
+	for _, ifNoneMatch := range []string{etag, StrongETag("q", "1")} {
+		req := httptest.NewRequest("GET", "/questions/1", nil)
+		req.Header.Set("If-None-Match", ifNoneMatch)
+
+		w := httptest.NewRecorder()
+		require.True(t, WriteNotModified(w, req, etag), ifNoneMatch)
+		require.Equal(t, etag, w.Header().Get("ETag"))
+	}
+}
+
+func TestWritePreconditionFailed_WeakTag(t *testing.T) {
+	etag := WeakETag("q", "1")
+
+	req := httptest.NewRequest("PUT", "/questions/1", nil)
+	req.Header.Set("If-Match", etag)
+
+	w := httptest.NewRecorder()
+	require.True(t, WritePreconditionFailed(w, req, etag))
+}
+
+func TestWriteNotModified(t *testing.T) {
+	const etag = `"abc"`
+
+	cases := []struct {
+		name        string
+		ifNoneMatch string
+		want        bool
+	}{
+		{"no_header", "", false},
+		{"match", `"abc"`, true},
+		{"weak_match", `W/"abc"`, true},
+		{"in_list", `"old", "abc"`, true},
+		{"any", `*`, true},
+		{"changed", `"old"`, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/questions/1", nil)
+			if tc.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tc.ifNoneMatch)
+			}
+
+			w := httptest.NewRecorder()
+			require.Equal(t, tc.want, WriteNotModified(w, req, etag))
+			require.Equal(t, etag, w.Header().Get("ETag"))
+			require.Equal(t, CacheControl, w.Header().Get("Cache-Control"))
+			if tc.want {
+				require.Equal(t, http.StatusNotModified, w.Code)
+				require.Empty(t, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestWritePreconditionFailed(t *testing.T) {
+	const etag = `"abc"`
+
+	cases := []struct {
+		name    string
+		ifMatch string
+		want    bool
+	}{
+		{"no_header", "", false},
+		{"match", `"abc"`, false},
+		{"any", `*`, false},
+		{"changed", `"old"`, true},
+		{"weak_never_matches", `W/"abc"`, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("PUT", "/questions/1", nil)
+			if tc.ifMatch != "" {
+				req.Header.Set("If-Match", tc.ifMatch)
+			}
+
+			w := httptest.NewRecorder()
+			require.Equal(t, tc.want, WritePreconditionFailed(w, req, etag))
+			if tc.want {
+				require.Equal(t, http.StatusPreconditionFailed, w.Code)
+				require.Equal(t, etag, w.Header().Get("ETag"))
+				require.JSONEq(t, `{"code":"precondition_failed","message":"The resource has changed since you last read it."}`, w.Body.String())
+			}
+		})
+	}
+}
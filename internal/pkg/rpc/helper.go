@@ -4,6 +4,9 @@ import (
 	"encoding/json"
 	"log/slog"
 	"net/http"
+	"reflect"
+
+	"test-question/internal/pkg/i18n"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
@@ -13,22 +16,62 @@ var (
 	validate = validator.New() //nolint:predeclared,gochecknoglobals
 )
 
+// BaseHTTPError carries a stable machine-readable code and its message in
+// the language of the response, filled in by WriteJSON.
 type BaseHTTPError struct {
+	Code    string `json:"code"`
 	Message string `json:"message"`
 }
 
-func NewBaseHTTPError(message string) *BaseHTTPError {
-	return &BaseHTTPError{Message: message}
+func NewBaseHTTPError(code string) *BaseHTTPError {
+	return &BaseHTTPError{Code: code}
+}
+
+func (e *BaseHTTPError) localize(c *i18n.Catalog) {
+	if e.Message == "" {
+		e.Message = c.Error(e.Code)
+	}
 }
 
+// ValidationErrorResponse maps each offending field to the code of the rule
+// it broke, and FieldMessages maps it to the localized message.
 type ValidationErrorResponse struct {
 	BaseHTTPError
-	Fields map[string]string `json:"fields"`
+	Fields        map[string]string `json:"fields"`
+	FieldMessages map[string]string `json:"field_messages"`
+
+	// params holds the parameters of the rules, such as a limit, that go
+	// into their messages.
+	params map[string]string
+}
+
+func (e *ValidationErrorResponse) localize(c *i18n.Catalog) {
+	e.BaseHTTPError.localize(c)
+
+	if e.FieldMessages == nil {
+		e.FieldMessages = make(map[string]string, len(e.Fields))
+	}
+	for field, code := range e.Fields {
+		if _, ok := e.FieldMessages[field]; !ok {
+			e.FieldMessages[field] = c.Field(code, "", e.params[field])
+		}
+	}
+}
+
+// localizable is a response body with messages to fill in.
+type localizable interface {
+	localize(c *i18n.Catalog)
+}
+
+// Catalog returns the catalog of the language the response is written in,
+// as set in its Content-Language header (see rpc_i18n).
+func Catalog(w http.ResponseWriter) *i18n.Catalog {
+	return i18n.Lookup(w.Header().Get("Content-Language"))
 }
 
 func ShouldBindJSON(r *http.Request, w http.ResponseWriter, obj any) bool {
 	if err := json.NewDecoder(r.Body).Decode(obj); err != nil {
-		writeValidationError(w, map[string]string{
+		WriteValidationError(w, map[string]string{
 			"body": "invalid_json",
 		})
 		return false
@@ -37,15 +80,21 @@ func ShouldBindJSON(r *http.Request, w http.ResponseWriter, obj any) bool {
 	if err := validate.Struct(obj); err != nil {
 		var verrs validator.ValidationErrors
 		if errors.As(err, &verrs) {
-			fields := map[string]string{}
+			c := Catalog(w)
+			resp := &ValidationErrorResponse{
+				BaseHTTPError: BaseHTTPError{Code: "validation_failed"},
+				Fields:        map[string]string{},
+				FieldMessages: map[string]string{},
+			}
 			for _, fe := range verrs {
-				fields[fe.Field()] = fe.Tag()
+				resp.Fields[fe.Field()] = fe.Tag()
+				resp.FieldMessages[fe.Field()] = c.Field(fe.Tag(), kindOf(fe.Kind()), fe.Param())
 			}
-			writeValidationError(w, fields)
+			WriteJSON(w, http.StatusUnprocessableEntity, resp)
 			return false
 		}
 
-		writeValidationError(w, map[string]string{
+		WriteValidationError(w, map[string]string{
 			"body": err.Error(),
 		})
 		return false
@@ -54,18 +103,46 @@ func ShouldBindJSON(r *http.Request, w http.ResponseWriter, obj any) bool {
 	return true
 }
 
-func writeValidationError(w http.ResponseWriter, fields map[string]string) {
-	resp := ValidationErrorResponse{
+// kindOf names the kind of value a validator rule was checked on, which
+// picks the wording of its message ("at least 1 character", "at least 1 item").
+func kindOf(k reflect.Kind) string {
+	switch k { //nolint:exhaustive
+	case reflect.String:
+		return "string"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return "slice"
+	default:
+		return "number"
+	}
+}
+
+// WriteValidationError answers 422 with the offending fields, the same shape
+// as request validation failures.
+func WriteValidationError(w http.ResponseWriter, fields map[string]string) {
+	WriteValidationErrorWithParams(w, fields, nil)
+}
+
+// WriteValidationErrorWithParams is WriteValidationError for rules with a
+// parameter; params maps a field to the parameter of the rule it broke.
+func WriteValidationErrorWithParams(w http.ResponseWriter, fields, params map[string]string) {
+	resp := &ValidationErrorResponse{
 		BaseHTTPError: BaseHTTPError{
-			Message: "validation_failed",
+			Code: "validation_failed",
 		},
 		Fields: fields,
+		params: params,
 	}
 
 	WriteJSON(w, http.StatusUnprocessableEntity, resp)
 }
 
+// WriteJSON writes v as the response body; error bodies get their messages
+// in the language of the response.
 func WriteJSON(w http.ResponseWriter, status int, v any) {
+	if l, ok := v.(localizable); ok {
+		l.localize(Catalog(w))
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
 	_ = json.NewEncoder(w).Encode(v) //nolint:errchkjson
@@ -89,5 +166,5 @@ func WriteForbidden(w http.ResponseWriter) {
 
 func WriteUnexpectedError(w http.ResponseWriter, err error) {
 	slog.Info("unhandled error:", "err", err)
-	WriteJSON(w, http.StatusInternalServerError, NewBaseHTTPError("internal error"))
+	WriteJSON(w, http.StatusInternalServerError, NewBaseHTTPError("internal_error"))
 }
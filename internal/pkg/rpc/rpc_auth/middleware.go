@@ -3,15 +3,20 @@ package rpc_auth
 import (
 	"context"
 	"encoding/base64"
+	"net"
 	"net/http"
 	"strings"
 
 	ent "test-question/internal/entity/user"
+	"test-question/internal/pkg/rpc"
 )
 
 type ctxKey string
 
-const CtxUserID ctxKey = "user_id"
+const (
+	CtxUserID   ctxKey = "user_id"
+	CtxUserRole ctxKey = "user_role"
+)
 
 type AuthUseCase interface {
 	AuthorizeUser(ctx context.Context, username, password string) (*ent.User, error)
@@ -44,6 +49,7 @@ func BasicAuthMiddleware(auth AuthUseCase) func(http.Handler) http.Handler {
 			}
 
 			ctx := context.WithValue(r.Context(), CtxUserID, user.ID)
+			ctx = context.WithValue(ctx, CtxUserRole, user.Role)
 			r = r.WithContext(ctx)
 
 			next.ServeHTTP(w, r)
@@ -59,6 +65,59 @@ func GetUserID(ctx context.Context) string {
 	return ""
 }
 
+// ClientKey identifies the caller: the authenticated user, else the
+// connection's IP. Forwarding headers are not trusted.
+func ClientKey(r *http.Request) string {
+	if userID := GetUserID(r.Context()); userID != "" {
+		return UserKey(userID)
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	return "ip:" + host
+}
+
+// UserKey is the ClientKey of an authenticated user.
+func UserKey(userID string) string {
+	return "user:" + userID
+}
+
 func InjectUserID(ctx context.Context, userID string) context.Context {
 	return context.WithValue(ctx, CtxUserID, userID)
 }
+
+func GetUserRole(ctx context.Context) ent.Role {
+	if role, ok := ctx.Value(CtxUserRole).(ent.Role); ok {
+		return role
+	}
+	return ""
+}
+
+func InjectUserRole(ctx context.Context, role ent.Role) context.Context {
+	return context.WithValue(ctx, CtxUserRole, role)
+}
+
+// RequireRole lets the request through only if the authenticated user
+// has one of the given roles.
+func RequireRole(roles ...ent.Role) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if GetUserID(r.Context()) == "" {
+				rpc.WriteUnauthorized(w)
+				return
+			}
+
+			role := GetUserRole(r.Context())
+			for _, allowed := range roles {
+				if role == allowed {
+					next.ServeHTTP(w, r)
+					return
+				}
+			}
+
+			rpc.WriteForbidden(w)
+		})
+	}
+}
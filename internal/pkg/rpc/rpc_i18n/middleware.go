@@ -0,0 +1,19 @@
+package rpc_i18n
+
+import (
+	"net/http"
+
+	"test-question/internal/pkg/i18n"
+)
+
+// Middleware picks the language of the response from Accept-Language and
+// declares it in Content-Language, which rpc writes error messages in.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h := w.Header()
+		h.Set("Content-Language", i18n.Negotiate(r.Header.Get("Accept-Language")))
+		h.Add("Vary", "Accept-Language")
+
+		next.ServeHTTP(w, r)
+	})
+}
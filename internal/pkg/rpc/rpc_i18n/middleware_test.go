@@ -0,0 +1,101 @@
+package rpc_i18n
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"test-question/internal/pkg/rpc"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware_LocalizesErrors(t *testing.T) {
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		rpc.WriteNotFound(w, "question_not_found")
+	}))
+
+	tests := map[string]struct {
+		acceptLanguage string
+		lang           string
+		body           string
+	}{
+		"russian":  {"ru-RU,ru;q=0.9,en;q=0.8", "ru", `{"code":"question_not_found","message":"Вопрос не найден."}`},
+		"english":  {"en-US", "en", `{"code":"question_not_found","message":"Question not found."}`},
+		"weighted": {"de;q=1, en;q=0.5, ru;q=0.7", "ru", `{"code":"question_not_found","message":"Вопрос не найден."}`},
+		"other":    {"de-DE", "en", `{"code":"question_not_found","message":"Question not found."}`},
+		"missing":  {"", "en", `{"code":"question_not_found","message":"Question not found."}`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/questions/1", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set("Accept-Language", tt.acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusNotFound, w.Code)
+			require.Equal(t, tt.lang, w.Header().Get("Content-Language"))
+			require.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+			require.JSONEq(t, tt.body, w.Body.String())
+		})
+	}
+}
+
+func TestMiddleware_LocalizesValidationErrors(t *testing.T) {
+	type body struct {
+		Text string `json:"text" validate:"required,min=1"`
+		Tags []int  `json:"tags" validate:"max=2,dive,gt=0"`
+	}
+
+	handler := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var b body
+		if rpc.ShouldBindJSON(r, w, &b) {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+
+	tests := map[string]struct {
+		payload string
+		lang    string
+		body    string
+	}{
+		"min english": {
+			`{"text":"","tags":[1]}`, "en",
+			`{"code":"validation_failed","message":"The request contains invalid fields.",
+			  "fields":{"Text":"required"},"field_messages":{"Text":"is required"}}`,
+		},
+		"max russian": {
+			`{"text":"x","tags":[1,2,3]}`, "ru",
+			`{"code":"validation_failed","message":"Запрос содержит некорректные поля.",
+			  "fields":{"Tags":"max"},"field_messages":{"Tags":"должно содержать не более 2 элементов"}}`,
+		},
+		"dive russian": {
+			`{"text":"x","tags":[0]}`, "ru",
+			`{"code":"validation_failed","message":"Запрос содержит некорректные поля.",
+			  "fields":{"Tags[0]":"gt"},"field_messages":{"Tags[0]":"должно быть больше 0"}}`,
+		},
+		"invalid json": {
+			`{`, "en",
+			`{"code":"validation_failed","message":"The request contains invalid fields.",
+			  "fields":{"body":"invalid_json"},"field_messages":{"body":"is not valid JSON"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/questions", strings.NewReader(tt.payload))
+			req.Header.Set("Accept-Language", tt.lang)
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+			require.JSONEq(t, tt.body, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package rpc_idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	ent "test-question/internal/entity/idempotency"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/pkg/tenant"
+)
+
+const (
+	HeaderKey      = "Idempotency-Key"
+	HeaderReplayed = "Idempotent-Replayed"
+)
+
+// Guard remembers the first response per user and idempotency key.
+type Guard interface {
+	Begin(ctx context.Context, userID, key, requestHash string) (*ent.Record, error)
+	Complete(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error
+	Release(ctx context.Context, userID, key string) error
+}
+
+// Middleware makes requests carrying an Idempotency-Key safe to retry: the
+// first response of a user to a key is stored and replayed verbatim to
+// retries with the same method, path and body in the same workspace. Reusing
+// the key for another request is 422; a retry while the first request is in
+// flight is 409.
+// Server errors are not stored, so the request can be retried.
+func Middleware(guard Guard) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(HeaderKey)
+			userID := rpc_auth.GetUserID(r.Context())
+			if key == "" || userID == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				rpc.WriteBadRequest(w, "invalid_body")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			rec, err := guard.Begin(r.Context(), userID, key, requestHash(r, body))
+			switch {
+			case errors.Is(err, ent.ErrInvalidKey):
+				rpc.WriteBadRequest(w, "invalid_idempotency_key")
+				return
+			case errors.Is(err, ent.ErrKeyReused):
+				rpc.WriteJSON(w, http.StatusUnprocessableEntity, rpc.NewBaseHTTPError("idempotency_key_reused"))
+				return
+			case errors.Is(err, ent.ErrKeyInProgress):
+				w.Header().Set("Retry-After", "1")
+				rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("request_in_progress"))
+				return
+			case err != nil:
+				rpc.WriteUnexpectedError(w, err)
+				return
+			}
+
+			if rec != nil {
+				replay(w, rec)
+				return
+			}
+
+			rw := &recorder{ResponseWriter: w}
+			next.ServeHTTP(rw, r)
+
+			// the response is already sent; store it even if the client left
+			ctx := context.WithoutCancel(r.Context())
+			if rw.status() >= http.StatusInternalServerError {
+				if err := guard.Release(ctx, userID, key); err != nil {
+					slog.Warn("idempotency key release failed", "err", err)
+				}
+				return
+			}
+
+			err = guard.Complete(ctx, userID, key, rw.status(), rw.Header().Get("Content-Type"), rw.body.Bytes())
+			if err != nil {
+				slog.Warn("idempotency key completion failed", "err", err)
+			}
+		})
+	}
+}
+
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + " " + strconv.Itoa(tenant.ID(r.Context())) + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func replay(w http.ResponseWriter, rec *ent.Record) {
+	if rec.ContentType != "" {
+		w.Header().Set("Content-Type", rec.ContentType)
+	}
+	w.Header().Set(HeaderReplayed, "true")
+	w.WriteHeader(rec.StatusCode)
+	_, _ = w.Write(rec.Body)
+}
+
+// recorder passes the response through and keeps a copy of it.
+type recorder struct {
+	http.ResponseWriter
+	code int
+	body bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(code int) {
+	if r.code == 0 {
+		r.code = code
+	}
+	r.ResponseWriter.WriteHeader(code)
+}
+
+func (r *recorder) Write(b []byte) (int, error) {
+	if r.code == 0 {
+		r.code = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *recorder) status() int {
+	if r.code == 0 {
+		return http.StatusOK
+	}
+	return r.code
+}
@@ -0,0 +1,167 @@
+package rpc_idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	ent "test-question/internal/entity/idempotency"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/pkg/tenant"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stubGuard struct {
+	rec      *ent.Record
+	err      error
+	hashes   []string
+	complete *ent.Record
+	released bool
+}
+
+func (g *stubGuard) Begin(_ context.Context, _, _, requestHash string) (*ent.Record, error) {
+	g.hashes = append(g.hashes, requestHash)
+	return g.rec, g.err
+}
+
+func (g *stubGuard) Complete(_ context.Context, userID, key string, statusCode int, contentType string, body []byte) error {
+	g.complete = &ent.Record{UserID: userID, Key: key, StatusCode: statusCode, ContentType: contentType, Body: body}
+	return nil
+}
+
+func (g *stubGuard) Release(context.Context, string, string) error {
+	g.released = true
+	return nil
+}
+
+func created(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_, _ = w.Write([]byte(`{"echo":` + string(body) + `}`))
+}
+
+func newRequest(body, key string) *http.Request {
+	req := httptest.NewRequest("POST", "/questions", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(HeaderKey, key)
+	}
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "u1"))
+}
+
+func TestMiddleware_NoKey(t *testing.T) {
+	guard := &stubGuard{}
+
+	w := httptest.NewRecorder()
+	Middleware(guard)(http.HandlerFunc(created)).ServeHTTP(w, newRequest(`1`, ""))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Empty(t, guard.hashes)
+	require.Nil(t, guard.complete)
+}
+
+func TestMiddleware_FirstRequestStored(t *testing.T) {
+	guard := &stubGuard{}
+
+	w := httptest.NewRecorder()
+	Middleware(guard)(http.HandlerFunc(created)).ServeHTTP(w, newRequest(`1`, "k1"))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, `{"echo":1}`, w.Body.String())
+	require.Equal(t, &ent.Record{
+		UserID:      "u1",
+		Key:         "k1",
+		StatusCode:  http.StatusCreated,
+		ContentType: "application/json",
+		Body:        []byte(`{"echo":1}`),
+	}, guard.complete)
+}
+
+func TestMiddleware_Replay(t *testing.T) {
+	guard := &stubGuard{rec: &ent.Record{StatusCode: http.StatusCreated, ContentType: "application/json", Body: []byte(`{"id":7}`)}}
+	called := false
+
+	w := httptest.NewRecorder()
+	Middleware(guard)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		called = true
+	})).ServeHTTP(w, newRequest(`1`, "k1"))
+
+	require.False(t, called)
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	require.Equal(t, "true", w.Header().Get(HeaderReplayed))
+	require.Equal(t, `{"id":7}`, w.Body.String())
+}
+
+func TestMiddleware_HashCoversBody(t *testing.T) {
+	guard := &stubGuard{}
+	h := Middleware(guard)(http.HandlerFunc(created))
+
+	h.ServeHTTP(httptest.NewRecorder(), newRequest(`1`, "k1"))
+	h.ServeHTTP(httptest.NewRecorder(), newRequest(`1`, "k1"))
+	h.ServeHTTP(httptest.NewRecorder(), newRequest(`2`, "k1"))
+
+	require.Len(t, guard.hashes, 3)
+	require.Equal(t, guard.hashes[0], guard.hashes[1])
+	require.NotEqual(t, guard.hashes[0], guard.hashes[2])
+}
+
+func TestMiddleware_HashCoversWorkspace(t *testing.T) {
+	guard := &stubGuard{}
+	h := Middleware(guard)(http.HandlerFunc(created))
+
+	req := newRequest(`1`, "k1")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	h.ServeHTTP(httptest.NewRecorder(), req.WithContext(tenant.Inject(req.Context(), 7)))
+
+	require.Len(t, guard.hashes, 2)
+	require.NotEqual(t, guard.hashes[0], guard.hashes[1])
+}
+
+func TestMiddleware_Errors(t *testing.T) {
+	cases := []struct {
+		err     error
+		status  int
+		errCode string
+	}{
+		{ent.ErrInvalidKey, http.StatusBadRequest, "invalid_idempotency_key"},
+		{ent.ErrKeyReused, http.StatusUnprocessableEntity, "idempotency_key_reused"},
+		{ent.ErrKeyInProgress, http.StatusConflict, "request_in_progress"},
+		{errors.New("db down"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.errCode, func(t *testing.T) {
+			guard := &stubGuard{err: tc.err}
+
+			w := httptest.NewRecorder()
+			Middleware(guard)(http.HandlerFunc(created)).ServeHTTP(w, newRequest(`1`, "k1"))
+
+			require.Equal(t, tc.status, w.Code)
+			var resp rpc.BaseHTTPError
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			require.Equal(t, tc.errCode, resp.Code)
+			require.Nil(t, guard.complete)
+		})
+	}
+}
+
+func TestMiddleware_ServerErrorReleased(t *testing.T) {
+	guard := &stubGuard{}
+
+	w := httptest.NewRecorder()
+	Middleware(guard)(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})).ServeHTTP(w, newRequest(`1`, "k1"))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	require.True(t, guard.released)
+	require.Nil(t, guard.complete)
+}
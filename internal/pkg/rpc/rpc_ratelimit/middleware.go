@@ -0,0 +1,58 @@
+package rpc_ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"test-question/internal/pkg/ratelimit"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+)
+
+// Store takes tokens from shared buckets. The in-memory store limits each
+// replica separately; a database-backed one can share buckets across them.
+type Store interface {
+	Take(ctx context.Context, key string, l ratelimit.Limit, n int) (ratelimit.Result, error)
+}
+
+// Limit lets through at most l requests of a user to the named route, or of
+// a client IP for anonymous requests. Every response carries the RateLimit-*
+// headers; over the limit it is 429 with Retry-After. Requests go through
+// when the store fails.
+func Limit(store Store, route string, l ratelimit.Limit) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		if !l.Enabled() {
+			return next
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			res, err := store.Take(r.Context(), route+":"+rpc_auth.ClientKey(r), l, 1)
+			if err != nil {
+				slog.Warn("rate limit store failed", "route", route, "err", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			h := w.Header()
+			h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+			h.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+			h.Set("RateLimit-Reset", ceilSeconds(res.Reset))
+
+			if !res.Allowed {
+				h.Set("Retry-After", ceilSeconds(res.RetryAfter))
+				rpc.WriteJSON(w, http.StatusTooManyRequests, rpc.NewBaseHTTPError("rate_limited"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
@@ -0,0 +1,93 @@
+package rpc_ratelimit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"test-question/internal/pkg/ratelimit"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stubStore struct {
+	res  ratelimit.Result
+	err  error
+	keys []string
+	ns   []int
+}
+
+func (s *stubStore) Take(_ context.Context, key string, _ ratelimit.Limit, n int) (ratelimit.Result, error) {
+	s.keys = append(s.keys, key)
+	s.ns = append(s.ns, n)
+	return s.res, s.err
+}
+
+var ok = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { //nolint:gochecknoglobals
+	w.WriteHeader(http.StatusCreated)
+})
+
+var perMinute = ratelimit.Limit{Requests: 5, Per: time.Minute} //nolint:gochecknoglobals
+
+func TestLimit_Allowed(t *testing.T) {
+	store := &stubStore{res: ratelimit.Result{Allowed: true, Limit: 5, Remaining: 4, Reset: 11500 * time.Millisecond}}
+
+	req := httptest.NewRequest("POST", "/questions", nil)
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "u1"))
+
+	w := httptest.NewRecorder()
+	Limit(store, "questions", perMinute)(ok).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, "5", w.Header().Get("RateLimit-Limit"))
+	require.Equal(t, "4", w.Header().Get("RateLimit-Remaining"))
+	require.Equal(t, "12", w.Header().Get("RateLimit-Reset"))
+	require.Empty(t, w.Header().Get("Retry-After"))
+	require.Equal(t, []string{"questions:user:u1"}, store.keys)
+}
+
+func TestLimit_Exceeded(t *testing.T) {
+	store := &stubStore{res: ratelimit.Result{Limit: 5, Reset: time.Minute, RetryAfter: 12 * time.Second}}
+
+	w := httptest.NewRecorder()
+	Limit(store, "questions", perMinute)(ok).ServeHTTP(w, httptest.NewRequest("POST", "/questions", nil))
+
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "12", w.Header().Get("Retry-After"))
+	require.Equal(t, "0", w.Header().Get("RateLimit-Remaining"))
+	require.JSONEq(t, `{"code":"rate_limited","message":"Too many requests. Please slow down."}`, w.Body.String())
+}
+
+func TestLimit_AnonymousKeyedByIP(t *testing.T) {
+	store := &stubStore{res: ratelimit.Result{Allowed: true}}
+
+	req := httptest.NewRequest("POST", "/questions", nil)
+	req.RemoteAddr = "10.0.0.7:51234"
+
+	Limit(store, "questions", perMinute)(ok).ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Equal(t, []string{"questions:ip:10.0.0.7"}, store.keys)
+}
+
+func TestLimit_StoreErrorLetsThrough(t *testing.T) {
+	store := &stubStore{err: errors.New("db down")}
+
+	w := httptest.NewRecorder()
+	Limit(store, "questions", perMinute)(ok).ServeHTTP(w, httptest.NewRequest("POST", "/questions", nil))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestLimit_Disabled(t *testing.T) {
+	store := &stubStore{}
+
+	w := httptest.NewRecorder()
+	Limit(store, "questions", ratelimit.Limit{})(ok).ServeHTTP(w, httptest.NewRequest("POST", "/questions", nil))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Empty(t, store.keys)
+}
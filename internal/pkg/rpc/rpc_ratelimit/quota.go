@@ -0,0 +1,47 @@
+package rpc_ratelimit
+
+import (
+	"context"
+	"log/slog"
+
+	"test-question/internal/pkg/ratelimit"
+	"test-question/internal/pkg/rpc/rpc_auth"
+)
+
+// Quota takes tokens from the buckets of the Limit middleware for actions
+// that are not requests of their own, like the operations of a batch or the
+// mutations of a GraphQL request.
+type Quota struct {
+	store  Store
+	limits map[string]ratelimit.Limit
+}
+
+// NewQuota limits the named routes the way Limit does; routes missing from
+// limits are not limited.
+func NewQuota(store Store, limits map[string]ratelimit.Limit) *Quota {
+	return &Quota{store: store, limits: limits}
+}
+
+// Allow takes a token of the user from the bucket of route, the one Limit
+// takes from for the user's requests. Like Limit, it lets the action through
+// when the store fails.
+func (q *Quota) Allow(ctx context.Context, route, userID string) bool {
+	return q.AllowN(ctx, route, userID, 1)
+}
+
+// AllowN is Allow for n actions at once: it takes n tokens, or none when
+// fewer are left.
+func (q *Quota) AllowN(ctx context.Context, route, userID string, n int) bool {
+	l := q.limits[route]
+	if !l.Enabled() {
+		return true
+	}
+
+	res, err := q.store.Take(ctx, route+":"+rpc_auth.UserKey(userID), l, n)
+	if err != nil {
+		slog.Warn("rate limit store failed", "route", route, "err", err)
+		return true
+	}
+
+	return res.Allowed
+}
@@ -0,0 +1,50 @@
+package rpc_ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"test-question/internal/pkg/ratelimit"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuota_Allow(t *testing.T) {
+	ctx := context.Background()
+
+	store := &stubStore{res: ratelimit.Result{Allowed: true}}
+	q := NewQuota(store, map[string]ratelimit.Limit{"questions": perMinute})
+
+	require.True(t, q.Allow(ctx, "questions", "u1"))
+	require.Equal(t, []string{"questions:user:u1"}, store.keys)
+	require.Equal(t, []int{1}, store.ns)
+
+	store.res.Allowed = false
+	require.False(t, q.Allow(ctx, "questions", "u1"))
+}
+
+func TestQuota_AllowN(t *testing.T) {
+	store := &stubStore{res: ratelimit.Result{Allowed: true}}
+	q := NewQuota(store, map[string]ratelimit.Limit{"batch_operations": perMinute})
+
+	require.True(t, q.AllowN(context.Background(), "batch_operations", "u1", 4))
+	require.Equal(t, []string{"batch_operations:user:u1"}, store.keys)
+	require.Equal(t, []int{4}, store.ns)
+}
+
+func TestQuota_NotLimited(t *testing.T) {
+	store := &stubStore{}
+	q := NewQuota(store, map[string]ratelimit.Limit{"questions": {}})
+
+	require.True(t, q.Allow(context.Background(), "questions", "u1"))
+	require.True(t, q.Allow(context.Background(), "answers", "u1"))
+	require.Empty(t, store.keys)
+}
+
+func TestQuota_StoreFails(t *testing.T) {
+	store := &stubStore{err: errors.New("store down")}
+	q := NewQuota(store, map[string]ratelimit.Limit{"questions": perMinute})
+
+	require.True(t, q.Allow(context.Background(), "questions", "u1"))
+}
@@ -0,0 +1,93 @@
+package rpc_workspace
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	entU "test-question/internal/entity/user"
+	entW "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/pkg/tenant"
+
+	"github.com/pkg/errors"
+)
+
+// Header names the workspace of a request whose path does not.
+const Header = "X-Workspace"
+
+const pathPrefix = "/w/"
+
+type Resolver interface {
+	Resolve(ctx context.Context, slug, userID string, role entU.Role) (*entW.Workspace, error)
+}
+
+// Middleware sets the workspace every request works in, which repositories
+// keep their queries to (see tenant): the one named by a /w/{slug} path
+// prefix, cut off before routing so /w/{slug}/questions is served as
+// /questions; else the one named by the X-Workspace header; else the
+// default workspace. Workspaces the user may not work in are 404.
+func Middleware(resolver Resolver) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			slug, rest, inPath := splitPath(r.URL.Path)
+			header := r.Header.Get(Header)
+
+			switch {
+			case inPath && header != "" && header != slug:
+				rpc.WriteBadRequest(w, "workspace_mismatch")
+				return
+			case !inPath && header == "":
+				next.ServeHTTP(w, r.WithContext(tenant.Inject(r.Context(), tenant.DefaultID)))
+				return
+			case !inPath:
+				slug = header
+			}
+
+			ctx := r.Context()
+			ws, err := resolver.Resolve(ctx, slug, rpc_auth.GetUserID(ctx), rpc_auth.GetUserRole(ctx))
+			if err != nil {
+				if errors.Is(err, entW.ErrWorkspaceNotFound) {
+					rpc.WriteNotFound(w, "workspace_not_found")
+					return
+				}
+				rpc.WriteUnexpectedError(w, err)
+				return
+			}
+
+			r = r.WithContext(tenant.Inject(ctx, ws.ID))
+			if inPath {
+				r = withPath(r, slug, rest)
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// splitPath cuts /w/{slug} off the path; rest is at least "/".
+func splitPath(path string) (slug, rest string, ok bool) {
+	tail, ok := strings.CutPrefix(path, pathPrefix)
+	if !ok {
+		return "", path, false
+	}
+
+	slug, rest, _ = strings.Cut(tail, "/")
+	return slug, "/" + rest, true
+}
+
+// withPath is r with the workspace prefix cut off, as http.StripPrefix
+// does. Slugs need no escaping, so the raw path loses the same prefix.
+func withPath(r *http.Request, slug, rest string) *http.Request {
+	r2 := new(http.Request)
+	*r2 = *r
+	r2.URL = new(url.URL)
+	*r2.URL = *r.URL
+	r2.URL.Path = rest
+	if r.URL.RawPath != "" {
+		r2.URL.RawPath = strings.TrimPrefix(r.URL.RawPath, pathPrefix+slug)
+	}
+	return r2
+}
@@ -0,0 +1,134 @@
+package rpc_workspace
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entU "test-question/internal/entity/user"
+	entW "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/pkg/tenant"
+
+	"github.com/stretchr/testify/require"
+)
+
+type stubResolver struct {
+	workspaces map[string]*entW.Workspace
+	err        error
+	calls      []string
+}
+
+func (s *stubResolver) Resolve(_ context.Context, slug, userID string, role entU.Role) (*entW.Workspace, error) {
+	s.calls = append(s.calls, slug+":"+userID+":"+string(role))
+	if s.err != nil {
+		return nil, s.err
+	}
+	if w, ok := s.workspaces[slug]; ok {
+		return w, nil
+	}
+	return nil, entW.ErrWorkspaceNotFound
+}
+
+func newResolver() *stubResolver {
+	return &stubResolver{workspaces: map[string]*entW.Workspace{"platform": {ID: 7, Slug: "platform"}}}
+}
+
+// serve records the path and workspace the next handler sees.
+func serve(t *testing.T, resolver Resolver, req *http.Request) (*httptest.ResponseRecorder, string, int) {
+	t.Helper()
+
+	var path string
+	var workspaceID int
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		workspaceID, _ = tenant.FromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ctx := rpc_auth.InjectUserRole(rpc_auth.InjectUserID(req.Context(), "u1"), entU.RoleUser)
+
+	w := httptest.NewRecorder()
+	Middleware(resolver)(next).ServeHTTP(w, req.WithContext(ctx))
+	return w, path, workspaceID
+}
+
+func TestMiddleware_Default(t *testing.T) {
+	resolver := newResolver()
+
+	w, path, id := serve(t, resolver, httptest.NewRequest("GET", "/questions", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "/questions", path)
+	require.Equal(t, tenant.DefaultID, id)
+	require.Empty(t, resolver.calls)
+}
+
+func TestMiddleware_Path(t *testing.T) {
+	resolver := newResolver()
+
+	w, path, id := serve(t, resolver, httptest.NewRequest("GET", "/w/platform/questions/3", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "/questions/3", path)
+	require.Equal(t, 7, id)
+	require.Equal(t, []string{"platform:u1:user"}, resolver.calls)
+}
+
+func TestMiddleware_Header(t *testing.T) {
+	req := httptest.NewRequest("POST", "/questions", nil)
+	req.Header.Set(Header, "platform")
+
+	w, path, id := serve(t, newResolver(), req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "/questions", path)
+	require.Equal(t, 7, id)
+}
+
+func TestMiddleware_PathAndHeaderMustAgree(t *testing.T) {
+	req := httptest.NewRequest("GET", "/w/platform/questions", nil)
+	req.Header.Set(Header, "platform")
+
+	w, _, id := serve(t, newResolver(), req)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, 7, id)
+
+	req = httptest.NewRequest("GET", "/w/platform/questions", nil)
+	req.Header.Set(Header, "data")
+
+	w, _, _ = serve(t, newResolver(), req)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestMiddleware_NotFound(t *testing.T) {
+	for _, target := range []string{"/w/data/questions", "/w/", "/w//questions"} {
+		w, path, _ := serve(t, newResolver(), httptest.NewRequest("GET", target, nil))
+
+		require.Equal(t, http.StatusNotFound, w.Code, target)
+		require.JSONEq(t, `{"code":"workspace_not_found","message":"Workspace not found."}`, w.Body.String())
+		require.Empty(t, path)
+	}
+}
+
+func TestMiddleware_ResolverError(t *testing.T) {
+	resolver := &stubResolver{err: errors.New("db down")}
+
+	w, _, _ := serve(t, resolver, httptest.NewRequest("GET", "/w/platform/questions", nil))
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestSplitPath(t *testing.T) {
+	for path, want := range map[string][3]any{
+		"/w/platform/questions": {"platform", "/questions", true},
+		"/w/platform":           {"platform", "/", true},
+		"/w/platform/":          {"platform", "/", true},
+		"/questions":            {"", "/questions", false},
+		"/wiki":                 {"", "/wiki", false},
+	} {
+		slug, rest, ok := splitPath(path)
+		require.Equal(t, want, [3]any{slug, rest, ok}, path)
+	}
+}
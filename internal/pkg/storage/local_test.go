@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocal_PutOpenDelete(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := NewLocal(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, s.Put(ctx, "attachments/ab/file", strings.NewReader("hello"), 5, "text/plain"))
+
+	rc, err := s.Open(ctx, "attachments/ab/file")
+	require.NoError(t, err)
+	got, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	require.NoError(t, rc.Close())
+	require.Equal(t, "hello", string(got))
+
+	entries, err := os.ReadDir(filepath.Join(dir, "attachments", "ab"))
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "no temporary files left behind")
+
+	require.NoError(t, s.Delete(ctx, "attachments/ab/file"))
+	require.NoError(t, s.Delete(ctx, "attachments/ab/file"), "deleting twice is fine")
+
+	_, err = s.Open(ctx, "attachments/ab/file")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocal_PutShortWrite(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	err = s.Put(ctx, "file", strings.NewReader("hel"), 5, "")
+	require.Error(t, err)
+
+	_, err = s.Open(ctx, "file")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestLocal_InvalidKeys(t *testing.T) {
+	ctx := context.Background()
+
+	s, err := NewLocal(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../up", "a/../../b", "a//b", `a\b`} {
+		_, err = s.Open(ctx, key)
+		require.ErrorIs(t, err, ErrInvalidKey, key)
+	}
+}
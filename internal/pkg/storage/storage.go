@@ -0,0 +1,58 @@
+// Package storage keeps uploaded files as objects under string keys. Local
+// stores them on disk; S3 talks to any S3-compatible object store.
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var (
+	ErrNotFound   = errors.New("object not found")
+	ErrInvalidKey = errors.New("invalid object key")
+)
+
+// Storage is what the service needs from an object store. Put gets the
+// exact size of the content up front, and deleting a missing object is not
+// an error.
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Config selects and configures the backend.
+type Config struct {
+	// Backend is "local" or "s3".
+	Backend  string
+	LocalDir string
+	S3       S3Config
+}
+
+func New(cfg Config) (Storage, error) {
+	switch cfg.Backend {
+	case "", "local":
+		return NewLocal(cfg.LocalDir)
+	case "s3":
+		return NewS3(cfg.S3, nil)
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", cfg.Backend)
+	}
+}
+
+// checkKey accepts slash-separated keys without empty, "." or ".." parts,
+// so a key can never point outside the bucket or directory.
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.ContainsRune(key, '\\') {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Package tenant carries the workspace a request works in and keeps queries
+// on workspace content to it.
+package tenant
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DefaultID is the workspace of content posted outside any other.
+const DefaultID = 1
+
+type ctxKey struct{}
+
+// Inject sets the workspace the queries made with ctx are limited to.
+func Inject(ctx context.Context, workspaceID int) context.Context {
+	return context.WithValue(ctx, ctxKey{}, workspaceID)
+}
+
+// FromContext returns the workspace of ctx, if one is set.
+func FromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(ctxKey{}).(int)
+	return id, ok
+}
+
+// ID returns the workspace new content of ctx belongs to: the one set, else
+// the default workspace.
+func ID(ctx context.Context) int {
+	if id, ok := FromContext(ctx); ok {
+		return id
+	}
+	return DefaultID
+}
+
+// Condition keeps the workspace_id column of the given table or alias to
+// the workspace of ctx. Without one, as for background workers that serve
+// every workspace, it holds for all rows. It can be passed as an argument
+// of raw SQL too.
+func Condition(ctx context.Context, table string) clause.Expr {
+	id, ok := FromContext(ctx)
+	if !ok {
+		return clause.Expr{SQL: "TRUE"}
+	}
+	return clause.Expr{SQL: "? = ?", Vars: []any{clause.Column{Table: table, Name: "workspace_id"}, id}}
+}
+
+// Scope adds Condition to a query.
+func Scope(ctx context.Context, table string) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(Condition(ctx, table))
+	}
+}
@@ -0,0 +1,32 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/clause"
+)
+
+func TestID(t *testing.T) {
+	ctx := context.Background()
+
+	_, ok := FromContext(ctx)
+	require.False(t, ok)
+	require.Equal(t, DefaultID, ID(ctx))
+
+	ctx = Inject(ctx, 7)
+
+	id, ok := FromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, 7, id)
+	require.Equal(t, 7, ID(ctx))
+}
+
+func TestCondition(t *testing.T) {
+	require.Equal(t, clause.Expr{SQL: "TRUE"}, Condition(context.Background(), "questions"))
+
+	c := Condition(Inject(context.Background(), 7), "q")
+	require.Equal(t, "? = ?", c.SQL)
+	require.Equal(t, []any{clause.Column{Table: "q", Name: "workspace_id"}, 7}, c.Vars)
+}
@@ -0,0 +1,109 @@
+// Package thumbnail scales PNG, JPEG and GIF images down to previews.
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+
+	// decoders of the supported formats
+	_ "image/gif"
+	_ "image/jpeg"
+)
+
+// MaxPixels refuses images whose decoded size would be out of proportion
+// to their file size, such as decompression bombs.
+const MaxPixels = 50_000_000
+
+var ErrTooManyPixels = errors.New("image has too many pixels")
+
+// ContentType is the format thumbnails are encoded in.
+const ContentType = "image/png"
+
+// Generate decodes an image and returns a PNG that fits into a size×size
+// square, keeping the aspect ratio. Images that already fit are only
+// re-encoded.
+func Generate(data []byte, size int) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode image config: %w", err)
+	}
+	if cfg.Width*cfg.Height > MaxPixels {
+		return nil, fmt.Errorf("%w: %dx%d", ErrTooManyPixels, cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+
+	w, h := fit(src.Bounds().Dx(), src.Bounds().Dy(), size)
+
+	var buf bytes.Buffer
+	if err = encode(&buf, scale(src, w, h)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func encode(w io.Writer, img image.Image) error {
+	enc := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := enc.Encode(w, img); err != nil {
+		return fmt.Errorf("encode thumbnail: %w", err)
+	}
+	return nil
+}
+
+// fit scales w×h down to fit into a size×size square.
+func fit(w, h, size int) (int, int) {
+	if w <= size && h <= size {
+		return w, h
+	}
+	if w >= h {
+		return size, max(1, h*size/w)
+	}
+	return max(1, w*size/h), size
+}
+
+// scale resizes by averaging all source pixels that fall into each target
+// pixel, which keeps detail of screenshots readable when shrinking a lot.
+func scale(src image.Image, w, h int) image.Image {
+	b := src.Bounds()
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+
+	for y := 0; y < h; y++ {
+		y0 := b.Min.Y + y*b.Dy()/h
+		y1 := max(y0+1, b.Min.Y+(y+1)*b.Dy()/h)
+
+		for x := 0; x < w; x++ {
+			x0 := b.Min.X + x*b.Dx()/w
+			x1 := max(x0+1, b.Min.X+(x+1)*b.Dx()/w)
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					c := color.NRGBA64Model.Convert(src.At(sx, sy)).(color.NRGBA64) //nolint:forcetypeassert
+					r += uint64(c.R)
+					g += uint64(c.G)
+					bl += uint64(c.B)
+					a += uint64(c.A)
+					n++
+				}
+			}
+
+			dst.SetNRGBA(x, y, color.NRGBA{
+				R: uint8((r / n) >> 8),
+				G: uint8((g / n) >> 8),
+				B: uint8((bl / n) >> 8),
+				A: uint8((a / n) >> 8),
+			})
+		}
+	}
+
+	return dst
+}
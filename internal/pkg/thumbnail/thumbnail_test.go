@@ -0,0 +1,108 @@
+package thumbnail
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func solid(w, h int, c color.Color) *image.NRGBA {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func TestGenerate_ScalesDownKeepingAspect(t *testing.T) {
+	src := solid(800, 400, color.NRGBA{R: 200, G: 10, B: 10, A: 255})
+
+	out, err := Generate(encodePNG(t, src), 256)
+	require.NoError(t, err)
+
+	img, format, err := image.Decode(bytes.NewReader(out))
+	require.NoError(t, err)
+	require.Equal(t, "png", format)
+	require.Equal(t, 256, img.Bounds().Dx())
+	require.Equal(t, 128, img.Bounds().Dy())
+
+	r, g, b, a := img.At(100, 60).RGBA()
+	require.Equal(t, [4]uint32{200, 10, 10, 255}, [4]uint32{r >> 8, g >> 8, b >> 8, a >> 8})
+}
+
+func TestGenerate_AveragesPixels(t *testing.T) {
+	// black and white columns average to grey
+	src := image.NewGray(image.Rect(0, 0, 4, 2))
+	for y := 0; y < 2; y++ {
+		src.SetGray(0, y, color.Gray{Y: 0})
+		src.SetGray(1, y, color.Gray{Y: 255})
+		src.SetGray(2, y, color.Gray{Y: 0})
+		src.SetGray(3, y, color.Gray{Y: 255})
+	}
+
+	out, err := Generate(encodePNG(t, src), 2)
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(out))
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 2, 1), img.Bounds())
+
+	r, _, _, _ := img.At(0, 0).RGBA()
+	require.InDelta(t, 127, r>>8, 1)
+}
+
+func TestGenerate_SmallImageKeepsSize(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, solid(30, 90, color.White), nil))
+
+	out, err := Generate(buf.Bytes(), 256)
+	require.NoError(t, err)
+
+	cfg, err := png.DecodeConfig(bytes.NewReader(out))
+	require.NoError(t, err)
+	require.Equal(t, 30, cfg.Width)
+	require.Equal(t, 90, cfg.Height)
+}
+
+func TestGenerate_Rejects(t *testing.T) {
+	_, err := Generate([]byte("not an image"), 256)
+	require.Error(t, err)
+
+	// a PNG header claiming a huge canvas is refused before decoding
+	huge := encodePNG(t, image.NewGray(image.Rect(0, 0, 1, 1)))
+	huge[16], huge[17], huge[18], huge[19] = 0, 0, 0x27, 0x10 // width 10000
+	huge[20], huge[21], huge[22], huge[23] = 0, 0, 0x27, 0x10 // height 10000
+	binary.BigEndian.PutUint32(huge[29:33], crc32.ChecksumIEEE(huge[12:29]))
+
+	_, err = Generate(huge, 256)
+	require.ErrorIs(t, err, ErrTooManyPixels)
+}
+
+func TestFit(t *testing.T) {
+	tests := []struct{ w, h, size, wantW, wantH int }{
+		{w: 100, h: 50, size: 200, wantW: 100, wantH: 50},
+		{w: 1000, h: 500, size: 100, wantW: 100, wantH: 50},
+		{w: 500, h: 1000, size: 100, wantW: 50, wantH: 100},
+		{w: 10000, h: 1, size: 100, wantW: 100, wantH: 1},
+	}
+	for _, tt := range tests {
+		w, h := fit(tt.w, tt.h, tt.size)
+		require.Equal(t, [2]int{tt.wantW, tt.wantH}, [2]int{w, h})
+	}
+}
@@ -14,8 +14,10 @@ func NewGormUoW(db *gorm.DB) *GormUnitOfWork {
 	return &GormUnitOfWork{db: db}
 }
 
+// Do runs fn in a transaction. Called inside another Do it joins the outer
+// transaction through a savepoint.
 func (u *GormUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
-	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	return GetTx(ctx, u.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		ctx = context.WithValue(ctx, "tx", tx) //nolint:fatcontext,staticcheck
 		return fn(ctx)
 	})
@@ -0,0 +1,91 @@
+// Package viewcount buffers question views in memory so that reads do not
+// write to the database. A viewer is counted once per question within a
+// window; the counts are drained and written in batches.
+package viewcount
+
+import (
+	"sync"
+	"time"
+)
+
+type Config struct {
+	// Window is how long repeated views of a question by one viewer count once.
+	Window time.Duration
+	// MaxViewers bounds how many (question, viewer) pairs are remembered;
+	// once it is reached, views of new pairs are dropped until Drain
+	// forgets the expired ones, rather than counted without deduplication.
+	MaxViewers int
+}
+
+type seenKey struct {
+	questionID int
+	viewer     string
+}
+
+type Counter struct {
+	cfg Config
+
+	mu      sync.Mutex
+	seen    map[seenKey]time.Time
+	pending map[int]int
+}
+
+func New(cfg Config) *Counter {
+	return &Counter{
+		cfg:     cfg,
+		seen:    make(map[seenKey]time.Time),
+		pending: make(map[int]int),
+	}
+}
+
+// Add counts a view of the question unless the viewer was counted for it
+// within the window or there is no room to remember the viewer, and reports
+// whether it was counted.
+func (c *Counter) Add(questionID int, viewer string, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := seenKey{questionID: questionID, viewer: viewer}
+	if at, ok := c.seen[key]; ok && now.Sub(at) < c.cfg.Window {
+		return false
+	}
+
+	if _, ok := c.seen[key]; !ok && len(c.seen) >= c.cfg.MaxViewers {
+		return false
+	}
+
+	c.seen[key] = now
+	c.pending[questionID]++
+	return true
+}
+
+// Drain returns the views counted since the previous Drain by question ID
+// and forgets viewers whose window has passed.
+func (c *Counter) Drain(now time.Time) map[int]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.expire(now)
+
+	out := c.pending
+	c.pending = make(map[int]int)
+	return out
+}
+
+// Restore adds back views that were drained but could not be written.
+func (c *Counter) Restore(counts map[int]int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, n := range counts {
+		c.pending[id] += n
+	}
+}
+
+func (c *Counter) expire(now time.Time) {
+	for key, at := range c.seen {
+		if now.Sub(at) >= c.cfg.Window {
+			delete(c.seen, key)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package viewcount
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+func TestCounter_DeduplicatesWithinWindow(t *testing.T) {
+	c := New(Config{Window: time.Hour, MaxViewers: 100})
+
+	require.True(t, c.Add(1, "user:alice", now))
+	require.False(t, c.Add(1, "user:alice", now.Add(59*time.Minute)))
+	require.True(t, c.Add(1, "ip:10.0.0.1", now))
+	require.True(t, c.Add(2, "user:alice", now))
+
+	// the window starts at the counted view, not at the latest one
+	require.True(t, c.Add(1, "user:alice", now.Add(time.Hour)))
+
+	require.Equal(t, map[int]int{1: 3, 2: 1}, c.Drain(now.Add(time.Hour)))
+	require.Empty(t, c.Drain(now.Add(time.Hour)))
+}
+
+func TestCounter_DrainForgetsExpiredViewers(t *testing.T) {
+	c := New(Config{Window: time.Hour, MaxViewers: 100})
+
+	c.Add(1, "user:alice", now)
+	c.Add(1, "user:bob", now.Add(30*time.Minute))
+	c.Drain(now.Add(time.Hour))
+
+	require.Len(t, c.seen, 1)
+	require.False(t, c.Add(1, "user:bob", now.Add(time.Hour)))
+}
+
+func TestCounter_Restore(t *testing.T) {
+	c := New(Config{Window: time.Hour, MaxViewers: 100})
+
+	c.Add(1, "user:alice", now)
+	drained := c.Drain(now)
+
+	c.Add(1, "user:bob", now)
+	c.Restore(drained)
+
+	require.Equal(t, map[int]int{1: 2}, c.Drain(now))
+}
+
+func TestCounter_MaxViewers(t *testing.T) {
+	c := New(Config{Window: time.Hour, MaxViewers: 2})
+
+	c.Add(1, "user:alice", now)
+	c.Add(1, "user:bob", now.Add(time.Minute))
+
+	// no room to remember carol, so her views are dropped
+	require.False(t, c.Add(1, "user:carol", now.Add(2*time.Minute)))
+	require.Len(t, c.seen, 2)
+
+	// a remembered viewer whose window passed is counted again in place
+	require.True(t, c.Add(1, "user:alice", now.Add(time.Hour)))
+
+	// only Drain forgets expired viewers
+	require.False(t, c.Add(1, "user:carol", now.Add(time.Hour+time.Minute)))
+	require.Equal(t, map[int]int{1: 3}, c.Drain(now.Add(time.Hour+time.Minute)))
+
+	require.True(t, c.Add(1, "user:carol", now.Add(time.Hour+2*time.Minute)))
+	require.Equal(t, map[int]int{1: 1}, c.Drain(now.Add(time.Hour+2*time.Minute)))
+}
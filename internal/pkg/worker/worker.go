@@ -0,0 +1,91 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	Runner interface {
+		Run(ctx context.Context)
+	}
+
+	logger interface {
+		ErrorContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+// Periodic runs job immediately and then every interval until ctx is cancelled.
+// A failed run is logged and retried on the next tick.
+type Periodic struct {
+	name     string
+	interval time.Duration
+	job      func(ctx context.Context) error
+	logger   logger
+	wake     <-chan struct{}
+}
+
+func NewPeriodic(
+	name string,
+	interval time.Duration,
+	job func(ctx context.Context) error,
+	logger logger,
+) *Periodic {
+	return &Periodic{
+		name:     name,
+		interval: interval,
+		job:      job,
+		logger:   logger,
+	}
+}
+
+// WakeOn makes the job also run whenever wake receives, ahead of the next tick.
+func (p *Periodic) WakeOn(wake <-chan struct{}) *Periodic {
+	p.wake = wake
+	return p
+}
+
+func (p *Periodic) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.job(ctx); err != nil && ctx.Err() == nil {
+			p.logger.ErrorContext(ctx, "worker run failed", "worker", p.name, "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-p.wake:
+		}
+	}
+}
+
+// Group runs background workers next to the HTTP server.
+type Group struct {
+	runners []Runner
+	wg      sync.WaitGroup
+}
+
+func NewGroup(runners ...Runner) *Group {
+	return &Group{runners: runners}
+}
+
+// Start launches every runner in its own goroutine; they stop when ctx is cancelled.
+func (g *Group) Start(ctx context.Context) {
+	for _, r := range g.runners {
+		g.wg.Add(1)
+		go func() {
+			defer g.wg.Done()
+			r.Run(ctx)
+		}()
+	}
+}
+
+// Wait blocks until all runners have returned.
+func (g *Group) Wait() {
+	g.wg.Wait()
+}
@@ -3,13 +3,16 @@ package answer
 import (
 	"context"
 	"errors"
+	"time"
 
 	ent "test-question/internal/entity/answer"
+	"test-question/internal/pkg/tenant"
 	"test-question/internal/pkg/uow"
 
 	"gorm.io/gorm"
 )
 
+// Repository keeps every query to the workspace of its context, see tenant.
 type Repository struct {
 	db *gorm.DB
 }
@@ -18,8 +21,13 @@ func NewRepository(db *gorm.DB) *Repository {
 	return &Repository{db: db}
 }
 
+func (r *Repository) scoped(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return db.WithContext(ctx).Scopes(tenant.Scope(ctx, "answers"))
+}
+
 func (r *Repository) Create(ctx context.Context, e *ent.Answer) (*ent.Answer, error) {
 	row := fromEntityAnswer(e)
+	row.WorkspaceID = tenant.ID(ctx)
 
 	if err := uow.GetTx(ctx, r.db).WithContext(ctx).Create(row).Error; err != nil {
 		return nil, err
@@ -28,10 +36,11 @@ func (r *Repository) Create(ctx context.Context, e *ent.Answer) (*ent.Answer, er
 	return toEntityAnswer(row), nil
 }
 
+// GetByID reads through the caller's transaction, if any.
 func (r *Repository) GetByID(ctx context.Context, id int) (*ent.Answer, error) {
 	var row answerRow
 
-	err := r.db.WithContext(ctx).First(&row, id).Error
+	err := r.scoped(ctx, uow.GetTx(ctx, r.db)).First(&row, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ent.ErrAnswerNotFound
@@ -42,14 +51,38 @@ func (r *Repository) GetByID(ctx context.Context, id int) (*ent.Answer, error) {
 	return toEntityAnswer(&row), nil
 }
 
+// Delete moves the answer to the trash. Like questions, deleted_at is the
+// transaction's NOW() so a restore can find the reputation reversals.
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return uow.GetTx(ctx, r.db).WithContext(ctx).Delete(&answerRow{}, id).Error
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&answerRow{}).
+		Where("id = ?", id).
+		Update("deleted_at", gorm.Expr("NOW()")).Error
 }
 
+// Remove trashes the answer on a moderator's decision; unlike Delete, its
+// author cannot restore it.
+func (r *Repository) Remove(ctx context.Context, id int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&answerRow{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"deleted_at":           gorm.Expr("NOW()"),
+			"removed_by_moderator": true,
+		}).Error
+}
+
+// DeleteByQuestionID trashes the question's remaining answers and marks them
+// as deleted with the question. Answers already in the trash keep their own
+// deletion and are not restored with the question.
 func (r *Repository) DeleteByQuestionID(ctx context.Context, questionID int) error {
-	err := uow.GetTx(ctx, r.db).WithContext(ctx).
+	err := r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&answerRow{}).
 		Where("question_id = ?", questionID).
-		Delete(&answerRow{}).Error
+		Updates(map[string]any{
+			"deleted_at":            gorm.Expr("NOW()"),
+			"deleted_with_question": true,
+		}).Error
 
 	if err != nil {
 		return err
@@ -58,22 +91,209 @@ func (r *Repository) DeleteByQuestionID(ctx context.Context, questionID int) err
 	return nil
 }
 
-func (r *Repository) ListByQuestionID(ctx context.Context, questionID int) ([]*ent.Answer, error) {
+// GetByIDWithDeleted finds an answer whether or not it is in the trash.
+func (r *Repository) GetByIDWithDeleted(ctx context.Context, id int) (*ent.Answer, error) {
+	var row answerRow
+
+	err := r.scoped(ctx, r.db).Unscoped().First(&row, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ent.ErrAnswerNotFound
+		}
+		return nil, err
+	}
+
+	return toEntityAnswer(&row), nil
+}
+
+// Restore takes the answer out of the trash.
+func (r *Repository) Restore(ctx context.Context, id int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Unscoped().
+		Model(&answerRow{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Updates(map[string]any{
+			"deleted_at":            nil,
+			"deleted_with_question": false,
+		}).Error
+}
+
+// RestoreByQuestionID restores the answers trashed together with the question.
+func (r *Repository) RestoreByQuestionID(ctx context.Context, questionID int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Unscoped().
+		Model(&answerRow{}).
+		Where("question_id = ? AND deleted_with_question", questionID).
+		Updates(map[string]any{
+			"deleted_at":            nil,
+			"deleted_with_question": false,
+		}).Error
+}
+
+// PurgeDeleted hard-deletes answers trashed before the given time.
+func (r *Repository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	res := r.scoped(ctx, r.db).
+		Unscoped().
+		Where("deleted_at < ?", before).
+		Delete(&answerRow{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return int(res.RowsAffected), nil
+}
+
+// scoreJoin sums the votes of each answer up into v.score.
+const scoreJoin = `LEFT JOIN LATERAL (
+	SELECT COALESCE(SUM(value), 0) AS score
+	FROM votes
+	WHERE target_type = 'answer' AND target_id = answers.id
+) v ON TRUE`
+
+// ListPage returns up to f.Limit answers of the question readers can see in
+// f.Sort, after f.After; hidden ones are left out. Scores are summed up from
+// the answers' votes.
+func (r *Repository) ListPage(ctx context.Context, f ent.Filter) ([]*ent.Answer, error) {
+	q := r.scoped(ctx, r.db).
+		Select("answers.*, v.score").
+		Joins(scoreJoin).
+		Where("answers.question_id = ? AND answers.hidden_at IS NULL", f.QuestionID)
+
+	switch f.Sort {
+	case ent.SortOldest:
+		if f.After != nil {
+			q = q.Where("(answers.created_at, answers.id) > (?, ?)", f.After.CreatedAt, f.After.ID)
+		}
+		q = q.Order("answers.created_at ASC, answers.id ASC")
+	case ent.SortNewest:
+		if f.After != nil {
+			q = q.Where("(answers.created_at, answers.id) < (?, ?)", f.After.CreatedAt, f.After.ID)
+		}
+		q = q.Order("answers.created_at DESC, answers.id DESC")
+	case ent.SortScore:
+		if f.After != nil {
+			q = q.Where("v.score < ? OR (v.score = ? AND answers.id > ?)", f.After.Score, f.After.Score, f.After.ID)
+		}
+		q = q.Order("v.score DESC, answers.id ASC")
+	default:
+		return nil, ent.ErrInvalidSort
+	}
+
 	var rows []answerRow
+	if err := q.Limit(f.Limit).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Answer, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityAnswer(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// CountByQuestionID counts the question's answers readers can see.
+func (r *Repository) CountByQuestionID(ctx context.Context, questionID int) (int, error) {
+	var n int64
+
+	err := r.scoped(ctx, r.db).
+		Model(&answerRow{}).
+		Where("question_id = ? AND hidden_at IS NULL", questionID).
+		Count(&n).Error
+
+	return int(n), err
+}
+
+// ListFirstByQuestionIDs returns, for each of the questions, the first page
+// of up to limit answers in sort that ListPage would return, ordered by
+// question.
+func (r *Repository) ListFirstByQuestionIDs(
+	ctx context.Context,
+	questionIDs []int,
+	sort ent.Sort,
+	limit int,
+) ([]*ent.Answer, error) {
+	var order string
+	switch sort {
+	case ent.SortOldest:
+		order = "answers.created_at ASC, answers.id ASC"
+	case ent.SortNewest:
+		order = "answers.created_at DESC, answers.id DESC"
+	case ent.SortScore:
+		order = "v.score DESC, answers.id ASC"
+	default:
+		return nil, ent.ErrInvalidSort
+	}
+
+	out := make([]*ent.Answer, 0)
+	if len(questionIDs) == 0 {
+		return out, nil
+	}
 
+	ranked := r.scoped(ctx, r.db).
+		Model(&answerRow{}).
+		Select("answers.*, v.score, ROW_NUMBER() OVER (PARTITION BY answers.question_id ORDER BY "+order+") AS rn").
+		Joins(scoreJoin).
+		Where("answers.question_id IN ? AND answers.hidden_at IS NULL", questionIDs)
+
+	var rows []answerRow
 	err := r.db.WithContext(ctx).
-		Where("question_id = ?", questionID).
-		Order("created_at ASC").
+		Table("(?) AS answers", ranked).
+		Where("rn <= ?", limit).
+		Order("question_id, rn").
 		Find(&rows).Error
-
 	if err != nil {
 		return nil, err
 	}
 
-	out := make([]*ent.Answer, 0, len(rows))
 	for i := range rows {
 		out = append(out, toEntityAnswer(&rows[i]))
 	}
 
 	return out, nil
 }
+
+// CountByQuestionIDs counts the answers readers can see of each of the
+// questions; questions without any are left out.
+func (r *Repository) CountByQuestionIDs(ctx context.Context, questionIDs []int) (map[int]int, error) {
+	out := make(map[int]int, len(questionIDs))
+	if len(questionIDs) == 0 {
+		return out, nil
+	}
+
+	var rows []struct {
+		QuestionID int
+		N          int
+	}
+	err := r.scoped(ctx, r.db).
+		Model(&answerRow{}).
+		Select("question_id, COUNT(*) AS n").
+		Where("question_id IN ? AND hidden_at IS NULL", questionIDs).
+		Group("question_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		out[row.QuestionID] = row.N
+	}
+
+	return out, nil
+}
+
+// Hide keeps the answer from readers until a moderator reviews it.
+func (r *Repository) Hide(ctx context.Context, id int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&answerRow{}).
+		Where("id = ? AND hidden_at IS NULL", id).
+		Update("hidden_at", gorm.Expr("NOW()")).Error
+}
+
+// Unhide shows the answer to readers again.
+func (r *Repository) Unhide(ctx context.Context, id int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&answerRow{}).
+		Where("id = ?", id).
+		Update("hidden_at", nil).Error
+}
@@ -10,6 +10,7 @@ import (
 
 	ent "test-question/internal/entity/answer"
 	entq "test-question/internal/entity/question"
+	"test-question/internal/pkg/tenant"
 	"test-question/internal/repository/question"
 	"test-question/internal/tests/dbsuite"
 
@@ -27,7 +28,8 @@ func (s *AnswerRepoInfraSuite) SetupTest() {
 	s.repo = &Repository{db: s.DB}
 	s.quesRepo = question.NewRepository(s.DB)
 
-	s.ResetTables("answers", "questions")
+	s.ResetTables("votes", "answers", "questions")
+	s.ResetWorkspaces()
 
 	q := &entq.Question{
 		Text:      "Test Question",
@@ -98,7 +100,24 @@ func (s *AnswerRepoInfraSuite) TestDeleteSoft() {
 	s.True(row.DeletedAt.Valid)
 }
 
-func (s *AnswerRepoInfraSuite) TestListByQuestionID() {
+func (s *AnswerRepoInfraSuite) TestRemove() {
+	ar := &answerRow{
+		QuestionID: int64(s.question.ID),
+		UserID:     "user3",
+		Text:       "remove me",
+		CreatedAt:  time.Now(),
+	}
+	s.Require().NoError(s.DB.Create(ar).Error)
+
+	s.Require().NoError(s.repo.Remove(context.Background(), int(ar.ID)))
+
+	out, err := s.repo.GetByIDWithDeleted(context.Background(), int(ar.ID))
+	s.Require().NoError(err)
+	s.NotNil(out.DeletedAt)
+	s.True(out.RemovedByModerator)
+}
+
+func (s *AnswerRepoInfraSuite) TestListPage() {
 	now := time.Now()
 
 	a1 := &answerRow{
@@ -117,12 +136,228 @@ func (s *AnswerRepoInfraSuite) TestListByQuestionID() {
 	s.NoError(s.DB.Create(a1).Error)
 	s.NoError(s.DB.Create(a2).Error)
 
-	list, err := s.repo.ListByQuestionID(context.Background(), s.question.ID)
+	list, err := s.repo.ListPage(context.Background(), s.page(ent.SortOldest, nil))
 	s.Require().NoError(err)
 	s.Require().Len(list, 2)
 
 	s.Equal("A1", list[0].Text)
 	s.Equal("A2", list[1].Text)
+
+	n, err := s.repo.CountByQuestionID(context.Background(), s.question.ID)
+	s.Require().NoError(err)
+	s.Equal(2, n)
+}
+
+func (s *AnswerRepoInfraSuite) page(sort ent.Sort, after *ent.Answer) ent.Filter {
+	f := ent.Filter{QuestionID: s.question.ID, Sort: sort, Limit: 100}
+	if after != nil {
+		c := ent.CursorAfter(after, sort)
+		f.After = &c
+	}
+	return f
+}
+
+func (s *AnswerRepoInfraSuite) TestListPage_Sorts() {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Microsecond)
+
+	// the first two share created_at: the id breaks the tie
+	rows := []*answerRow{
+		{QuestionID: int64(s.question.ID), UserID: "u1", Text: "A", CreatedAt: now},
+		{QuestionID: int64(s.question.ID), UserID: "u2", Text: "B", CreatedAt: now},
+		{QuestionID: int64(s.question.ID), UserID: "u3", Text: "C", CreatedAt: now.Add(time.Minute)},
+	}
+	for _, row := range rows {
+		s.Require().NoError(s.DB.Create(row).Error)
+	}
+	s.Require().NoError(s.DB.Exec(`
+		INSERT INTO votes (user_id, target_type, target_id, value) VALUES
+			('x', 'answer', ?, 1), ('y', 'answer', ?, 1), ('z', 'answer', ?, 1), ('x', 'question', ?, 5)`,
+		rows[2].ID, rows[2].ID, rows[0].ID, rows[1].ID).Error)
+
+	texts := func(f ent.Filter) []string {
+		list, err := s.repo.ListPage(ctx, f)
+		s.Require().NoError(err)
+		out := make([]string, len(list))
+		for i, a := range list {
+			out[i] = a.Text
+		}
+		return out
+	}
+
+	s.Equal([]string{"A", "B", "C"}, texts(s.page(ent.SortOldest, nil)))
+	s.Equal([]string{"C", "B", "A"}, texts(s.page(ent.SortNewest, nil)))
+	s.Equal([]string{"C", "A", "B"}, texts(s.page(ent.SortScore, nil)))
+
+	list, err := s.repo.ListPage(ctx, s.page(ent.SortScore, nil))
+	s.Require().NoError(err)
+	s.Equal(2, list[0].Score)
+	s.Equal(0, list[2].Score)
+
+	// each sort resumes after the cursor's answer
+	s.Equal([]string{"B", "C"}, texts(s.page(ent.SortOldest, &ent.Answer{ID: int(rows[0].ID), CreatedAt: now})))
+	s.Equal([]string{"A"}, texts(s.page(ent.SortNewest, &ent.Answer{ID: int(rows[1].ID), CreatedAt: now})))
+	s.Equal([]string{"B"}, texts(s.page(ent.SortScore, &ent.Answer{ID: int(rows[0].ID), Score: 1})))
+
+	f := s.page(ent.SortOldest, nil)
+	f.Limit = 2
+	s.Equal([]string{"A", "B"}, texts(f))
+}
+
+func (s *AnswerRepoInfraSuite) TestListFirstByQuestionIDs() {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Microsecond)
+
+	other, err := s.quesRepo.Create(ctx, &entq.Question{Text: "Other", UserID: "u9", CreatedAt: now})
+	s.Require().NoError(err)
+
+	rows := []*answerRow{
+		{QuestionID: int64(s.question.ID), UserID: "u1", Text: "A", CreatedAt: now},
+		{QuestionID: int64(s.question.ID), UserID: "u2", Text: "B", CreatedAt: now.Add(time.Minute)},
+		{QuestionID: int64(s.question.ID), UserID: "u3", Text: "C", CreatedAt: now.Add(2 * time.Minute)},
+		{QuestionID: int64(other.ID), UserID: "u1", Text: "X", CreatedAt: now},
+		{QuestionID: int64(other.ID), UserID: "u2", Text: "hidden", CreatedAt: now, HiddenAt: &now},
+	}
+	for _, row := range rows {
+		s.Require().NoError(s.DB.Create(row).Error)
+	}
+	s.Require().NoError(s.DB.Exec(`INSERT INTO votes (user_id, target_type, target_id, value) VALUES ('x', 'answer', ?, 1)`,
+		rows[2].ID).Error)
+
+	texts := func(sort ent.Sort, limit int) []string {
+		list, err := s.repo.ListFirstByQuestionIDs(ctx, []int{s.question.ID, other.ID}, sort, limit)
+		s.Require().NoError(err)
+		out := make([]string, len(list))
+		for i, a := range list {
+			out[i] = a.Text
+		}
+		return out
+	}
+
+	s.Equal([]string{"A", "B", "X"}, texts(ent.SortOldest, 2))
+	s.Equal([]string{"C", "B", "X"}, texts(ent.SortNewest, 2))
+	s.Equal([]string{"C", "X"}, texts(ent.SortScore, 1))
+
+	list, err := s.repo.ListFirstByQuestionIDs(ctx, []int{s.question.ID}, ent.SortScore, 1)
+	s.Require().NoError(err)
+	s.Require().Len(list, 1)
+	s.Equal(1, list[0].Score)
+
+	counts, err := s.repo.CountByQuestionIDs(ctx, []int{s.question.ID, other.ID, other.ID + 1})
+	s.Require().NoError(err)
+	s.Equal(map[int]int{s.question.ID: 3, other.ID: 1}, counts)
+}
+
+func (s *AnswerRepoInfraSuite) TestRestoreByQuestionID_OnlyCascaded() {
+	ctx := context.Background()
+
+	own := &answerRow{QuestionID: int64(s.question.ID), UserID: "u1", Text: "deleted on its own"}
+	cascaded := &answerRow{QuestionID: int64(s.question.ID), UserID: "u2", Text: "deleted with question"}
+	s.Require().NoError(s.DB.Create(own).Error)
+	s.Require().NoError(s.DB.Create(cascaded).Error)
+
+	s.Require().NoError(s.repo.Delete(ctx, int(own.ID)))
+	s.Require().NoError(s.repo.DeleteByQuestionID(ctx, s.question.ID))
+
+	list, err := s.repo.ListPage(ctx, s.page(ent.SortOldest, nil))
+	s.Require().NoError(err)
+	s.Empty(list)
+
+	s.Require().NoError(s.repo.RestoreByQuestionID(ctx, s.question.ID))
+
+	list, err = s.repo.ListPage(ctx, s.page(ent.SortOldest, nil))
+	s.Require().NoError(err)
+	s.Require().Len(list, 1)
+	s.Equal(int(cascaded.ID), list[0].ID)
+
+	trashed, err := s.repo.GetByIDWithDeleted(ctx, int(own.ID))
+	s.Require().NoError(err)
+	s.NotNil(trashed.DeletedAt)
+}
+
+func (s *AnswerRepoInfraSuite) TestHideAndUnhide() {
+	ctx := context.Background()
+
+	a := &answerRow{QuestionID: int64(s.question.ID), UserID: "u1", Text: "reported"}
+	s.Require().NoError(s.DB.Create(a).Error)
+	s.Require().NoError(s.repo.Hide(ctx, int(a.ID)))
+
+	list, err := s.repo.ListPage(ctx, s.page(ent.SortOldest, nil))
+	s.Require().NoError(err)
+	s.Empty(list)
+
+	s.Require().NoError(s.repo.Unhide(ctx, int(a.ID)))
+
+	out, err := s.repo.GetByID(ctx, int(a.ID))
+	s.Require().NoError(err)
+	s.Nil(out.HiddenAt)
+}
+
+func (s *AnswerRepoInfraSuite) TestRestoreAndPurge() {
+	ctx := context.Background()
+
+	kept := &answerRow{QuestionID: int64(s.question.ID), UserID: "u1", Text: "restored"}
+	purged := &answerRow{QuestionID: int64(s.question.ID), UserID: "u1", Text: "purged"}
+	s.Require().NoError(s.DB.Create(kept).Error)
+	s.Require().NoError(s.DB.Create(purged).Error)
+
+	s.Require().NoError(s.repo.Delete(ctx, int(kept.ID)))
+	s.Require().NoError(s.repo.Delete(ctx, int(purged.ID)))
+	s.Require().NoError(s.repo.Restore(ctx, int(kept.ID)))
+
+	_, err := s.repo.GetByID(ctx, int(kept.ID))
+	s.Require().NoError(err)
+
+	n, err := s.repo.PurgeDeleted(ctx, time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Zero(n)
+
+	n, err = s.repo.PurgeDeleted(ctx, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	s.Equal(1, n)
+
+	_, err = s.repo.GetByIDWithDeleted(ctx, int(purged.ID))
+	s.ErrorIs(err, ent.ErrAnswerNotFound)
+}
+
+func (s *AnswerRepoInfraSuite) TestWorkspaceIsolation() {
+	team := tenant.Inject(context.Background(), s.Workspace("team"))
+	public := tenant.Inject(context.Background(), tenant.DefaultID)
+
+	q, err := s.quesRepo.Create(team, &entq.Question{Text: "team question", UserID: "11111111-1111-1111-1111-111111111111"})
+	s.Require().NoError(err)
+	a, err := s.repo.Create(team, &ent.Answer{QuestionID: q.ID, UserID: "u1", Text: "team answer"})
+	s.Require().NoError(err)
+
+	var row answerRow
+	s.Require().NoError(s.DB.First(&row, a.ID).Error)
+	s.NotEqual(tenant.DefaultID, row.WorkspaceID)
+
+	_, err = s.repo.GetByID(public, a.ID)
+	s.ErrorIs(err, ent.ErrAnswerNotFound)
+	_, err = s.repo.GetByIDWithDeleted(public, a.ID)
+	s.ErrorIs(err, ent.ErrAnswerNotFound)
+
+	f := s.page(ent.SortOldest, nil)
+	f.QuestionID = q.ID
+
+	list, err := s.repo.ListPage(public, f)
+	s.Require().NoError(err)
+	s.Empty(list)
+	n, err := s.repo.CountByQuestionID(public, q.ID)
+	s.Require().NoError(err)
+	s.Zero(n)
+
+	s.Require().NoError(s.repo.Hide(public, a.ID))
+	s.Require().NoError(s.repo.DeleteByQuestionID(public, q.ID))
+
+	got, err := s.repo.GetByID(team, a.ID)
+	s.Require().NoError(err)
+	s.Nil(got.HiddenAt)
+
+	list, err = s.repo.ListPage(team, f)
+	s.Require().NoError(err)
+	s.Len(list, 1)
 }
 
 func TestAnswerRepoInfraSuite(t *testing.T) {
@@ -9,12 +9,22 @@ import (
 )
 
 type answerRow struct {
-	ID         int64          `gorm:"primaryKey;column:id"`
-	QuestionID int64          `gorm:"column:question_id;not null"`
-	UserID     string         `gorm:"column:user_id;type:text;not null"`
-	Text       string         `gorm:"column:text;type:text;not null"`
-	CreatedAt  time.Time      `gorm:"column:created_at;autoCreateTime"`
-	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	ID         int64  `gorm:"primaryKey;column:id"`
+	QuestionID int64  `gorm:"column:question_id;not null"`
+	UserID     string `gorm:"column:user_id;type:text;not null"`
+	Text       string `gorm:"column:text;type:text;not null"`
+	Revision   int    `gorm:"column:revision;not null;default:1"`
+	// Score is summed up from votes when reading pages.
+	Score     int64          `gorm:"->;column:score"`
+	CreatedAt time.Time      `gorm:"column:created_at;autoCreateTime"`
+	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	HiddenAt  *time.Time     `gorm:"column:hidden_at"`
+	// DeletedWithQuestion marks answers trashed by their question's deletion.
+	DeletedWithQuestion bool `gorm:"column:deleted_with_question;not null;default:false"`
+	// RemovedByModerator is set by Remove.
+	RemovedByModerator bool `gorm:"column:removed_by_moderator;not null;default:false"`
+	// WorkspaceID is set from the context on create, see tenant.
+	WorkspaceID int `gorm:"column:workspace_id;not null;default:1"`
 }
 
 func (answerRow) TableName() string {
@@ -25,13 +35,23 @@ func toEntityAnswer(a *answerRow) *answer.Answer {
 	if a == nil {
 		return nil
 	}
-	return &answer.Answer{
+	out := &answer.Answer{
 		ID:         int(a.ID),
 		QuestionID: int(a.QuestionID),
 		UserID:     a.UserID,
 		Text:       a.Text,
+		Revision:   a.Revision,
+		Score:      int(a.Score),
 		CreatedAt:  a.CreatedAt,
+		HiddenAt:   a.HiddenAt,
+
+		RemovedByModerator: a.RemovedByModerator,
+	}
+	if a.DeletedAt.Valid {
+		deletedAt := a.DeletedAt.Time
+		out.DeletedAt = &deletedAt
 	}
+	return out
 }
 
 func fromEntityAnswer(e *answer.Answer) *answerRow {
@@ -43,6 +63,8 @@ func fromEntityAnswer(e *answer.Answer) *answerRow {
 		QuestionID: int64(e.QuestionID),
 		UserID:     e.UserID,
 		Text:       e.Text,
+		Revision:   e.Revision,
 		CreatedAt:  e.CreatedAt,
+		HiddenAt:   e.HiddenAt,
 	}
 }
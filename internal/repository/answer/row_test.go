@@ -7,6 +7,7 @@ import (
 	ent "test-question/internal/entity/answer"
 
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestAnswerConverters(t *testing.T) {
@@ -24,6 +25,8 @@ func TestAnswerConverters(t *testing.T) {
 				QuestionID: 3,
 				UserID:     "u1",
 				Text:       "hello",
+				Revision:   2,
+				Score:      -1,
 				CreatedAt:  now,
 			},
 			entity: &ent.Answer{
@@ -31,9 +34,31 @@ func TestAnswerConverters(t *testing.T) {
 				QuestionID: 3,
 				UserID:     "u1",
 				Text:       "hello",
+				Revision:   2,
+				Score:      -1,
 				CreatedAt:  now,
 			},
 		},
+		{
+			name: "deleted_row",
+			row: &answerRow{
+				ID:                  11,
+				QuestionID:          3,
+				UserID:              "u1",
+				Text:                "gone",
+				CreatedAt:           now,
+				DeletedAt:           gorm.DeletedAt{Time: now, Valid: true},
+				DeletedWithQuestion: true,
+			},
+			entity: &ent.Answer{
+				ID:         11,
+				QuestionID: 3,
+				UserID:     "u1",
+				Text:       "gone",
+				CreatedAt:  now,
+				DeletedAt:  &now,
+			},
+		},
 		{
 			name:   "nil_row",
 			row:    nil,
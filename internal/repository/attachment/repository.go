@@ -0,0 +1,155 @@
+package attachment
+
+import (
+	"context"
+	"time"
+
+	ent "test-question/internal/entity/attachment"
+	"test-question/internal/pkg/tenant"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Create(ctx context.Context, e *ent.Attachment) (*ent.Attachment, error) {
+	row := fromEntityAttachment(e)
+
+	if err := uow.GetTx(ctx, r.db).WithContext(ctx).Create(row).Error; err != nil {
+		return nil, err
+	}
+
+	return toEntityAttachment(row), nil
+}
+
+// GetByID also tells whether the attachment is published: its post is in
+// the workspace of ctx and, for answers with the answered question, is
+// neither trashed nor hidden.
+func (r *Repository) GetByID(ctx context.Context, id int) (*ent.Attachment, error) {
+	var rows []publishedRow
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT at.*,
+			(q.id IS NOT NULL OR (a.id IS NOT NULL AND aq.id IS NOT NULL)) AS published
+		FROM attachments at
+		LEFT JOIN questions q ON q.id = at.question_id AND q.deleted_at IS NULL AND q.hidden_at IS NULL AND ?
+		LEFT JOIN answers a ON a.id = at.answer_id AND a.deleted_at IS NULL AND a.hidden_at IS NULL AND ?
+		LEFT JOIN questions aq ON aq.id = a.question_id AND aq.deleted_at IS NULL AND aq.hidden_at IS NULL
+		WHERE at.id = ?`, tenant.Condition(ctx, "q"), tenant.Condition(ctx, "a"), id).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ent.ErrAttachmentNotFound
+	}
+
+	return toEntityPublished(&rows[0]), nil
+}
+
+// AttachToQuestion hands the user's orphaned uploads over to the question.
+// Unless every one of them can be attached none is, and ErrUnavailable is
+// returned.
+func (r *Repository) AttachToQuestion(ctx context.Context, userID string, questionID int, ids []int) error {
+	return r.attach(ctx, "question_id", questionID, userID, ids)
+}
+
+// AttachToAnswer is AttachToQuestion for answers.
+func (r *Repository) AttachToAnswer(ctx context.Context, userID string, answerID int, ids []int) error {
+	return r.attach(ctx, "answer_id", answerID, userID, ids)
+}
+
+func (r *Repository) attach(ctx context.Context, column string, targetID int, userID string, ids []int) error {
+	ids = ent.UniqueIDs(ids)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return uow.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&attachmentRow{}).
+			Where("id IN ? AND user_id = ? AND question_id IS NULL AND answer_id IS NULL", ids, userID).
+			Update(column, targetID)
+		if res.Error != nil {
+			return res.Error
+		}
+		if int(res.RowsAffected) != len(ids) {
+			return ent.ErrUnavailable
+		}
+		return nil
+	})
+}
+
+// ListByPosts returns the attachments of the question itself and of the
+// given answers in upload order.
+func (r *Repository) ListByPosts(ctx context.Context, questionID int, answerIDs []int) ([]*ent.Attachment, error) {
+	var rows []attachmentRow
+
+	q := r.db.WithContext(ctx).Where("question_id = ?", questionID)
+	if len(answerIDs) > 0 {
+		q = q.Or("answer_id IN ?", answerIDs)
+	}
+
+	err := q.
+		Order("id ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Attachment, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityAttachment(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// ListOrphans returns attachments nothing refers to: uploads never
+// attached before the given time and kept in no draft of the uploader, and
+// attachments whose post was purged.
+func (r *Repository) ListOrphans(ctx context.Context, before time.Time, limit int) ([]*ent.Attachment, error) {
+	var rows []attachmentRow
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT * FROM attachments at
+		WHERE (at.question_id IS NULL AND at.answer_id IS NULL AND at.created_at < ?
+				AND NOT EXISTS (SELECT 1 FROM drafts d
+					WHERE d.user_id = at.user_id AND d.attachment_ids @> jsonb_build_array(at.id)))
+			OR (at.question_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM questions q WHERE q.id = at.question_id))
+			OR (at.answer_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM answers a WHERE a.id = at.answer_id))
+		ORDER BY at.id ASC
+		LIMIT ?`, before, limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Attachment, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityAttachment(&rows[i]))
+	}
+
+	return out, nil
+}
+
+func (r *Repository) DeleteByIDs(ctx context.Context, ids []int) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	res := r.db.WithContext(ctx).
+		Where("id IN ?", ids).
+		Delete(&attachmentRow{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return int(res.RowsAffected), nil
+}
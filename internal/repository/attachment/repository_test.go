@@ -0,0 +1,197 @@
+//go:build integration
+// +build integration
+
+package attachment
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/attachment"
+	"test-question/internal/pkg/tenant"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AttachmentRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *AttachmentRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("attachments", "answers", "questions", "drafts")
+	s.ResetWorkspaces()
+}
+
+func (s *AttachmentRepoInfraSuite) upload(userID string, createdAt time.Time) int {
+	a, err := s.repo.Create(context.Background(), &ent.Attachment{
+		UserID:      userID,
+		Filename:    "log.txt",
+		ContentType: "text/plain; charset=utf-8",
+		Size:        3,
+		StorageKey:  "attachments/" + userID + createdAt.Format("150405.000000"),
+		CreatedAt:   createdAt,
+	})
+	s.Require().NoError(err)
+	return a.ID
+}
+
+func (s *AttachmentRepoInfraSuite) question() int {
+	var id int
+	s.Require().NoError(s.DB.Raw(
+		"INSERT INTO questions (text, user_id) VALUES ('q', '00000000-0000-0000-0000-000000000001') RETURNING id").
+		Scan(&id).Error)
+	return id
+}
+
+func (s *AttachmentRepoInfraSuite) answer(questionID int) int {
+	var id int
+	s.Require().NoError(s.DB.Raw(
+		"INSERT INTO answers (question_id, user_id, text) VALUES (?, 'u1', 'a') RETURNING id", questionID).
+		Scan(&id).Error)
+	return id
+}
+
+func (s *AttachmentRepoInfraSuite) TestAttach_AllOrNothing() {
+	ctx := context.Background()
+	now := time.Now()
+
+	mine := s.upload("u1", now)
+	other := s.upload("u2", now)
+	qID := s.question()
+
+	s.ErrorIs(s.repo.AttachToQuestion(ctx, "u1", qID, []int{mine, other}), ent.ErrUnavailable)
+
+	got, err := s.repo.GetByID(ctx, mine)
+	s.Require().NoError(err)
+	s.Nil(got.QuestionID, "nothing attached when one upload is unavailable")
+
+	s.Require().NoError(s.repo.AttachToQuestion(ctx, "u1", qID, []int{mine, mine}))
+
+	aID := s.answer(qID)
+	s.ErrorIs(s.repo.AttachToAnswer(ctx, "u1", aID, []int{mine}), ent.ErrUnavailable, "already attached")
+}
+
+func (s *AttachmentRepoInfraSuite) TestGetByID_Published() {
+	ctx := context.Background()
+	now := time.Now()
+
+	qID := s.question()
+	aID := s.answer(qID)
+	onQuestion := s.upload("u1", now)
+	onAnswer := s.upload("u1", now)
+	orphan := s.upload("u1", now)
+
+	s.Require().NoError(s.repo.AttachToQuestion(ctx, "u1", qID, []int{onQuestion}))
+	s.Require().NoError(s.repo.AttachToAnswer(ctx, "u1", aID, []int{onAnswer}))
+
+	for id, want := range map[int]bool{onQuestion: true, onAnswer: true, orphan: false} {
+		got, err := s.repo.GetByID(ctx, id)
+		s.Require().NoError(err)
+		s.Equal(want, got.Published)
+	}
+
+	// hiding the question unpublishes the attachments of its answers too
+	s.Require().NoError(s.DB.Exec("UPDATE questions SET hidden_at = NOW() WHERE id = ?", qID).Error)
+
+	got, err := s.repo.GetByID(ctx, onAnswer)
+	s.Require().NoError(err)
+	s.False(got.Published)
+
+	_, err = s.repo.GetByID(ctx, 999)
+	s.ErrorIs(err, ent.ErrAttachmentNotFound)
+}
+
+func (s *AttachmentRepoInfraSuite) TestGetByID_OtherWorkspaceUnpublished() {
+	team := s.Workspace("team")
+
+	qID := s.question()
+	aID := s.answer(qID)
+	s.Require().NoError(s.DB.Exec("UPDATE questions SET workspace_id = ? WHERE id = ?", team, qID).Error)
+	s.Require().NoError(s.DB.Exec("UPDATE answers SET workspace_id = ? WHERE id = ?", team, aID).Error)
+
+	onQuestion := s.upload("u1", time.Now())
+	onAnswer := s.upload("u1", time.Now())
+	s.Require().NoError(s.repo.AttachToQuestion(context.Background(), "u1", qID, []int{onQuestion}))
+	s.Require().NoError(s.repo.AttachToAnswer(context.Background(), "u1", aID, []int{onAnswer}))
+
+	for ctx, want := range map[context.Context]bool{
+		tenant.Inject(context.Background(), team):             true,
+		tenant.Inject(context.Background(), tenant.DefaultID): false,
+	} {
+		for _, id := range []int{onQuestion, onAnswer} {
+			got, err := s.repo.GetByID(ctx, id)
+			s.Require().NoError(err)
+			s.Equal(want, got.Published)
+		}
+	}
+}
+
+func (s *AttachmentRepoInfraSuite) TestListByPosts() {
+	ctx := context.Background()
+	now := time.Now()
+
+	qID := s.question()
+	aID := s.answer(qID)
+	otherID := s.answer(qID)
+	first := s.upload("u1", now)
+	second := s.upload("u1", now)
+	third := s.upload("u1", now)
+	s.upload("u1", now)
+
+	s.Require().NoError(s.repo.AttachToAnswer(ctx, "u1", aID, []int{second}))
+	s.Require().NoError(s.repo.AttachToAnswer(ctx, "u1", otherID, []int{third}))
+	s.Require().NoError(s.repo.AttachToQuestion(ctx, "u1", qID, []int{first}))
+
+	got, err := s.repo.ListByPosts(ctx, qID, []int{aID})
+	s.Require().NoError(err)
+	s.Require().Len(got, 2)
+	s.Equal(first, got[0].ID)
+	s.Equal(second, got[1].ID)
+	s.Equal(aID, *got[1].AnswerID)
+
+	got, err = s.repo.ListByPosts(ctx, qID, nil)
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Equal(first, got[0].ID)
+}
+
+func (s *AttachmentRepoInfraSuite) TestListOrphansAndDelete() {
+	ctx := context.Background()
+	now := time.Now()
+
+	old := s.upload("u1", now.Add(-48*time.Hour))
+	s.upload("u1", now)
+	qID := s.question()
+	purged := s.upload("u1", now.Add(-48*time.Hour))
+	s.Require().NoError(s.repo.AttachToQuestion(ctx, "u1", qID, []int{purged}))
+	kept := s.upload("u1", now.Add(-48*time.Hour))
+	s.Require().NoError(s.repo.AttachToQuestion(ctx, "u1", qID, []int{kept}))
+
+	s.Require().NoError(s.DB.Exec("UPDATE attachments SET question_id = 999 WHERE id = ?", purged).Error)
+
+	drafted := s.upload("u1", now.Add(-48*time.Hour))
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO drafts (user_id, question_id, text, attachment_ids, expires_at) VALUES ('u1', 0, 'draft', ?, ?)",
+		fmt.Sprintf("[%d]", drafted), now.Add(time.Hour),
+	).Error)
+
+	got, err := s.repo.ListOrphans(ctx, now.Add(-24*time.Hour), 10)
+	s.Require().NoError(err)
+	s.Require().Len(got, 2)
+	s.Equal(old, got[0].ID)
+	s.Equal(purged, got[1].ID)
+
+	n, err := s.repo.DeleteByIDs(ctx, []int{old, purged})
+	s.Require().NoError(err)
+	s.Equal(2, n)
+}
+
+func TestAttachmentRepoInfraSuite(t *testing.T) {
+	s := &AttachmentRepoInfraSuite{}
+	suite.Run(t, s)
+}
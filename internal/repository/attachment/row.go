@@ -0,0 +1,95 @@
+package attachment
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/attachment"
+)
+
+type attachmentRow struct {
+	ID           int64     `gorm:"primaryKey;column:id"`
+	UserID       string    `gorm:"column:user_id;type:text;not null"`
+	Filename     string    `gorm:"column:filename;type:varchar(255);not null"`
+	ContentType  string    `gorm:"column:content_type;type:varchar(255);not null"`
+	Size         int64     `gorm:"column:size;not null"`
+	StorageKey   string    `gorm:"column:storage_key;type:varchar(255);not null"`
+	ThumbnailKey string    `gorm:"column:thumbnail_key;type:varchar(255);not null"`
+	QuestionID   *int64    `gorm:"column:question_id"`
+	AnswerID     *int64    `gorm:"column:answer_id"`
+	CreatedAt    time.Time `gorm:"column:created_at;not null"`
+}
+
+func (attachmentRow) TableName() string {
+	return "attachments"
+}
+
+// publishedRow is an attachment together with whether readers can see the
+// post it belongs to.
+type publishedRow struct {
+	attachmentRow `gorm:"embedded"`
+	Published     bool `gorm:"column:published"`
+}
+
+func toEntityAttachment(r *attachmentRow) *ent.Attachment {
+	if r == nil {
+		return nil
+	}
+
+	return &ent.Attachment{
+		ID:           int(r.ID),
+		UserID:       r.UserID,
+		Filename:     r.Filename,
+		ContentType:  r.ContentType,
+		Size:         r.Size,
+		StorageKey:   r.StorageKey,
+		ThumbnailKey: r.ThumbnailKey,
+		QuestionID:   toIntPtr(r.QuestionID),
+		AnswerID:     toIntPtr(r.AnswerID),
+		CreatedAt:    r.CreatedAt,
+	}
+}
+
+func fromEntityAttachment(e *ent.Attachment) *attachmentRow {
+	if e == nil {
+		return nil
+	}
+
+	return &attachmentRow{
+		ID:           int64(e.ID),
+		UserID:       e.UserID,
+		Filename:     e.Filename,
+		ContentType:  e.ContentType,
+		Size:         e.Size,
+		StorageKey:   e.StorageKey,
+		ThumbnailKey: e.ThumbnailKey,
+		QuestionID:   fromIntPtr(e.QuestionID),
+		AnswerID:     fromIntPtr(e.AnswerID),
+		CreatedAt:    e.CreatedAt,
+	}
+}
+
+func toEntityPublished(r *publishedRow) *ent.Attachment {
+	if r == nil {
+		return nil
+	}
+
+	out := toEntityAttachment(&r.attachmentRow)
+	out.Published = r.Published
+	return out
+}
+
+func toIntPtr(v *int64) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+func fromIntPtr(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+	return &i
+}
@@ -0,0 +1,50 @@
+package attachment
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/attachment"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentConverters(t *testing.T) {
+	now := time.Now()
+	qID := 7
+	rowQID := int64(7)
+
+	row := &attachmentRow{
+		ID:           3,
+		UserID:       "u1",
+		Filename:     "screen.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		StorageKey:   "attachments/k",
+		ThumbnailKey: "thumbnails/k.png",
+		QuestionID:   &rowQID,
+		CreatedAt:    now,
+	}
+	entity := &ent.Attachment{
+		ID:           3,
+		UserID:       "u1",
+		Filename:     "screen.png",
+		ContentType:  "image/png",
+		Size:         1024,
+		StorageKey:   "attachments/k",
+		ThumbnailKey: "thumbnails/k.png",
+		QuestionID:   &qID,
+		CreatedAt:    now,
+	}
+
+	require.Equal(t, entity, toEntityAttachment(row))
+	require.Equal(t, row, fromEntityAttachment(entity))
+
+	published := toEntityPublished(&publishedRow{attachmentRow: *row, Published: true})
+	require.True(t, published.Published)
+	require.Equal(t, 7, *published.QuestionID)
+
+	require.Nil(t, toEntityAttachment(nil))
+	require.Nil(t, fromEntityAttachment(nil))
+	require.Nil(t, toEntityPublished(nil))
+}
@@ -0,0 +1,163 @@
+package bounty
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ent "test-question/internal/entity/bounty"
+	"test-question/internal/pkg/tenant"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Create opens b unless its question already has an open bounty, in which
+// case it fails with ErrBountyExists.
+func (r *Repository) Create(ctx context.Context, b *ent.Bounty) (*ent.Bounty, error) {
+	row := fromEntityBounty(b)
+
+	res := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "question_id"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "status = 'open'"}}},
+			DoNothing:   true,
+		}).
+		Create(row)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, ent.ErrBountyExists
+	}
+
+	return toEntityBounty(row), nil
+}
+
+func (r *Repository) GetOpen(ctx context.Context, questionID int) (*ent.Bounty, error) {
+	var row bountyRow
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Where("question_id = ? AND status = ?", questionID, ent.StatusOpen).
+		Take(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ent.ErrBountyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntityBounty(&row), nil
+}
+
+// Close moves an open bounty to status, paid to answerID unless it is zero.
+// A bounty that was closed meanwhile fails with ErrBountyNotFound, so only
+// one caller can pay it out.
+func (r *Repository) Close(ctx context.Context, id int, status ent.Status, answerID int, at time.Time) error {
+	updates := map[string]any{
+		"status":    status,
+		"closed_at": at,
+	}
+	if answerID != 0 {
+		updates["answer_id"] = answerID
+	}
+
+	res := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&bountyRow{}).
+		Where("id = ? AND status = ?", id, ent.StatusOpen).
+		Updates(updates)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ent.ErrBountyNotFound
+	}
+
+	return nil
+}
+
+// ListExpired returns open bounties that expired by now, oldest first.
+func (r *Repository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*ent.Bounty, error) {
+	var rows []bountyRow
+
+	err := r.db.WithContext(ctx).
+		Where("status = ? AND expires_at <= ?", ent.StatusOpen, now).
+		Order("expires_at ASC, id ASC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Bounty, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityBounty(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// ListFeatured returns bounties open at now on questions readers of the
+// workspace can see, those ending soonest first.
+func (r *Repository) ListFeatured(ctx context.Context, now time.Time, limit int) ([]*ent.Featured, error) {
+	var rows []featuredRow
+
+	err := r.db.WithContext(ctx).
+		Table("bounties b").
+		Select("b.*, q.text AS question_text").
+		Joins("JOIN questions q ON q.id = b.question_id").
+		Where("b.status = ? AND b.expires_at > ?", ent.StatusOpen, now).
+		Where("q.deleted_at IS NULL AND q.hidden_at IS NULL").
+		Scopes(tenant.Scope(ctx, "q")).
+		Order("b.expires_at ASC, b.id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Featured, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityFeatured(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// TopAnswer returns the id of the highest voted answer to the question
+// posted since the given time by someone else than exceptUserID, or zero
+// when no such answer has a positive score. Trashed and hidden answers are
+// left out; on a tie the earlier answer wins.
+func (r *Repository) TopAnswer(ctx context.Context, questionID int, since time.Time, exceptUserID string) (int, error) {
+	var ids []int
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).Raw(`
+		SELECT a.id FROM answers a
+		JOIN LATERAL (
+			SELECT COALESCE(SUM(value), 0) AS score
+			FROM votes
+			WHERE target_type = 'answer' AND target_id = a.id
+		) v ON TRUE
+		WHERE a.question_id = ? AND a.created_at >= ? AND a.user_id <> ?
+			AND a.deleted_at IS NULL AND a.hidden_at IS NULL AND v.score > 0
+		ORDER BY v.score DESC, a.created_at ASC, a.id ASC
+		LIMIT 1`, questionID, since, exceptUserID).
+		Scan(&ids).Error
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	return ids[0], nil
+}
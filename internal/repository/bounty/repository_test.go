@@ -0,0 +1,160 @@
+//go:build integration
+// +build integration
+
+package bounty
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/bounty"
+	"test-question/internal/pkg/tenant"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type BountyRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *BountyRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("bounties", "votes", "answers", "questions")
+	s.ResetWorkspaces()
+}
+
+func (s *BountyRepoInfraSuite) question(id int, text string) {
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO questions (id, text, user_id) VALUES (?, ?, 'asker')", id, text,
+	).Error)
+}
+
+func (s *BountyRepoInfraSuite) answer(id, questionID int, userID string, createdAt time.Time, score int) {
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO answers (id, question_id, user_id, text, created_at) VALUES (?, ?, ?, 'a', ?)",
+		id, questionID, userID, createdAt,
+	).Error)
+	for i := range score {
+		s.Require().NoError(s.DB.Exec(
+			"INSERT INTO votes (user_id, target_type, target_id, value) VALUES (?, 'answer', ?, 1)",
+			"voter-"+string(rune('a'+i)), id,
+		).Error)
+	}
+}
+
+func (s *BountyRepoInfraSuite) open(questionID int, now time.Time, ttl time.Duration) *ent.Bounty {
+	b, err := s.repo.Create(context.Background(), &ent.Bounty{
+		QuestionID: questionID,
+		UserID:     "asker",
+		Amount:     50,
+		Status:     ent.StatusOpen,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(ttl),
+	})
+	s.Require().NoError(err)
+	return b
+}
+
+func (s *BountyRepoInfraSuite) TestCreate_OneOpenPerQuestion() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	s.question(1, "q")
+
+	b := s.open(1, now, time.Hour)
+	s.NotZero(b.ID)
+
+	_, err := s.repo.Create(ctx, &ent.Bounty{
+		QuestionID: 1, UserID: "asker", Amount: 50, Status: ent.StatusOpen, CreatedAt: now, ExpiresAt: now,
+	})
+	s.ErrorIs(err, ent.ErrBountyExists)
+
+	got, err := s.repo.GetOpen(ctx, 1)
+	s.Require().NoError(err)
+	s.Equal(b.ID, got.ID)
+
+	s.Require().NoError(s.repo.Close(ctx, b.ID, ent.StatusRefunded, 0, now))
+	s.ErrorIs(s.repo.Close(ctx, b.ID, ent.StatusAwarded, 5, now), ent.ErrBountyNotFound)
+
+	_, err = s.repo.GetOpen(ctx, 1)
+	s.ErrorIs(err, ent.ErrBountyNotFound)
+
+	s.open(1, now, time.Hour)
+}
+
+func (s *BountyRepoInfraSuite) TestListExpiredAndFeatured() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	s.question(1, "expired")
+	s.question(2, "ends later")
+	s.question(3, "ends soon")
+	s.question(4, "hidden")
+	s.Require().NoError(s.DB.Exec("UPDATE questions SET hidden_at = NOW() WHERE id = 4").Error)
+
+	expired := s.open(1, now.Add(-2*time.Hour), time.Hour)
+	later := s.open(2, now, 2*time.Hour)
+	soon := s.open(3, now, time.Hour)
+	s.open(4, now, time.Hour)
+
+	got, err := s.repo.ListExpired(ctx, now, 10)
+	s.Require().NoError(err)
+	s.Require().Len(got, 1)
+	s.Equal(expired.ID, got[0].ID)
+
+	featured, err := s.repo.ListFeatured(ctx, now, 10)
+	s.Require().NoError(err)
+	s.Require().Len(featured, 2)
+	s.Equal(soon.ID, featured[0].ID)
+	s.Equal("ends soon", featured[0].QuestionText)
+	s.Equal(later.ID, featured[1].ID)
+}
+
+func (s *BountyRepoInfraSuite) TestListFeatured_Workspace() {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	team := s.Workspace("team")
+	s.question(1, "public")
+	s.question(2, "team")
+	s.Require().NoError(s.DB.Exec("UPDATE questions SET workspace_id = ? WHERE id = 2", team).Error)
+
+	public := s.open(1, now, time.Hour)
+	private := s.open(2, now, 2*time.Hour)
+
+	featured, err := s.repo.ListFeatured(tenant.Inject(context.Background(), tenant.DefaultID), now, 10)
+	s.Require().NoError(err)
+	s.Require().Len(featured, 1)
+	s.Equal(public.ID, featured[0].ID)
+
+	featured, err = s.repo.ListFeatured(tenant.Inject(context.Background(), team), now, 10)
+	s.Require().NoError(err)
+	s.Require().Len(featured, 1)
+	s.Equal(private.ID, featured[0].ID)
+}
+
+func (s *BountyRepoInfraSuite) TestTopAnswer() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	s.question(1, "q")
+
+	s.answer(1, 1, "u1", now.Add(-time.Hour), 5)
+	s.answer(2, 1, "asker", now, 4)
+	s.answer(3, 1, "u2", now.Add(time.Minute), 2)
+	s.answer(4, 1, "u3", now.Add(2*time.Minute), 2)
+	s.answer(5, 1, "u4", now.Add(3*time.Minute), 0)
+
+	id, err := s.repo.TopAnswer(ctx, 1, now, "asker")
+	s.Require().NoError(err)
+	s.Equal(3, id)
+
+	s.Require().NoError(s.DB.Exec("UPDATE answers SET deleted_at = NOW() WHERE id IN (3, 4)").Error)
+
+	id, err = s.repo.TopAnswer(ctx, 1, now, "asker")
+	s.Require().NoError(err)
+	s.Zero(id)
+}
+
+func TestBountyRepoInfraSuite(t *testing.T) {
+	s := &BountyRepoInfraSuite{}
+	suite.Run(t, s)
+}
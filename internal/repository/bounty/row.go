@@ -0,0 +1,81 @@
+package bounty
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/bounty"
+)
+
+type bountyRow struct {
+	ID         int64      `gorm:"primaryKey;column:id"`
+	QuestionID int64      `gorm:"column:question_id;not null"`
+	UserID     string     `gorm:"column:user_id;type:text;not null"`
+	Amount     int        `gorm:"column:amount;not null"`
+	Status     string     `gorm:"column:status;type:varchar(16);not null"`
+	AnswerID   *int64     `gorm:"column:answer_id"`
+	CreatedAt  time.Time  `gorm:"column:created_at;not null"`
+	ExpiresAt  time.Time  `gorm:"column:expires_at;not null"`
+	ClosedAt   *time.Time `gorm:"column:closed_at"`
+}
+
+func (bountyRow) TableName() string {
+	return "bounties"
+}
+
+// featuredRow is an open bounty together with the text of its question.
+type featuredRow struct {
+	bountyRow    `gorm:"embedded"`
+	QuestionText string `gorm:"column:question_text"`
+}
+
+func toEntityBounty(r *bountyRow) *ent.Bounty {
+	if r == nil {
+		return nil
+	}
+
+	out := &ent.Bounty{
+		ID:         int(r.ID),
+		QuestionID: int(r.QuestionID),
+		UserID:     r.UserID,
+		Amount:     r.Amount,
+		Status:     ent.Status(r.Status),
+		CreatedAt:  r.CreatedAt,
+		ExpiresAt:  r.ExpiresAt,
+		ClosedAt:   r.ClosedAt,
+	}
+	if r.AnswerID != nil {
+		out.AnswerID = int(*r.AnswerID)
+	}
+
+	return out
+}
+
+func fromEntityBounty(e *ent.Bounty) *bountyRow {
+	if e == nil {
+		return nil
+	}
+
+	row := &bountyRow{
+		ID:         int64(e.ID),
+		QuestionID: int64(e.QuestionID),
+		UserID:     e.UserID,
+		Amount:     e.Amount,
+		Status:     string(e.Status),
+		CreatedAt:  e.CreatedAt,
+		ExpiresAt:  e.ExpiresAt,
+		ClosedAt:   e.ClosedAt,
+	}
+	if e.AnswerID != 0 {
+		answerID := int64(e.AnswerID)
+		row.AnswerID = &answerID
+	}
+
+	return row
+}
+
+func toEntityFeatured(r *featuredRow) *ent.Featured {
+	return &ent.Featured{
+		Bounty:       *toEntityBounty(&r.bountyRow),
+		QuestionText: r.QuestionText,
+	}
+}
@@ -0,0 +1,65 @@
+package bounty
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/bounty"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBountyConverters(t *testing.T) {
+	now := time.Now()
+	closed := now.Add(time.Hour)
+	answerID := int64(9)
+
+	row := &bountyRow{
+		ID:         3,
+		QuestionID: 7,
+		UserID:     "u1",
+		Amount:     50,
+		Status:     "awarded",
+		AnswerID:   &answerID,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(24 * time.Hour),
+		ClosedAt:   &closed,
+	}
+	entity := &ent.Bounty{
+		ID:         3,
+		QuestionID: 7,
+		UserID:     "u1",
+		Amount:     50,
+		Status:     ent.StatusAwarded,
+		AnswerID:   9,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(24 * time.Hour),
+		ClosedAt:   &closed,
+	}
+
+	require.Equal(t, entity, toEntityBounty(row))
+	require.Equal(t, row, fromEntityBounty(entity))
+
+	require.Nil(t, toEntityBounty(nil))
+	require.Nil(t, fromEntityBounty(nil))
+}
+
+func TestBountyConverters_Open(t *testing.T) {
+	row := &bountyRow{ID: 3, QuestionID: 7, UserID: "u1", Amount: 50, Status: "open"}
+	entity := &ent.Bounty{ID: 3, QuestionID: 7, UserID: "u1", Amount: 50, Status: ent.StatusOpen}
+
+	require.Equal(t, entity, toEntityBounty(row))
+	require.Equal(t, row, fromEntityBounty(entity))
+}
+
+func TestFeaturedConverter(t *testing.T) {
+	row := &featuredRow{
+		bountyRow:    bountyRow{ID: 3, QuestionID: 7, Amount: 50, Status: "open"},
+		QuestionText: "why",
+	}
+
+	require.Equal(t, &ent.Featured{
+		Bounty:       ent.Bounty{ID: 3, QuestionID: 7, Amount: 50, Status: ent.StatusOpen},
+		QuestionText: "why",
+	}, toEntityFeatured(row))
+}
@@ -0,0 +1,182 @@
+package corpus
+
+import (
+	"context"
+	"fmt"
+
+	ent "test-question/internal/entity/corpus"
+	"test-question/internal/pkg/tenant"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+// usernameChunk bounds the IN list of one user lookup.
+const usernameChunk = 1000
+
+var (
+	questionColumns = []string{"id", "text", "user_id", "status", "accepted_answer_id", "duplicate_of", "created_at", "workspace_id"} //nolint:gochecknoglobals
+	answerColumns   = []string{"id", "question_id", "user_id", "text", "created_at", "workspace_id"}                                  //nolint:gochecknoglobals
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// ExportPage returns up to limit questions with an id above afterID, in id
+// order, with their answers, from the workspace of ctx. Trashed and hidden
+// content is left out.
+func (r *Repository) ExportPage(ctx context.Context, afterID, limit int) ([]*ent.Question, error) {
+	var qRows []exportQuestionRow
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT q.id, q.text, COALESCE(u.username, q.user_id::text) AS author, q.status,
+			q.accepted_answer_id, q.duplicate_of, q.created_at
+		FROM questions q
+		LEFT JOIN users u ON u.id = q.user_id
+		WHERE q.id > ? AND q.deleted_at IS NULL AND q.hidden_at IS NULL AND ?
+		ORDER BY q.id
+		LIMIT ?`,
+		afterID, tenant.Condition(ctx, "q"), limit,
+	).Scan(&qRows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(qRows) == 0 {
+		return nil, nil
+	}
+
+	out := make([]*ent.Question, len(qRows))
+	byID := make(map[int64]*ent.Question, len(qRows))
+	ids := make([]int64, len(qRows))
+	for i := range qRows {
+		out[i] = toEntityQuestion(&qRows[i])
+		byID[qRows[i].ID] = out[i]
+		ids[i] = qRows[i].ID
+	}
+
+	var aRows []exportAnswerRow
+
+	err = r.db.WithContext(ctx).Raw(`
+		SELECT a.id, a.question_id, a.text, COALESCE(u.username, a.user_id) AS author, a.created_at
+		FROM answers a
+		LEFT JOIN users u ON u.id::text = a.user_id
+		WHERE a.question_id IN ? AND a.deleted_at IS NULL AND a.hidden_at IS NULL
+		ORDER BY a.id`,
+		ids,
+	).Scan(&aRows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range aRows {
+		q := byID[aRows[i].QuestionID]
+		q.Answers = append(q.Answers, toEntityAnswer(&aRows[i]))
+	}
+
+	return out, nil
+}
+
+// UserIDs maps usernames to user ids. Unknown usernames are left out.
+func (r *Repository) UserIDs(ctx context.Context, usernames []string) (map[string]string, error) {
+	out := make(map[string]string, len(usernames))
+
+	for start := 0; start < len(usernames); start += usernameChunk {
+		end := min(start+usernameChunk, len(usernames))
+
+		var rows []userRow
+		err := r.db.WithContext(ctx).
+			Table("users").
+			Select("id", "username").
+			Where("username IN ? AND deleted_at IS NULL", usernames[start:end]).
+			Scan(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			out[row.Username] = row.ID
+		}
+	}
+
+	return out, nil
+}
+
+// Load bulk-inserts the plan with COPY in one transaction, into the
+// workspace of ctx. New ids are
+// taken from the tables' sequences up front, so references can be set in
+// the same pass.
+func (r *Repository) Load(ctx context.Context, plan *ent.Plan) error {
+	sqlDB, err := r.db.DB()
+	if err != nil {
+		return err
+	}
+
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		pc, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver connection %T", driverConn)
+		}
+
+		return pgx.BeginFunc(ctx, pc.Conn(), func(tx pgx.Tx) error {
+			return load(ctx, tx, plan, tenant.ID(ctx))
+		})
+	})
+}
+
+func load(ctx context.Context, tx pgx.Tx, plan *ent.Plan, workspaceID int) error {
+	questionIDs, err := allocateIDs(ctx, tx, "questions", len(plan.Questions))
+	if err != nil {
+		return fmt.Errorf("allocate question ids: %w", err)
+	}
+
+	answerIDs, err := allocateIDs(ctx, tx, "answers", len(plan.Answers))
+	if err != nil {
+		return fmt.Errorf("allocate answer ids: %w", err)
+	}
+
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"questions"}, questionColumns,
+		pgx.CopyFromSlice(len(plan.Questions), func(i int) ([]any, error) {
+			return questionValues(&plan.Questions[i], questionIDs[i], questionIDs, answerIDs, workspaceID)
+		}))
+	if err != nil {
+		return fmt.Errorf("copy questions: %w", err)
+	}
+
+	_, err = tx.CopyFrom(ctx, pgx.Identifier{"answers"}, answerColumns,
+		pgx.CopyFromSlice(len(plan.Answers), func(i int) ([]any, error) {
+			return answerValues(&plan.Answers[i], answerIDs[i], questionIDs, workspaceID), nil
+		}))
+	if err != nil {
+		return fmt.Errorf("copy answers: %w", err)
+	}
+
+	return nil
+}
+
+func allocateIDs(ctx context.Context, tx pgx.Tx, table string, n int) ([]int64, error) {
+	if n == 0 {
+		return nil, nil
+	}
+
+	rows, err := tx.Query(ctx,
+		"SELECT nextval(pg_get_serial_sequence($1, 'id')) FROM generate_series(1, $2)",
+		table, n,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return pgx.CollectRows(rows, pgx.RowTo[int64])
+}
@@ -0,0 +1,79 @@
+//go:build integration
+// +build integration
+
+package corpus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/corpus"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const aliceID = "11111111-1111-1111-1111-111111111111"
+
+type CorpusRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *CorpusRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("answers", "questions")
+}
+
+func (s *CorpusRepoInfraSuite) TestLoadAndExport() {
+	ctx := context.Background()
+	created := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	// a question to make the new ids differ from the source ones
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO questions (text, user_id) VALUES ('existing', ?)", aliceID).Error)
+
+	err := s.repo.Load(ctx, &ent.Plan{
+		Questions: []ent.PlannedQuestion{
+			{Text: "canonical", UserID: aliceID, Status: "closed", CreatedAt: created, AcceptedAnswer: 1, DuplicateOf: -1},
+			{Text: "duplicate", UserID: aliceID, Status: "open", CreatedAt: created, AcceptedAnswer: -1, DuplicateOf: 0},
+		},
+		Answers: []ent.PlannedAnswer{
+			{Question: 0, UserID: aliceID, Text: "first", CreatedAt: created},
+			{Question: 0, UserID: aliceID, Text: "second", CreatedAt: created.Add(time.Hour)},
+		},
+	})
+	s.Require().NoError(err)
+
+	page, err := s.repo.ExportPage(ctx, 1, 10)
+	s.Require().NoError(err)
+	s.Require().Len(page, 2)
+
+	canonical, duplicate := page[0], page[1]
+	s.Equal("canonical", canonical.Text)
+	s.Equal("alice", canonical.Author)
+	s.Equal("closed", canonical.Status)
+	s.True(created.Equal(canonical.CreatedAt))
+	s.Require().Len(canonical.Answers, 2)
+	s.Equal(canonical.Answers[1].ID, canonical.AcceptedAnswerID)
+	s.True(created.Add(time.Hour).Equal(canonical.Answers[1].CreatedAt))
+	s.Equal(canonical.ID, duplicate.DuplicateOf)
+	s.Empty(duplicate.Answers)
+
+	// the sequences moved past the copied ids
+	var next int
+	s.Require().NoError(s.DB.Raw(
+		"INSERT INTO questions (text, user_id) VALUES ('after', ?) RETURNING id", aliceID).Scan(&next).Error)
+	s.Greater(next, duplicate.ID)
+}
+
+func (s *CorpusRepoInfraSuite) TestUserIDs() {
+	ids, err := s.repo.UserIDs(context.Background(), []string{"alice", "nobody"})
+	s.Require().NoError(err)
+	s.Equal(map[string]string{"alice": aliceID}, ids)
+}
+
+func TestCorpusRepoInfraSuite(t *testing.T) {
+	suite.Run(t, new(CorpusRepoInfraSuite))
+}
@@ -0,0 +1,88 @@
+package corpus
+
+import (
+	"fmt"
+	"time"
+
+	ent "test-question/internal/entity/corpus"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type exportQuestionRow struct {
+	ID               int64     `gorm:"column:id"`
+	Text             string    `gorm:"column:text"`
+	Author           string    `gorm:"column:author"`
+	Status           string    `gorm:"column:status"`
+	AcceptedAnswerID *int64    `gorm:"column:accepted_answer_id"`
+	DuplicateOf      *int64    `gorm:"column:duplicate_of"`
+	CreatedAt        time.Time `gorm:"column:created_at"`
+}
+
+type exportAnswerRow struct {
+	ID         int64     `gorm:"column:id"`
+	QuestionID int64     `gorm:"column:question_id"`
+	Text       string    `gorm:"column:text"`
+	Author     string    `gorm:"column:author"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+type userRow struct {
+	ID       string `gorm:"column:id"`
+	Username string `gorm:"column:username"`
+}
+
+func toEntityQuestion(r *exportQuestionRow) *ent.Question {
+	out := &ent.Question{
+		ID:        int(r.ID),
+		Text:      r.Text,
+		Author:    r.Author,
+		Status:    r.Status,
+		CreatedAt: r.CreatedAt,
+		Answers:   []ent.Answer{},
+	}
+	if r.AcceptedAnswerID != nil {
+		out.AcceptedAnswerID = int(*r.AcceptedAnswerID)
+	}
+	if r.DuplicateOf != nil {
+		out.DuplicateOf = int(*r.DuplicateOf)
+	}
+	return out
+}
+
+func toEntityAnswer(r *exportAnswerRow) ent.Answer {
+	return ent.Answer{
+		ID:        int(r.ID),
+		Text:      r.Text,
+		Author:    r.Author,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// questionValues is a planned question as a COPY row: id, text, user_id,
+// status, accepted_answer_id, duplicate_of, created_at, workspace_id. COPY
+// encodes the uuid user_id column only from a uuid value.
+func questionValues(q *ent.PlannedQuestion, id int64, questionIDs, answerIDs []int64, workspaceID int) ([]any, error) {
+	var userID pgtype.UUID
+	if err := userID.Scan(q.UserID); err != nil {
+		return nil, fmt.Errorf("question user id %q: %w", q.UserID, err)
+	}
+
+	return []any{
+		id, q.Text, userID, q.Status,
+		ref(q.AcceptedAnswer, answerIDs), ref(q.DuplicateOf, questionIDs), q.CreatedAt, workspaceID,
+	}, nil
+}
+
+// answerValues is a planned answer as a COPY row: id, question_id,
+// user_id, text, created_at, workspace_id.
+func answerValues(a *ent.PlannedAnswer, id int64, questionIDs []int64, workspaceID int) []any {
+	return []any{id, questionIDs[a.Question], a.UserID, a.Text, a.CreatedAt, workspaceID}
+}
+
+func ref(index int, ids []int64) *int64 {
+	if index < 0 {
+		return nil
+	}
+	return &ids[index]
+}
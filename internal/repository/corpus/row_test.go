@@ -0,0 +1,69 @@
+package corpus
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/corpus"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrInt64(v int64) *int64 { return &v }
+
+func TestExportConverters(t *testing.T) {
+	now := time.Now()
+
+	q := toEntityQuestion(&exportQuestionRow{
+		ID:               3,
+		Text:             "q",
+		Author:           "alice",
+		Status:           "closed",
+		AcceptedAnswerID: ptrInt64(8),
+		DuplicateOf:      ptrInt64(1),
+		CreatedAt:        now,
+	})
+	require.Equal(t, &ent.Question{
+		ID:               3,
+		Text:             "q",
+		Author:           "alice",
+		Status:           "closed",
+		AcceptedAnswerID: 8,
+		DuplicateOf:      1,
+		CreatedAt:        now,
+		Answers:          []ent.Answer{},
+	}, q)
+
+	a := toEntityAnswer(&exportAnswerRow{ID: 8, QuestionID: 3, Text: "a", Author: "bob", CreatedAt: now})
+	require.Equal(t, ent.Answer{ID: 8, Text: "a", Author: "bob", CreatedAt: now}, a)
+}
+
+func TestCopyValues(t *testing.T) {
+	now := time.Now()
+	questionIDs := []int64{100, 101}
+	answerIDs := []int64{200}
+
+	values, err := questionValues(&ent.PlannedQuestion{
+		Text:           "q",
+		UserID:         "11111111-1111-1111-1111-111111111111",
+		Status:         "open",
+		CreatedAt:      now,
+		AcceptedAnswer: 0,
+		DuplicateOf:    -1,
+	}, 101, questionIDs, answerIDs, 3)
+	require.NoError(t, err)
+	require.Len(t, values, len(questionColumns))
+	require.Equal(t, int64(101), values[0])
+	require.IsType(t, pgtype.UUID{}, values[2])
+	require.Equal(t, ptrInt64(200), values[4])
+	require.Nil(t, values[5])
+	require.Equal(t, 3, values[7])
+
+	_, err = questionValues(&ent.PlannedQuestion{UserID: "alice", AcceptedAnswer: -1, DuplicateOf: -1}, 1, questionIDs, answerIDs, 3)
+	require.Error(t, err)
+
+	values = answerValues(&ent.PlannedAnswer{Question: 1, UserID: "u1", Text: "a", CreatedAt: now}, 200, questionIDs, 3)
+	require.Equal(t, []any{int64(200), int64(101), "u1", "a", now, 3}, values)
+	require.Len(t, values, len(answerColumns))
+}
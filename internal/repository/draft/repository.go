@@ -0,0 +1,93 @@
+package draft
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ent "test-question/internal/entity/draft"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Save stores d, replacing the user's draft under the same key.
+func (r *Repository) Save(ctx context.Context, d *ent.Draft) error {
+	return uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "question_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"text", "attachment_ids", "updated_at", "expires_at"}),
+		}).
+		Create(fromEntityDraft(d)).Error
+}
+
+// Get returns the user's draft unless it expired by now.
+func (r *Repository) Get(ctx context.Context, userID string, key ent.Key, now time.Time) (*ent.Draft, error) {
+	var row draftRow
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Where("user_id = ? AND question_id = ? AND expires_at > ?", userID, key.QuestionID, now).
+		Take(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ent.ErrDraftNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntityDraft(&row), nil
+}
+
+// Take deletes the user's draft and returns it, unless it expired by now.
+// Of concurrent callers in transactions only one gets the draft.
+func (r *Repository) Take(ctx context.Context, userID string, key ent.Key, now time.Time) (*ent.Draft, error) {
+	var rows []draftRow
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.Returning{}).
+		Where("user_id = ? AND question_id = ? AND expires_at > ?", userID, key.QuestionID, now).
+		Delete(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ent.ErrDraftNotFound
+	}
+
+	return toEntityDraft(&rows[0]), nil
+}
+
+func (r *Repository) Delete(ctx context.Context, userID string, key ent.Key) error {
+	res := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Where("user_id = ? AND question_id = ?", userID, key.QuestionID).
+		Delete(&draftRow{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ent.ErrDraftNotFound
+	}
+
+	return nil
+}
+
+// PurgeExpired deletes drafts that expired before the given time.
+func (r *Repository) PurgeExpired(ctx context.Context, before time.Time) (int, error) {
+	res := r.db.WithContext(ctx).
+		Where("expires_at < ?", before).
+		Delete(&draftRow{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return int(res.RowsAffected), nil
+}
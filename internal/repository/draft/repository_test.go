@@ -0,0 +1,106 @@
+//go:build integration
+// +build integration
+
+package draft
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/draft"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type DraftRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *DraftRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("drafts")
+}
+
+func (s *DraftRepoInfraSuite) draft(key ent.Key, text string, now time.Time) *ent.Draft {
+	return &ent.Draft{
+		UserID:        "u1",
+		Key:           key,
+		Text:          text,
+		AttachmentIDs: []int{},
+		UpdatedAt:     now,
+		ExpiresAt:     now.Add(time.Hour),
+	}
+}
+
+func (s *DraftRepoInfraSuite) TestSaveAndGet() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	s.Require().NoError(s.repo.Save(ctx, s.draft(ent.QuestionKey, "first", now)))
+
+	d := s.draft(ent.QuestionKey, "second", now.Add(time.Minute))
+	d.AttachmentIDs = []int{5, 6}
+	s.Require().NoError(s.repo.Save(ctx, d))
+	s.Require().NoError(s.repo.Save(ctx, s.draft(ent.AnswerKey(3), "an answer", now)))
+
+	got, err := s.repo.Get(ctx, "u1", ent.QuestionKey, now)
+	s.Require().NoError(err)
+	s.Equal(d.Text, got.Text)
+	s.Equal([]int{5, 6}, got.AttachmentIDs)
+	s.Equal(d.ExpiresAt, got.ExpiresAt.UTC())
+
+	got, err = s.repo.Get(ctx, "u1", ent.AnswerKey(3), now)
+	s.Require().NoError(err)
+	s.Equal("an answer", got.Text)
+
+	_, err = s.repo.Get(ctx, "u2", ent.QuestionKey, now)
+	s.ErrorIs(err, ent.ErrDraftNotFound)
+
+	_, err = s.repo.Get(ctx, "u1", ent.QuestionKey, now.Add(2*time.Hour))
+	s.ErrorIs(err, ent.ErrDraftNotFound)
+}
+
+func (s *DraftRepoInfraSuite) TestTake() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	s.Require().NoError(s.repo.Save(ctx, s.draft(ent.AnswerKey(3), "an answer", now)))
+
+	_, err := s.repo.Take(ctx, "u1", ent.AnswerKey(3), now.Add(2*time.Hour))
+	s.ErrorIs(err, ent.ErrDraftNotFound)
+
+	got, err := s.repo.Take(ctx, "u1", ent.AnswerKey(3), now)
+	s.Require().NoError(err)
+	s.Equal("an answer", got.Text)
+	s.Equal(ent.AnswerKey(3), got.Key)
+
+	_, err = s.repo.Take(ctx, "u1", ent.AnswerKey(3), now)
+	s.ErrorIs(err, ent.ErrDraftNotFound)
+}
+
+func (s *DraftRepoInfraSuite) TestDeleteAndPurge() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	s.Require().NoError(s.repo.Save(ctx, s.draft(ent.QuestionKey, "q", now)))
+	s.Require().NoError(s.repo.Save(ctx, s.draft(ent.AnswerKey(1), "a1", now.Add(-2*time.Hour))))
+	s.Require().NoError(s.repo.Save(ctx, s.draft(ent.AnswerKey(2), "a2", now)))
+
+	s.Require().NoError(s.repo.Delete(ctx, "u1", ent.QuestionKey))
+	s.ErrorIs(s.repo.Delete(ctx, "u1", ent.QuestionKey), ent.ErrDraftNotFound)
+
+	n, err := s.repo.PurgeExpired(ctx, now)
+	s.Require().NoError(err)
+	s.Equal(1, n)
+
+	_, err = s.repo.Get(ctx, "u1", ent.AnswerKey(2), now)
+	s.NoError(err)
+}
+
+func TestDraftRepoInfraSuite(t *testing.T) {
+	s := &DraftRepoInfraSuite{}
+	suite.Run(t, s)
+}
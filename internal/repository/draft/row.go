@@ -0,0 +1,55 @@
+package draft
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/draft"
+)
+
+type draftRow struct {
+	UserID        string    `gorm:"primaryKey;column:user_id;type:text"`
+	QuestionID    int64     `gorm:"primaryKey;column:question_id;autoIncrement:false"`
+	Text          string    `gorm:"column:text;type:text;not null"`
+	AttachmentIDs []int     `gorm:"column:attachment_ids;type:jsonb;serializer:json;not null"`
+	UpdatedAt     time.Time `gorm:"column:updated_at;not null"`
+	ExpiresAt     time.Time `gorm:"column:expires_at;not null"`
+}
+
+func (draftRow) TableName() string {
+	return "drafts"
+}
+
+func toEntityDraft(r *draftRow) *ent.Draft {
+	if r == nil {
+		return nil
+	}
+
+	return &ent.Draft{
+		UserID:        r.UserID,
+		Key:           ent.Key{QuestionID: int(r.QuestionID)},
+		Text:          r.Text,
+		AttachmentIDs: r.AttachmentIDs,
+		UpdatedAt:     r.UpdatedAt,
+		ExpiresAt:     r.ExpiresAt,
+	}
+}
+
+func fromEntityDraft(e *ent.Draft) *draftRow {
+	if e == nil {
+		return nil
+	}
+
+	ids := e.AttachmentIDs
+	if ids == nil {
+		ids = []int{}
+	}
+
+	return &draftRow{
+		UserID:        e.UserID,
+		QuestionID:    int64(e.Key.QuestionID),
+		Text:          e.Text,
+		AttachmentIDs: ids,
+		UpdatedAt:     e.UpdatedAt,
+		ExpiresAt:     e.ExpiresAt,
+	}
+}
@@ -0,0 +1,45 @@
+package draft
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/draft"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDraftConverters(t *testing.T) {
+	now := time.Now()
+
+	row := &draftRow{
+		UserID:        "u1",
+		QuestionID:    7,
+		Text:          "try restarting it",
+		AttachmentIDs: []int{3, 4},
+		UpdatedAt:     now,
+		ExpiresAt:     now.Add(time.Hour),
+	}
+	entity := &ent.Draft{
+		UserID:        "u1",
+		Key:           ent.AnswerKey(7),
+		Text:          "try restarting it",
+		AttachmentIDs: []int{3, 4},
+		UpdatedAt:     now,
+		ExpiresAt:     now.Add(time.Hour),
+	}
+
+	require.Equal(t, entity, toEntityDraft(row))
+	require.Equal(t, row, fromEntityDraft(entity))
+
+	require.Nil(t, toEntityDraft(nil))
+	require.Nil(t, fromEntityDraft(nil))
+}
+
+func TestDraftConverters_QuestionWithoutAttachments(t *testing.T) {
+	row := fromEntityDraft(&ent.Draft{UserID: "u1", Key: ent.QuestionKey, Text: "why"})
+
+	require.Zero(t, row.QuestionID)
+	require.NotNil(t, row.AttachmentIDs)
+	require.Empty(t, row.AttachmentIDs)
+}
@@ -0,0 +1,50 @@
+package follow
+
+import (
+	"context"
+	"time"
+
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Follow(ctx context.Context, userID string, questionID int, at time.Time) error {
+	return uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&followRow{
+			UserID:     userID,
+			QuestionID: int64(questionID),
+			CreatedAt:  at,
+		}).Error
+}
+
+func (r *Repository) Unfollow(ctx context.Context, userID string, questionID int) error {
+	return uow.GetTx(ctx, r.db).WithContext(ctx).
+		Where("user_id = ? AND question_id = ?", userID, questionID).
+		Delete(&followRow{}).Error
+}
+
+func (r *Repository) ListFollowers(ctx context.Context, questionID int) ([]string, error) {
+	var out []string
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&followRow{}).
+		Where("question_id = ?", questionID).
+		Order("created_at ASC").
+		Pluck("user_id", &out).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,54 @@
+//go:build integration
+// +build integration
+
+package follow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type FollowRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *FollowRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("question_follows")
+}
+
+func (s *FollowRepoInfraSuite) TestFollowIsIdempotent() {
+	ctx := context.Background()
+	now := time.Now()
+
+	s.Require().NoError(s.repo.Follow(ctx, "u1", 1, now))
+	s.Require().NoError(s.repo.Follow(ctx, "u1", 1, now))
+	s.Require().NoError(s.repo.Follow(ctx, "u2", 1, now.Add(time.Second)))
+	s.Require().NoError(s.repo.Follow(ctx, "u3", 2, now))
+
+	followers, err := s.repo.ListFollowers(ctx, 1)
+	s.Require().NoError(err)
+	s.Equal([]string{"u1", "u2"}, followers)
+}
+
+func (s *FollowRepoInfraSuite) TestUnfollow() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.repo.Follow(ctx, "u1", 1, time.Now()))
+	s.Require().NoError(s.repo.Unfollow(ctx, "u1", 1))
+
+	followers, err := s.repo.ListFollowers(ctx, 1)
+	s.Require().NoError(err)
+	s.Empty(followers)
+}
+
+func TestFollowRepoInfraSuite(t *testing.T) {
+	s := &FollowRepoInfraSuite{}
+	suite.Run(t, s)
+}
@@ -0,0 +1,15 @@
+package follow
+
+import (
+	"time"
+)
+
+type followRow struct {
+	UserID     string    `gorm:"primaryKey;column:user_id;type:text"`
+	QuestionID int64     `gorm:"primaryKey;column:question_id"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (followRow) TableName() string {
+	return "question_follows"
+}
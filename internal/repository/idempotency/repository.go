@@ -0,0 +1,101 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ent "test-question/internal/entity/idempotency"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Acquire stores rec as an in-flight request unless the user already holds
+// the key. An expired record is taken over, and so is an in-flight one whose
+// lock ran out. It reports whether rec was stored.
+func (r *Repository) Acquire(ctx context.Context, rec *ent.Record) (bool, error) {
+	row := fromEntityRecord(rec)
+	row.StatusCode = nil
+	row.ContentType = ""
+	row.Body = nil
+
+	res := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "user_id"}, {Name: "key"}},
+			DoUpdates: clause.AssignmentColumns([]string{
+				"request_hash", "status_code", "content_type", "body", "created_at", "expires_at", "locked_until",
+			}),
+			Where: clause.Where{Exprs: []clause.Expression{
+				clause.Expr{SQL: "idempotency_keys.expires_at <= EXCLUDED.created_at OR " +
+					"(idempotency_keys.status_code IS NULL AND idempotency_keys.locked_until <= EXCLUDED.created_at)"},
+			}},
+		}).
+		Create(row)
+	if res.Error != nil {
+		return false, res.Error
+	}
+
+	return res.RowsAffected > 0, nil
+}
+
+func (r *Repository) Get(ctx context.Context, userID, key string) (*ent.Record, error) {
+	var row keyRow
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND key = ?", userID, key).
+		Take(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ent.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntityRecord(&row), nil
+}
+
+// Complete stores the response of the in-flight request.
+func (r *Repository) Complete(
+	ctx context.Context,
+	userID, key string,
+	statusCode int,
+	contentType string,
+	body []byte,
+) error {
+	return r.db.WithContext(ctx).
+		Model(&keyRow{}).
+		Where("user_id = ? AND key = ? AND status_code IS NULL", userID, key).
+		Updates(map[string]any{
+			"status_code":  statusCode,
+			"content_type": contentType,
+			"body":         body,
+		}).Error
+}
+
+// Release forgets an in-flight request, so the key can be used again.
+func (r *Repository) Release(ctx context.Context, userID, key string) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND key = ? AND status_code IS NULL", userID, key).
+		Delete(&keyRow{}).Error
+}
+
+// PurgeExpired deletes records that expired before the given time.
+func (r *Repository) PurgeExpired(ctx context.Context, before time.Time) (int, error) {
+	res := r.db.WithContext(ctx).
+		Where("expires_at < ?", before).
+		Delete(&keyRow{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return int(res.RowsAffected), nil
+}
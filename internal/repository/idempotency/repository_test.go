@@ -0,0 +1,157 @@
+//go:build integration
+// +build integration
+
+package idempotency
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/idempotency"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type IdempotencyRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *IdempotencyRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("idempotency_keys")
+}
+
+func (s *IdempotencyRepoInfraSuite) record(key, hash string, now time.Time) *ent.Record {
+	return &ent.Record{
+		UserID:      "u1",
+		Key:         key,
+		RequestHash: hash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+		LockedUntil: now.Add(time.Minute),
+	}
+}
+
+func (s *IdempotencyRepoInfraSuite) TestAcquire_CompleteAndReplay() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	ok, err := s.repo.Acquire(ctx, s.record("k1", "h1", now))
+	s.Require().NoError(err)
+	s.True(ok)
+
+	ok, err = s.repo.Acquire(ctx, s.record("k1", "h2", now))
+	s.Require().NoError(err)
+	s.False(ok)
+
+	got, err := s.repo.Get(ctx, "u1", "k1")
+	s.Require().NoError(err)
+	s.Equal("h1", got.RequestHash)
+	s.False(got.Completed())
+
+	s.Require().NoError(s.repo.Complete(ctx, "u1", "k1", 201, "application/json", []byte(`{"id":1}`)))
+
+	got, err = s.repo.Get(ctx, "u1", "k1")
+	s.Require().NoError(err)
+	s.Equal(201, got.StatusCode)
+	s.Equal("application/json", got.ContentType)
+	s.Equal([]byte(`{"id":1}`), got.Body)
+
+	// another user has its own key space
+	other := s.record("k1", "h1", now)
+	other.UserID = "u2"
+	ok, err = s.repo.Acquire(ctx, other)
+	s.Require().NoError(err)
+	s.True(ok)
+}
+
+func (s *IdempotencyRepoInfraSuite) TestAcquire_TakesOverExpired() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	ok, err := s.repo.Acquire(ctx, s.record("k1", "h1", now))
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().NoError(s.repo.Complete(ctx, "u1", "k1", 201, "application/json", []byte(`{}`)))
+
+	ok, err = s.repo.Acquire(ctx, s.record("k1", "h2", now.Add(2*time.Hour)))
+	s.Require().NoError(err)
+	s.True(ok)
+
+	got, err := s.repo.Get(ctx, "u1", "k1")
+	s.Require().NoError(err)
+	s.Equal("h2", got.RequestHash)
+	s.False(got.Completed())
+}
+
+func (s *IdempotencyRepoInfraSuite) TestAcquire_TakesOverStaleLock() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	ok, err := s.repo.Acquire(ctx, s.record("k1", "h1", now))
+	s.Require().NoError(err)
+	s.Require().True(ok)
+
+	ok, err = s.repo.Acquire(ctx, s.record("k1", "h1", now.Add(30*time.Second)))
+	s.Require().NoError(err)
+	s.False(ok)
+
+	// the first request died without completing or releasing the key
+	ok, err = s.repo.Acquire(ctx, s.record("k1", "h2", now.Add(2*time.Minute)))
+	s.Require().NoError(err)
+	s.True(ok)
+
+	got, err := s.repo.Get(ctx, "u1", "k1")
+	s.Require().NoError(err)
+	s.Equal("h2", got.RequestHash)
+	s.Equal(now.Add(3*time.Minute), got.LockedUntil.UTC())
+}
+
+func (s *IdempotencyRepoInfraSuite) TestAcquire_KeepsCompletedPastLock() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	ok, err := s.repo.Acquire(ctx, s.record("k1", "h1", now))
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().NoError(s.repo.Complete(ctx, "u1", "k1", 201, "application/json", []byte(`{}`)))
+
+	ok, err = s.repo.Acquire(ctx, s.record("k1", "h1", now.Add(2*time.Minute)))
+	s.Require().NoError(err)
+	s.False(ok)
+
+	got, err := s.repo.Get(ctx, "u1", "k1")
+	s.Require().NoError(err)
+	s.Equal(201, got.StatusCode)
+}
+
+func (s *IdempotencyRepoInfraSuite) TestReleaseAndPurge() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	_, err := s.repo.Acquire(ctx, s.record("k1", "h1", now))
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.Release(ctx, "u1", "k1"))
+
+	_, err = s.repo.Get(ctx, "u1", "k1")
+	s.ErrorIs(err, ent.ErrKeyNotFound)
+
+	_, err = s.repo.Acquire(ctx, s.record("k2", "h1", now))
+	s.Require().NoError(err)
+	_, err = s.repo.Acquire(ctx, s.record("k3", "h1", now.Add(2*time.Hour)))
+	s.Require().NoError(err)
+
+	n, err := s.repo.PurgeExpired(ctx, now.Add(90*time.Minute))
+	s.Require().NoError(err)
+	s.Equal(1, n)
+
+	_, err = s.repo.Get(ctx, "u1", "k3")
+	s.NoError(err)
+}
+
+func TestIdempotencyRepoInfraSuite(t *testing.T) {
+	suite.Run(t, new(IdempotencyRepoInfraSuite))
+}
@@ -0,0 +1,68 @@
+package idempotency
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/idempotency"
+)
+
+type keyRow struct {
+	UserID      string    `gorm:"primaryKey;column:user_id;type:text"`
+	Key         string    `gorm:"primaryKey;column:key;type:varchar(255)"`
+	RequestHash string    `gorm:"column:request_hash;type:varchar(64);not null"`
+	StatusCode  *int      `gorm:"column:status_code"`
+	ContentType string    `gorm:"column:content_type;type:varchar(255);not null"`
+	Body        []byte    `gorm:"column:body"`
+	CreatedAt   time.Time `gorm:"column:created_at;not null"`
+	ExpiresAt   time.Time `gorm:"column:expires_at;not null"`
+	LockedUntil time.Time `gorm:"column:locked_until;not null"`
+}
+
+func (keyRow) TableName() string {
+	return "idempotency_keys"
+}
+
+func toEntityRecord(r *keyRow) *ent.Record {
+	if r == nil {
+		return nil
+	}
+
+	rec := &ent.Record{
+		UserID:      r.UserID,
+		Key:         r.Key,
+		RequestHash: r.RequestHash,
+		ContentType: r.ContentType,
+		Body:        r.Body,
+		CreatedAt:   r.CreatedAt,
+		ExpiresAt:   r.ExpiresAt,
+		LockedUntil: r.LockedUntil,
+	}
+	if r.StatusCode != nil {
+		rec.StatusCode = *r.StatusCode
+	}
+
+	return rec
+}
+
+func fromEntityRecord(e *ent.Record) *keyRow {
+	if e == nil {
+		return nil
+	}
+
+	row := &keyRow{
+		UserID:      e.UserID,
+		Key:         e.Key,
+		RequestHash: e.RequestHash,
+		ContentType: e.ContentType,
+		Body:        e.Body,
+		CreatedAt:   e.CreatedAt,
+		ExpiresAt:   e.ExpiresAt,
+		LockedUntil: e.LockedUntil,
+	}
+	if e.StatusCode != 0 {
+		status := e.StatusCode
+		row.StatusCode = &status
+	}
+
+	return row
+}
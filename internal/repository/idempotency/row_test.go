@@ -0,0 +1,53 @@
+package idempotency
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/idempotency"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordConverters(t *testing.T) {
+	now := time.Now()
+	status := 201
+
+	row := &keyRow{
+		UserID:      "u1",
+		Key:         "k1",
+		RequestHash: "abc",
+		StatusCode:  &status,
+		ContentType: "application/json",
+		Body:        []byte(`{"id":1}`),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+		LockedUntil: now.Add(time.Minute),
+	}
+	entity := &ent.Record{
+		UserID:      "u1",
+		Key:         "k1",
+		RequestHash: "abc",
+		StatusCode:  201,
+		ContentType: "application/json",
+		Body:        []byte(`{"id":1}`),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Hour),
+		LockedUntil: now.Add(time.Minute),
+	}
+
+	require.Equal(t, entity, toEntityRecord(row))
+	require.Equal(t, row, fromEntityRecord(entity))
+
+	require.Nil(t, toEntityRecord(nil))
+	require.Nil(t, fromEntityRecord(nil))
+}
+
+func TestRecordConverters_InFlight(t *testing.T) {
+	row := &keyRow{UserID: "u1", Key: "k1", RequestHash: "abc"}
+	entity := &ent.Record{UserID: "u1", Key: "k1", RequestHash: "abc"}
+
+	require.Equal(t, entity, toEntityRecord(row))
+	require.Equal(t, row, fromEntityRecord(entity))
+	require.False(t, entity.Completed())
+}
@@ -0,0 +1,97 @@
+package mention
+
+import (
+	"context"
+
+	ent "test-question/internal/entity/mention"
+	"test-question/internal/pkg/tenant"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) CreateBatch(ctx context.Context, ms []*ent.Mention) ([]*ent.Mention, error) {
+	out := make([]*ent.Mention, 0, len(ms))
+	if len(ms) == 0 {
+		return out, nil
+	}
+
+	rows := make([]*mentionRow, 0, len(ms))
+	for _, m := range ms {
+		rows = append(rows, fromEntityMention(m))
+	}
+
+	if err := uow.GetTx(ctx, r.db).WithContext(ctx).Create(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		out = append(out, toEntityMention(row))
+	}
+
+	return out, nil
+}
+
+// ListByPosts returns the mentions in the question itself and in the given
+// answers in the order they were made.
+func (r *Repository) ListByPosts(ctx context.Context, questionID int, answerIDs []int) ([]*ent.Mention, error) {
+	q := r.withUsername(ctx).Where("m.question_id = ? AND m.answer_id IS NULL", questionID)
+	if len(answerIDs) > 0 {
+		q = q.Or("m.answer_id IN ?", answerIDs)
+	}
+
+	return list(q.Order("m.id ASC"))
+}
+
+// ListByAnswerID returns the mentions in the answer.
+func (r *Repository) ListByAnswerID(ctx context.Context, answerID int) ([]*ent.Mention, error) {
+	return list(r.withUsername(ctx).Where("m.answer_id = ?", answerID).Order("m.id ASC"))
+}
+
+// ListByUser returns a page of the mentions of a user in the workspace,
+// newest first. Mentions in posts readers cannot see, because the post or
+// the answered question is in the trash or hidden, are left out.
+func (r *Repository) ListByUser(ctx context.Context, f ent.Filter) ([]*ent.Mention, error) {
+	q := r.withUsername(ctx).
+		Joins("JOIN questions q ON q.id = m.question_id AND q.deleted_at IS NULL AND q.hidden_at IS NULL").
+		Joins("LEFT JOIN answers a ON a.id = m.answer_id").
+		Where("m.user_id = ?", f.UserID).
+		Where("m.answer_id IS NULL OR (a.deleted_at IS NULL AND a.hidden_at IS NULL)").
+		Scopes(tenant.Scope(ctx, "q"))
+
+	if f.BeforeID > 0 {
+		q = q.Where("m.id < ?", f.BeforeID)
+	}
+
+	return list(q.Order("m.id DESC").Limit(f.Limit))
+}
+
+func (r *Repository) withUsername(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("mentions m").
+		Select("m.*, u.username").
+		Joins("JOIN users u ON u.id::text = m.user_id")
+}
+
+func list(q *gorm.DB) ([]*ent.Mention, error) {
+	var rows []mentionRow
+
+	if err := q.Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Mention, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityMention(&rows[i]))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,145 @@
+//go:build integration
+// +build integration
+
+package mention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/mention"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	ann = "aaaaaaaa-0000-0000-0000-000000000001"
+	ben = "aaaaaaaa-0000-0000-0000-000000000002"
+)
+
+type MentionRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *MentionRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("mentions", "answers", "questions", "users")
+
+	for id, name := range map[string]string{ann: "ann", ben: "ben"} {
+		s.Require().NoError(s.DB.Exec(
+			"INSERT INTO users (id, username, password) VALUES (?, ?, 'x')", id, name).Error)
+	}
+}
+
+func (s *MentionRepoInfraSuite) question() int {
+	var id int
+	s.Require().NoError(s.DB.Raw(
+		"INSERT INTO questions (text, user_id) VALUES ('q', ?) RETURNING id", ben).
+		Scan(&id).Error)
+	return id
+}
+
+func (s *MentionRepoInfraSuite) answer(questionID int) int {
+	var id int
+	s.Require().NoError(s.DB.Raw(
+		"INSERT INTO answers (question_id, user_id, text) VALUES (?, ?, 'a') RETURNING id", questionID, ben).
+		Scan(&id).Error)
+	return id
+}
+
+func (s *MentionRepoInfraSuite) mention(userID string, questionID, answerID int) *ent.Mention {
+	out, err := s.repo.CreateBatch(context.Background(), []*ent.Mention{{
+		UserID:     userID,
+		AuthorID:   ben,
+		QuestionID: questionID,
+		AnswerID:   answerID,
+		CreatedAt:  time.Now(),
+	}})
+	s.Require().NoError(err)
+	s.Require().Len(out, 1)
+	return out[0]
+}
+
+func (s *MentionRepoInfraSuite) TestCreateAndListByPost() {
+	ctx := context.Background()
+	q := s.question()
+	a := s.answer(q)
+
+	inQuestion := s.mention(ann, q, 0)
+	inAnswer := s.mention(ann, q, a)
+	s.mention(ben, q, a)
+
+	s.NotZero(inQuestion.ID)
+
+	all, err := s.repo.ListByPosts(ctx, q, []int{a})
+	s.Require().NoError(err)
+	s.Require().Len(all, 3)
+	s.Equal(inQuestion.ID, all[0].ID)
+	s.Equal("ann", all[0].Username)
+	s.Zero(all[0].AnswerID)
+	s.Equal(a, all[1].AnswerID)
+
+	own, err := s.repo.ListByPosts(ctx, q, nil)
+	s.Require().NoError(err)
+	s.Require().Len(own, 1)
+	s.Equal(inQuestion.ID, own[0].ID)
+
+	inA, err := s.repo.ListByAnswerID(ctx, a)
+	s.Require().NoError(err)
+	s.Require().Len(inA, 2)
+	s.Equal(inAnswer.ID, inA[0].ID)
+	s.Equal("ben", inA[1].Username)
+
+	// the same user is linked once per post
+	_, err = s.repo.CreateBatch(ctx, []*ent.Mention{{UserID: ann, AuthorID: ben, QuestionID: q}})
+	s.Error(err)
+}
+
+func (s *MentionRepoInfraSuite) TestListByUser_PagesAndHidesUnpublished() {
+	ctx := context.Background()
+
+	q := s.question()
+	first := s.mention(ann, q, 0)
+	a := s.answer(q)
+	second := s.mention(ann, q, a)
+	s.mention(ben, q, a)
+
+	trashed := s.answer(q)
+	s.mention(ann, q, trashed)
+	s.Require().NoError(s.DB.Exec("UPDATE answers SET deleted_at = NOW() WHERE id = ?", trashed).Error)
+
+	hidden := s.question()
+	s.mention(ann, hidden, 0)
+	s.Require().NoError(s.DB.Exec("UPDATE questions SET hidden_at = NOW() WHERE id = ?", hidden).Error)
+
+	page, err := s.repo.ListByUser(ctx, ent.Filter{UserID: ann, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(page, 1)
+	s.Equal(second.ID, page[0].ID)
+
+	page, err = s.repo.ListByUser(ctx, ent.Filter{UserID: ann, BeforeID: second.ID, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(page, 1)
+	s.Equal(first.ID, page[0].ID)
+}
+
+func (s *MentionRepoInfraSuite) TestPurgedPostsTakeTheirMentions() {
+	ctx := context.Background()
+
+	q := s.question()
+	a := s.answer(q)
+	s.mention(ann, q, a)
+
+	s.Require().NoError(s.DB.Exec("DELETE FROM answers WHERE id = ?", a).Error)
+
+	out, err := s.repo.ListByPosts(ctx, q, []int{a})
+	s.Require().NoError(err)
+	s.Empty(out)
+}
+
+func TestMentionRepoInfraSuite(t *testing.T) {
+	suite.Run(t, &MentionRepoInfraSuite{})
+}
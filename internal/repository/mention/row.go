@@ -0,0 +1,59 @@
+package mention
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/mention"
+)
+
+type mentionRow struct {
+	ID         int64  `gorm:"primaryKey;column:id"`
+	UserID     string `gorm:"column:user_id;type:text;not null"`
+	AuthorID   string `gorm:"column:author_id;type:text;not null"`
+	QuestionID int64  `gorm:"column:question_id;not null"`
+	AnswerID   *int64 `gorm:"column:answer_id"`
+	// Username is joined from users when reading.
+	Username  string    `gorm:"->;column:username"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (mentionRow) TableName() string {
+	return "mentions"
+}
+
+func toEntityMention(r *mentionRow) *ent.Mention {
+	if r == nil {
+		return nil
+	}
+	out := &ent.Mention{
+		ID:         int(r.ID),
+		UserID:     r.UserID,
+		Username:   r.Username,
+		AuthorID:   r.AuthorID,
+		QuestionID: int(r.QuestionID),
+		CreatedAt:  r.CreatedAt,
+	}
+	if r.AnswerID != nil {
+		out.AnswerID = int(*r.AnswerID)
+	}
+	return out
+}
+
+func fromEntityMention(e *ent.Mention) *mentionRow {
+	if e == nil {
+		return nil
+	}
+	row := &mentionRow{
+		ID:         int64(e.ID),
+		UserID:     e.UserID,
+		Username:   e.Username,
+		AuthorID:   e.AuthorID,
+		QuestionID: int64(e.QuestionID),
+		CreatedAt:  e.CreatedAt,
+	}
+	if e.AnswerID != 0 {
+		answerID := int64(e.AnswerID)
+		row.AnswerID = &answerID
+	}
+	return row
+}
@@ -0,0 +1,45 @@
+package mention
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/mention"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMentionConverters(t *testing.T) {
+	now := time.Now()
+	answerID := int64(9)
+
+	row := &mentionRow{
+		ID:         4,
+		UserID:     "u1",
+		AuthorID:   "u2",
+		QuestionID: 7,
+		AnswerID:   &answerID,
+		Username:   "alice",
+		CreatedAt:  now,
+	}
+	entity := &ent.Mention{
+		ID:         4,
+		UserID:     "u1",
+		Username:   "alice",
+		AuthorID:   "u2",
+		QuestionID: 7,
+		AnswerID:   9,
+		CreatedAt:  now,
+	}
+
+	require.Equal(t, entity, toEntityMention(row))
+	require.Equal(t, row, fromEntityMention(entity))
+
+	row.AnswerID = nil
+	entity.AnswerID = 0
+	require.Equal(t, entity, toEntityMention(row))
+	require.Equal(t, row, fromEntityMention(entity))
+
+	require.Nil(t, toEntityMention(nil))
+	require.Nil(t, fromEntityMention(nil))
+}
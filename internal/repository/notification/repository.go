@@ -0,0 +1,139 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	ent "test-question/internal/entity/notification"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) CreateBatch(ctx context.Context, ns []*ent.Notification) error {
+	if len(ns) == 0 {
+		return nil
+	}
+
+	rows := make([]*notificationRow, 0, len(ns))
+	for _, n := range ns {
+		rows = append(rows, fromEntityNotification(n))
+	}
+
+	return uow.GetTx(ctx, r.db).WithContext(ctx).Create(&rows).Error
+}
+
+func (r *Repository) List(ctx context.Context, f ent.Filter) ([]*ent.Notification, error) {
+	var rows []notificationRow
+
+	q := r.db.WithContext(ctx).Where("user_id = ?", f.UserID)
+
+	if f.UnreadOnly {
+		q = q.Where("read_at IS NULL")
+	}
+
+	if f.BeforeID > 0 {
+		q = q.Where("id < ?", f.BeforeID)
+	}
+
+	err := q.Order("id DESC").Limit(f.Limit).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Notification, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityNotification(&rows[i]))
+	}
+
+	return out, nil
+}
+
+func (r *Repository) MarkRead(ctx context.Context, userID string, id int, at time.Time) error {
+	res := r.db.WithContext(ctx).
+		Model(&notificationRow{}).
+		Where("id = ? AND user_id = ?", id, userID).
+		Update("read_at", gorm.Expr("COALESCE(read_at, ?)", at))
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ent.ErrNotificationNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) MarkAllRead(ctx context.Context, userID string, at time.Time) (int, error) {
+	res := r.db.WithContext(ctx).
+		Model(&notificationRow{}).
+		Where("user_id = ? AND read_at IS NULL", userID).
+		Update("read_at", at)
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return int(res.RowsAffected), nil
+}
+
+func (r *Repository) GetSettings(ctx context.Context, userID string) (ent.Settings, error) {
+	var rows []settingRow
+
+	if err := r.db.WithContext(ctx).Where("user_id = ?", userID).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	out := make(ent.Settings, len(rows))
+	for _, row := range rows {
+		out[ent.Type(row.Type)] = row.Enabled
+	}
+
+	return out, nil
+}
+
+func (r *Repository) SaveSettings(ctx context.Context, userID string, s ent.Settings) error {
+	if len(s) == 0 {
+		return nil
+	}
+
+	rows := make([]settingRow, 0, len(s))
+	for t, enabled := range s {
+		rows = append(rows, settingRow{UserID: userID, Type: string(t), Enabled: enabled})
+	}
+
+	return uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"enabled"}),
+		}).
+		Create(&rows).Error
+}
+
+// DisabledUsers returns the subset of userIDs that switched the given type off.
+func (r *Repository) DisabledUsers(ctx context.Context, t ent.Type, userIDs []string) ([]string, error) {
+	var out []string
+
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&settingRow{}).
+		Where("type = ? AND enabled = FALSE AND user_id IN ?", t, userIDs).
+		Pluck("user_id", &out).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,102 @@
+//go:build integration
+// +build integration
+
+package notification
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/notification"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *NotificationRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("notifications", "notification_settings")
+}
+
+func (s *NotificationRepoInfraSuite) seed(userID string, n int) {
+	batch := make([]*ent.Notification, 0, n)
+	for i := 0; i < n; i++ {
+		batch = append(batch, &ent.Notification{
+			UserID:     userID,
+			Type:       ent.TypeNewAnswer,
+			ActorID:    "actor",
+			QuestionID: 1,
+			AnswerID:   i + 1,
+			CreatedAt:  time.Now(),
+		})
+	}
+	s.Require().NoError(s.repo.CreateBatch(context.Background(), batch))
+}
+
+func (s *NotificationRepoInfraSuite) TestListCursor() {
+	s.seed("u1", 5)
+	s.seed("u2", 1)
+
+	first, err := s.repo.List(context.Background(), ent.Filter{UserID: "u1", Limit: 3})
+	s.Require().NoError(err)
+	s.Require().Len(first, 3)
+	s.Equal(5, first[0].AnswerID)
+
+	second, err := s.repo.List(context.Background(), ent.Filter{UserID: "u1", BeforeID: first[2].ID, Limit: 3})
+	s.Require().NoError(err)
+	s.Require().Len(second, 2)
+	s.Equal(1, second[1].AnswerID)
+}
+
+func (s *NotificationRepoInfraSuite) TestMarkRead() {
+	s.seed("u1", 3)
+
+	all, err := s.repo.List(context.Background(), ent.Filter{UserID: "u1", Limit: 10})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.MarkRead(context.Background(), "u1", all[0].ID, time.Now()))
+	s.ErrorIs(s.repo.MarkRead(context.Background(), "u2", all[1].ID, time.Now()), ent.ErrNotificationNotFound)
+
+	unread, err := s.repo.List(context.Background(), ent.Filter{UserID: "u1", UnreadOnly: true, Limit: 10})
+	s.Require().NoError(err)
+	s.Len(unread, 2)
+
+	n, err := s.repo.MarkAllRead(context.Background(), "u1", time.Now())
+	s.Require().NoError(err)
+	s.Equal(2, n)
+
+	unread, err = s.repo.List(context.Background(), ent.Filter{UserID: "u1", UnreadOnly: true, Limit: 10})
+	s.Require().NoError(err)
+	s.Empty(unread)
+}
+
+func (s *NotificationRepoInfraSuite) TestSettings() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.repo.SaveSettings(ctx, "u1", ent.Settings{ent.TypeNewAnswer: false}))
+
+	settings, err := s.repo.GetSettings(ctx, "u1")
+	s.Require().NoError(err)
+	s.False(settings.Enabled(ent.TypeNewAnswer))
+
+	disabled, err := s.repo.DisabledUsers(ctx, ent.TypeNewAnswer, []string{"u1", "u2"})
+	s.Require().NoError(err)
+	s.Equal([]string{"u1"}, disabled)
+
+	s.Require().NoError(s.repo.SaveSettings(ctx, "u1", ent.Settings{ent.TypeNewAnswer: true}))
+
+	disabled, err = s.repo.DisabledUsers(ctx, ent.TypeNewAnswer, []string{"u1", "u2"})
+	s.Require().NoError(err)
+	s.Empty(disabled)
+}
+
+func TestNotificationRepoInfraSuite(t *testing.T) {
+	s := &NotificationRepoInfraSuite{}
+	suite.Run(t, s)
+}
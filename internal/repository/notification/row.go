@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/notification"
+)
+
+type notificationRow struct {
+	ID         int64      `gorm:"primaryKey;column:id"`
+	UserID     string     `gorm:"column:user_id;type:text;not null"`
+	Type       string     `gorm:"column:type;type:varchar(32);not null"`
+	ActorID    string     `gorm:"column:actor_id;type:text;not null"`
+	QuestionID int64      `gorm:"column:question_id;not null"`
+	AnswerID   *int64     `gorm:"column:answer_id"`
+	ReadAt     *time.Time `gorm:"column:read_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (notificationRow) TableName() string {
+	return "notifications"
+}
+
+type settingRow struct {
+	UserID  string `gorm:"primaryKey;column:user_id;type:text"`
+	Type    string `gorm:"primaryKey;column:type;type:varchar(32)"`
+	Enabled bool   `gorm:"column:enabled;not null"`
+}
+
+func (settingRow) TableName() string {
+	return "notification_settings"
+}
+
+func toEntityNotification(r *notificationRow) *ent.Notification {
+	if r == nil {
+		return nil
+	}
+	out := &ent.Notification{
+		ID:         int(r.ID),
+		UserID:     r.UserID,
+		Type:       ent.Type(r.Type),
+		ActorID:    r.ActorID,
+		QuestionID: int(r.QuestionID),
+		ReadAt:     r.ReadAt,
+		CreatedAt:  r.CreatedAt,
+	}
+	if r.AnswerID != nil {
+		out.AnswerID = int(*r.AnswerID)
+	}
+	return out
+}
+
+func fromEntityNotification(e *ent.Notification) *notificationRow {
+	if e == nil {
+		return nil
+	}
+	row := &notificationRow{
+		ID:         int64(e.ID),
+		UserID:     e.UserID,
+		Type:       string(e.Type),
+		ActorID:    e.ActorID,
+		QuestionID: int64(e.QuestionID),
+		ReadAt:     e.ReadAt,
+		CreatedAt:  e.CreatedAt,
+	}
+	if e.AnswerID != 0 {
+		answerID := int64(e.AnswerID)
+		row.AnswerID = &answerID
+	}
+	return row
+}
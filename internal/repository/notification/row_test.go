@@ -0,0 +1,74 @@
+package notification
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/notification"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationConverters(t *testing.T) {
+	now := time.Now()
+	answerID := int64(8)
+
+	tests := []struct {
+		name   string
+		row    *notificationRow
+		entity *ent.Notification
+	}{
+		{
+			name: "with_answer",
+			row: &notificationRow{
+				ID:         1,
+				UserID:     "u1",
+				Type:       "new_answer",
+				ActorID:    "u2",
+				QuestionID: 3,
+				AnswerID:   &answerID,
+				ReadAt:     &now,
+				CreatedAt:  now,
+			},
+			entity: &ent.Notification{
+				ID:         1,
+				UserID:     "u1",
+				Type:       ent.TypeNewAnswer,
+				ActorID:    "u2",
+				QuestionID: 3,
+				AnswerID:   8,
+				ReadAt:     &now,
+				CreatedAt:  now,
+			},
+		},
+		{
+			name: "without_answer",
+			row: &notificationRow{
+				ID:         2,
+				UserID:     "u1",
+				Type:       "new_answer",
+				ActorID:    "u2",
+				QuestionID: 3,
+				CreatedAt:  now,
+			},
+			entity: &ent.Notification{
+				ID:         2,
+				UserID:     "u1",
+				Type:       ent.TypeNewAnswer,
+				ActorID:    "u2",
+				QuestionID: 3,
+				CreatedAt:  now,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.entity, toEntityNotification(tt.row))
+			require.Equal(t, tt.row, fromEntityNotification(tt.entity))
+		})
+	}
+
+	require.Nil(t, toEntityNotification(nil))
+	require.Nil(t, fromEntityNotification(nil))
+}
@@ -0,0 +1,137 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	ent "test-question/internal/entity/outbox"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Record appends events to the outbox inside the caller's transaction.
+// Events whose key is already recorded are skipped.
+func (r *Repository) Record(ctx context.Context, events ...ent.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	rows := make([]*messageRow, 0, len(events))
+	for _, e := range events {
+		row, err := fromEntityEvent(e)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+
+	return uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "dedup_key"}},
+			DoNothing: true,
+		}).
+		Create(&rows).Error
+}
+
+// ClaimDue picks up to limit unprocessed messages that are due at now and
+// leases them until leaseUntil, so concurrent relays skip them meanwhile.
+func (r *Repository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	limit int,
+) ([]*ent.Message, error) {
+	var rows []messageRow
+
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE outbox
+		SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE processed_at IS NULL AND next_attempt_at <= ?
+			ORDER BY id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		leaseUntil, now, limit,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Message, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityMessage(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// HandledBy returns the names of the handlers that already processed the message.
+func (r *Repository) HandledBy(ctx context.Context, messageID int64) ([]string, error) {
+	var handlers []string
+
+	err := r.db.WithContext(ctx).
+		Model(&handledRow{}).
+		Where("message_id = ?", messageID).
+		Pluck("handler", &handlers).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return handlers, nil
+}
+
+// MarkHandled is written in the handler's transaction, so a handler's
+// effects and its marker commit or roll back together.
+func (r *Repository) MarkHandled(ctx context.Context, messageID int64, handler string, at time.Time) error {
+	return uow.GetTx(ctx, r.db).WithContext(ctx).
+		Create(&handledRow{MessageID: messageID, Handler: handler, HandledAt: at}).Error
+}
+
+func (r *Repository) MarkProcessed(ctx context.Context, messageID int64, at time.Time) error {
+	return r.db.WithContext(ctx).
+		Model(&messageRow{}).
+		Where("id = ?", messageID).
+		Update("processed_at", at).Error
+}
+
+func (r *Repository) MarkFailed(
+	ctx context.Context,
+	messageID int64,
+	attempts int,
+	nextAttemptAt time.Time,
+	lastErr string,
+) error {
+	return r.db.WithContext(ctx).
+		Model(&messageRow{}).
+		Where("id = ?", messageID).
+		Updates(map[string]any{
+			"attempts":        attempts,
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastErr,
+		}).Error
+}
+
+// PurgeProcessed deletes messages processed before the given time.
+func (r *Repository) PurgeProcessed(ctx context.Context, before time.Time) (int, error) {
+	res := r.db.WithContext(ctx).
+		Where("processed_at IS NOT NULL AND processed_at < ?", before).
+		Delete(&messageRow{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return int(res.RowsAffected), nil
+}
@@ -0,0 +1,117 @@
+//go:build integration
+// +build integration
+
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	ent "test-question/internal/entity/outbox"
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type OutboxRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *OutboxRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("outbox_handled", "outbox")
+}
+
+func (s *OutboxRepoInfraSuite) TestRecord_Dedup() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	e := ent.QuestionCreated{Question: entQ.Question{ID: 1, Text: "t"}, OccurredAt: now}
+
+	s.Require().NoError(s.repo.Record(ctx, e))
+	s.Require().NoError(s.repo.Record(ctx, e))
+
+	claimed, err := s.repo.ClaimDue(ctx, now.Add(time.Second), now.Add(time.Minute), 10)
+	s.Require().NoError(err)
+	s.Require().Len(claimed, 1)
+	s.Equal(ent.TypeQuestionCreated, claimed[0].Type)
+	s.Equal(e.Key(), claimed[0].Key)
+}
+
+func (s *OutboxRepoInfraSuite) TestClaimDue() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	s.Require().NoError(s.repo.Record(ctx,
+		ent.AnswerCreated{Answer: entA.Answer{ID: 1}, OccurredAt: now},
+		ent.AnswerCreated{Answer: entA.Answer{ID: 2}, OccurredAt: now},
+	))
+
+	claimed, err := s.repo.ClaimDue(ctx, now.Add(time.Second), now.Add(time.Minute), 1)
+	s.Require().NoError(err)
+	s.Require().Len(claimed, 1)
+	first := claimed[0].ID
+
+	claimed, err = s.repo.ClaimDue(ctx, now.Add(time.Second), now.Add(time.Minute), 10)
+	s.Require().NoError(err)
+	s.Require().Len(claimed, 1)
+	s.NotEqual(first, claimed[0].ID)
+
+	// both leased: nothing left to claim
+	claimed, err = s.repo.ClaimDue(ctx, now.Add(time.Second), now.Add(time.Minute), 10)
+	s.Require().NoError(err)
+	s.Empty(claimed)
+}
+
+func (s *OutboxRepoInfraSuite) TestHandledAndProcessed() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	s.Require().NoError(s.repo.Record(ctx, ent.AnswerCreated{Answer: entA.Answer{ID: 1}, OccurredAt: now}))
+
+	claimed, err := s.repo.ClaimDue(ctx, now.Add(time.Second), now.Add(time.Second), 10)
+	s.Require().NoError(err)
+	s.Require().Len(claimed, 1)
+	id := claimed[0].ID
+
+	s.Require().NoError(s.repo.MarkHandled(ctx, id, "notifications", now))
+	s.Require().NoError(s.repo.MarkFailed(ctx, id, 1, now.Add(time.Minute), "webhooks: boom"))
+
+	handled, err := s.repo.HandledBy(ctx, id)
+	s.Require().NoError(err)
+	s.Equal([]string{"notifications"}, handled)
+
+	// backed off: not due yet
+	claimed, err = s.repo.ClaimDue(ctx, now.Add(30*time.Second), now.Add(time.Hour), 10)
+	s.Require().NoError(err)
+	s.Empty(claimed)
+
+	claimed, err = s.repo.ClaimDue(ctx, now.Add(2*time.Minute), now.Add(time.Hour), 10)
+	s.Require().NoError(err)
+	s.Require().Len(claimed, 1)
+	s.Equal(1, claimed[0].Attempts)
+	s.Equal("webhooks: boom", claimed[0].LastError)
+
+	s.Require().NoError(s.repo.MarkProcessed(ctx, id, now))
+
+	purged, err := s.repo.PurgeProcessed(ctx, now.Add(-time.Minute))
+	s.Require().NoError(err)
+	s.Zero(purged)
+
+	purged, err = s.repo.PurgeProcessed(ctx, now.Add(time.Minute))
+	s.Require().NoError(err)
+	s.Equal(1, purged)
+
+	handled, err = s.repo.HandledBy(ctx, id)
+	s.Require().NoError(err)
+	s.Empty(handled)
+}
+
+func TestOutboxRepoInfraSuite(t *testing.T) {
+	s := &OutboxRepoInfraSuite{}
+	suite.Run(t, s)
+}
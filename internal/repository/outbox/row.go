@@ -0,0 +1,69 @@
+package outbox
+
+import (
+	"encoding/json"
+	"time"
+
+	ent "test-question/internal/entity/outbox"
+)
+
+type messageRow struct {
+	ID            int64      `gorm:"primaryKey;column:id"`
+	Type          string     `gorm:"column:type;type:varchar(64);not null"`
+	DedupKey      string     `gorm:"column:dedup_key;type:text;not null"`
+	Payload       string     `gorm:"column:payload;type:text;not null"`
+	Attempts      int        `gorm:"column:attempts;not null"`
+	NextAttemptAt time.Time  `gorm:"column:next_attempt_at;default:now()"`
+	LastError     *string    `gorm:"column:last_error"`
+	ProcessedAt   *time.Time `gorm:"column:processed_at"`
+	CreatedAt     time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (messageRow) TableName() string {
+	return "outbox"
+}
+
+type handledRow struct {
+	MessageID int64     `gorm:"primaryKey;column:message_id"`
+	Handler   string    `gorm:"primaryKey;column:handler;type:varchar(64)"`
+	HandledAt time.Time `gorm:"column:handled_at;not null"`
+}
+
+func (handledRow) TableName() string {
+	return "outbox_handled"
+}
+
+func toEntityMessage(r *messageRow) *ent.Message {
+	if r == nil {
+		return nil
+	}
+
+	out := &ent.Message{
+		ID:            r.ID,
+		Type:          ent.EventType(r.Type),
+		Key:           r.DedupKey,
+		Payload:       []byte(r.Payload),
+		Attempts:      r.Attempts,
+		NextAttemptAt: r.NextAttemptAt,
+		ProcessedAt:   r.ProcessedAt,
+		CreatedAt:     r.CreatedAt,
+	}
+	if r.LastError != nil {
+		out.LastError = *r.LastError
+	}
+	return out
+}
+
+// fromEntityEvent builds a new, not yet attempted outbox row for e.
+func fromEntityEvent(e ent.Event) (*messageRow, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messageRow{
+		Type:     string(e.Type()),
+		DedupKey: e.Key(),
+		Payload:  string(payload),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	ent "test-question/internal/entity/outbox"
+	entQ "test-question/internal/entity/question"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_toEntityMessage(t *testing.T) {
+	now := time.Now()
+	lastErr := "notifications: db down"
+
+	m := toEntityMessage(&messageRow{
+		ID:            5,
+		Type:          "answer.created",
+		DedupKey:      "answer.created:9",
+		Payload:       `{}`,
+		Attempts:      2,
+		NextAttemptAt: now,
+		LastError:     &lastErr,
+		CreatedAt:     now,
+	})
+	require.NotNil(t, m)
+	require.Equal(t, int64(5), m.ID)
+	require.Equal(t, ent.TypeAnswerCreated, m.Type)
+	require.Equal(t, "answer.created:9", m.Key)
+	require.Equal(t, []byte(`{}`), m.Payload)
+	require.Equal(t, 2, m.Attempts)
+	require.Equal(t, now, m.NextAttemptAt)
+	require.Equal(t, lastErr, m.LastError)
+	require.Nil(t, m.ProcessedAt)
+}
+
+func Test_toEntityMessage_nil(t *testing.T) {
+	require.Nil(t, toEntityMessage(nil))
+}
+
+func Test_fromEntityEvent(t *testing.T) {
+	at := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	row, err := fromEntityEvent(ent.AnswerCreated{
+		Question:   entQ.Question{ID: 3, UserID: "owner"},
+		Answer:     entA.Answer{ID: 9, QuestionID: 3, UserID: "author"},
+		OccurredAt: at,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "answer.created", row.Type)
+	require.Equal(t, "answer.created:9", row.DedupKey)
+	require.Contains(t, row.Payload, `"occurred_at":"2024-11-20T12:00:00Z"`)
+	require.Zero(t, row.ID)
+	require.Zero(t, row.Attempts)
+}
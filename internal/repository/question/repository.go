@@ -2,14 +2,21 @@ package question
 
 import (
 	"context"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	ent "test-question/internal/entity/question"
+	entR "test-question/internal/entity/ranking"
+	"test-question/internal/pkg/tenant"
 	"test-question/internal/pkg/uow"
 
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
 
+// Repository keeps every query to the workspace of its context, see tenant.
 type Repository struct {
 	db *gorm.DB
 }
@@ -18,10 +25,15 @@ func NewRepository(db *gorm.DB) *Repository {
 	return &Repository{db: db}
 }
 
+func (r *Repository) scoped(ctx context.Context, db *gorm.DB) *gorm.DB {
+	return db.WithContext(ctx).Scopes(tenant.Scope(ctx, "questions"))
+}
+
+// List returns the live questions readers can see; hidden ones are left out.
 func (r *Repository) List(ctx context.Context) ([]*ent.Question, error) {
 	var rows []questionRow
 
-	err := r.db.WithContext(ctx).Find(&rows).Order("created_at DESC").Error
+	err := r.scoped(ctx, r.db).Where("hidden_at IS NULL").Find(&rows).Order("created_at DESC").Error
 	if err != nil {
 		return nil, err
 	}
@@ -34,8 +46,46 @@ func (r *Repository) List(ctx context.Context) ([]*ent.Question, error) {
 	return res, nil
 }
 
+// ListRanked returns the questions readers can see by their last computed
+// score, highest first; limit 0 lists them all. By OrderHot questions not
+// ranked yet come after the rest, by OrderTrending only questions with a
+// positive score are listed.
+func (r *Repository) ListRanked(ctx context.Context, order entR.Order, limit int) ([]*ent.Question, error) {
+	q := r.scoped(ctx, r.db).
+		Select("questions.*").
+		Where("questions.hidden_at IS NULL")
+
+	switch order {
+	case entR.OrderHot:
+		q = q.Joins("LEFT JOIN question_scores s ON s.question_id = questions.id").
+			Order("COALESCE(s.hot, 0) DESC, questions.id DESC")
+	case entR.OrderTrending:
+		q = q.Joins("JOIN question_scores s ON s.question_id = questions.id AND s.trending > 0").
+			Order("s.trending DESC, questions.id DESC")
+	default:
+		return nil, entR.ErrInvalidOrder
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	var rows []questionRow
+	if err := q.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	res := make([]*ent.Question, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, toEntityQuestion(&row))
+	}
+
+	return res, nil
+}
+
 func (r *Repository) Create(ctx context.Context, e *ent.Question) (*ent.Question, error) {
 	row := fromEntityQuestion(e)
+	row.WorkspaceID = tenant.ID(ctx)
 
 	if err := uow.GetTx(ctx, r.db).WithContext(ctx).
 		Create(row).Error; err != nil {
@@ -45,16 +95,150 @@ func (r *Repository) Create(ctx context.Context, e *ent.Question) (*ent.Question
 	return toEntityQuestion(row), nil
 }
 
+// Delete moves the question to the trash. deleted_at is the transaction's
+// NOW(), the instant reputation reversals of the same transaction get too;
+// a restore relies on that to find them.
 func (r *Repository) Delete(ctx context.Context, id int) error {
-	return uow.GetTx(ctx, r.db).WithContext(ctx).
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&questionRow{}).
+		Where("id = ?", id).
+		Update("deleted_at", gorm.Expr("NOW()")).Error
+}
+
+// Remove trashes the question on a moderator's decision; unlike Delete, its
+// author cannot restore it.
+func (r *Repository) Remove(ctx context.Context, id int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&questionRow{}).
 		Where("id = ?", id).
-		Delete(&questionRow{}).Error
+		Updates(map[string]any{
+			"deleted_at":           gorm.Expr("NOW()"),
+			"removed_by_moderator": true,
+		}).Error
+}
+
+// ListWithDeleted lists all questions, trashed ones included.
+func (r *Repository) ListWithDeleted(ctx context.Context) ([]*ent.Question, error) {
+	var rows []questionRow
+
+	err := r.scoped(ctx, r.db).Unscoped().Order("created_at DESC").Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*ent.Question, 0, len(rows))
+	for i := range rows {
+		res = append(res, toEntityQuestion(&rows[i]))
+	}
+
+	return res, nil
 }
 
+// GetByIDWithDeleted finds a question whether or not it is in the trash.
+func (r *Repository) GetByIDWithDeleted(ctx context.Context, id int) (*ent.Question, error) {
+	var row questionRow
+
+	err := r.scoped(ctx, r.db).Unscoped().Where("id = ?", id).First(&row).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ent.ErrQuestionNotFound
+		}
+		return nil, err
+	}
+
+	return toEntityQuestion(&row), nil
+}
+
+// Restore takes the question out of the trash.
+func (r *Repository) Restore(ctx context.Context, id int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Unscoped().
+		Model(&questionRow{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		Update("deleted_at", nil).Error
+}
+
+// PurgeDeleted hard-deletes questions trashed before the given time.
+func (r *Repository) PurgeDeleted(ctx context.Context, before time.Time) (int, error) {
+	res := r.scoped(ctx, r.db).
+		Unscoped().
+		Where("deleted_at < ?", before).
+		Delete(&questionRow{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return int(res.RowsAffected), nil
+}
+
+// FindSimilar returns visible questions that are not duplicates themselves and
+// whose text has pg_trgm similarity of at least threshold with the given
+// one, most similar first. The threshold is set for the % operator so the
+// trigram index is used.
+func (r *Repository) FindSimilar(
+	ctx context.Context,
+	text string,
+	threshold float64,
+	limit int,
+) ([]*ent.SimilarQuestion, error) {
+	var rows []similarRow
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SELECT set_config('pg_trgm.similarity_threshold', ?, true)",
+			strconv.FormatFloat(threshold, 'f', -1, 64)).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Raw(`
+			SELECT id, text, similarity(text, ?) AS similarity
+			FROM questions
+			WHERE text % ? AND deleted_at IS NULL AND hidden_at IS NULL AND duplicate_of IS NULL AND ?
+			ORDER BY similarity DESC, id
+			LIMIT ?`, text, text, tenant.Condition(ctx, "questions"), limit).
+			Scan(&rows).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.SimilarQuestion, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntitySimilar(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// MarkDuplicate points the question, and the questions already marked as
+// its duplicates, at the canonical one so no chain of duplicates forms.
+func (r *Repository) MarkDuplicate(ctx context.Context, id, canonicalID int) error {
+	return r.scoped(ctx, r.db).
+		Model(&questionRow{}).
+		Where("id = ? OR duplicate_of = ?", id, id).
+		Updates(map[string]any{
+			"duplicate_of": canonicalID,
+			"revision":     gorm.Expr("revision + 1"),
+		}).Error
+}
+
+// UnmarkDuplicate clears the duplicate mark of the question.
+func (r *Repository) UnmarkDuplicate(ctx context.Context, id int) error {
+	return r.scoped(ctx, r.db).
+		Model(&questionRow{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"duplicate_of": nil,
+			"revision":     gorm.Expr("revision + 1"),
+		}).Error
+}
+
+// GetByID reads through the caller's transaction, if any, so it sees the
+// questions created earlier in it.
 func (r *Repository) GetByID(ctx context.Context, id int) (*ent.Question, error) {
 	var row questionRow
 
-	err := r.db.WithContext(ctx).Where("id = ?", id).First(&row).Error
+	err := r.scoped(ctx, uow.GetTx(ctx, r.db)).Where("id = ?", id).First(&row).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ent.ErrQuestionNotFound
@@ -64,3 +248,128 @@ func (r *Repository) GetByID(ctx context.Context, id int) (*ent.Question, error)
 
 	return toEntityQuestion(&row), nil
 }
+
+// ListByIDs returns the questions with the given IDs, hidden ones included;
+// unknown IDs are skipped.
+func (r *Repository) ListByIDs(ctx context.Context, ids []int) ([]*ent.Question, error) {
+	res := make([]*ent.Question, 0, len(ids))
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	var rows []questionRow
+	if err := r.scoped(ctx, r.db).Where("id IN ?", ids).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		res = append(res, toEntityQuestion(&rows[i]))
+	}
+
+	return res, nil
+}
+
+func (r *Repository) SetAcceptedAnswer(ctx context.Context, questionID, answerID int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&questionRow{}).
+		Where("id = ?", questionID).
+		Updates(map[string]any{
+			"accepted_answer_id": answerID,
+			"revision":           gorm.Expr("revision + 1"),
+		}).Error
+}
+
+// SetStatus moves the question from one status to another. It fails with
+// ErrInvalidTransition if the question is no longer in the from status.
+func (r *Repository) SetStatus(ctx context.Context, id int, from, to ent.Status) error {
+	res := r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&questionRow{}).
+		Where("id = ? AND status = ?", id, string(from)).
+		Updates(map[string]any{
+			"status":   string(to),
+			"revision": gorm.Expr("revision + 1"),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ent.ErrInvalidTransition
+	}
+
+	return nil
+}
+
+func (r *Repository) AddTransition(ctx context.Context, t *ent.Transition) (*ent.Transition, error) {
+	row := fromEntityTransition(t)
+
+	if err := uow.GetTx(ctx, r.db).WithContext(ctx).Create(row).Error; err != nil {
+		return nil, err
+	}
+
+	return toEntityTransition(row), nil
+}
+
+// ListTransitions returns the status history of the question, oldest first.
+func (r *Repository) ListTransitions(ctx context.Context, questionID int) ([]*ent.Transition, error) {
+	var rows []transitionRow
+
+	err := r.db.WithContext(ctx).
+		Where("question_id = ?", questionID).
+		Order("id ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Transition, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityTransition(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// Hide keeps the question from readers until a moderator reviews it.
+func (r *Repository) Hide(ctx context.Context, id int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&questionRow{}).
+		Where("id = ? AND hidden_at IS NULL", id).
+		Update("hidden_at", gorm.Expr("NOW()")).Error
+}
+
+// Unhide shows the question to readers again.
+func (r *Repository) Unhide(ctx context.Context, id int) error {
+	return r.scoped(ctx, uow.GetTx(ctx, r.db)).
+		Model(&questionRow{}).
+		Where("id = ?", id).
+		Update("hidden_at", nil).Error
+}
+
+// AddViews adds buffered views to the questions' counts in one statement.
+// Views do not change the revision: the count is not an edit. Rows are
+// updated in ID order so that concurrent flushes lock them in the same order.
+func (r *Repository) AddViews(ctx context.Context, counts map[int]int) error {
+	if len(counts) == 0 {
+		return nil
+	}
+
+	ids := make([]int, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	values := make([]string, len(ids))
+	args := make([]any, 0, 2*len(ids))
+	for i, id := range ids {
+		values[i] = "(?::bigint, ?::bigint)"
+		args = append(args, id, counts[id])
+	}
+
+	return uow.GetTx(ctx, r.db).WithContext(ctx).Exec(`
+		UPDATE questions AS q
+		SET view_count = q.view_count + v.n
+		FROM (VALUES `+strings.Join(values, ", ")+`) AS v(id, n)
+		WHERE q.id = v.id`, args...).Error
+}
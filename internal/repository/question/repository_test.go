@@ -9,6 +9,8 @@ import (
 	"time"
 
 	ent "test-question/internal/entity/question"
+	entR "test-question/internal/entity/ranking"
+	"test-question/internal/pkg/tenant"
 	"test-question/internal/tests/dbsuite"
 
 	"github.com/stretchr/testify/suite"
@@ -21,7 +23,8 @@ type QuestionRepoInfraSuite struct {
 
 func (s *QuestionRepoInfraSuite) SetupTest() {
 	s.repo = &Repository{db: s.DB}
-	s.ResetTables("answers", "questions")
+	s.ResetTables("answers", "questions", "question_transitions")
+	s.ResetWorkspaces()
 }
 
 func (s *QuestionRepoInfraSuite) TestCreate() {
@@ -65,6 +68,27 @@ func (s *QuestionRepoInfraSuite) TestGetByID() {
 	s.WithinDuration(q.CreatedAt, out.CreatedAt, time.Second)
 }
 
+func (s *QuestionRepoInfraSuite) TestListByIDs() {
+	ctx := context.Background()
+
+	var ids []int
+	for _, text := range []string{"first", "second", "gone"} {
+		q, err := s.repo.Create(ctx, &ent.Question{Text: text, UserID: "11111111-1111-1111-1111-111111111111", CreatedAt: time.Now()})
+		s.Require().NoError(err)
+		ids = append(ids, q.ID)
+	}
+	s.Require().NoError(s.repo.Delete(ctx, ids[2]))
+
+	out, err := s.repo.ListByIDs(ctx, append(ids, ids[2]+100))
+	s.Require().NoError(err)
+	s.Require().Len(out, 2, "deleted and unknown questions are skipped")
+	s.ElementsMatch([]int{ids[0], ids[1]}, []int{out[0].ID, out[1].ID})
+
+	out, err = s.repo.ListByIDs(ctx, nil)
+	s.Require().NoError(err)
+	s.Empty(out)
+}
+
 func (s *QuestionRepoInfraSuite) TestDelete() {
 	q := &questionRow{
 		Text:      "to delete",
@@ -86,6 +110,287 @@ func (s *QuestionRepoInfraSuite) TestDelete() {
 	s.True(row.DeletedAt.Valid)
 }
 
+func (s *QuestionRepoInfraSuite) TestRemove() {
+	q := &questionRow{
+		Text:      "to remove",
+		UserID:    "11111111-1111-1111-1111-111111111111",
+		CreatedAt: time.Now(),
+	}
+	s.Require().NoError(s.DB.Create(q).Error)
+
+	s.Require().NoError(s.repo.Remove(context.Background(), int(q.ID)))
+
+	out, err := s.repo.GetByIDWithDeleted(context.Background(), int(q.ID))
+	s.Require().NoError(err)
+	s.NotNil(out.DeletedAt)
+	s.True(out.RemovedByModerator)
+}
+
+func (s *QuestionRepoInfraSuite) TestSetAcceptedAnswer() {
+	q := &questionRow{
+		Text:      "to accept",
+		UserID:    "11111111-1111-1111-1111-111111111111",
+		CreatedAt: time.Now(),
+	}
+	s.Require().NoError(s.DB.Create(q).Error)
+
+	err := s.repo.SetAcceptedAnswer(context.Background(), int(q.ID), 42)
+	s.Require().NoError(err)
+
+	out, err := s.repo.GetByID(context.Background(), int(q.ID))
+	s.Require().NoError(err)
+	s.Equal(42, out.AcceptedAnswerID)
+	s.Equal(2, out.Revision)
+}
+
+func (s *QuestionRepoInfraSuite) TestRestoreAndListWithDeleted() {
+	ctx := context.Background()
+
+	q := &questionRow{Text: "trash me", UserID: "11111111-1111-1111-1111-111111111111"}
+	s.Require().NoError(s.DB.Create(q).Error)
+	s.Require().NoError(s.repo.Delete(ctx, int(q.ID)))
+
+	list, err := s.repo.ListWithDeleted(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(list, 1)
+	s.NotNil(list[0].DeletedAt)
+
+	_, err = s.repo.GetByID(ctx, int(q.ID))
+	s.Require().ErrorIs(err, ent.ErrQuestionNotFound)
+
+	s.Require().NoError(s.repo.Restore(ctx, int(q.ID)))
+
+	out, err := s.repo.GetByID(ctx, int(q.ID))
+	s.Require().NoError(err)
+	s.Nil(out.DeletedAt)
+}
+
+func (s *QuestionRepoInfraSuite) TestHideAndUnhide() {
+	ctx := context.Background()
+
+	q := &questionRow{Text: "reported", UserID: "11111111-1111-1111-1111-111111111111"}
+	s.Require().NoError(s.DB.Create(q).Error)
+	s.Require().NoError(s.repo.Hide(ctx, int(q.ID)))
+
+	list, err := s.repo.List(ctx)
+	s.Require().NoError(err)
+	s.Empty(list)
+
+	out, err := s.repo.GetByID(ctx, int(q.ID))
+	s.Require().NoError(err)
+	s.NotNil(out.HiddenAt)
+
+	s.Require().NoError(s.repo.Unhide(ctx, int(q.ID)))
+
+	list, err = s.repo.List(ctx)
+	s.Require().NoError(err)
+	s.Len(list, 1)
+}
+
+func (s *QuestionRepoInfraSuite) TestAddViews() {
+	ctx := context.Background()
+
+	a := &questionRow{Text: "viewed", UserID: "11111111-1111-1111-1111-111111111111"}
+	b := &questionRow{Text: "also viewed", UserID: "11111111-1111-1111-1111-111111111111"}
+	s.Require().NoError(s.DB.Create(a).Error)
+	s.Require().NoError(s.DB.Create(b).Error)
+
+	s.Require().NoError(s.repo.AddViews(ctx, map[int]int{int(a.ID): 3, int(b.ID): 1}))
+	s.Require().NoError(s.repo.AddViews(ctx, map[int]int{int(a.ID): 2, 999999: 4}))
+	s.Require().NoError(s.repo.AddViews(ctx, nil))
+
+	out, err := s.repo.GetByID(ctx, int(a.ID))
+	s.Require().NoError(err)
+	s.Equal(5, out.ViewCount)
+	s.Equal(1, out.Revision)
+
+	out, err = s.repo.GetByID(ctx, int(b.ID))
+	s.Require().NoError(err)
+	s.Equal(1, out.ViewCount)
+}
+
+func (s *QuestionRepoInfraSuite) TestListRanked() {
+	ctx := context.Background()
+
+	rows := make([]*questionRow, 4)
+	for i := range rows {
+		rows[i] = &questionRow{Text: "ranked", UserID: "11111111-1111-1111-1111-111111111111"}
+		s.Require().NoError(s.DB.Create(rows[i]).Error)
+	}
+	s.Require().NoError(s.DB.Exec(`
+		INSERT INTO question_scores (question_id, hot, trending, view_heat, views_seen, computed_at) VALUES
+			(?, 1, 5, 0, 0, NOW()), (?, 2, 0, 0, 0, NOW()), (?, 9, 9, 0, 0, NOW())`,
+		rows[0].ID, rows[1].ID, rows[2].ID).Error)
+	s.Require().NoError(s.repo.Hide(ctx, int(rows[2].ID)))
+
+	ids := func(qs []*ent.Question) []int {
+		out := make([]int, len(qs))
+		for i, q := range qs {
+			out[i] = q.ID
+		}
+		return out
+	}
+
+	hot, err := s.repo.ListRanked(ctx, entR.OrderHot, 0)
+	s.Require().NoError(err)
+	s.Equal([]int{int(rows[1].ID), int(rows[0].ID), int(rows[3].ID)}, ids(hot))
+
+	trending, err := s.repo.ListRanked(ctx, entR.OrderTrending, 10)
+	s.Require().NoError(err)
+	s.Equal([]int{int(rows[0].ID)}, ids(trending))
+
+	_, err = s.repo.ListRanked(ctx, "random", 0)
+	s.ErrorIs(err, entR.ErrInvalidOrder)
+}
+
+func (s *QuestionRepoInfraSuite) TestPurgeDeleted() {
+	ctx := context.Background()
+
+	q := &questionRow{Text: "purge me", UserID: "11111111-1111-1111-1111-111111111111"}
+	s.Require().NoError(s.DB.Create(q).Error)
+	s.Require().NoError(s.repo.Delete(ctx, int(q.ID)))
+
+	n, err := s.repo.PurgeDeleted(ctx, time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+	s.Equal(1, n)
+
+	_, err = s.repo.GetByIDWithDeleted(ctx, int(q.ID))
+	s.ErrorIs(err, ent.ErrQuestionNotFound)
+}
+
+func (s *QuestionRepoInfraSuite) TestFindSimilar() {
+	ctx := context.Background()
+	userID := "11111111-1111-1111-1111-111111111111"
+
+	canonical := &questionRow{Text: "how do I reverse a slice in go", UserID: userID}
+	marked := &questionRow{Text: "how do I reverse a slice in golang", UserID: userID}
+	other := &questionRow{Text: "postgres vacuum settings", UserID: userID}
+	for _, q := range []*questionRow{canonical, marked, other} {
+		s.Require().NoError(s.DB.Create(q).Error)
+	}
+	s.Require().NoError(s.repo.MarkDuplicate(ctx, int(marked.ID), int(canonical.ID)))
+
+	out, err := s.repo.FindSimilar(ctx, "how to reverse a slice in go", 0.3, 5)
+	s.Require().NoError(err)
+	s.Require().Len(out, 1)
+	s.Equal(int(canonical.ID), out[0].ID)
+	s.Greater(out[0].Similarity, 0.3)
+}
+
+func (s *QuestionRepoInfraSuite) TestMarkDuplicate_RepointsChain() {
+	ctx := context.Background()
+	userID := "11111111-1111-1111-1111-111111111111"
+
+	a := &questionRow{Text: "a", UserID: userID}
+	b := &questionRow{Text: "b", UserID: userID}
+	c := &questionRow{Text: "c", UserID: userID}
+	for _, q := range []*questionRow{a, b, c} {
+		s.Require().NoError(s.DB.Create(q).Error)
+	}
+
+	s.Require().NoError(s.repo.MarkDuplicate(ctx, int(a.ID), int(b.ID)))
+	s.Require().NoError(s.repo.MarkDuplicate(ctx, int(b.ID), int(c.ID)))
+
+	out, err := s.repo.GetByID(ctx, int(a.ID))
+	s.Require().NoError(err)
+	s.Equal(int(c.ID), out.DuplicateOfID)
+
+	s.Require().NoError(s.repo.UnmarkDuplicate(ctx, int(a.ID)))
+
+	out, err = s.repo.GetByID(ctx, int(a.ID))
+	s.Require().NoError(err)
+	s.Zero(out.DuplicateOfID)
+}
+
+func (s *QuestionRepoInfraSuite) TestStatusAndTransitions() {
+	ctx := context.Background()
+
+	q, err := s.repo.Create(ctx, &ent.Question{Text: "close me", UserID: "11111111-1111-1111-1111-111111111111"})
+	s.Require().NoError(err)
+	s.Equal(ent.StatusOpen, q.Status)
+
+	s.Require().NoError(s.repo.SetStatus(ctx, q.ID, ent.StatusOpen, ent.StatusClosed))
+	s.ErrorIs(s.repo.SetStatus(ctx, q.ID, ent.StatusOpen, ent.StatusLocked), ent.ErrInvalidTransition)
+
+	out, err := s.repo.GetByID(ctx, q.ID)
+	s.Require().NoError(err)
+	s.Equal(ent.StatusClosed, out.Status)
+
+	_, err = s.repo.AddTransition(ctx, &ent.Transition{
+		QuestionID: q.ID,
+		Action:     ent.ActionClose,
+		From:       ent.StatusOpen,
+		To:         ent.StatusClosed,
+		ActorID:    "11111111-1111-1111-1111-111111111111",
+		Reason:     "answered elsewhere",
+		CreatedAt:  time.Now(),
+	})
+	s.Require().NoError(err)
+
+	history, err := s.repo.ListTransitions(ctx, q.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 1)
+	s.Equal(ent.ActionClose, history[0].Action)
+	s.Equal("answered elsewhere", history[0].Reason)
+}
+
+func (s *QuestionRepoInfraSuite) TestWorkspaceIsolation() {
+	team := tenant.Inject(context.Background(), s.Workspace("team"))
+	public := tenant.Inject(context.Background(), tenant.DefaultID)
+	userID := "11111111-1111-1111-1111-111111111111"
+
+	secret, err := s.repo.Create(team, &ent.Question{Text: "how do we rotate the staging keys", UserID: userID})
+	s.Require().NoError(err)
+	open, err := s.repo.Create(public, &ent.Question{Text: "how do we rotate the staging logs", UserID: userID})
+	s.Require().NoError(err)
+
+	ids := func(qs []*ent.Question) []int {
+		out := make([]int, len(qs))
+		for i, q := range qs {
+			out[i] = q.ID
+		}
+		return out
+	}
+
+	// ==== Reads see the workspace of the context only ====
+	list, err := s.repo.List(team)
+	s.Require().NoError(err)
+	s.Equal([]int{secret.ID}, ids(list))
+
+	list, err = s.repo.List(public)
+	s.Require().NoError(err)
+	s.Equal([]int{open.ID}, ids(list))
+
+	hot, err := s.repo.ListRanked(public, entR.OrderHot, 0)
+	s.Require().NoError(err)
+	s.Equal([]int{open.ID}, ids(hot))
+
+	_, err = s.repo.GetByID(public, secret.ID)
+	s.ErrorIs(err, ent.ErrQuestionNotFound)
+	_, err = s.repo.GetByIDWithDeleted(public, secret.ID)
+	s.ErrorIs(err, ent.ErrQuestionNotFound)
+
+	similar, err := s.repo.FindSimilar(public, "how do we rotate the staging keys", 0.3, 5)
+	s.Require().NoError(err)
+	s.Require().Len(similar, 1)
+	s.Equal(open.ID, similar[0].ID)
+
+	// ==== Writes do not reach into another workspace ====
+	s.ErrorIs(s.repo.SetStatus(public, secret.ID, ent.StatusOpen, ent.StatusClosed), ent.ErrInvalidTransition)
+	s.Require().NoError(s.repo.Hide(public, secret.ID))
+	s.Require().NoError(s.repo.Delete(public, secret.ID))
+
+	got, err := s.repo.GetByID(team, secret.ID)
+	s.Require().NoError(err)
+	s.Nil(got.HiddenAt)
+	s.Equal(ent.StatusOpen, got.Status)
+
+	// ==== Background work without a workspace sees them all ====
+	list, err = s.repo.List(context.Background())
+	s.Require().NoError(err)
+	s.ElementsMatch([]int{secret.ID, open.ID}, ids(list))
+}
+
 func TestQuestionRepoInfraSuite(t *testing.T) {
 	s := &QuestionRepoInfraSuite{}
 	suite.Run(t, s)
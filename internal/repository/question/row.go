@@ -9,11 +9,21 @@ import (
 )
 
 type questionRow struct {
-	ID        int64          `gorm:"primaryKey;column:id"`
-	Text      string         `gorm:"column:text;type:text;not null"`
-	UserID    string         `gorm:"column:user_id;type:varchar(64);not null;index"`
-	CreatedAt time.Time      `gorm:"column:created_at;autoCreateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	ID               int64          `gorm:"primaryKey;column:id"`
+	Text             string         `gorm:"column:text;type:text;not null"`
+	UserID           string         `gorm:"column:user_id;type:varchar(64);not null;index"`
+	Status           string         `gorm:"column:status;type:varchar(16);not null;default:open"`
+	AcceptedAnswerID *int64         `gorm:"column:accepted_answer_id"`
+	DuplicateOf      *int64         `gorm:"column:duplicate_of"`
+	Revision         int            `gorm:"column:revision;not null;default:1"`
+	ViewCount        int64          `gorm:"column:view_count;not null;default:0"`
+	CreatedAt        time.Time      `gorm:"column:created_at;autoCreateTime"`
+	DeletedAt        gorm.DeletedAt `gorm:"column:deleted_at;index"`
+	HiddenAt         *time.Time     `gorm:"column:hidden_at"`
+	// RemovedByModerator is set by Remove.
+	RemovedByModerator bool `gorm:"column:removed_by_moderator;not null;default:false"`
+	// WorkspaceID is set from the context on create, see tenant.
+	WorkspaceID int `gorm:"column:workspace_id;not null;default:1"`
 }
 
 func (questionRow) TableName() string {
@@ -24,22 +34,107 @@ func toEntityQuestion(q *questionRow) *question.Question {
 	if q == nil {
 		return nil
 	}
-	return &question.Question{
+	out := &question.Question{
 		ID:        int(q.ID),
 		Text:      q.Text,
 		UserID:    q.UserID,
+		Status:    question.Status(q.Status),
+		Revision:  q.Revision,
+		ViewCount: int(q.ViewCount),
 		CreatedAt: q.CreatedAt,
+		HiddenAt:  q.HiddenAt,
+
+		RemovedByModerator: q.RemovedByModerator,
+	}
+	if q.AcceptedAnswerID != nil {
+		out.AcceptedAnswerID = int(*q.AcceptedAnswerID)
+	}
+	if q.DuplicateOf != nil {
+		out.DuplicateOfID = int(*q.DuplicateOf)
 	}
+	if q.DeletedAt.Valid {
+		deletedAt := q.DeletedAt.Time
+		out.DeletedAt = &deletedAt
+	}
+	return out
 }
 
 func fromEntityQuestion(e *question.Question) *questionRow {
 	if e == nil {
 		return nil
 	}
-	return &questionRow{
+	row := &questionRow{
 		ID:        int64(e.ID),
 		Text:      e.Text,
 		UserID:    e.UserID,
+		Status:    string(e.Status),
+		Revision:  e.Revision,
+		ViewCount: int64(e.ViewCount),
 		CreatedAt: e.CreatedAt,
+		HiddenAt:  e.HiddenAt,
+	}
+	if e.AcceptedAnswerID != 0 {
+		accepted := int64(e.AcceptedAnswerID)
+		row.AcceptedAnswerID = &accepted
+	}
+	if e.DuplicateOfID != 0 {
+		duplicateOf := int64(e.DuplicateOfID)
+		row.DuplicateOf = &duplicateOf
+	}
+	return row
+}
+
+type similarRow struct {
+	ID         int64   `gorm:"column:id"`
+	Text       string  `gorm:"column:text"`
+	Similarity float64 `gorm:"column:similarity"`
+}
+
+func toEntitySimilar(r *similarRow) *question.SimilarQuestion {
+	return &question.SimilarQuestion{
+		ID:         int(r.ID),
+		Text:       r.Text,
+		Similarity: r.Similarity,
+	}
+}
+
+type transitionRow struct {
+	ID         int64     `gorm:"primaryKey;column:id"`
+	QuestionID int64     `gorm:"column:question_id;not null"`
+	Action     string    `gorm:"column:action;type:varchar(16);not null"`
+	FromStatus string    `gorm:"column:from_status;type:varchar(16);not null"`
+	ToStatus   string    `gorm:"column:to_status;type:varchar(16);not null"`
+	ActorID    string    `gorm:"column:actor_id;type:varchar(64);not null"`
+	Reason     string    `gorm:"column:reason;type:text;not null"`
+	CreatedAt  time.Time `gorm:"column:created_at"`
+}
+
+func (transitionRow) TableName() string {
+	return "question_transitions"
+}
+
+func toEntityTransition(r *transitionRow) *question.Transition {
+	return &question.Transition{
+		ID:         int(r.ID),
+		QuestionID: int(r.QuestionID),
+		Action:     question.Action(r.Action),
+		From:       question.Status(r.FromStatus),
+		To:         question.Status(r.ToStatus),
+		ActorID:    r.ActorID,
+		Reason:     r.Reason,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func fromEntityTransition(e *question.Transition) *transitionRow {
+	return &transitionRow{
+		ID:         int64(e.ID),
+		QuestionID: int64(e.QuestionID),
+		Action:     string(e.Action),
+		FromStatus: string(e.From),
+		ToStatus:   string(e.To),
+		ActorID:    e.ActorID,
+		Reason:     e.Reason,
+		CreatedAt:  e.CreatedAt,
 	}
 }
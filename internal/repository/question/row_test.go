@@ -7,6 +7,7 @@ import (
 	ent "test-question/internal/entity/question"
 
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 func TestQuestionConverters(t *testing.T) {
@@ -23,15 +24,72 @@ func TestQuestionConverters(t *testing.T) {
 				ID:        1,
 				Text:      "hi",
 				UserID:    "1",
+				Status:    "closed",
+				Revision:  3,
+				ViewCount: 42,
 				CreatedAt: now,
 			},
 			entity: &ent.Question{
 				ID:        1,
 				Text:      "hi",
 				UserID:    "1",
+				Status:    ent.StatusClosed,
+				Revision:  3,
+				ViewCount: 42,
 				CreatedAt: now,
 			},
 		},
+		{
+			name: "row_with_accepted_answer",
+			row: &questionRow{
+				ID:               3,
+				Text:             "answered",
+				UserID:           "1",
+				AcceptedAnswerID: ptrInt64(7),
+				CreatedAt:        now,
+			},
+			entity: &ent.Question{
+				ID:               3,
+				Text:             "answered",
+				UserID:           "1",
+				AcceptedAnswerID: 7,
+				CreatedAt:        now,
+			},
+		},
+		{
+			name: "duplicate_row",
+			row: &questionRow{
+				ID:          6,
+				Text:        "again",
+				UserID:      "1",
+				DuplicateOf: ptrInt64(3),
+				CreatedAt:   now,
+			},
+			entity: &ent.Question{
+				ID:            6,
+				Text:          "again",
+				UserID:        "1",
+				DuplicateOfID: 3,
+				CreatedAt:     now,
+			},
+		},
+		{
+			name: "deleted_row",
+			row: &questionRow{
+				ID:        5,
+				Text:      "gone",
+				UserID:    "1",
+				CreatedAt: now,
+				DeletedAt: gorm.DeletedAt{Time: now, Valid: true},
+			},
+			entity: &ent.Question{
+				ID:        5,
+				Text:      "gone",
+				UserID:    "1",
+				CreatedAt: now,
+				DeletedAt: &now,
+			},
+		},
 		{
 			name:   "nil_row",
 			row:    nil,
@@ -61,15 +119,51 @@ func TestQuestionConverters(t *testing.T) {
 				ID:        2,
 				Text:      "yo",
 				UserID:    "1",
+				Status:    ent.StatusOpen,
 				CreatedAt: now,
 			},
 			row: &questionRow{
 				ID:        2,
 				Text:      "yo",
 				UserID:    "1",
+				Status:    "open",
 				CreatedAt: now,
 			},
 		},
+		{
+			name: "entity_with_accepted_answer",
+			entity: &ent.Question{
+				ID:               4,
+				Text:             "answered",
+				UserID:           "1",
+				AcceptedAnswerID: 9,
+				CreatedAt:        now,
+			},
+			row: &questionRow{
+				ID:               4,
+				Text:             "answered",
+				UserID:           "1",
+				AcceptedAnswerID: ptrInt64(9),
+				CreatedAt:        now,
+			},
+		},
+		{
+			name: "duplicate_entity",
+			entity: &ent.Question{
+				ID:            6,
+				Text:          "again",
+				UserID:        "1",
+				DuplicateOfID: 3,
+				CreatedAt:     now,
+			},
+			row: &questionRow{
+				ID:          6,
+				Text:        "again",
+				UserID:      "1",
+				DuplicateOf: ptrInt64(3),
+				CreatedAt:   now,
+			},
+		},
 		{
 			name:   "nil_entity",
 			entity: nil,
@@ -88,3 +182,40 @@ func TestQuestionConverters(t *testing.T) {
 		})
 	}
 }
+
+func TestTransitionConverters(t *testing.T) {
+	now := time.Now()
+
+	row := &transitionRow{
+		ID:         1,
+		QuestionID: 2,
+		Action:     "close",
+		FromStatus: "open",
+		ToStatus:   "closed",
+		ActorID:    "u1",
+		Reason:     "off-topic",
+		CreatedAt:  now,
+	}
+	e := &ent.Transition{
+		ID:         1,
+		QuestionID: 2,
+		Action:     ent.ActionClose,
+		From:       ent.StatusOpen,
+		To:         ent.StatusClosed,
+		ActorID:    "u1",
+		Reason:     "off-topic",
+		CreatedAt:  now,
+	}
+
+	require.Equal(t, e, toEntityTransition(row))
+	require.Equal(t, row, fromEntityTransition(e))
+}
+
+func TestToEntitySimilar(t *testing.T) {
+	got := toEntitySimilar(&similarRow{ID: 4, Text: "how to go", Similarity: 0.75})
+	require.Equal(t, &ent.SimilarQuestion{ID: 4, Text: "how to go", Similarity: 0.75}, got)
+}
+
+func ptrInt64(v int64) *int64 {
+	return &v
+}
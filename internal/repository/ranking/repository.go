@@ -0,0 +1,101 @@
+package ranking
+
+import (
+	"context"
+	"time"
+
+	ent "test-question/internal/entity/ranking"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Decay weights of activity are clamped at this many half-lives: beyond it
+// they are nil for any purpose, and Postgres reports float underflow as an
+// error rather than rounding to zero.
+const maxHalvings = 1000
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// ListActivity returns the activity of every question readers can see as of
+// now, together with its previous score. Answers and votes are weighed by
+// their age with ranking.Decay; trashed and hidden answers do not count.
+func (r *Repository) ListActivity(ctx context.Context, now time.Time, halfLife time.Duration) ([]*ent.Activity, error) {
+	var rows []activityRow
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT q.id AS question_id, q.created_at, q.view_count AS views,
+			COALESCE(a.n, 0) AS answers, COALESCE(a.decayed, 0) AS decayed_answers,
+			COALESCE(v.total, 0) AS votes, COALESCE(v.decayed, 0) AS decayed_votes,
+			s.hot AS prev_hot, s.trending AS prev_trending,
+			s.view_heat AS prev_view_heat, s.views_seen AS prev_views_seen,
+			s.computed_at AS prev_computed_at
+		FROM questions q
+		LEFT JOIN (
+			SELECT question_id, COUNT(*) AS n, SUM(`+decayed("created_at")+`) AS decayed
+			FROM answers
+			WHERE deleted_at IS NULL AND hidden_at IS NULL
+			GROUP BY question_id
+		) a ON a.question_id = q.id
+		LEFT JOIN (
+			SELECT target_id, SUM(value) AS total, SUM(value * `+decayed("created_at")+`) AS decayed
+			FROM votes
+			WHERE target_type = 'question'
+			GROUP BY target_id
+		) v ON v.target_id = q.id
+		LEFT JOIN question_scores s ON s.question_id = q.id
+		WHERE q.deleted_at IS NULL AND q.hidden_at IS NULL
+		ORDER BY q.id`,
+		map[string]any{
+			"now":          now,
+			"half_life":    halfLife.Seconds(),
+			"max_halvings": maxHalvings,
+		},
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Activity, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityActivity(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// decayed is ranking.Decay in SQL for the age of column at @now.
+func decayed(column string) string {
+	return `POWER(0.5, LEAST(GREATEST(EXTRACT(EPOCH FROM (CAST(@now AS timestamptz) - ` + column + `))::float8, 0)
+		/ CAST(@half_life AS float8), @max_halvings))`
+}
+
+// SaveScores stores the scores of one ranking and drops older scores of
+// questions it did not rank, because they were trashed or hidden since.
+func (r *Repository) SaveScores(ctx context.Context, scores []*ent.Score, computedAt time.Time) error {
+	tx := uow.GetTx(ctx, r.db).WithContext(ctx)
+
+	if len(scores) > 0 {
+		rows := make([]*scoreRow, 0, len(scores))
+		for _, s := range scores {
+			rows = append(rows, fromEntityScore(s))
+		}
+
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "question_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"hot", "trending", "view_heat", "views_seen", "computed_at"}),
+		}).CreateInBatches(rows, 500).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Where("computed_at < ?", computedAt).Delete(&scoreRow{}).Error
+}
@@ -0,0 +1,120 @@
+//go:build integration
+// +build integration
+
+package ranking
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/ranking"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const author = "11111111-1111-1111-1111-111111111111"
+
+type RankingRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+	now  time.Time
+}
+
+func (s *RankingRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.now = time.Date(2024, 11, 21, 12, 0, 0, 0, time.UTC)
+	s.ResetTables("question_scores", "votes", "answers", "questions")
+}
+
+func (s *RankingRepoInfraSuite) question(createdAt time.Time, views int) int {
+	var id int
+	s.Require().NoError(s.DB.Raw(
+		"INSERT INTO questions (text, user_id, created_at, view_count) VALUES ('q', ?, ?, ?) RETURNING id",
+		author, createdAt, views).Scan(&id).Error)
+	return id
+}
+
+func (s *RankingRepoInfraSuite) TestListActivity() {
+	ctx := context.Background()
+
+	id := s.question(s.now.Add(-24*time.Hour), 7)
+	s.Require().NoError(s.DB.Exec(`
+		INSERT INTO answers (question_id, user_id, text, created_at) VALUES
+			(?, 'a', 'now', ?), (?, 'b', 'a half-life ago', ?)`,
+		id, s.now, id, s.now.Add(-time.Hour)).Error)
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO answers (question_id, user_id, text, deleted_at) VALUES (?, 'c', 'trashed', NOW())", id).Error)
+	s.Require().NoError(s.DB.Exec(`
+		INSERT INTO votes (user_id, target_type, target_id, value, created_at) VALUES
+			('a', 'question', ?, 1, ?), ('b', 'question', ?, -1, ?), ('c', 'answer', ?, 1, ?)`,
+		id, s.now, id, s.now.Add(-2*time.Hour), id, s.now).Error)
+
+	hidden := s.question(s.now, 0)
+	s.Require().NoError(s.DB.Exec("UPDATE questions SET hidden_at = NOW() WHERE id = ?", hidden).Error)
+
+	out, err := s.repo.ListActivity(ctx, s.now, time.Hour)
+	s.Require().NoError(err)
+	s.Require().Len(out, 1)
+
+	a := out[0]
+	s.Equal(id, a.QuestionID)
+	s.Equal(2, a.Answers)
+	s.Equal(0, a.Votes)
+	s.Equal(7, a.Views)
+	s.InDelta(1.5, a.DecayedAnswers, 1e-9)
+	s.InDelta(0.75, a.DecayedVotes, 1e-9)
+	s.Nil(a.Prev)
+}
+
+func (s *RankingRepoInfraSuite) TestListActivity_AncientActivityDecaysToNothing() {
+	ctx := context.Background()
+
+	id := s.question(s.now.Add(-10*365*24*time.Hour), 0)
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO answers (question_id, user_id, text, created_at) VALUES (?, 'a', 'old', ?)",
+		id, s.now.Add(-10*365*24*time.Hour)).Error)
+
+	out, err := s.repo.ListActivity(ctx, s.now, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Len(out, 1)
+	s.InDelta(0, out[0].DecayedAnswers, 1e-9)
+}
+
+func (s *RankingRepoInfraSuite) TestSaveScores() {
+	ctx := context.Background()
+
+	kept := s.question(s.now, 0)
+	gone := s.question(s.now, 0)
+
+	earlier := s.now.Add(-5 * time.Minute)
+	s.Require().NoError(s.repo.SaveScores(ctx, []*ent.Score{
+		{QuestionID: kept, Hot: 1, ComputedAt: earlier},
+		{QuestionID: gone, Hot: 2, ComputedAt: earlier},
+	}, earlier))
+
+	s.Require().NoError(s.repo.SaveScores(ctx, []*ent.Score{
+		{QuestionID: kept, Hot: 3, Trending: 2, ViewHeat: 4, ViewsSeen: 5, ComputedAt: s.now},
+	}, s.now))
+
+	out, err := s.repo.ListActivity(ctx, s.now, time.Hour)
+	s.Require().NoError(err)
+	s.Require().Len(out, 2)
+
+	s.Equal(&ent.Score{
+		QuestionID: kept, Hot: 3, Trending: 2, ViewHeat: 4, ViewsSeen: 5, ComputedAt: s.now,
+	}, withUTC(out[0].Prev))
+	s.Nil(out[1].Prev)
+}
+
+func withUTC(sc *ent.Score) *ent.Score {
+	if sc != nil {
+		sc.ComputedAt = sc.ComputedAt.UTC()
+	}
+	return sc
+}
+
+func TestRankingRepoInfraSuite(t *testing.T) {
+	suite.Run(t, new(RankingRepoInfraSuite))
+}
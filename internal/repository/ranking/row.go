@@ -0,0 +1,79 @@
+package ranking
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/ranking"
+)
+
+type scoreRow struct {
+	QuestionID int64     `gorm:"primaryKey;autoIncrement:false;column:question_id"`
+	Hot        float64   `gorm:"column:hot;not null"`
+	Trending   float64   `gorm:"column:trending;not null"`
+	ViewHeat   float64   `gorm:"column:view_heat;not null"`
+	ViewsSeen  int64     `gorm:"column:views_seen;not null"`
+	ComputedAt time.Time `gorm:"column:computed_at;not null"`
+}
+
+func (scoreRow) TableName() string {
+	return "question_scores"
+}
+
+func fromEntityScore(e *ent.Score) *scoreRow {
+	return &scoreRow{
+		QuestionID: int64(e.QuestionID),
+		Hot:        e.Hot,
+		Trending:   e.Trending,
+		ViewHeat:   e.ViewHeat,
+		ViewsSeen:  int64(e.ViewsSeen),
+		ComputedAt: e.ComputedAt,
+	}
+}
+
+// activityRow is a live question with its activity and, once ranked, its
+// previous score.
+type activityRow struct {
+	QuestionID     int64      `gorm:"column:question_id"`
+	CreatedAt      time.Time  `gorm:"column:created_at"`
+	Answers        int64      `gorm:"column:answers"`
+	Votes          int64      `gorm:"column:votes"`
+	Views          int64      `gorm:"column:views"`
+	DecayedAnswers float64    `gorm:"column:decayed_answers"`
+	DecayedVotes   float64    `gorm:"column:decayed_votes"`
+	PrevHot        *float64   `gorm:"column:prev_hot"`
+	PrevTrending   *float64   `gorm:"column:prev_trending"`
+	PrevViewHeat   *float64   `gorm:"column:prev_view_heat"`
+	PrevViewsSeen  *int64     `gorm:"column:prev_views_seen"`
+	PrevComputedAt *time.Time `gorm:"column:prev_computed_at"`
+}
+
+func toEntityActivity(r *activityRow) *ent.Activity {
+	out := &ent.Activity{
+		QuestionID:     int(r.QuestionID),
+		CreatedAt:      r.CreatedAt,
+		Answers:        int(r.Answers),
+		Votes:          int(r.Votes),
+		Views:          int(r.Views),
+		DecayedAnswers: r.DecayedAnswers,
+		DecayedVotes:   r.DecayedVotes,
+	}
+	if r.PrevComputedAt != nil {
+		out.Prev = &ent.Score{
+			QuestionID: int(r.QuestionID),
+			Hot:        deref(r.PrevHot),
+			Trending:   deref(r.PrevTrending),
+			ViewHeat:   deref(r.PrevViewHeat),
+			ViewsSeen:  int(deref(r.PrevViewsSeen)),
+			ComputedAt: *r.PrevComputedAt,
+		}
+	}
+	return out
+}
+
+func deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
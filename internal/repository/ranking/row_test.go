@@ -0,0 +1,94 @@
+package ranking
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/ranking"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScoreConverter(t *testing.T) {
+	now := time.Now()
+
+	require.Equal(t, &scoreRow{
+		QuestionID: 1,
+		Hot:        2.5,
+		Trending:   1.5,
+		ViewHeat:   3,
+		ViewsSeen:  4,
+		ComputedAt: now,
+	}, fromEntityScore(&ent.Score{
+		QuestionID: 1,
+		Hot:        2.5,
+		Trending:   1.5,
+		ViewHeat:   3,
+		ViewsSeen:  4,
+		ComputedAt: now,
+	}))
+}
+
+func TestActivityConverter(t *testing.T) {
+	now := time.Now()
+	heat, hot, trending := 3.0, 2.5, 1.5
+	seen := int64(4)
+
+	tests := []struct {
+		name   string
+		row    *activityRow
+		entity *ent.Activity
+	}{
+		{
+			name: "first_ranking",
+			row: &activityRow{
+				QuestionID:     1,
+				CreatedAt:      now,
+				Answers:        2,
+				Votes:          -1,
+				Views:          10,
+				DecayedAnswers: 1.5,
+				DecayedVotes:   -0.5,
+			},
+			entity: &ent.Activity{
+				QuestionID:     1,
+				CreatedAt:      now,
+				Answers:        2,
+				Votes:          -1,
+				Views:          10,
+				DecayedAnswers: 1.5,
+				DecayedVotes:   -0.5,
+			},
+		},
+		{
+			name: "ranked_before",
+			row: &activityRow{
+				QuestionID:     1,
+				CreatedAt:      now,
+				PrevHot:        &hot,
+				PrevTrending:   &trending,
+				PrevViewHeat:   &heat,
+				PrevViewsSeen:  &seen,
+				PrevComputedAt: &now,
+			},
+			entity: &ent.Activity{
+				QuestionID: 1,
+				CreatedAt:  now,
+				Prev: &ent.Score{
+					QuestionID: 1,
+					Hot:        2.5,
+					Trending:   1.5,
+					ViewHeat:   3,
+					ViewsSeen:  4,
+					ComputedAt: now,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.entity, toEntityActivity(tt.row))
+		})
+	}
+}
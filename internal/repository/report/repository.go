@@ -0,0 +1,121 @@
+package report
+
+import (
+	"context"
+	"time"
+
+	ent "test-question/internal/entity/report"
+	"test-question/internal/pkg/tenant"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Create files the report. A user has at most one open report per content;
+// a second one fails with ErrAlreadyReported.
+func (r *Repository) Create(ctx context.Context, e *ent.Report) (*ent.Report, error) {
+	row := fromEntityReport(e)
+
+	res := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:     []clause.Column{{Name: "target_type"}, {Name: "target_id"}, {Name: "reporter_id"}},
+			TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Eq{Column: "status", Value: string(ent.StatusOpen)}}},
+			DoNothing:   true,
+		}).
+		Create(row)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return nil, ent.ErrAlreadyReported
+	}
+
+	return toEntityReport(row), nil
+}
+
+// CountOpen returns how many open reports the content has.
+func (r *Repository) CountOpen(ctx context.Context, targetType ent.TargetType, targetID int) (int, error) {
+	var count int64
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&reportRow{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", string(targetType), targetID, string(ent.StatusOpen)).
+		Count(&count).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// Queue returns the content with open reports, the most reported first and
+// the longest waiting among equals. Content of other workspaces and content
+// already gone from the site is left out.
+func (r *Repository) Queue(ctx context.Context, limit int) ([]*ent.QueueItem, error) {
+	var rows []queueRow
+
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT rp.target_type, rp.target_id,
+			COALESCE(q.user_id, a.user_id) AS author_id,
+			COALESCE(q.text, a.text) AS text,
+			COALESCE(q.hidden_at, a.hidden_at) IS NOT NULL AS hidden,
+			COUNT(*) AS reports,
+			STRING_AGG(DISTINCT rp.reason, ',') AS reasons,
+			MIN(rp.created_at) AS first_reported_at
+		FROM reports rp
+		LEFT JOIN questions q ON rp.target_type = ? AND q.id = rp.target_id AND q.deleted_at IS NULL AND ?
+		LEFT JOIN answers a ON rp.target_type = ? AND a.id = rp.target_id AND a.deleted_at IS NULL AND ?
+		WHERE rp.status = ? AND (q.id IS NOT NULL OR a.id IS NOT NULL)
+		GROUP BY rp.target_type, rp.target_id, q.user_id, a.user_id, q.text, a.text, q.hidden_at, a.hidden_at
+		ORDER BY reports DESC, first_reported_at ASC
+		LIMIT ?`,
+		string(ent.TargetQuestion), tenant.Condition(ctx, "q"),
+		string(ent.TargetAnswer), tenant.Condition(ctx, "a"),
+		string(ent.StatusOpen), limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.QueueItem, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityQueueItem(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// ResolveOpen closes the open reports of the content with the given status
+// and returns how many were closed.
+func (r *Repository) ResolveOpen(
+	ctx context.Context,
+	targetType ent.TargetType,
+	targetID int,
+	status ent.Status,
+	moderatorID string,
+	at time.Time,
+) (int, error) {
+	res := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Model(&reportRow{}).
+		Where("target_type = ? AND target_id = ? AND status = ?", string(targetType), targetID, string(ent.StatusOpen)).
+		Updates(map[string]any{
+			"status":      string(status),
+			"resolved_by": moderatorID,
+			"resolved_at": at,
+		})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return int(res.RowsAffected), nil
+}
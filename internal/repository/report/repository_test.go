@@ -0,0 +1,96 @@
+//go:build integration
+// +build integration
+
+package report
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/report"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ReportRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *ReportRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("reports", "answers", "questions")
+}
+
+func (s *ReportRepoInfraSuite) report(targetType ent.TargetType, targetID int, reporterID string, reason ent.Reason) error {
+	_, err := s.repo.Create(context.Background(), &ent.Report{
+		TargetType: targetType,
+		TargetID:   targetID,
+		ReporterID: reporterID,
+		Reason:     reason,
+		Status:     ent.StatusOpen,
+	})
+	return err
+}
+
+func (s *ReportRepoInfraSuite) TestCreate_OneOpenPerReporter() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.report(ent.TargetQuestion, 1, "u1", ent.ReasonSpam))
+	s.ErrorIs(s.report(ent.TargetQuestion, 1, "u1", ent.ReasonOffensive), ent.ErrAlreadyReported)
+	s.Require().NoError(s.report(ent.TargetQuestion, 1, "u2", ent.ReasonOffensive))
+
+	n, err := s.repo.CountOpen(ctx, ent.TargetQuestion, 1)
+	s.Require().NoError(err)
+	s.Equal(2, n)
+
+	resolved, err := s.repo.ResolveOpen(ctx, ent.TargetQuestion, 1, ent.StatusDismissed, "m1", time.Now())
+	s.Require().NoError(err)
+	s.Equal(2, resolved)
+
+	// after a resolution the same user may report again
+	s.Require().NoError(s.report(ent.TargetQuestion, 1, "u1", ent.ReasonSpam))
+
+	n, err = s.repo.CountOpen(ctx, ent.TargetQuestion, 1)
+	s.Require().NoError(err)
+	s.Equal(1, n)
+}
+
+func (s *ReportRepoInfraSuite) TestQueue_SortedByReports() {
+	ctx := context.Background()
+
+	var qID, aID int
+	s.Require().NoError(s.DB.Raw(
+		"INSERT INTO questions (text, user_id) VALUES ('spam question', 'author') RETURNING id").
+		Scan(&qID).Error)
+	s.Require().NoError(s.DB.Raw(
+		"INSERT INTO answers (question_id, user_id, text) VALUES (?, 'author2', 'rude answer') RETURNING id", qID).
+		Scan(&aID).Error)
+
+	s.Require().NoError(s.report(ent.TargetQuestion, qID, "u1", ent.ReasonSpam))
+	s.Require().NoError(s.report(ent.TargetAnswer, aID, "u1", ent.ReasonOffensive))
+	s.Require().NoError(s.report(ent.TargetAnswer, aID, "u2", ent.ReasonOffTopic))
+	// reports of missing content stay out of the queue
+	s.Require().NoError(s.report(ent.TargetAnswer, 999, "u1", ent.ReasonSpam))
+
+	items, err := s.repo.Queue(ctx, 10)
+	s.Require().NoError(err)
+	s.Require().Len(items, 2)
+
+	s.Equal(ent.TargetAnswer, items[0].TargetType)
+	s.Equal(aID, items[0].TargetID)
+	s.Equal("author2", items[0].AuthorID)
+	s.Equal(2, items[0].Reports)
+	s.ElementsMatch([]ent.Reason{ent.ReasonOffensive, ent.ReasonOffTopic}, items[0].Reasons)
+
+	s.Equal(ent.TargetQuestion, items[1].TargetType)
+	s.Equal("spam question", items[1].Text)
+	s.Equal(1, items[1].Reports)
+}
+
+func TestReportRepoInfraSuite(t *testing.T) {
+	s := &ReportRepoInfraSuite{}
+	suite.Run(t, s)
+}
@@ -0,0 +1,97 @@
+package report
+
+import (
+	"strings"
+	"time"
+
+	ent "test-question/internal/entity/report"
+)
+
+type reportRow struct {
+	ID         int64      `gorm:"primaryKey;column:id"`
+	TargetType string     `gorm:"column:target_type;type:varchar(16);not null"`
+	TargetID   int64      `gorm:"column:target_id;not null"`
+	ReporterID string     `gorm:"column:reporter_id;type:varchar(64);not null"`
+	Reason     string     `gorm:"column:reason;type:varchar(16);not null"`
+	Note       string     `gorm:"column:note;type:text;not null"`
+	Status     string     `gorm:"column:status;type:varchar(16);not null;default:open"`
+	ResolvedBy *string    `gorm:"column:resolved_by;type:varchar(64)"`
+	ResolvedAt *time.Time `gorm:"column:resolved_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (reportRow) TableName() string {
+	return "reports"
+}
+
+func toEntityReport(r *reportRow) *ent.Report {
+	if r == nil {
+		return nil
+	}
+	out := &ent.Report{
+		ID:         int(r.ID),
+		TargetType: ent.TargetType(r.TargetType),
+		TargetID:   int(r.TargetID),
+		ReporterID: r.ReporterID,
+		Reason:     ent.Reason(r.Reason),
+		Note:       r.Note,
+		Status:     ent.Status(r.Status),
+		ResolvedAt: r.ResolvedAt,
+		CreatedAt:  r.CreatedAt,
+	}
+	if r.ResolvedBy != nil {
+		out.ResolvedBy = *r.ResolvedBy
+	}
+	return out
+}
+
+func fromEntityReport(e *ent.Report) *reportRow {
+	if e == nil {
+		return nil
+	}
+	row := &reportRow{
+		ID:         int64(e.ID),
+		TargetType: string(e.TargetType),
+		TargetID:   int64(e.TargetID),
+		ReporterID: e.ReporterID,
+		Reason:     string(e.Reason),
+		Note:       e.Note,
+		Status:     string(e.Status),
+		ResolvedAt: e.ResolvedAt,
+		CreatedAt:  e.CreatedAt,
+	}
+	if e.ResolvedBy != "" {
+		resolvedBy := e.ResolvedBy
+		row.ResolvedBy = &resolvedBy
+	}
+	return row
+}
+
+type queueRow struct {
+	TargetType      string    `gorm:"column:target_type"`
+	TargetID        int64     `gorm:"column:target_id"`
+	AuthorID        string    `gorm:"column:author_id"`
+	Text            string    `gorm:"column:text"`
+	Hidden          bool      `gorm:"column:hidden"`
+	Reports         int       `gorm:"column:reports"`
+	Reasons         string    `gorm:"column:reasons"`
+	FirstReportedAt time.Time `gorm:"column:first_reported_at"`
+}
+
+func toEntityQueueItem(r *queueRow) *ent.QueueItem {
+	out := &ent.QueueItem{
+		TargetType:      ent.TargetType(r.TargetType),
+		TargetID:        int(r.TargetID),
+		AuthorID:        r.AuthorID,
+		Text:            r.Text,
+		Hidden:          r.Hidden,
+		Reports:         r.Reports,
+		FirstReportedAt: r.FirstReportedAt,
+	}
+	if r.Reasons != "" {
+		for _, reason := range strings.Split(r.Reasons, ",") {
+			out.Reasons = append(out.Reasons, ent.Reason(reason))
+		}
+	}
+	return out
+}
@@ -0,0 +1,72 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/report"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportConverters(t *testing.T) {
+	now := time.Now()
+	moderator := "m1"
+
+	row := &reportRow{
+		ID:         1,
+		TargetType: "answer",
+		TargetID:   5,
+		ReporterID: "u1",
+		Reason:     "spam",
+		Note:       "ads",
+		Status:     "dismissed",
+		ResolvedBy: &moderator,
+		ResolvedAt: &now,
+		CreatedAt:  now,
+	}
+	entity := &ent.Report{
+		ID:         1,
+		TargetType: ent.TargetAnswer,
+		TargetID:   5,
+		ReporterID: "u1",
+		Reason:     ent.ReasonSpam,
+		Note:       "ads",
+		Status:     ent.StatusDismissed,
+		ResolvedBy: "m1",
+		ResolvedAt: &now,
+		CreatedAt:  now,
+	}
+
+	require.Equal(t, entity, toEntityReport(row))
+	require.Equal(t, row, fromEntityReport(entity))
+
+	require.Nil(t, toEntityReport(nil))
+	require.Nil(t, fromEntityReport(nil))
+}
+
+func TestQueueItemConverter(t *testing.T) {
+	now := time.Now()
+
+	out := toEntityQueueItem(&queueRow{
+		TargetType:      "question",
+		TargetID:        3,
+		AuthorID:        "u2",
+		Text:            "buy now",
+		Hidden:          true,
+		Reports:         2,
+		Reasons:         "off_topic,spam",
+		FirstReportedAt: now,
+	})
+
+	require.Equal(t, &ent.QueueItem{
+		TargetType:      ent.TargetQuestion,
+		TargetID:        3,
+		AuthorID:        "u2",
+		Text:            "buy now",
+		Hidden:          true,
+		Reports:         2,
+		Reasons:         []ent.Reason{ent.ReasonOffTopic, ent.ReasonSpam},
+		FirstReportedAt: now,
+	}, out)
+}
@@ -0,0 +1,325 @@
+package reputation
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	ent "test-question/internal/entity/reputation"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+)
+
+// reverseSQL selects a reversal entry for every live entry matched by the
+// appended conditions. Entries that are reversals themselves or were
+// already reversed are skipped, so calling it twice is a no-op. Bounty
+// points are settled by the bounty itself and are never reversed.
+const reverseSQL = `
+SELECT e.user_id, e.actor_id, e.reason, -e.delta AS delta, e.subject_type, e.subject_id,
+       e.id AS reversal_of, NOW() AS created_at
+FROM reputation_events e
+WHERE e.reversal_of IS NULL
+  AND NOT EXISTS (SELECT 1 FROM reputation_events r WHERE r.reversal_of = e.id)
+  AND e.reason NOT IN ('bounty_offered', 'bounty_awarded', 'bounty_refunded')`
+
+// reinstateSQL selects entries undoing the reversals written at the given
+// instant, that is, in the transaction that trashed the subject. Reversals
+// from other causes, such as a retracted vote, have other timestamps. A
+// reversal that was clamped gives back only what it took.
+const reinstateSQL = `
+SELECT o.user_id, o.actor_id, o.reason, -r.delta AS delta, o.subject_type, o.subject_id, NOW() AS created_at
+FROM reputation_events r
+JOIN reputation_events o ON o.id = r.reversal_of
+WHERE r.created_at = ?`
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Add adds an entry. One taking points is clamped at the balance of its
+// user, so that points already spent, say on a bounty, cannot be taken
+// again and drive the balance below zero.
+func (r *Repository) Add(ctx context.Context, e *ent.Entry) (*ent.Entry, error) {
+	row := fromEntityEntry(e)
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return insertClamped(tx, []*entryRow{row})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntityEntry(row), nil
+}
+
+// Spend adds an entry taking points from its user unless that would bring
+// the balance below zero, failing with ErrInsufficientReputation. Spends of
+// a user wait for each other, so concurrent ones cannot overdraw together.
+func (r *Repository) Spend(ctx context.Context, e *ent.Entry) (*ent.Entry, error) {
+	row := fromEntityEntry(e)
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		totals, err := lockBalances(tx, []string{e.UserID})
+		if err != nil {
+			return err
+		}
+
+		if totals[e.UserID]+e.Delta < 0 {
+			return ent.ErrInsufficientReputation
+		}
+
+		return tx.Create(row).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntityEntry(row), nil
+}
+
+func (r *Repository) Reverse(ctx context.Context, f ent.ReverseFilter) error {
+	var (
+		sb   strings.Builder
+		args []any
+	)
+
+	sb.WriteString(reverseSQL)
+	sb.WriteString(" AND e.subject_type = ? AND e.subject_id = ?")
+	args = append(args, f.SubjectType, f.SubjectID)
+
+	if f.ActorID != "" {
+		sb.WriteString(" AND e.actor_id = ?")
+		args = append(args, f.ActorID)
+	}
+
+	if len(f.Reasons) > 0 {
+		sb.WriteString(" AND e.reason IN ?")
+		args = append(args, f.Reasons)
+	}
+
+	return r.insertSelected(ctx, sb.String(), args...)
+}
+
+// ReverseByQuestion reverses everything earned on the question and on all of its answers.
+func (r *Repository) ReverseByQuestion(ctx context.Context, questionID int) error {
+	return r.insertSelected(ctx, reverseSQL+`
+  AND (
+      (e.subject_type = ? AND e.subject_id = ?)
+      OR (e.subject_type = ? AND e.subject_id IN (SELECT id FROM answers WHERE question_id = ?))
+  )`,
+		ent.SubjectQuestion, questionID,
+		ent.SubjectAnswer, questionID,
+	)
+}
+
+// ReinstateQuestion undoes ReverseByQuestion for a question trashed at deletedAt.
+func (r *Repository) ReinstateQuestion(ctx context.Context, questionID int, deletedAt time.Time) error {
+	return r.insertSelected(ctx, reinstateSQL+`
+  AND (
+      (r.subject_type = ? AND r.subject_id = ?)
+      OR (r.subject_type = ? AND r.subject_id IN (SELECT id FROM answers WHERE question_id = ?))
+  )`,
+		deletedAt,
+		ent.SubjectQuestion, questionID,
+		ent.SubjectAnswer, questionID,
+	)
+}
+
+// ReinstateAnswer undoes the reversal of an answer trashed at deletedAt.
+func (r *Repository) ReinstateAnswer(ctx context.Context, answerID int, deletedAt time.Time) error {
+	return r.insertSelected(ctx, reinstateSQL+`
+  AND r.subject_type = ? AND r.subject_id = ?`,
+		deletedAt,
+		ent.SubjectAnswer, answerID,
+	)
+}
+
+// insertSelected adds the entries selected by query, clamping those that
+// take points as Add does.
+func (r *Repository) insertSelected(ctx context.Context, query string, args ...any) error {
+	return uow.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var rows []*entryRow
+		if err := tx.Raw(query, args...).Scan(&rows).Error; err != nil {
+			return err
+		}
+
+		return insertClamped(tx, rows)
+	})
+}
+
+// insertClamped creates the rows, first cutting the points taken from each
+// user down to what the balance, together with the points the rows give,
+// can cover. Like Spend it holds the balance lock of every user it takes
+// points from.
+func insertClamped(tx *gorm.DB, rows []*entryRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var debited []string
+	for _, row := range rows {
+		if row.Delta < 0 && !slices.Contains(debited, row.UserID) {
+			debited = append(debited, row.UserID)
+		}
+	}
+
+	if len(debited) > 0 {
+		balances, err := lockBalances(tx, debited)
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			if row.Delta > 0 {
+				balances[row.UserID] += row.Delta
+			}
+		}
+
+		for _, row := range rows {
+			if row.Delta < 0 {
+				row.Delta = -min(-row.Delta, max(balances[row.UserID], 0))
+				balances[row.UserID] += row.Delta
+			}
+		}
+	}
+
+	return tx.Create(rows).Error
+}
+
+// lockBalances takes the balance locks of the users, in a fixed order so
+// that two transactions cannot wait for each other, and returns their
+// balances.
+func lockBalances(tx *gorm.DB, userIDs []string) (map[string]int, error) {
+	userIDs = slices.Sorted(slices.Values(userIDs))
+
+	for _, userID := range userIDs {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", "reputation:"+userID).Error
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var rows []struct {
+		UserID string
+		Total  int
+	}
+	err := tx.Model(&entryRow{}).
+		Select("user_id, COALESCE(SUM(delta), 0) AS total").
+		Where("user_id IN ?", userIDs).
+		Group("user_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]int, len(userIDs))
+	for _, row := range rows {
+		out[row.UserID] = row.Total
+	}
+
+	return out, nil
+}
+
+func (r *Repository) Total(ctx context.Context, userID string) (int, error) {
+	var total int
+
+	err := r.db.WithContext(ctx).
+		Model(&entryRow{}).
+		Select("COALESCE(SUM(delta), 0)").
+		Where("user_id = ?", userID).
+		Scan(&total).Error
+	if err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+// Totals sums the points of each of the users; users without any are left
+// out.
+func (r *Repository) Totals(ctx context.Context, userIDs []string) (map[string]int, error) {
+	out := make(map[string]int, len(userIDs))
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+
+	var rows []struct {
+		UserID string
+		Total  int
+	}
+	err := r.db.WithContext(ctx).
+		Model(&entryRow{}).
+		Select("user_id, COALESCE(SUM(delta), 0) AS total").
+		Where("user_id IN ?", userIDs).
+		Group("user_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		out[row.UserID] = row.Total
+	}
+
+	return out, nil
+}
+
+func (r *Repository) ListByUser(ctx context.Context, userID string, limit int) ([]*ent.Entry, error) {
+	var rows []entryRow
+
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Entry, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityEntry(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// Leaderboard sums points earned since the given time. Zero since means all time.
+func (r *Repository) Leaderboard(ctx context.Context, since time.Time, limit int) ([]*ent.LeaderboardItem, error) {
+	var rows []leaderboardRow
+
+	q := r.db.WithContext(ctx).
+		Table("reputation_events e").
+		Select("e.user_id, COALESCE(u.username, '') AS username, SUM(e.delta) AS points").
+		Joins("LEFT JOIN users u ON u.id::text = e.user_id")
+
+	if !since.IsZero() {
+		q = q.Where("e.created_at >= ?", since)
+	}
+
+	err := q.
+		Group("e.user_id, u.username").
+		Order("points DESC, e.user_id").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.LeaderboardItem, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &ent.LeaderboardItem{
+			UserID:   row.UserID,
+			Username: row.Username,
+			Points:   row.Points,
+		})
+	}
+
+	return out, nil
+}
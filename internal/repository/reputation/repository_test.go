@@ -0,0 +1,362 @@
+//go:build integration
+// +build integration
+
+package reputation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/reputation"
+	"test-question/internal/pkg/uow"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	aliceID = "11111111-1111-1111-1111-111111111111"
+	bobID   = "22222222-2222-2222-2222-222222222222"
+	carolID = "44444444-4444-4444-4444-444444444444"
+)
+
+type ReputationRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *ReputationRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("reputation_events", "answers", "questions")
+}
+
+func (s *ReputationRepoInfraSuite) add(userID, actorID string, reason ent.Reason, subject ent.SubjectType, subjectID int) {
+	_, err := s.repo.Add(context.Background(), &ent.Entry{
+		UserID:      userID,
+		ActorID:     actorID,
+		Reason:      reason,
+		Delta:       ent.PointsFor(reason),
+		SubjectType: subject,
+		SubjectID:   subjectID,
+		CreatedAt:   time.Now(),
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ReputationRepoInfraSuite) TestTotalAndHistory() {
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 1)
+	s.add(aliceID, bobID, ent.ReasonAnswerAccepted, ent.SubjectAnswer, 1)
+
+	total, err := s.repo.Total(context.Background(), aliceID)
+	s.Require().NoError(err)
+	s.Equal(25, total)
+
+	history, err := s.repo.ListByUser(context.Background(), aliceID, 10)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Equal(ent.ReasonAnswerAccepted, history[0].Reason)
+}
+
+func (s *ReputationRepoInfraSuite) TestTotals() {
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 1)
+	s.add(aliceID, bobID, ent.ReasonAnswerAccepted, ent.SubjectAnswer, 1)
+	s.add(bobID, aliceID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 2)
+
+	totals, err := s.repo.Totals(context.Background(), []string{aliceID, bobID, "nobody"})
+	s.Require().NoError(err)
+	s.Equal(map[string]int{aliceID: 25, bobID: 10}, totals)
+}
+
+func (s *ReputationRepoInfraSuite) TestReverseIsIdempotent() {
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 1)
+
+	filter := ent.ReverseFilter{
+		SubjectType: ent.SubjectAnswer,
+		SubjectID:   1,
+		ActorID:     bobID,
+	}
+	s.Require().NoError(s.repo.Reverse(context.Background(), filter))
+	s.Require().NoError(s.repo.Reverse(context.Background(), filter))
+
+	total, err := s.repo.Total(context.Background(), aliceID)
+	s.Require().NoError(err)
+	s.Equal(0, total)
+
+	history, err := s.repo.ListByUser(context.Background(), aliceID, 10)
+	s.Require().NoError(err)
+	s.Require().Len(history, 2)
+	s.Equal(history[1].ID, history[0].ReversalOf)
+}
+
+func (s *ReputationRepoInfraSuite) TestReverseByReason() {
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 1)
+	s.add(aliceID, bobID, ent.ReasonAnswerAccepted, ent.SubjectAnswer, 1)
+
+	err := s.repo.Reverse(context.Background(), ent.ReverseFilter{
+		SubjectType: ent.SubjectAnswer,
+		SubjectID:   1,
+		Reasons:     []ent.Reason{ent.ReasonAnswerAccepted},
+	})
+	s.Require().NoError(err)
+
+	total, err := s.repo.Total(context.Background(), aliceID)
+	s.Require().NoError(err)
+	s.Equal(10, total)
+}
+
+func (s *ReputationRepoInfraSuite) TestReverseByQuestion() {
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO questions (id, text, user_id) VALUES (1, 'q', ?)", bobID,
+	).Error)
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO answers (id, question_id, user_id, text) VALUES (5, 1, ?, 'a')", aliceID,
+	).Error)
+
+	s.add(bobID, aliceID, ent.ReasonQuestionUpvoted, ent.SubjectQuestion, 1)
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 5)
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 6)
+
+	s.Require().NoError(s.repo.ReverseByQuestion(context.Background(), 1))
+
+	bobTotal, err := s.repo.Total(context.Background(), bobID)
+	s.Require().NoError(err)
+	s.Equal(0, bobTotal)
+
+	aliceTotal, err := s.repo.Total(context.Background(), aliceID)
+	s.Require().NoError(err)
+	s.Equal(10, aliceTotal)
+}
+
+func (s *ReputationRepoInfraSuite) TestReverseByQuestion_KeepsBounties() {
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO questions (id, text, user_id) VALUES (1, 'q', ?)", bobID,
+	).Error)
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO answers (id, question_id, user_id, text) VALUES (5, 1, ?, 'a')", aliceID,
+	).Error)
+
+	_, err := s.repo.Add(context.Background(), &ent.Entry{
+		UserID: aliceID, ActorID: bobID, Reason: ent.ReasonBountyAwarded, Delta: 50,
+		SubjectType: ent.SubjectAnswer, SubjectID: 5, CreatedAt: time.Now(),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.ReverseByQuestion(context.Background(), 1))
+
+	total, err := s.repo.Total(context.Background(), aliceID)
+	s.Require().NoError(err)
+	s.Equal(50, total)
+}
+
+func (s *ReputationRepoInfraSuite) spend(userID string, points int) error {
+	_, err := s.repo.Spend(context.Background(), &ent.Entry{
+		UserID:      userID,
+		ActorID:     userID,
+		Reason:      ent.ReasonBountyOffered,
+		Delta:       -points,
+		SubjectType: ent.SubjectQuestion,
+		SubjectID:   1,
+		CreatedAt:   time.Now(),
+	})
+	return err
+}
+
+func (s *ReputationRepoInfraSuite) TestSpend() {
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 1)
+	s.add(aliceID, bobID, ent.ReasonAnswerAccepted, ent.SubjectAnswer, 1)
+
+	s.ErrorIs(s.spend(aliceID, 26), ent.ErrInsufficientReputation)
+	s.Require().NoError(s.spend(aliceID, 20))
+	s.ErrorIs(s.spend(aliceID, 6), ent.ErrInsufficientReputation)
+	s.Require().NoError(s.spend(aliceID, 5))
+
+	total, err := s.repo.Total(context.Background(), aliceID)
+	s.Require().NoError(err)
+	s.Equal(0, total)
+}
+
+func (s *ReputationRepoInfraSuite) TestSpend_ConcurrentCannotOverdraw() {
+	s.add(aliceID, bobID, ent.ReasonAnswerAccepted, ent.SubjectAnswer, 1)
+
+	manager := uow.NewGormUoW(s.DB)
+	errs := make(chan error, 4)
+
+	for range 4 {
+		go func() {
+			errs <- manager.Do(context.Background(), func(ctx context.Context) error {
+				_, err := s.repo.Spend(ctx, &ent.Entry{
+					UserID: aliceID, ActorID: aliceID, Reason: ent.ReasonBountyOffered, Delta: -10,
+					SubjectType: ent.SubjectQuestion, SubjectID: 1, CreatedAt: time.Now(),
+				})
+				if err != nil {
+					return err
+				}
+				// Hold the transaction so the others have to wait for it.
+				time.Sleep(20 * time.Millisecond)
+				return nil
+			})
+		}()
+	}
+
+	var failed int
+	for range 4 {
+		if err := <-errs; err != nil {
+			s.Require().ErrorIs(err, ent.ErrInsufficientReputation)
+			failed++
+		}
+	}
+	s.Equal(3, failed)
+
+	total, err := s.repo.Total(context.Background(), aliceID)
+	s.Require().NoError(err)
+	s.Equal(5, total)
+}
+
+func (s *ReputationRepoInfraSuite) TestReverse_ClampedAfterBounty() {
+	ctx := context.Background()
+
+	// alice offers the points of an upvote as a bounty, then loses the upvote
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 1)
+	s.Require().NoError(s.spend(aliceID, 10))
+
+	filter := ent.ReverseFilter{SubjectType: ent.SubjectAnswer, SubjectID: 1, ActorID: bobID}
+	s.Require().NoError(s.repo.Reverse(ctx, filter))
+	s.Require().NoError(s.repo.Reverse(ctx, filter))
+
+	total, err := s.repo.Total(ctx, aliceID)
+	s.Require().NoError(err)
+	s.Equal(0, total)
+
+	history, err := s.repo.ListByUser(ctx, aliceID, 10)
+	s.Require().NoError(err)
+	s.Require().Len(history, 3)
+	s.Equal(0, history[0].Delta)
+	s.Equal(history[2].ID, history[0].ReversalOf)
+}
+
+func (s *ReputationRepoInfraSuite) TestReverseByQuestion_ClampedAfterBounty() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO questions (id, text, user_id) VALUES (1, 'q', ?)", bobID,
+	).Error)
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO answers (id, question_id, user_id, text) VALUES (5, 1, ?, 'a')", aliceID,
+	).Error)
+
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 5)
+	s.add(aliceID, bobID, ent.ReasonAnswerAccepted, ent.SubjectAnswer, 5)
+	s.Require().NoError(s.spend(aliceID, 20))
+
+	var deletedAt time.Time
+	s.Require().NoError(uow.NewGormUoW(s.DB).Do(ctx, func(ctx context.Context) error {
+		if err := uow.GetTx(ctx, s.DB).Exec("UPDATE questions SET deleted_at = NOW() WHERE id = 1").Error; err != nil {
+			return err
+		}
+		if err := s.repo.ReverseByQuestion(ctx, 1); err != nil {
+			return err
+		}
+		return uow.GetTx(ctx, s.DB).Raw("SELECT deleted_at FROM questions WHERE id = 1").Scan(&deletedAt).Error
+	}))
+
+	total, err := s.repo.Total(ctx, aliceID)
+	s.Require().NoError(err)
+	s.Equal(0, total)
+
+	// only the 5 points the reversals took are given back
+	s.Require().NoError(s.repo.ReinstateQuestion(ctx, 1, deletedAt))
+
+	total, err = s.repo.Total(ctx, aliceID)
+	s.Require().NoError(err)
+	s.Equal(5, total)
+}
+
+func (s *ReputationRepoInfraSuite) TestAdd_DebitClampedAtBalance() {
+	ctx := context.Background()
+
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 1)
+
+	got, err := s.repo.Add(ctx, &ent.Entry{
+		UserID: aliceID, ActorID: bobID, Reason: ent.ReasonAnswerDownvoted, Delta: -15,
+		SubjectType: ent.SubjectAnswer, SubjectID: 1, CreatedAt: time.Now(),
+	})
+	s.Require().NoError(err)
+	s.Equal(-10, got.Delta)
+
+	total, err := s.repo.Total(ctx, aliceID)
+	s.Require().NoError(err)
+	s.Equal(0, total)
+}
+
+func (s *ReputationRepoInfraSuite) TestReinstateQuestion() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO questions (id, text, user_id) VALUES (1, 'q', ?)", bobID,
+	).Error)
+	s.Require().NoError(s.DB.Exec(
+		"INSERT INTO answers (id, question_id, user_id, text) VALUES (5, 1, ?, 'a')", aliceID,
+	).Error)
+
+	s.add(bobID, aliceID, ent.ReasonQuestionUpvoted, ent.SubjectQuestion, 1)
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 5)
+
+	// a vote retracted before the deletion stays retracted
+	s.add(bobID, carolID, ent.ReasonQuestionUpvoted, ent.SubjectQuestion, 1)
+	s.Require().NoError(s.repo.Reverse(ctx, ent.ReverseFilter{
+		SubjectType: ent.SubjectQuestion, SubjectID: 1, ActorID: carolID,
+	}))
+
+	// the deletion: trash and reverse in one transaction
+	var deletedAt time.Time
+	s.Require().NoError(uow.NewGormUoW(s.DB).Do(ctx, func(ctx context.Context) error {
+		if err := uow.GetTx(ctx, s.DB).Exec("UPDATE questions SET deleted_at = NOW() WHERE id = 1").Error; err != nil {
+			return err
+		}
+		if err := s.repo.ReverseByQuestion(ctx, 1); err != nil {
+			return err
+		}
+		return uow.GetTx(ctx, s.DB).Raw("SELECT deleted_at FROM questions WHERE id = 1").Scan(&deletedAt).Error
+	}))
+
+	bobTotal, err := s.repo.Total(ctx, bobID)
+	s.Require().NoError(err)
+	s.Equal(0, bobTotal)
+
+	s.Require().NoError(s.repo.ReinstateQuestion(ctx, 1, deletedAt))
+
+	bobTotal, err = s.repo.Total(ctx, bobID)
+	s.Require().NoError(err)
+	s.Equal(5, bobTotal)
+
+	aliceTotal, err := s.repo.Total(ctx, aliceID)
+	s.Require().NoError(err)
+	s.Equal(10, aliceTotal)
+}
+
+func (s *ReputationRepoInfraSuite) TestLeaderboard() {
+	s.add(aliceID, bobID, ent.ReasonAnswerUpvoted, ent.SubjectAnswer, 1)
+	s.add(bobID, aliceID, ent.ReasonQuestionUpvoted, ent.SubjectQuestion, 2)
+
+	s.Require().NoError(s.DB.Exec(
+		"UPDATE reputation_events SET created_at = NOW() - INTERVAL '30 days' WHERE user_id = ?", aliceID,
+	).Error)
+
+	all, err := s.repo.Leaderboard(context.Background(), time.Time{}, 10)
+	s.Require().NoError(err)
+	s.Require().Len(all, 2)
+	s.Equal(aliceID, all[0].UserID)
+	s.Equal("alice", all[0].Username)
+	s.Equal(10, all[0].Points)
+
+	week, err := s.repo.Leaderboard(context.Background(), time.Now().AddDate(0, 0, -7), 10)
+	s.Require().NoError(err)
+	s.Require().Len(week, 1)
+	s.Equal(bobID, week[0].UserID)
+}
+
+func TestReputationRepoInfraSuite(t *testing.T) {
+	s := &ReputationRepoInfraSuite{}
+	suite.Run(t, s)
+}
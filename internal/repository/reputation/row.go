@@ -0,0 +1,70 @@
+package reputation
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/reputation"
+)
+
+type entryRow struct {
+	ID          int64     `gorm:"primaryKey;column:id"`
+	UserID      string    `gorm:"column:user_id;type:text;not null"`
+	ActorID     string    `gorm:"column:actor_id;type:text;not null"`
+	Reason      string    `gorm:"column:reason;type:varchar(32);not null"`
+	Delta       int       `gorm:"column:delta;not null"`
+	SubjectType string    `gorm:"column:subject_type;type:varchar(16);not null"`
+	SubjectID   int64     `gorm:"column:subject_id;not null"`
+	ReversalOf  *int64    `gorm:"column:reversal_of"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (entryRow) TableName() string {
+	return "reputation_events"
+}
+
+type leaderboardRow struct {
+	UserID   string `gorm:"column:user_id"`
+	Username string `gorm:"column:username"`
+	Points   int    `gorm:"column:points"`
+}
+
+func toEntityEntry(r *entryRow) *ent.Entry {
+	if r == nil {
+		return nil
+	}
+	out := &ent.Entry{
+		ID:          int(r.ID),
+		UserID:      r.UserID,
+		ActorID:     r.ActorID,
+		Reason:      ent.Reason(r.Reason),
+		Delta:       r.Delta,
+		SubjectType: ent.SubjectType(r.SubjectType),
+		SubjectID:   int(r.SubjectID),
+		CreatedAt:   r.CreatedAt,
+	}
+	if r.ReversalOf != nil {
+		out.ReversalOf = int(*r.ReversalOf)
+	}
+	return out
+}
+
+func fromEntityEntry(e *ent.Entry) *entryRow {
+	if e == nil {
+		return nil
+	}
+	row := &entryRow{
+		ID:          int64(e.ID),
+		UserID:      e.UserID,
+		ActorID:     e.ActorID,
+		Reason:      string(e.Reason),
+		Delta:       e.Delta,
+		SubjectType: string(e.SubjectType),
+		SubjectID:   int64(e.SubjectID),
+		CreatedAt:   e.CreatedAt,
+	}
+	if e.ReversalOf != 0 {
+		reversalOf := int64(e.ReversalOf)
+		row.ReversalOf = &reversalOf
+	}
+	return row
+}
@@ -0,0 +1,80 @@
+package reputation
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/reputation"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntryConverters(t *testing.T) {
+	now := time.Now()
+	reversalOf := int64(3)
+
+	tests := []struct {
+		name   string
+		row    *entryRow
+		entity *ent.Entry
+	}{
+		{
+			name: "entry",
+			row: &entryRow{
+				ID:          1,
+				UserID:      "u1",
+				ActorID:     "u2",
+				Reason:      "answer_upvoted",
+				Delta:       10,
+				SubjectType: "answer",
+				SubjectID:   7,
+				CreatedAt:   now,
+			},
+			entity: &ent.Entry{
+				ID:          1,
+				UserID:      "u1",
+				ActorID:     "u2",
+				Reason:      ent.ReasonAnswerUpvoted,
+				Delta:       10,
+				SubjectType: ent.SubjectAnswer,
+				SubjectID:   7,
+				CreatedAt:   now,
+			},
+		},
+		{
+			name: "reversal",
+			row: &entryRow{
+				ID:          4,
+				UserID:      "u1",
+				ActorID:     "u2",
+				Reason:      "answer_upvoted",
+				Delta:       -10,
+				SubjectType: "answer",
+				SubjectID:   7,
+				ReversalOf:  &reversalOf,
+				CreatedAt:   now,
+			},
+			entity: &ent.Entry{
+				ID:          4,
+				UserID:      "u1",
+				ActorID:     "u2",
+				Reason:      ent.ReasonAnswerUpvoted,
+				Delta:       -10,
+				SubjectType: ent.SubjectAnswer,
+				SubjectID:   7,
+				ReversalOf:  3,
+				CreatedAt:   now,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.entity, toEntityEntry(tt.row))
+			require.Equal(t, tt.row, fromEntityEntry(tt.entity))
+		})
+	}
+
+	require.Nil(t, toEntityEntry(nil))
+	require.Nil(t, fromEntityEntry(nil))
+}
@@ -0,0 +1,100 @@
+package stream
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	ent "test-question/internal/entity/stream"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// appendLock serializes appends until they commit, so events become visible
+// in the order of their ids and readers can page by id without missing any.
+const appendLock = 0x73747265616d // "stream"
+
+// Append adds an event to the log inside the caller's transaction and
+// notifies ent.Channel with its id.
+func (r *Repository) Append(ctx context.Context, topic, typ string, data []byte) (int64, error) {
+	row := &eventRow{Topic: topic, Type: typ, Data: string(data)}
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?)", appendLock).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(row).Error; err != nil {
+			return err
+		}
+		// delivered on commit, once the event can be read
+		return tx.Exec("SELECT pg_notify(?, ?)", ent.Channel, strconv.FormatInt(row.ID, 10)).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return row.ID, nil
+}
+
+// ListAfter returns up to limit events appended after afterID, oldest first.
+func (r *Repository) ListAfter(ctx context.Context, afterID int64, limit int) ([]*ent.Published, error) {
+	var rows []eventRow
+
+	err := r.db.WithContext(ctx).
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Published, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityPublished(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// ListLatest returns the last n events, oldest first.
+func (r *Repository) ListLatest(ctx context.Context, n int) ([]*ent.Published, error) {
+	var rows []eventRow
+
+	latest := r.db.Model(&eventRow{}).Order("id DESC").Limit(n)
+	err := r.db.WithContext(ctx).
+		Table("(?) AS stream_events", latest).
+		Order("id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Published, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityPublished(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// DeleteBefore drops events appended before t and returns how many.
+func (r *Repository) DeleteBefore(ctx context.Context, t time.Time) (int, error) {
+	res := r.db.WithContext(ctx).
+		Where("created_at < ?", t).
+		Delete(&eventRow{})
+	if res.Error != nil {
+		return 0, res.Error
+	}
+
+	return int(res.RowsAffected), nil
+}
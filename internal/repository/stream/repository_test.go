@@ -0,0 +1,92 @@
+//go:build integration
+// +build integration
+
+package stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/stream"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type StreamRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *StreamRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("stream_events")
+}
+
+func (s *StreamRepoInfraSuite) TestAppend_ListAfter() {
+	ctx := context.Background()
+
+	first, err := s.repo.Append(ctx, "question:1", ent.TypeAnswerCreated, []byte(`{"id":1}`))
+	s.Require().NoError(err)
+	second, err := s.repo.Append(ctx, "question:2", ent.TypeAnswerDeleted, []byte(`{"id":2}`))
+	s.Require().NoError(err)
+	s.Greater(second, first)
+
+	events, err := s.repo.ListAfter(ctx, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Equal("question:1", events[0].Topic)
+	s.Equal(ent.Event{ID: first, Type: ent.TypeAnswerCreated, Data: []byte(`{"id":1}`)}, events[0].Event)
+
+	events, err = s.repo.ListAfter(ctx, first, 10)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Equal(second, events[0].Event.ID)
+
+	events, err = s.repo.ListAfter(ctx, 0, 1)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Equal(first, events[0].Event.ID)
+}
+
+func (s *StreamRepoInfraSuite) TestListLatest() {
+	ctx := context.Background()
+
+	var ids []int64
+	for range 3 {
+		id, err := s.repo.Append(ctx, "question:1", ent.TypeAnswerCreated, []byte(`{}`))
+		s.Require().NoError(err)
+		ids = append(ids, id)
+	}
+
+	events, err := s.repo.ListLatest(ctx, 2)
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Equal(ids[1], events[0].Event.ID)
+	s.Equal(ids[2], events[1].Event.ID)
+}
+
+func (s *StreamRepoInfraSuite) TestDeleteBefore() {
+	ctx := context.Background()
+
+	_, err := s.repo.Append(ctx, "question:1", ent.TypeAnswerCreated, []byte(`{}`))
+	s.Require().NoError(err)
+	s.Require().NoError(s.DB.Exec("UPDATE stream_events SET created_at = NOW() - INTERVAL '2 hours'").Error)
+	kept, err := s.repo.Append(ctx, "question:1", ent.TypeAnswerCreated, []byte(`{}`))
+	s.Require().NoError(err)
+
+	n, err := s.repo.DeleteBefore(ctx, time.Now().Add(-time.Hour))
+	s.Require().NoError(err)
+	s.Equal(1, n)
+
+	events, err := s.repo.ListAfter(ctx, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(events, 1)
+	s.Equal(kept, events[0].Event.ID)
+}
+
+func TestStreamRepoInfraSuite(t *testing.T) {
+	s := &StreamRepoInfraSuite{}
+	suite.Run(t, s)
+}
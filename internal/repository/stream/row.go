@@ -0,0 +1,30 @@
+package stream
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/stream"
+)
+
+type eventRow struct {
+	ID        int64     `gorm:"primaryKey;column:id"`
+	Topic     string    `gorm:"column:topic;type:text;not null"`
+	Type      string    `gorm:"column:type;type:varchar(64);not null"`
+	Data      string    `gorm:"column:data;type:text;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (eventRow) TableName() string {
+	return "stream_events"
+}
+
+func toEntityPublished(r *eventRow) *ent.Published {
+	if r == nil {
+		return nil
+	}
+
+	return &ent.Published{
+		Topic: r.Topic,
+		Event: ent.Event{ID: r.ID, Type: r.Type, Data: []byte(r.Data)},
+	}
+}
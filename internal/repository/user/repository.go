@@ -47,3 +47,58 @@ func (r *Repository) GetUserByUsernamePassword(ctx context.Context, username, pa
 
 	return toEntityUser(&row), nil
 }
+
+func (r *Repository) GetByID(ctx context.Context, id string) (*ent.User, error) {
+	var row userRow
+
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&row).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return toEntityUser(&row), nil
+}
+
+// ListByUsernames returns the users with the given usernames; unknown
+// names are skipped.
+func (r *Repository) ListByUsernames(ctx context.Context, usernames []string) ([]*ent.User, error) {
+	out := make([]*ent.User, 0, len(usernames))
+	if len(usernames) == 0 {
+		return out, nil
+	}
+
+	var rows []userRow
+	err := r.db.WithContext(ctx).Where("username IN ?", usernames).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		out = append(out, toEntityUser(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// ListByIDs returns the users with the given IDs; unknown IDs are skipped.
+func (r *Repository) ListByIDs(ctx context.Context, ids []string) ([]*ent.User, error) {
+	out := make([]*ent.User, 0, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	var rows []userRow
+	err := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rows {
+		out = append(out, toEntityUser(&rows[i]))
+	}
+
+	return out, nil
+}
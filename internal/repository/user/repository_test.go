@@ -70,6 +70,62 @@ func (s *UserRepoInfraSuite) TestGetUserByUsernamePassword_NotFound() {
 	s.ErrorIs(err, ErrUserNotFound)
 }
 
+func (s *UserRepoInfraSuite) TestGetByID() {
+	row := &userRow{
+		ID:        "33333333-3333-3333-3333-333333333333",
+		Username:  "kate",
+		Password:  "secret",
+		CreatedAt: time.Now(),
+	}
+	s.Require().NoError(s.DB.Create(row).Error)
+
+	out, err := s.repo.GetByID(context.Background(), row.ID)
+	s.Require().NoError(err)
+	s.Equal("kate", out.Username)
+
+	_, err = s.repo.GetByID(context.Background(), "44444444-4444-4444-4444-444444444444")
+	s.ErrorIs(err, ErrUserNotFound)
+}
+
+func (s *UserRepoInfraSuite) TestListByUsernames() {
+	for _, row := range []*userRow{
+		{ID: "55555555-5555-5555-5555-555555555555", Username: "ann", Password: "x", CreatedAt: time.Now()},
+		{ID: "66666666-6666-6666-6666-666666666666", Username: "ben", Password: "x", CreatedAt: time.Now()},
+	} {
+		s.Require().NoError(s.DB.Create(row).Error)
+	}
+
+	out, err := s.repo.ListByUsernames(context.Background(), []string{"ann", "ghost", "Ben"})
+	s.Require().NoError(err)
+	s.Require().Len(out, 1, "unknown and differently cased names are skipped")
+	s.Equal("55555555-5555-5555-5555-555555555555", out[0].ID)
+
+	out, err = s.repo.ListByUsernames(context.Background(), nil)
+	s.Require().NoError(err)
+	s.Empty(out)
+}
+
+func (s *UserRepoInfraSuite) TestListByIDs() {
+	for _, row := range []*userRow{
+		{ID: "55555555-5555-5555-5555-555555555555", Username: "ann", Password: "x", CreatedAt: time.Now()},
+		{ID: "66666666-6666-6666-6666-666666666666", Username: "ben", Password: "x", CreatedAt: time.Now()},
+	} {
+		s.Require().NoError(s.DB.Create(row).Error)
+	}
+
+	out, err := s.repo.ListByIDs(context.Background(), []string{
+		"55555555-5555-5555-5555-555555555555",
+		"77777777-7777-7777-7777-777777777777",
+	})
+	s.Require().NoError(err)
+	s.Require().Len(out, 1, "unknown IDs are skipped")
+	s.Equal("ann", out[0].Username)
+
+	out, err = s.repo.ListByIDs(context.Background(), nil)
+	s.Require().NoError(err)
+	s.Empty(out)
+}
+
 func TestUserRepoInfraSuite(t *testing.T) {
 	s := &UserRepoInfraSuite{}
 	suite.Run(t, s)
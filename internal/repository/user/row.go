@@ -12,6 +12,7 @@ type userRow struct {
 	ID        string         `gorm:"primaryKey;column:id"`
 	Username  string         `gorm:"column:username;unique"`
 	Password  string         `gorm:"column:password"`
+	Role      string         `gorm:"column:role;default:user"`
 	CreatedAt time.Time      `gorm:"column:created_at;autoCreateTime"`
 	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;index"`
 }
@@ -29,6 +30,7 @@ func toEntityUser(r *userRow) *ent.User {
 		ID:        r.ID,
 		Username:  r.Username,
 		Password:  r.Password,
+		Role:      ent.Role(r.Role),
 		CreatedAt: r.CreatedAt,
 	}
 }
@@ -42,6 +44,7 @@ func fromEntityUser(e *ent.User) *userRow {
 		ID:        e.ID,
 		Username:  e.Username,
 		Password:  e.Password,
+		Role:      string(e.Role),
 		CreatedAt: e.CreatedAt,
 	}
 }
@@ -16,6 +16,7 @@ func Test_toEntityUser(t *testing.T) {
 		ID:        "uuid-1",
 		Username:  "test",
 		Password:  "pass",
+		Role:      "admin",
 		CreatedAt: now,
 	}
 
@@ -24,6 +25,7 @@ func Test_toEntityUser(t *testing.T) {
 	require.Equal(t, "uuid-1", u.ID)
 	require.Equal(t, "test", u.Username)
 	require.Equal(t, "pass", u.Password)
+	require.Equal(t, ent.RoleAdmin, u.Role)
 	require.Equal(t, now, u.CreatedAt)
 }
 
@@ -38,6 +40,7 @@ func Test_fromEntityUser(t *testing.T) {
 		ID:        "uuid-2",
 		Username:  "hello",
 		Password:  "123",
+		Role:      ent.RoleUser,
 		CreatedAt: now,
 	}
 
@@ -46,6 +49,7 @@ func Test_fromEntityUser(t *testing.T) {
 	require.Equal(t, "uuid-2", row.ID)
 	require.Equal(t, "hello", row.Username)
 	require.Equal(t, "123", row.Password)
+	require.Equal(t, "user", row.Role)
 	require.Equal(t, now, row.CreatedAt)
 }
 
@@ -0,0 +1,53 @@
+package vote
+
+import (
+	"context"
+	"errors"
+
+	ent "test-question/internal/entity/vote"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) Get(ctx context.Context, userID string, targetType ent.TargetType, targetID int) (*ent.Vote, error) {
+	var row voteRow
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Where("user_id = ? AND target_type = ? AND target_id = ?", userID, targetType, targetID).
+		First(&row).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ent.ErrVoteNotFound
+		}
+		return nil, err
+	}
+
+	return toEntityVote(&row), nil
+}
+
+func (r *Repository) Upsert(ctx context.Context, v *ent.Vote) error {
+	row := fromEntityVote(v)
+
+	return uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}, {Name: "target_type"}, {Name: "target_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "created_at"}),
+		}).
+		Create(row).Error
+}
+
+func (r *Repository) Delete(ctx context.Context, userID string, targetType ent.TargetType, targetID int) error {
+	return uow.GetTx(ctx, r.db).WithContext(ctx).
+		Where("user_id = ? AND target_type = ? AND target_id = ?", userID, targetType, targetID).
+		Delete(&voteRow{}).Error
+}
@@ -0,0 +1,71 @@
+//go:build integration
+// +build integration
+
+package vote
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/vote"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type VoteRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *VoteRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("votes")
+}
+
+func (s *VoteRepoInfraSuite) TestUpsertAndGet() {
+	ctx := context.Background()
+
+	v := &ent.Vote{
+		UserID:     "u1",
+		TargetType: ent.TargetAnswer,
+		TargetID:   10,
+		Value:      ent.Up,
+		CreatedAt:  time.Now(),
+	}
+	s.Require().NoError(s.repo.Upsert(ctx, v))
+
+	v.Value = ent.Down
+	s.Require().NoError(s.repo.Upsert(ctx, v))
+
+	out, err := s.repo.Get(ctx, "u1", ent.TargetAnswer, 10)
+	s.Require().NoError(err)
+	s.Equal(ent.Down, out.Value)
+
+	var count int64
+	s.Require().NoError(s.DB.Model(&voteRow{}).Count(&count).Error)
+	s.Equal(int64(1), count)
+}
+
+func (s *VoteRepoInfraSuite) TestDelete() {
+	ctx := context.Background()
+
+	s.Require().NoError(s.repo.Upsert(ctx, &ent.Vote{
+		UserID:     "u1",
+		TargetType: ent.TargetQuestion,
+		TargetID:   3,
+		Value:      ent.Up,
+		CreatedAt:  time.Now(),
+	}))
+
+	s.Require().NoError(s.repo.Delete(ctx, "u1", ent.TargetQuestion, 3))
+
+	_, err := s.repo.Get(ctx, "u1", ent.TargetQuestion, 3)
+	s.ErrorIs(err, ent.ErrVoteNotFound)
+}
+
+func TestVoteRepoInfraSuite(t *testing.T) {
+	s := &VoteRepoInfraSuite{}
+	suite.Run(t, s)
+}
@@ -0,0 +1,46 @@
+package vote
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/vote"
+)
+
+type voteRow struct {
+	ID         int64     `gorm:"primaryKey;column:id"`
+	UserID     string    `gorm:"column:user_id;type:text;not null"`
+	TargetType string    `gorm:"column:target_type;type:varchar(16);not null"`
+	TargetID   int64     `gorm:"column:target_id;not null"`
+	Value      int       `gorm:"column:value;not null"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (voteRow) TableName() string {
+	return "votes"
+}
+
+func toEntityVote(r *voteRow) *ent.Vote {
+	if r == nil {
+		return nil
+	}
+	return &ent.Vote{
+		UserID:     r.UserID,
+		TargetType: ent.TargetType(r.TargetType),
+		TargetID:   int(r.TargetID),
+		Value:      r.Value,
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+func fromEntityVote(e *ent.Vote) *voteRow {
+	if e == nil {
+		return nil
+	}
+	return &voteRow{
+		UserID:     e.UserID,
+		TargetType: string(e.TargetType),
+		TargetID:   int64(e.TargetID),
+		Value:      e.Value,
+		CreatedAt:  e.CreatedAt,
+	}
+}
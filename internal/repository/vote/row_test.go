@@ -0,0 +1,35 @@
+package vote
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/vote"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteConverters(t *testing.T) {
+	now := time.Now()
+
+	row := &voteRow{
+		UserID:     "u1",
+		TargetType: "answer",
+		TargetID:   5,
+		Value:      1,
+		CreatedAt:  now,
+	}
+	entity := &ent.Vote{
+		UserID:     "u1",
+		TargetType: ent.TargetAnswer,
+		TargetID:   5,
+		Value:      ent.Up,
+		CreatedAt:  now,
+	}
+
+	require.Equal(t, entity, toEntityVote(row))
+	require.Equal(t, row, fromEntityVote(entity))
+
+	require.Nil(t, toEntityVote(nil))
+	require.Nil(t, fromEntityVote(nil))
+}
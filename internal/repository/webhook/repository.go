@@ -0,0 +1,179 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ent "test-question/internal/entity/webhook"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+func (r *Repository) CreateSubscription(ctx context.Context, s *ent.Subscription) (*ent.Subscription, error) {
+	row := fromEntitySubscription(s)
+
+	if err := r.db.WithContext(ctx).Create(row).Error; err != nil {
+		return nil, err
+	}
+
+	return toEntitySubscription(row), nil
+}
+
+func (r *Repository) GetSubscription(ctx context.Context, id int) (*ent.Subscription, error) {
+	var row subscriptionRow
+
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&row).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ent.ErrSubscriptionNotFound
+		}
+		return nil, err
+	}
+
+	return toEntitySubscription(&row), nil
+}
+
+func (r *Repository) ListSubscriptions(ctx context.Context) ([]*ent.Subscription, error) {
+	var rows []subscriptionRow
+
+	if err := r.db.WithContext(ctx).Order("id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Subscription, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntitySubscription(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// ListSubscriptionsByEvent returns the active subscriptions whose filter contains e.
+func (r *Repository) ListSubscriptionsByEvent(ctx context.Context, e ent.Event) ([]*ent.Subscription, error) {
+	var rows []subscriptionRow
+
+	err := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Where("active AND ? = ANY(string_to_array(events, ?))", string(e), eventsSeparator).
+		Order("id").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Subscription, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntitySubscription(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// DeleteSubscription removes the subscription together with its deliveries.
+func (r *Repository) DeleteSubscription(ctx context.Context, id int) error {
+	res := r.db.WithContext(ctx).Where("id = ?", id).Delete(&subscriptionRow{})
+	if res.Error != nil {
+		return res.Error
+	}
+
+	if res.RowsAffected == 0 {
+		return ent.ErrSubscriptionNotFound
+	}
+
+	return nil
+}
+
+func (r *Repository) EnqueueDeliveries(ctx context.Context, ds []*ent.Delivery) error {
+	if len(ds) == 0 {
+		return nil
+	}
+
+	rows := make([]*deliveryRow, 0, len(ds))
+	for _, d := range ds {
+		rows = append(rows, fromEntityDelivery(d))
+	}
+
+	return uow.GetTx(ctx, r.db).WithContext(ctx).Create(&rows).Error
+}
+
+// ClaimDueDeliveries picks up to limit pending deliveries that are due at now
+// and leases them until leaseUntil, so concurrent workers skip them while they
+// are being sent. A worker that dies mid-send simply lets the lease expire.
+func (r *Repository) ClaimDueDeliveries(
+	ctx context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	limit int,
+) ([]*ent.Delivery, error) {
+	var rows []deliveryRow
+
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE webhook_deliveries
+		SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		leaseUntil, string(ent.StatusPending), now, limit,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Delivery, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityDelivery(&rows[i]))
+	}
+
+	return out, nil
+}
+
+// SaveDeliveryAttempt stores the outcome of the latest attempt of d.
+func (r *Repository) SaveDeliveryAttempt(ctx context.Context, d *ent.Delivery) error {
+	row := fromEntityDelivery(d)
+
+	return r.db.WithContext(ctx).
+		Model(&deliveryRow{}).
+		Where("id = ?", d.ID).
+		Updates(map[string]any{
+			"status":           row.Status,
+			"attempts":         row.Attempts,
+			"next_attempt_at":  row.NextAttemptAt,
+			"last_status_code": row.LastStatusCode,
+			"last_error":       row.LastError,
+			"delivered_at":     row.DeliveredAt,
+		}).Error
+}
+
+func (r *Repository) ListDeliveries(ctx context.Context, subscriptionID int, limit int) ([]*ent.Delivery, error) {
+	var rows []deliveryRow
+
+	err := r.db.WithContext(ctx).
+		Where("subscription_id = ?", subscriptionID).
+		Order("id DESC").
+		Limit(limit).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Delivery, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityDelivery(&rows[i]))
+	}
+
+	return out, nil
+}
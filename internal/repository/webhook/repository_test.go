@@ -0,0 +1,117 @@
+//go:build integration
+// +build integration
+
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/webhook"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WebhookRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *WebhookRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("webhook_subscriptions", "webhook_deliveries")
+}
+
+func (s *WebhookRepoInfraSuite) createSubscription(active bool, events ...ent.Event) *ent.Subscription {
+	sub, err := s.repo.CreateSubscription(context.Background(), &ent.Subscription{
+		URL:       "https://hooks.example.com",
+		Events:    events,
+		Secret:    "s3cr3t",
+		Active:    active,
+		CreatedBy: "admin",
+	})
+	s.Require().NoError(err)
+	return sub
+}
+
+func (s *WebhookRepoInfraSuite) TestListSubscriptionsByEvent() {
+	ctx := context.Background()
+
+	a := s.createSubscription(true, ent.EventQuestionCreated, ent.EventAnswerCreated)
+	s.createSubscription(true, ent.EventQuestionDeleted)
+	s.createSubscription(false, ent.EventAnswerCreated)
+
+	subs, err := s.repo.ListSubscriptionsByEvent(ctx, ent.EventAnswerCreated)
+	s.Require().NoError(err)
+	s.Require().Len(subs, 1)
+	s.Equal(a.ID, subs[0].ID)
+}
+
+func (s *WebhookRepoInfraSuite) TestDeleteSubscription() {
+	ctx := context.Background()
+
+	sub := s.createSubscription(true, ent.EventQuestionCreated)
+
+	s.Require().NoError(s.repo.DeleteSubscription(ctx, sub.ID))
+	s.ErrorIs(s.repo.DeleteSubscription(ctx, sub.ID), ent.ErrSubscriptionNotFound)
+
+	_, err := s.repo.GetSubscription(ctx, sub.ID)
+	s.ErrorIs(err, ent.ErrSubscriptionNotFound)
+}
+
+func (s *WebhookRepoInfraSuite) TestClaimDueDeliveries() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	sub := s.createSubscription(true, ent.EventQuestionCreated)
+
+	s.Require().NoError(s.repo.EnqueueDeliveries(ctx, []*ent.Delivery{
+		{SubscriptionID: sub.ID, Event: ent.EventQuestionCreated, Payload: []byte(`{}`), Status: ent.StatusPending, NextAttemptAt: now.Add(-time.Minute)},
+		{SubscriptionID: sub.ID, Event: ent.EventQuestionCreated, Payload: []byte(`{}`), Status: ent.StatusPending, NextAttemptAt: now.Add(time.Minute)},
+		{SubscriptionID: sub.ID, Event: ent.EventQuestionCreated, Payload: []byte(`{}`), Status: ent.StatusDead, NextAttemptAt: now.Add(-time.Minute)},
+	}))
+
+	claimed, err := s.repo.ClaimDueDeliveries(ctx, now, now.Add(time.Minute), 10)
+	s.Require().NoError(err)
+	s.Require().Len(claimed, 1)
+	s.Equal(1, claimed[0].ID)
+
+	// leased: a second worker gets nothing
+	claimed, err = s.repo.ClaimDueDeliveries(ctx, now, now.Add(time.Minute), 10)
+	s.Require().NoError(err)
+	s.Empty(claimed)
+}
+
+func (s *WebhookRepoInfraSuite) TestSaveDeliveryAttempt() {
+	ctx := context.Background()
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	sub := s.createSubscription(true, ent.EventQuestionCreated)
+	s.Require().NoError(s.repo.EnqueueDeliveries(ctx, []*ent.Delivery{
+		{SubscriptionID: sub.ID, Event: ent.EventQuestionCreated, Payload: []byte(`{}`), Status: ent.StatusPending, NextAttemptAt: now},
+	}))
+
+	s.Require().NoError(s.repo.SaveDeliveryAttempt(ctx, &ent.Delivery{
+		ID:             1,
+		Status:         ent.StatusDead,
+		Attempts:       5,
+		NextAttemptAt:  now,
+		LastStatusCode: 500,
+		LastError:      "unexpected status 500",
+	}))
+
+	list, err := s.repo.ListDeliveries(ctx, sub.ID, 10)
+	s.Require().NoError(err)
+	s.Require().Len(list, 1)
+	s.Equal(ent.StatusDead, list[0].Status)
+	s.Equal(5, list[0].Attempts)
+	s.Equal(500, list[0].LastStatusCode)
+	s.Equal("unexpected status 500", list[0].LastError)
+}
+
+func TestWebhookRepoInfraSuite(t *testing.T) {
+	s := &WebhookRepoInfraSuite{}
+	suite.Run(t, s)
+}
@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"strings"
+	"time"
+
+	ent "test-question/internal/entity/webhook"
+)
+
+type subscriptionRow struct {
+	ID        int64     `gorm:"primaryKey;column:id"`
+	URL       string    `gorm:"column:url;type:text;not null"`
+	Events    string    `gorm:"column:events;type:text;not null"`
+	Secret    string    `gorm:"column:secret;type:text;not null"`
+	Active    bool      `gorm:"column:active;not null"`
+	CreatedBy string    `gorm:"column:created_by;type:text;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (subscriptionRow) TableName() string {
+	return "webhook_subscriptions"
+}
+
+type deliveryRow struct {
+	ID             int64      `gorm:"primaryKey;column:id"`
+	SubscriptionID int64      `gorm:"column:subscription_id;not null"`
+	Event          string     `gorm:"column:event;type:varchar(32);not null"`
+	Payload        string     `gorm:"column:payload;type:text;not null"`
+	Status         string     `gorm:"column:status;type:varchar(16);not null"`
+	Attempts       int        `gorm:"column:attempts;not null"`
+	NextAttemptAt  time.Time  `gorm:"column:next_attempt_at;not null"`
+	LastStatusCode *int       `gorm:"column:last_status_code"`
+	LastError      *string    `gorm:"column:last_error"`
+	DeliveredAt    *time.Time `gorm:"column:delivered_at"`
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (deliveryRow) TableName() string {
+	return "webhook_deliveries"
+}
+
+// events are stored as a comma-separated list, e.g. "question.created,answer.created".
+const eventsSeparator = ","
+
+func toEntitySubscription(r *subscriptionRow) *ent.Subscription {
+	if r == nil {
+		return nil
+	}
+
+	events := make([]ent.Event, 0)
+	for _, e := range strings.Split(r.Events, eventsSeparator) {
+		if e != "" {
+			events = append(events, ent.Event(e))
+		}
+	}
+
+	return &ent.Subscription{
+		ID:        int(r.ID),
+		URL:       r.URL,
+		Events:    events,
+		Secret:    r.Secret,
+		Active:    r.Active,
+		CreatedBy: r.CreatedBy,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func fromEntitySubscription(e *ent.Subscription) *subscriptionRow {
+	if e == nil {
+		return nil
+	}
+
+	events := make([]string, len(e.Events))
+	for i, ev := range e.Events {
+		events[i] = string(ev)
+	}
+
+	return &subscriptionRow{
+		ID:        int64(e.ID),
+		URL:       e.URL,
+		Events:    strings.Join(events, eventsSeparator),
+		Secret:    e.Secret,
+		Active:    e.Active,
+		CreatedBy: e.CreatedBy,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func toEntityDelivery(r *deliveryRow) *ent.Delivery {
+	if r == nil {
+		return nil
+	}
+
+	out := &ent.Delivery{
+		ID:             int(r.ID),
+		SubscriptionID: int(r.SubscriptionID),
+		Event:          ent.Event(r.Event),
+		Payload:        []byte(r.Payload),
+		Status:         ent.DeliveryStatus(r.Status),
+		Attempts:       r.Attempts,
+		NextAttemptAt:  r.NextAttemptAt,
+		DeliveredAt:    r.DeliveredAt,
+		CreatedAt:      r.CreatedAt,
+	}
+	if r.LastStatusCode != nil {
+		out.LastStatusCode = *r.LastStatusCode
+	}
+	if r.LastError != nil {
+		out.LastError = *r.LastError
+	}
+	return out
+}
+
+func fromEntityDelivery(e *ent.Delivery) *deliveryRow {
+	if e == nil {
+		return nil
+	}
+
+	row := &deliveryRow{
+		ID:             int64(e.ID),
+		SubscriptionID: int64(e.SubscriptionID),
+		Event:          string(e.Event),
+		Payload:        string(e.Payload),
+		Status:         string(e.Status),
+		Attempts:       e.Attempts,
+		NextAttemptAt:  e.NextAttemptAt,
+		DeliveredAt:    e.DeliveredAt,
+		CreatedAt:      e.CreatedAt,
+	}
+	if e.LastStatusCode != 0 {
+		row.LastStatusCode = &e.LastStatusCode
+	}
+	if e.LastError != "" {
+		row.LastError = &e.LastError
+	}
+	return row
+}
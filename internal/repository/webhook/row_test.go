@@ -0,0 +1,101 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/webhook"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_toEntitySubscription(t *testing.T) {
+	now := time.Now()
+
+	s := toEntitySubscription(&subscriptionRow{
+		ID:        3,
+		URL:       "https://hooks.example.com/qa",
+		Events:    "question.created,answer.deleted",
+		Secret:    "s3cr3t",
+		Active:    true,
+		CreatedBy: "admin",
+		CreatedAt: now,
+	})
+	require.NotNil(t, s)
+	require.Equal(t, 3, s.ID)
+	require.Equal(t, []ent.Event{ent.EventQuestionCreated, ent.EventAnswerDeleted}, s.Events)
+	require.Equal(t, "s3cr3t", s.Secret)
+	require.True(t, s.Active)
+	require.Equal(t, now, s.CreatedAt)
+}
+
+func Test_toEntitySubscription_nil(t *testing.T) {
+	require.Nil(t, toEntitySubscription(nil))
+}
+
+func Test_fromEntitySubscription(t *testing.T) {
+	row := fromEntitySubscription(&ent.Subscription{
+		ID:     3,
+		URL:    "https://hooks.example.com/qa",
+		Events: []ent.Event{ent.EventAnswerCreated, ent.EventQuestionDeleted},
+	})
+	require.NotNil(t, row)
+	require.Equal(t, int64(3), row.ID)
+	require.Equal(t, "answer.created,question.deleted", row.Events)
+}
+
+func Test_fromEntitySubscription_nil(t *testing.T) {
+	require.Nil(t, fromEntitySubscription(nil))
+}
+
+func Test_toEntityDelivery(t *testing.T) {
+	now := time.Now()
+	code := 502
+	msg := "bad gateway"
+
+	d := toEntityDelivery(&deliveryRow{
+		ID:             7,
+		SubscriptionID: 3,
+		Event:          "answer.created",
+		Payload:        `{"a":1}`,
+		Status:         "pending",
+		Attempts:       2,
+		NextAttemptAt:  now,
+		LastStatusCode: &code,
+		LastError:      &msg,
+		CreatedAt:      now,
+	})
+	require.NotNil(t, d)
+	require.Equal(t, 7, d.ID)
+	require.Equal(t, 3, d.SubscriptionID)
+	require.Equal(t, ent.EventAnswerCreated, d.Event)
+	require.Equal(t, []byte(`{"a":1}`), d.Payload)
+	require.Equal(t, ent.StatusPending, d.Status)
+	require.Equal(t, 2, d.Attempts)
+	require.Equal(t, 502, d.LastStatusCode)
+	require.Equal(t, "bad gateway", d.LastError)
+	require.Nil(t, d.DeliveredAt)
+}
+
+func Test_toEntityDelivery_nil(t *testing.T) {
+	require.Nil(t, toEntityDelivery(nil))
+}
+
+func Test_fromEntityDelivery(t *testing.T) {
+	row := fromEntityDelivery(&ent.Delivery{
+		SubscriptionID: 3,
+		Event:          ent.EventQuestionCreated,
+		Payload:        []byte(`{}`),
+		Status:         ent.StatusPending,
+	})
+	require.NotNil(t, row)
+	require.Equal(t, int64(3), row.SubscriptionID)
+	require.Equal(t, "question.created", row.Event)
+	require.Equal(t, "{}", row.Payload)
+	require.Nil(t, row.LastStatusCode)
+	require.Nil(t, row.LastError)
+}
+
+func Test_fromEntityDelivery_nil(t *testing.T) {
+	require.Nil(t, fromEntityDelivery(nil))
+}
@@ -0,0 +1,115 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+
+	ent "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/uow"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Repository struct {
+	db *gorm.DB
+}
+
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Create fails with ErrSlugTaken when another workspace has the slug.
+func (r *Repository) Create(ctx context.Context, w *ent.Workspace) (*ent.Workspace, error) {
+	row := fromEntityWorkspace(w)
+
+	res := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "slug"}},
+			DoNothing: true,
+		}).
+		Create(row)
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil, ent.ErrSlugTaken
+	}
+
+	return toEntityWorkspace(row), nil
+}
+
+func (r *Repository) GetBySlug(ctx context.Context, slug string) (*ent.Workspace, error) {
+	var row workspaceRow
+
+	err := r.db.WithContext(ctx).Where("slug = ?", slug).Take(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ent.ErrWorkspaceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntityWorkspace(&row), nil
+}
+
+func (r *Repository) GetMember(ctx context.Context, workspaceID int, userID string) (*ent.Member, error) {
+	var row memberRow
+
+	err := r.db.WithContext(ctx).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Take(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ent.ErrMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntityMember(&row), nil
+}
+
+// AddMember keeps the role of a user who is a member already.
+func (r *Repository) AddMember(ctx context.Context, m *ent.Member) error {
+	return uow.GetTx(ctx, r.db).WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(fromEntityMember(m)).Error
+}
+
+// RemoveMember fails with ErrMemberNotFound when the user is no member.
+func (r *Repository) RemoveMember(ctx context.Context, workspaceID int, userID string) error {
+	res := uow.GetTx(ctx, r.db).WithContext(ctx).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		Delete(&memberRow{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ent.ErrMemberNotFound
+	}
+
+	return nil
+}
+
+// ListByUser returns the workspaces the user is a member of, by slug.
+func (r *Repository) ListByUser(ctx context.Context, userID string) ([]*ent.Membership, error) {
+	var rows []membershipRow
+
+	err := r.db.WithContext(ctx).
+		Table("workspace_members m").
+		Select("w.*, m.role").
+		Joins("JOIN workspaces w ON w.id = m.workspace_id").
+		Where("m.user_id = ?", userID).
+		Order("w.slug ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*ent.Membership, 0, len(rows))
+	for i := range rows {
+		out = append(out, toEntityMembership(&rows[i]))
+	}
+
+	return out, nil
+}
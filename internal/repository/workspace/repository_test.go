@@ -0,0 +1,89 @@
+//go:build integration
+// +build integration
+
+package workspace
+
+import (
+	"context"
+	"testing"
+
+	ent "test-question/internal/entity/workspace"
+	"test-question/internal/tests/dbsuite"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type WorkspaceRepoInfraSuite struct {
+	dbsuite.DBSuite
+	repo *Repository
+}
+
+func (s *WorkspaceRepoInfraSuite) SetupTest() {
+	s.repo = &Repository{db: s.DB}
+	s.ResetTables("workspace_members")
+	s.ResetWorkspaces()
+}
+
+func (s *WorkspaceRepoInfraSuite) TestCreateAndGetBySlug() {
+	ctx := context.Background()
+
+	def, err := s.repo.GetBySlug(ctx, ent.DefaultSlug)
+	s.Require().NoError(err)
+	s.Equal(1, def.ID)
+	s.True(def.IsDefault())
+
+	w, err := s.repo.Create(ctx, &ent.Workspace{Slug: "platform", Name: "Platform team"})
+	s.Require().NoError(err)
+	s.Greater(w.ID, 1)
+	s.False(w.CreatedAt.IsZero())
+
+	_, err = s.repo.Create(ctx, &ent.Workspace{Slug: "platform", Name: "Someone else"})
+	s.ErrorIs(err, ent.ErrSlugTaken)
+
+	got, err := s.repo.GetBySlug(ctx, "platform")
+	s.Require().NoError(err)
+	s.Equal(w.ID, got.ID)
+	s.Equal("Platform team", got.Name)
+
+	_, err = s.repo.GetBySlug(ctx, "missing")
+	s.ErrorIs(err, ent.ErrWorkspaceNotFound)
+}
+
+func (s *WorkspaceRepoInfraSuite) TestMembers() {
+	ctx := context.Background()
+
+	w, err := s.repo.Create(ctx, &ent.Workspace{Slug: "platform", Name: "Platform team"})
+	s.Require().NoError(err)
+	other, err := s.repo.Create(ctx, &ent.Workspace{Slug: "data", Name: "Data team"})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.repo.AddMember(ctx, &ent.Member{WorkspaceID: w.ID, UserID: "u1", Role: ent.RoleOwner}))
+	s.Require().NoError(s.repo.AddMember(ctx, &ent.Member{WorkspaceID: other.ID, UserID: "u1", Role: ent.RoleMember}))
+	s.Require().NoError(s.repo.AddMember(ctx, &ent.Member{WorkspaceID: w.ID, UserID: "u2", Role: ent.RoleMember}))
+
+	// adding a member again keeps the role
+	s.Require().NoError(s.repo.AddMember(ctx, &ent.Member{WorkspaceID: w.ID, UserID: "u1", Role: ent.RoleMember}))
+
+	m, err := s.repo.GetMember(ctx, w.ID, "u1")
+	s.Require().NoError(err)
+	s.Equal(ent.RoleOwner, m.Role)
+
+	_, err = s.repo.GetMember(ctx, other.ID, "u2")
+	s.ErrorIs(err, ent.ErrMemberNotFound)
+
+	list, err := s.repo.ListByUser(ctx, "u1")
+	s.Require().NoError(err)
+	s.Require().Len(list, 2)
+	s.Equal("data", list[0].Slug)
+	s.Equal(ent.RoleMember, list[0].Role)
+	s.Equal("platform", list[1].Slug)
+	s.Equal(ent.RoleOwner, list[1].Role)
+
+	s.Require().NoError(s.repo.RemoveMember(ctx, w.ID, "u2"))
+	s.ErrorIs(s.repo.RemoveMember(ctx, w.ID, "u2"), ent.ErrMemberNotFound)
+}
+
+func TestWorkspaceRepoInfraSuite(t *testing.T) {
+	s := &WorkspaceRepoInfraSuite{}
+	suite.Run(t, s)
+}
@@ -0,0 +1,97 @@
+package workspace
+
+import (
+	"time"
+
+	ent "test-question/internal/entity/workspace"
+)
+
+type workspaceRow struct {
+	ID        int64     `gorm:"primaryKey;column:id"`
+	Slug      string    `gorm:"column:slug;type:varchar(32);not null"`
+	Name      string    `gorm:"column:name;type:text;not null"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (workspaceRow) TableName() string {
+	return "workspaces"
+}
+
+type memberRow struct {
+	WorkspaceID int64     `gorm:"primaryKey;column:workspace_id;autoIncrement:false"`
+	UserID      string    `gorm:"primaryKey;column:user_id;type:text"`
+	Role        string    `gorm:"column:role;type:varchar(16);not null"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+func (memberRow) TableName() string {
+	return "workspace_members"
+}
+
+type membershipRow struct {
+	workspaceRow
+	Role string `gorm:"column:role"`
+}
+
+func toEntityWorkspace(r *workspaceRow) *ent.Workspace {
+	if r == nil {
+		return nil
+	}
+
+	return &ent.Workspace{
+		ID:        int(r.ID),
+		Slug:      r.Slug,
+		Name:      r.Name,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+func fromEntityWorkspace(e *ent.Workspace) *workspaceRow {
+	if e == nil {
+		return nil
+	}
+
+	return &workspaceRow{
+		ID:        int64(e.ID),
+		Slug:      e.Slug,
+		Name:      e.Name,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func toEntityMember(r *memberRow) *ent.Member {
+	if r == nil {
+		return nil
+	}
+
+	return &ent.Member{
+		WorkspaceID: int(r.WorkspaceID),
+		UserID:      r.UserID,
+		Role:        ent.Role(r.Role),
+		CreatedAt:   r.CreatedAt,
+	}
+}
+
+func fromEntityMember(e *ent.Member) *memberRow {
+	if e == nil {
+		return nil
+	}
+
+	return &memberRow{
+		WorkspaceID: int64(e.WorkspaceID),
+		UserID:      e.UserID,
+		Role:        string(e.Role),
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func toEntityMembership(r *membershipRow) *ent.Membership {
+	if r == nil {
+		return nil
+	}
+
+	return &ent.Membership{
+		Workspace: *toEntityWorkspace(&r.workspaceRow),
+		Role:      ent.Role(r.Role),
+	}
+}
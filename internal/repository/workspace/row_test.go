@@ -0,0 +1,51 @@
+package workspace
+
+import (
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/workspace"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkspaceConverters(t *testing.T) {
+	now := time.Now()
+
+	row := &workspaceRow{ID: 2, Slug: "platform", Name: "Platform team", CreatedAt: now}
+	entity := &ent.Workspace{ID: 2, Slug: "platform", Name: "Platform team", CreatedAt: now}
+
+	require.Equal(t, entity, toEntityWorkspace(row))
+	require.Equal(t, row, fromEntityWorkspace(entity))
+
+	require.Nil(t, toEntityWorkspace(nil))
+	require.Nil(t, fromEntityWorkspace(nil))
+}
+
+func TestMemberConverters(t *testing.T) {
+	now := time.Now()
+
+	row := &memberRow{WorkspaceID: 2, UserID: "u1", Role: "owner", CreatedAt: now}
+	entity := &ent.Member{WorkspaceID: 2, UserID: "u1", Role: ent.RoleOwner, CreatedAt: now}
+
+	require.Equal(t, entity, toEntityMember(row))
+	require.Equal(t, row, fromEntityMember(entity))
+
+	require.Nil(t, toEntityMember(nil))
+	require.Nil(t, fromEntityMember(nil))
+}
+
+func TestMembershipConverter(t *testing.T) {
+	now := time.Now()
+
+	row := &membershipRow{
+		workspaceRow: workspaceRow{ID: 2, Slug: "platform", Name: "Platform team", CreatedAt: now},
+		Role:         "member",
+	}
+
+	require.Equal(t, &ent.Membership{
+		Workspace: ent.Workspace{ID: 2, Slug: "platform", Name: "Platform team", CreatedAt: now},
+		Role:      ent.RoleMember,
+	}, toEntityMembership(row))
+	require.Nil(t, toEntityMembership(nil))
+}
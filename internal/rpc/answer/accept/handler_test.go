@@ -0,0 +1,77 @@
+package accept
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entA "test-question/internal/entity/answer"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/answer/accept/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(id, userID string) *http.Request {
+	req := httptest.NewRequest("POST", "/answers/"+id+"/accept", nil)
+	req.SetPathValue("id", id)
+	if userID != "" {
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), userID))
+	}
+	return req
+}
+
+func TestHandler_Accept_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("AcceptAnswer", mock.Anything, 5, "owner").Return(nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5", "owner"))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Accept_InvalidID(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("x", "owner"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Accept_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5", ""))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestHandler_Accept_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "answer_not_found", err: entA.ErrAnswerNotFound, code: http.StatusNotFound},
+		{name: "question_not_found", err: entA.ErrRequestedQuestionNotFound, code: http.StatusNotFound},
+		{name: "forbidden", err: entA.ErrAccessDenied, code: http.StatusForbidden},
+		{name: "unexpected", err: errors.New("boom"), code: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("AcceptAnswer", mock.Anything, 5, "owner").Return(tt.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, newRequest("5", "owner"))
+
+			require.Equal(t, tt.code, w.Code)
+		})
+	}
+}
@@ -7,6 +7,10 @@ import (
 	"strconv"
 
 	"test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
+	entP "test-question/internal/entity/policy"
+	entQ "test-question/internal/entity/question"
 	"test-question/internal/pkg/rpc"
 	"test-question/internal/pkg/rpc/rpc_auth"
 )
@@ -19,19 +23,29 @@ type (
 			questionID int,
 			userID string,
 			text string,
+			attachmentIDs []int,
 		) (*answer.Answer, error)
 	}
 )
 
 type CreateAnswerRequest struct {
 	Text string `json:"text" validate:"required,min=1"`
+	// AttachmentIDs are the user's uploads, at most attachment.MaxPerPost.
+	AttachmentIDs []int `json:"attachment_ids" validate:"max=10,dive,gt=0"`
 }
 
 type CreateAnswerResponse struct {
-	ID         int    `json:"id"`
-	Text       string `json:"text"`
-	UserID     string `json:"user_id"`
-	QuestionID int    `json:"question_id"`
+	ID         int       `json:"id"`
+	Text       string    `json:"text"`
+	UserID     string    `json:"user_id"`
+	QuestionID int       `json:"question_id"`
+	Mentions   []Mention `json:"mentions"`
+}
+
+// Mention is a user the text mentions as @username.
+type Mention struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
 }
 
 type Handler struct {
@@ -51,7 +65,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	qIDStr := r.PathValue("id")
 	qID, err := strconv.Atoi(qIDStr)
 	if err != nil {
-		rpc.WriteBadRequest(w, "invalid question id")
+		rpc.WriteBadRequest(w, "invalid_question_id")
 		return
 	}
 
@@ -61,12 +75,26 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	a, err := h.uc.CreateAnswer(r.Context(), qID, userID, req.Text)
+	a, err := h.uc.CreateAnswer(r.Context(), qID, userID, req.Text, req.AttachmentIDs)
 	if err != nil {
+		var violations entP.Violations
+
 		switch {
+		case errors.As(err, &violations):
+			rpc.WriteValidationErrorWithParams(w, violations.Rules(), violations.Params())
+			return
+		case errors.Is(err, entAt.ErrUnavailable):
+			rpc.WriteValidationError(w, map[string]string{"AttachmentIDs": "unavailable"})
+			return
 		case errors.Is(err, answer.ErrRequestedQuestionNotFound):
 			rpc.WriteNotFound(w, "question_not_found")
 			return
+		case errors.Is(err, entQ.ErrQuestionClosed):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("question_closed"))
+			return
+		case errors.Is(err, entQ.ErrQuestionLocked):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("question_locked"))
+			return
 		default:
 			rpc.WriteUnexpectedError(w, err)
 			return
@@ -78,5 +106,14 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		Text:       a.Text,
 		UserID:     a.UserID,
 		QuestionID: a.QuestionID,
+		Mentions:   toMentions(a.Mentions),
 	})
 }
+
+func toMentions(ms []*entM.Mention) []Mention {
+	out := make([]Mention, len(ms))
+	for i, m := range ms {
+		out[i] = Mention{UserID: m.UserID, Username: m.Username}
+	}
+	return out
+}
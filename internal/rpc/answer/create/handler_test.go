@@ -10,6 +10,10 @@ import (
 	"time"
 
 	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
+	entP "test-question/internal/entity/policy"
+	entQ "test-question/internal/entity/question"
 	"test-question/internal/pkg/rpc/rpc_auth"
 	"test-question/internal/rpc/answer/create/mocks"
 
@@ -28,6 +32,7 @@ func TestHandler_Create_Success(t *testing.T) {
 			10,
 			"user-1",
 			"hello answer",
+			[]int(nil),
 		).
 		Return(&entA.Answer{
 			ID:         100,
@@ -35,6 +40,7 @@ func TestHandler_Create_Success(t *testing.T) {
 			UserID:     "user-1",
 			QuestionID: 10,
 			CreatedAt:  now,
+			Mentions:   []*entM.Mention{{UserID: "user-2", Username: "ann"}},
 		}, nil)
 
 	h := NewHandler(mUC)
@@ -58,6 +64,7 @@ func TestHandler_Create_Success(t *testing.T) {
 	require.Equal(t, "hello answer", resp.Text)
 	require.Equal(t, "user-1", resp.UserID)
 	require.Equal(t, 10, resp.QuestionID)
+	require.Equal(t, []Mention{{UserID: "user-2", Username: "ann"}}, resp.Mentions)
 }
 
 func TestHandler_Create_InvalidQuestionID(t *testing.T) {
@@ -79,7 +86,7 @@ func TestHandler_Create_InvalidQuestionID(t *testing.T) {
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
 
-	require.Equal(t, "invalid question id", resp["message"])
+	require.Equal(t, "invalid_question_id", resp["code"])
 }
 
 func TestHandler_Create_Unauthorized(t *testing.T) {
@@ -100,7 +107,7 @@ func TestHandler_Create_Unauthorized(t *testing.T) {
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
 
-	require.Equal(t, "unauthorized", resp["message"])
+	require.Equal(t, "unauthorized", resp["code"])
 }
 
 func TestHandler_Create_QuestionNotFound(t *testing.T) {
@@ -112,6 +119,7 @@ func TestHandler_Create_QuestionNotFound(t *testing.T) {
 			55,
 			"user-1",
 			"hi",
+			[]int(nil),
 		).
 		Return(nil, entA.ErrRequestedQuestionNotFound)
 
@@ -131,7 +139,40 @@ func TestHandler_Create_QuestionNotFound(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "question_not_found", resp["message"])
+	require.Equal(t, "question_not_found", resp["code"])
+}
+
+func TestHandler_Create_QuestionNotOpen(t *testing.T) {
+	tests := []struct {
+		err     error
+		message string
+	}{
+		{err: entQ.ErrQuestionClosed, message: "question_closed"},
+		{err: entQ.ErrQuestionLocked, message: "question_locked"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.message, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+
+			mUC.
+				On("CreateAnswer", mock.Anything, 55, "user-1", "hi", []int(nil)).
+				Return(nil, tt.err)
+
+			req := httptest.NewRequest("POST", "/questions/55/answers", bytes.NewBufferString(`{"text":"hi"}`))
+			req.SetPathValue("id", "55")
+			req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusConflict, w.Code)
+
+			var resp map[string]any
+			json.Unmarshal(w.Body.Bytes(), &resp)
+			require.Equal(t, tt.message, resp["code"])
+		})
+	}
 }
 
 func TestHandler_Create_ValidationError(t *testing.T) {
@@ -153,7 +194,24 @@ func TestHandler_Create_ValidationError(t *testing.T) {
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
 
-	require.Equal(t, "validation_failed", resp["message"])
+	require.Equal(t, "validation_failed", resp["code"])
+}
+
+func TestHandler_Create_PolicyViolation(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.
+		On("CreateAnswer", mock.Anything, 10, "user-1", "aaaa", []int(nil)).
+		Return(nil, entP.Violations{"Text": {Rule: entP.RuleMaxLength, Param: "10000"}})
+
+	req := httptest.NewRequest("POST", "/questions/10/answers", bytes.NewBufferString(`{"text":"aaaa"}`))
+	req.SetPathValue("id", "10")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, `{"code":"validation_failed","message":"The request contains invalid fields.","fields":{"Text":"max_length"},"field_messages":{"Text":"is longer than 10000 characters"}}`, w.Body.String())
 }
 
 func TestHandler_Create_UnexpectedError(t *testing.T) {
@@ -165,6 +223,7 @@ func TestHandler_Create_UnexpectedError(t *testing.T) {
 			99,
 			"user-1",
 			"xx",
+			[]int(nil),
 		).
 		Return(nil, fmt.Errorf("boom"))
 
@@ -184,5 +243,23 @@ func TestHandler_Create_UnexpectedError(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "internal error", resp["message"])
+	require.Equal(t, "internal_error", resp["code"])
+}
+
+func TestHandler_Create_AttachmentsUnavailable(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("CreateAnswer", mock.Anything, 55, "user-1", "hi", []int{2}).
+		Return(nil, entAt.ErrUnavailable)
+
+	req := httptest.NewRequest("POST", "/questions/55/answers", bytes.NewBufferString(`{"text":"hi","attachment_ids":[2]}`))
+	req.SetPathValue("id", "55")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, `{"code":"validation_failed","message":"The request contains invalid fields.","fields":{"AttachmentIDs":"unavailable"},"field_messages":{"AttachmentIDs":"refers to attachments you cannot use"}}`, w.Body.String())
 }
@@ -14,9 +14,9 @@ type UseCase struct {
 	mock.Mock
 }
 
-// CreateAnswer provides a mock function with given fields: ctx, questionID, userID, text
-func (_m *UseCase) CreateAnswer(ctx context.Context, questionID int, userID string, text string) (*answer.Answer, error) {
-	ret := _m.Called(ctx, questionID, userID, text)
+// CreateAnswer provides a mock function with given fields: ctx, questionID, userID, text, attachmentIDs
+func (_m *UseCase) CreateAnswer(ctx context.Context, questionID int, userID string, text string, attachmentIDs []int) (*answer.Answer, error) {
+	ret := _m.Called(ctx, questionID, userID, text, attachmentIDs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CreateAnswer")
@@ -24,19 +24,19 @@ func (_m *UseCase) CreateAnswer(ctx context.Context, questionID int, userID stri
 
 	var r0 *answer.Answer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) (*answer.Answer, error)); ok {
-		return rf(ctx, questionID, userID, text)
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, []int) (*answer.Answer, error)); ok {
+		return rf(ctx, questionID, userID, text, attachmentIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) *answer.Answer); ok {
-		r0 = rf(ctx, questionID, userID, text)
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, []int) *answer.Answer); ok {
+		r0 = rf(ctx, questionID, userID, text, attachmentIDs)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*answer.Answer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int, string, string) error); ok {
-		r1 = rf(ctx, questionID, userID, text)
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string, []int) error); ok {
+		r1 = rf(ctx, questionID, userID, text, attachmentIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -32,7 +32,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
 	answerID, err := strconv.Atoi(idStr)
 	if err != nil {
-		rpc.WriteBadRequest(w, "invalid answer id")
+		rpc.WriteBadRequest(w, "invalid_answer_id")
 		return
 	}
 
@@ -54,7 +54,7 @@ func TestHandler_Delete_InvalidID(t *testing.T) {
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
 
-	require.Equal(t, "invalid answer id", resp["message"])
+	require.Equal(t, "invalid_answer_id", resp["code"])
 }
 
 func TestHandler_Delete_Unauthorized(t *testing.T) {
@@ -72,7 +72,7 @@ func TestHandler_Delete_Unauthorized(t *testing.T) {
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
 
-	require.Equal(t, "unauthorized", resp["message"])
+	require.Equal(t, "unauthorized", resp["code"])
 }
 
 func TestHandler_Delete_NotFound(t *testing.T) {
@@ -99,7 +99,7 @@ func TestHandler_Delete_NotFound(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "answer_not_found", resp["message"])
+	require.Equal(t, "answer_not_found", resp["code"])
 }
 
 func TestHandler_Delete_AccessDenied(t *testing.T) {
@@ -126,7 +126,7 @@ func TestHandler_Delete_AccessDenied(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "access_denied", resp["message"])
+	require.Equal(t, "access_denied", resp["code"])
 }
 
 func TestHandler_Delete_UnexpectedError(t *testing.T) {
@@ -153,5 +153,5 @@ func TestHandler_Delete_UnexpectedError(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "internal error", resp["message"])
+	require.Equal(t, "internal_error", resp["code"])
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	entA "test-question/internal/entity/answer"
+	entM "test-question/internal/entity/mention"
 	"test-question/internal/pkg/rpc"
 
 	"github.com/pkg/errors"
@@ -20,10 +21,17 @@ type (
 )
 
 type Response struct {
-	ID        int    `json:"id"`
-	Text      string `json:"text"`
-	UserID    string `json:"user_id"`
-	CreatedAt string `json:"created_at"`
+	ID        int       `json:"id"`
+	Text      string    `json:"text"`
+	UserID    string    `json:"user_id"`
+	CreatedAt string    `json:"created_at"`
+	Mentions  []Mention `json:"mentions"`
+}
+
+// Mention is a user the text mentions as @username.
+type Mention struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
 }
 
 type Handler struct {
@@ -38,7 +46,7 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
 	answerID, err := strconv.Atoi(idStr)
 	if err != nil {
-		rpc.WriteBadRequest(w, "invalid answer id")
+		rpc.WriteBadRequest(w, "invalid_answer_id")
 		return
 	}
 
@@ -54,10 +62,28 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if rpc.WriteNotModified(w, r, ETag(a)) {
+		return
+	}
+
 	rpc.WriteJSON(w, http.StatusOK, Response{
 		ID:        a.ID,
 		Text:      a.Text,
 		UserID:    a.UserID,
 		CreatedAt: a.CreatedAt.Format(time.RFC3339),
+		Mentions:  toMentions(a.Mentions),
 	})
 }
+
+// ETag identifies the answer's current representation.
+func ETag(a *entA.Answer) string {
+	return rpc.StrongETag("answer", strconv.Itoa(a.ID), strconv.Itoa(a.Revision))
+}
+
+func toMentions(ms []*entM.Mention) []Mention {
+	out := make([]Mention, len(ms))
+	for i, m := range ms {
+		out[i] = Mention{UserID: m.UserID, Username: m.Username}
+	}
+	return out
+}
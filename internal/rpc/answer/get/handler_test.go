@@ -9,6 +9,7 @@ import (
 	"time"
 
 	entA "test-question/internal/entity/answer"
+	entM "test-question/internal/entity/mention"
 	"test-question/internal/rpc/answer/get/mocks"
 
 	"github.com/stretchr/testify/mock"
@@ -29,6 +30,7 @@ func TestHandler_Get_Success(t *testing.T) {
 			Text:      "hi",
 			UserID:    "u1",
 			CreatedAt: now,
+			Mentions:  []*entM.Mention{{UserID: "u2", Username: "ann"}},
 		}, nil)
 
 	h := NewHandler(mUC)
@@ -47,6 +49,7 @@ func TestHandler_Get_Success(t *testing.T) {
 	require.Equal(t, "hi", resp.Text)
 	require.Equal(t, "u1", resp.UserID)
 	require.Equal(t, now.Format(time.RFC3339), resp.CreatedAt)
+	require.Equal(t, []Mention{{UserID: "u2", Username: "ann"}}, resp.Mentions)
 }
 
 func TestHandler_Get_InvalidID(t *testing.T) {
@@ -63,7 +66,7 @@ func TestHandler_Get_InvalidID(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "invalid answer id", resp["message"])
+	require.Equal(t, "invalid_answer_id", resp["code"])
 }
 
 func TestHandler_Get_NotFound(t *testing.T) {
@@ -88,7 +91,7 @@ func TestHandler_Get_NotFound(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "answer_not_found", resp["message"])
+	require.Equal(t, "answer_not_found", resp["code"])
 }
 
 func TestHandler_Get_UnexpectedError(t *testing.T) {
@@ -113,5 +116,23 @@ func TestHandler_Get_UnexpectedError(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "internal error", resp["message"])
+	require.Equal(t, "internal_error", resp["code"])
+}
+
+func TestHandler_Get_NotModified(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	a := &entA.Answer{ID: 10, Text: "hi", Revision: 1}
+
+	mUC.On("GetAnswer", mock.Anything, 10).Return(a, nil)
+
+	req := httptest.NewRequest("GET", "/answers/10", nil)
+	req.SetPathValue("id", "10")
+	req.Header.Set("If-None-Match", ETag(a))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Empty(t, w.Body.String())
+	require.Equal(t, ETag(a), w.Header().Get("ETag"))
 }
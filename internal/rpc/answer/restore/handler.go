@@ -0,0 +1,77 @@
+package restore
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entA "test-question/internal/entity/answer"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		RestoreAnswer(ctx context.Context, answerID int, userID string) error
+	}
+)
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	answerID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_answer_id")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	err = h.uc.RestoreAnswer(r.Context(), answerID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entA.ErrAnswerNotFound):
+			rpc.WriteNotFound(w, "answer_not_found")
+			return
+
+		case errors.Is(err, entA.ErrAccessDenied):
+			rpc.WriteForbidden(w)
+			return
+
+		case errors.Is(err, entA.ErrNotDeleted):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("answer_not_deleted"))
+			return
+
+		case errors.Is(err, entA.ErrRequestedQuestionNotFound):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("question_deleted"))
+			return
+
+		case errors.Is(err, entA.ErrRemovedByModerator):
+			rpc.WriteJSON(w, http.StatusForbidden, rpc.NewBaseHTTPError("removed_by_moderator"))
+			return
+
+		case errors.Is(err, entA.ErrRestoreExpired):
+			rpc.WriteJSON(w, http.StatusGone, rpc.NewBaseHTTPError("restore_period_expired"))
+			return
+
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
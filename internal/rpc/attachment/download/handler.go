@@ -0,0 +1,91 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+
+	entAt "test-question/internal/entity/attachment"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/pkg/thumbnail"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Open(ctx context.Context, userID string, id int, thumb bool) (*entAt.Attachment, io.ReadCloser, error)
+	}
+)
+
+type Handler struct {
+	uc    useCase
+	thumb bool
+}
+
+// NewHandler serves the attachment's file, or its thumbnail when thumb is
+// set.
+func NewHandler(uc useCase, thumb bool) *Handler {
+	return &Handler{uc: uc, thumb: thumb}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_attachment_id")
+		return
+	}
+
+	a, body, err := h.uc.Open(r.Context(), rpc_auth.GetUserID(r.Context()), id, h.thumb)
+	if err != nil {
+		if errors.Is(err, entAt.ErrAttachmentNotFound) {
+			rpc.WriteNotFound(w, "attachment_not_found")
+			return
+		}
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+	defer func() { _ = body.Close() }()
+
+	// files never change once uploaded
+	if rpc.WriteNotModified(w, r, ETag(a, h.thumb)) {
+		return
+	}
+
+	header := w.Header()
+	header.Set("X-Content-Type-Options", "nosniff")
+	header.Set("Content-Security-Policy", "default-src 'none'; sandbox")
+
+	if h.thumb {
+		header.Set("Content-Type", thumbnail.ContentType)
+		header.Set("Content-Disposition", "inline")
+	} else {
+		header.Set("Content-Type", a.ContentType)
+		header.Set("Content-Length", strconv.FormatInt(a.Size, 10))
+		header.Set("Content-Disposition", disposition(a))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, body); err != nil {
+		slog.Info("attachment download interrupted:", "attachment_id", a.ID, "err", err)
+	}
+}
+
+// ETag identifies the attachment's file or thumbnail.
+func ETag(a *entAt.Attachment, thumb bool) string {
+	return rpc.StrongETag("attachment", strconv.Itoa(a.ID), strconv.FormatBool(thumb))
+}
+
+// disposition shows images in the browser and has everything else saved
+// under its original name.
+func disposition(a *entAt.Attachment) string {
+	kind := "attachment"
+	if entAt.IsImage(a.ContentType) {
+		kind = "inline"
+	}
+	return mime.FormatMediaType(kind, map[string]string{"filename": a.Filename})
+}
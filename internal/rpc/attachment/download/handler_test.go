@@ -0,0 +1,97 @@
+package download
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	entAt "test-question/internal/entity/attachment"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/attachment/download/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(t *testing.T, h *Handler, target string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /attachments/{id}", h)
+	mux.Handle("GET /attachments/{id}/thumbnail", h)
+
+	req := httptest.NewRequest("GET", target, nil)
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "u1"))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	return w
+}
+
+func TestHandler_Download_File(t *testing.T) {
+	a := &entAt.Attachment{ID: 3, Filename: "app log.txt", ContentType: "text/plain; charset=utf-8", Size: 5}
+
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Open", mock.Anything, "u1", 3, false).Return(a, io.NopCloser(strings.NewReader("hello")), nil)
+
+	w := serve(t, NewHandler(mUC, false), "/attachments/3", nil)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "hello", w.Body.String())
+	require.Equal(t, "text/plain; charset=utf-8", w.Header().Get("Content-Type"))
+	require.Equal(t, "5", w.Header().Get("Content-Length"))
+	require.Equal(t, `attachment; filename="app log.txt"`, w.Header().Get("Content-Disposition"))
+	require.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+	require.Equal(t, ETag(a, false), w.Header().Get("ETag"))
+}
+
+func TestHandler_Download_Thumbnail(t *testing.T) {
+	a := &entAt.Attachment{ID: 3, Filename: "shot.png", ContentType: "image/png", Size: 500, ThumbnailKey: "t"}
+
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Open", mock.Anything, "u1", 3, true).Return(a, io.NopCloser(strings.NewReader("png")), nil)
+
+	w := serve(t, NewHandler(mUC, true), "/attachments/3/thumbnail", nil)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "png", w.Body.String())
+	require.Equal(t, "image/png", w.Header().Get("Content-Type"))
+	require.Equal(t, "inline", w.Header().Get("Content-Disposition"))
+	require.NotEqual(t, ETag(a, false), ETag(a, true))
+}
+
+func TestHandler_Download_NotModified(t *testing.T) {
+	a := &entAt.Attachment{ID: 3, Filename: "shot.png", ContentType: "image/png", Size: 3}
+
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Open", mock.Anything, "u1", 3, false).Return(a, io.NopCloser(strings.NewReader("png")), nil)
+
+	w := serve(t, NewHandler(mUC, false), "/attachments/3", http.Header{"If-None-Match": {ETag(a, false)}})
+
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Empty(t, w.Body.String())
+}
+
+func TestHandler_Download_Errors(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Open", mock.Anything, "u1", 3, false).Return(nil, nil, entAt.ErrAttachmentNotFound)
+	mUC.On("Open", mock.Anything, "u1", 4, false).Return(nil, nil, errors.New("storage down"))
+
+	h := NewHandler(mUC, false)
+
+	w := serve(t, h, "/attachments/3", nil)
+	require.Equal(t, http.StatusNotFound, w.Code)
+	require.JSONEq(t, `{"code":"attachment_not_found","message":"Attachment not found."}`, w.Body.String())
+
+	w = serve(t, h, "/attachments/4", nil)
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+
+	w = serve(t, h, "/attachments/abc", nil)
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,70 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	attachment "test-question/internal/entity/attachment"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Open provides a mock function with given fields: ctx, userID, id, thumb
+func (_m *UseCase) Open(ctx context.Context, userID string, id int, thumb bool) (*attachment.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(ctx, userID, id, thumb)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Open")
+	}
+
+	var r0 *attachment.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, bool) (*attachment.Attachment, io.ReadCloser, error)); ok {
+		return rf(ctx, userID, id, thumb)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, bool) *attachment.Attachment); ok {
+		r0 = rf(ctx, userID, id, thumb)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*attachment.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, bool) io.ReadCloser); ok {
+		r1 = rf(ctx, userID, id, thumb)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, int, bool) error); ok {
+		r2 = rf(ctx, userID, id, thumb)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,129 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	entAt "test-question/internal/entity/attachment"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+)
+
+// multipartOverhead is what the request may carry besides the file:
+// boundaries, part headers and other fields.
+const multipartOverhead = 64 << 10
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Upload(ctx context.Context, userID, filename string, r io.Reader) (*entAt.Attachment, error)
+	}
+)
+
+type Response struct {
+	ID           int    `json:"id"`
+	Filename     string `json:"filename"`
+	ContentType  string `json:"content_type"`
+	Size         int64  `json:"size"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
+type Handler struct {
+	uc      useCase
+	maxSize int64
+}
+
+// NewHandler accepts files of up to maxSize bytes in the "file" field of a
+// multipart/form-data request.
+func NewHandler(uc useCase, maxSize int64) *Handler {
+	return &Handler{uc: uc, maxSize: maxSize}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSize+multipartOverhead)
+
+	mr, err := r.MultipartReader()
+	if err != nil {
+		rpc.WriteValidationError(w, map[string]string{"body": "invalid_multipart"})
+		return
+	}
+
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			rpc.WriteValidationError(w, map[string]string{"file": "required"})
+			return
+		}
+		if err != nil {
+			h.writeReadError(w, err)
+			return
+		}
+
+		if part.FormName() != "file" {
+			_ = part.Close()
+			continue
+		}
+
+		a, err := h.uc.Upload(r.Context(), userID, part.FileName(), part)
+		_ = part.Close()
+		if err != nil {
+			h.writeUploadError(w, err)
+			return
+		}
+
+		rpc.WriteJSON(w, http.StatusCreated, toResponse(a))
+		return
+	}
+}
+
+func (h *Handler) writeUploadError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, entAt.ErrEmptyFile):
+		rpc.WriteValidationError(w, map[string]string{"file": "empty"})
+	case errors.Is(err, entAt.ErrTooLarge):
+		writeTooLarge(w)
+	case errors.Is(err, entAt.ErrUnsupportedType):
+		rpc.WriteJSON(w, http.StatusUnsupportedMediaType, rpc.NewBaseHTTPError("unsupported_media_type"))
+	default:
+		h.writeReadError(w, err)
+	}
+}
+
+// writeReadError tells a body cut off by the size limit apart from other
+// failures.
+func (h *Handler) writeReadError(w http.ResponseWriter, err error) {
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		writeTooLarge(w)
+		return
+	}
+	rpc.WriteUnexpectedError(w, err)
+}
+
+func writeTooLarge(w http.ResponseWriter) {
+	rpc.WriteJSON(w, http.StatusRequestEntityTooLarge, rpc.NewBaseHTTPError("file_too_large"))
+}
+
+func toResponse(a *entAt.Attachment) Response {
+	resp := Response{
+		ID:          a.ID,
+		Filename:    a.Filename,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		URL:         "/attachments/" + strconv.Itoa(a.ID),
+	}
+	if a.HasThumbnail() {
+		resp.ThumbnailURL = resp.URL + "/thumbnail"
+	}
+	return resp
+}
@@ -0,0 +1,120 @@
+package upload
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	entAt "test-question/internal/entity/attachment"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/attachment/upload/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func multipartRequest(t *testing.T, field, filename, content string) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	require.NoError(t, mw.WriteField("note", "ignored"))
+	fw, err := mw.CreateFormFile(field, filename)
+	require.NoError(t, err)
+	_, err = fw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, mw.Close())
+
+	req := httptest.NewRequest("POST", "/attachments", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "u1"))
+}
+
+func TestHandler_Upload_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.
+		On("Upload", mock.Anything, "u1", "shot.png", mock.Anything).
+		Run(func(args mock.Arguments) {
+			data, err := io.ReadAll(args.Get(3).(io.Reader))
+			require.NoError(t, err)
+			require.Equal(t, "content", string(data))
+		}).
+		Return(&entAt.Attachment{
+			ID: 4, Filename: "shot.png", ContentType: "image/png", Size: 7, ThumbnailKey: "thumbnails/k.png",
+		}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, 1024).ServeHTTP(w, multipartRequest(t, "file", "shot.png", "content"))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.JSONEq(t, `{
+		"id":4,"filename":"shot.png","content_type":"image/png","size":7,
+		"url":"/attachments/4","thumbnail_url":"/attachments/4/thumbnail"
+	}`, w.Body.String())
+}
+
+func TestHandler_Upload_Errors(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+		body   string
+	}{
+		{entAt.ErrEmptyFile, http.StatusUnprocessableEntity, `{"code":"validation_failed","message":"The request contains invalid fields.","fields":{"file":"empty"},"field_messages":{"file":"must not be empty"}}`},
+		{entAt.ErrTooLarge, http.StatusRequestEntityTooLarge, `{"code":"file_too_large","message":"The file is too large."}`},
+		{entAt.ErrUnsupportedType, http.StatusUnsupportedMediaType, `{"code":"unsupported_media_type","message":"Unsupported file type."}`},
+		{errors.New("disk full"), http.StatusInternalServerError, `{"code":"internal_error","message":"Something went wrong. Please try again later."}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("Upload", mock.Anything, "u1", "a.bin", mock.Anything).Return(nil, tt.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC, 1024).ServeHTTP(w, multipartRequest(t, "file", "a.bin", "x"))
+
+			require.Equal(t, tt.status, w.Code)
+			require.JSONEq(t, tt.body, w.Body.String())
+		})
+	}
+}
+
+func TestHandler_Upload_BodyTooLarge(t *testing.T) {
+	w := httptest.NewRecorder()
+	req := multipartRequest(t, "note", "", strings.Repeat("x", 200<<10))
+	NewHandler(mocks.NewUseCase(t), 1024).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func TestHandler_Upload_Invalid(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, 1024).ServeHTTP(w, multipartRequest(t, "other", "a.txt", "x"))
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, `{"code":"validation_failed","message":"The request contains invalid fields.","fields":{"file":"required"},"field_messages":{"file":"is required"}}`, w.Body.String())
+
+	req := httptest.NewRequest("POST", "/attachments", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "u1"))
+
+	w = httptest.NewRecorder()
+	NewHandler(mUC, 1024).ServeHTTP(w, req)
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, `{"code":"validation_failed","message":"The request contains invalid fields.","fields":{"body":"invalid_multipart"},"field_messages":{"body":"is not a valid multipart form"}}`, w.Body.String())
+}
+
+func TestHandler_Upload_Unauthorized(t *testing.T) {
+	req := httptest.NewRequest("POST", "/attachments", nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mocks.NewUseCase(t), 1024).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	attachment "test-question/internal/entity/attachment"
+
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Upload provides a mock function with given fields: ctx, userID, filename, r
+func (_m *UseCase) Upload(ctx context.Context, userID string, filename string, r io.Reader) (*attachment.Attachment, error) {
+	ret := _m.Called(ctx, userID, filename, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upload")
+	}
+
+	var r0 *attachment.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Reader) (*attachment.Attachment, error)); ok {
+		return rf(ctx, userID, filename, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, io.Reader) *attachment.Attachment); ok {
+		r0 = rf(ctx, userID, filename, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*attachment.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, io.Reader) error); ok {
+		r1 = rf(ctx, userID, filename, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,208 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entB "test-question/internal/entity/batch"
+	entP "test-question/internal/entity/policy"
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/i18n"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Run(ctx context.Context, userID string, ops []entB.Operation, atomic bool) ([]entB.Result, error)
+	}
+)
+
+type Request struct {
+	// Atomic runs the batch in one transaction, rolled back on the first failure.
+	Atomic     bool        `json:"atomic"`
+	Operations []Operation `json:"operations" validate:"required,min=1,dive"`
+}
+
+type Operation struct {
+	Op         string `json:"op" validate:"required,oneof=create_question create_answer delete_question delete_answer"`
+	Text       string `json:"text"`
+	Force      bool   `json:"force"`
+	QuestionID int    `json:"question_id"`
+	// QuestionRef is the index of an earlier create_question operation.
+	QuestionRef *int `json:"question_ref"`
+	AnswerID    int  `json:"answer_id"`
+	// AttachmentIDs holds at most attachment.MaxPerPost uploads.
+	AttachmentIDs []int `json:"attachment_ids" validate:"max=10,dive,gt=0"`
+}
+
+type Response struct {
+	Succeeded int      `json:"succeeded"`
+	Failed    int      `json:"failed"`
+	Results   []Result `json:"results"`
+}
+
+// Result carries the status and body the single endpoint of the
+// operation would have answered with.
+type Result struct {
+	Index      int               `json:"index"`
+	Status     int               `json:"status"`
+	ID         int               `json:"id,omitempty"`
+	QuestionID int               `json:"question_id,omitempty"`
+	Code       string            `json:"code,omitempty"`
+	Message    string            `json:"message,omitempty"`
+	Fields     map[string]string `json:"fields,omitempty"`
+
+	FieldMessages map[string]string `json:"field_messages,omitempty"`
+}
+
+// Handler runs POST /batch. The request takes one token of the "batch"
+// rate limit; on top of that every operation takes one of the
+// "batch_operations" limit. An operation over it fails alone with 429
+// rate_limited; an atomic batch takes the tokens of all its operations up
+// front and is refused as a whole with 429 rate_limited before it starts.
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	ops := make([]entB.Operation, len(req.Operations))
+	for i, op := range req.Operations {
+		ops[i] = entB.Operation{
+			Type:        entB.OpType(op.Op),
+			Text:        op.Text,
+			Force:       op.Force,
+			QuestionID:  op.QuestionID,
+			QuestionRef: op.QuestionRef,
+			AnswerID:    op.AnswerID,
+
+			AttachmentIDs: op.AttachmentIDs,
+		}
+	}
+
+	results, err := h.uc.Run(r.Context(), userID, ops, req.Atomic)
+	if err != nil {
+		var (
+			tooMany *entB.TooManyOperationsError
+			opErr   *entB.OperationError
+		)
+		switch {
+		case errors.Is(err, entB.ErrRateLimited):
+			rpc.WriteJSON(w, http.StatusTooManyRequests, rpc.NewBaseHTTPError("rate_limited"))
+		case errors.As(err, &tooMany):
+			rpc.WriteValidationErrorWithParams(w,
+				map[string]string{"operations": "max"},
+				map[string]string{"operations": strconv.Itoa(tooMany.Max)},
+			)
+		case errors.As(err, &opErr):
+			field := "operations[" + strconv.Itoa(opErr.Index) + "]"
+			if errors.Is(err, entB.ErrInvalidRef) {
+				rpc.WriteValidationError(w, map[string]string{field + ".question_ref": "invalid_ref"})
+			} else {
+				rpc.WriteValidationError(w, map[string]string{field: "invalid_operation"})
+			}
+		default:
+			rpc.WriteUnexpectedError(w, err)
+		}
+		return
+	}
+
+	c := rpc.Catalog(w)
+	resp := Response{Results: make([]Result, len(results))}
+	for i, res := range results {
+		resp.Results[i] = toResult(c, i, res)
+		if res.Err == nil {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, resp)
+}
+
+func toResult(c *i18n.Catalog, i int, res entB.Result) Result {
+	out := Result{Index: i}
+
+	if res.Err == nil {
+		switch {
+		case res.Question != nil:
+			out.Status = http.StatusCreated
+			out.ID = res.Question.ID
+		case res.Answer != nil:
+			out.Status = http.StatusCreated
+			out.ID = res.Answer.ID
+			out.QuestionID = res.Answer.QuestionID
+		default:
+			out.Status = http.StatusNoContent
+		}
+		return out
+	}
+
+	var (
+		violations entP.Violations
+		params     map[string]string
+	)
+	switch {
+	case errors.As(res.Err, &violations):
+		out.Status, out.Code = http.StatusUnprocessableEntity, "validation_failed"
+		out.Fields, params = violations.Rules(), violations.Params()
+	case errors.Is(res.Err, entAt.ErrUnavailable):
+		out.Status, out.Code = http.StatusUnprocessableEntity, "validation_failed"
+		out.Fields = map[string]string{"AttachmentIDs": "unavailable"}
+	case errors.Is(res.Err, entQ.ErrPossibleDuplicates):
+		out.Status, out.Code = http.StatusConflict, "possible_duplicates"
+	case errors.Is(res.Err, entQ.ErrQuestionClosed):
+		out.Status, out.Code = http.StatusConflict, "question_closed"
+	case errors.Is(res.Err, entQ.ErrQuestionLocked):
+		out.Status, out.Code = http.StatusConflict, "question_locked"
+	case errors.Is(res.Err, entQ.ErrQuestionNotFound), errors.Is(res.Err, entA.ErrRequestedQuestionNotFound):
+		out.Status, out.Code = http.StatusNotFound, "question_not_found"
+	case errors.Is(res.Err, entA.ErrAnswerNotFound):
+		out.Status, out.Code = http.StatusNotFound, "answer_not_found"
+	case errors.Is(res.Err, entQ.ErrAccessDenied), errors.Is(res.Err, entA.ErrAccessDenied):
+		out.Status, out.Code = http.StatusForbidden, "access_denied"
+	case errors.Is(res.Err, entB.ErrRateLimited):
+		out.Status, out.Code = http.StatusTooManyRequests, "rate_limited"
+	case errors.Is(res.Err, entB.ErrRolledBack):
+		out.Status, out.Code = http.StatusFailedDependency, "rolled_back"
+	case errors.Is(res.Err, entB.ErrSkipped):
+		out.Status, out.Code = http.StatusFailedDependency, "skipped"
+	case errors.Is(res.Err, entB.ErrRefFailed):
+		out.Status, out.Code = http.StatusFailedDependency, "ref_failed"
+	default:
+		slog.Info("unhandled batch operation error:", "err", res.Err)
+		out.Status, out.Code = http.StatusInternalServerError, "internal_error"
+	}
+
+	out.Message = c.Error(out.Code)
+	if len(out.Fields) > 0 {
+		out.FieldMessages = make(map[string]string, len(out.Fields))
+		for field, code := range out.Fields {
+			out.FieldMessages[field] = c.Field(code, "", params[field])
+		}
+	}
+
+	return out
+}
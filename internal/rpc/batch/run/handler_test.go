@@ -0,0 +1,209 @@
+package run
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entB "test-question/internal/entity/batch"
+	entP "test-question/internal/entity/policy"
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/i18n"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/batch/run/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(body, userID string) *http.Request {
+	req := httptest.NewRequest("POST", "/batch", bytes.NewBufferString(body))
+	if userID != "" {
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), userID))
+	}
+	return req
+}
+
+func TestHandler_Batch_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	ref := 0
+	mUC.On("Run", mock.Anything, "u1", []entB.Operation{
+		{Type: entB.OpCreateQuestion, Text: "q", Force: true},
+		{Type: entB.OpCreateAnswer, Text: "a", QuestionRef: &ref},
+		{Type: entB.OpDeleteAnswer, AnswerID: 4},
+		{Type: entB.OpDeleteQuestion, QuestionID: 5},
+	}, true).Return([]entB.Result{
+		{Question: &entQ.Question{ID: 7}},
+		{Answer: &entA.Answer{ID: 9, QuestionID: 7}},
+		{},
+		{Err: entQ.ErrAccessDenied},
+	}, nil)
+
+	body := `{"atomic":true,"operations":[
+		{"op":"create_question","text":"q","force":true},
+		{"op":"create_answer","text":"a","question_ref":0},
+		{"op":"delete_answer","answer_id":4},
+		{"op":"delete_question","question_id":5}
+	]}`
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest(body, "u1"))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"succeeded":3,"failed":1,"results":[
+		{"index":0,"status":201,"id":7},
+		{"index":1,"status":201,"id":9,"question_id":7},
+		{"index":2,"status":204},
+		{"index":3,"status":403,"code":"access_denied","message":"You are not allowed to do this."}
+	]}`, w.Body.String())
+}
+
+func TestHandler_Batch_OperationErrors(t *testing.T) {
+	tests := []struct {
+		err    error
+		status int
+		code   string
+	}{
+		{entP.Violations{"Text": {Rule: "max_length"}}, http.StatusUnprocessableEntity, "validation_failed"},
+		{entAt.ErrUnavailable, http.StatusUnprocessableEntity, "validation_failed"},
+		{entQ.ErrPossibleDuplicates, http.StatusConflict, "possible_duplicates"},
+		{entQ.ErrQuestionClosed, http.StatusConflict, "question_closed"},
+		{entQ.ErrQuestionLocked, http.StatusConflict, "question_locked"},
+		{entA.ErrRequestedQuestionNotFound, http.StatusNotFound, "question_not_found"},
+		{entA.ErrAnswerNotFound, http.StatusNotFound, "answer_not_found"},
+		{entB.ErrRateLimited, http.StatusTooManyRequests, "rate_limited"},
+		{entB.ErrRolledBack, http.StatusFailedDependency, "rolled_back"},
+		{entB.ErrSkipped, http.StatusFailedDependency, "skipped"},
+		{entB.ErrRefFailed, http.StatusFailedDependency, "ref_failed"},
+		{errors.New("boom"), http.StatusInternalServerError, "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			res := toResult(i18n.Lookup(i18n.English), 2, entB.Result{Err: tt.err})
+			require.Equal(t, 2, res.Index)
+			require.Equal(t, tt.status, res.Status)
+			require.Equal(t, tt.code, res.Code)
+			require.Equal(t, i18n.Lookup(i18n.English).Error(tt.code), res.Message)
+		})
+	}
+}
+
+func TestHandler_Batch_ViolationParams(t *testing.T) {
+	res := toResult(i18n.Lookup(i18n.English), 0, entB.Result{
+		Err: entP.Violations{"Text": {Rule: entP.RuleMaxLength, Param: "10000"}},
+	})
+
+	require.Equal(t, map[string]string{"Text": "max_length"}, res.Fields)
+	require.Equal(t, map[string]string{"Text": "is longer than 10000 characters"}, res.FieldMessages)
+}
+
+func TestHandler_Batch_TooManyOperations(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Run", mock.Anything, "u1", mock.Anything, false).Return(nil, &entB.TooManyOperationsError{Max: 100})
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest(`{"operations":[{"op":"delete_answer","answer_id":1}]}`, "u1"))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, `{
+		"code":"validation_failed",
+		"message":"The request contains invalid fields.",
+		"fields":{"operations":"max"},
+		"field_messages":{"operations":"must be at most 100"}
+	}`, w.Body.String())
+}
+
+func TestHandler_Batch_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		err    error
+		fields string
+	}{
+		{
+			name:   "empty",
+			body:   `{"operations":[]}`,
+			fields: `{"Operations":"min"}`,
+		},
+		{
+			name:   "unknown_op",
+			body:   `{"operations":[{"op":"edit_question"}]}`,
+			fields: `{"Op":"oneof"}`,
+		},
+		{
+			name:   "too_many",
+			body:   `{"operations":[{"op":"delete_answer","answer_id":1}]}`,
+			err:    &entB.TooManyOperationsError{Max: 100},
+			fields: `{"operations":"max"}`,
+		},
+		{
+			name:   "invalid_operation",
+			body:   `{"operations":[{"op":"delete_answer"}]}`,
+			err:    &entB.OperationError{Index: 0, Err: entB.ErrInvalidOperation},
+			fields: `{"operations[0]":"invalid_operation"}`,
+		},
+		{
+			name:   "invalid_ref",
+			body:   `{"operations":[{"op":"create_answer","text":"a","question_ref":3}]}`,
+			err:    &entB.OperationError{Index: 0, Err: entB.ErrInvalidRef},
+			fields: `{"operations[0].question_ref":"invalid_ref"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			if tt.err != nil {
+				mUC.On("Run", mock.Anything, "u1", mock.Anything, false).Return(nil, tt.err)
+			}
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, newRequest(tt.body, "u1"))
+
+			require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+			var resp rpc.ValidationErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			require.Equal(t, "validation_failed", resp.Code)
+			fields, _ := json.Marshal(resp.Fields)
+			require.JSONEq(t, tt.fields, string(fields))
+		})
+	}
+}
+
+func TestHandler_Batch_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest(`{"operations":[{"op":"delete_answer","answer_id":1}]}`, ""))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestHandler_Batch_RateLimited(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Run", mock.Anything, "u1", mock.Anything, true).Return(nil, entB.ErrRateLimited)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest(`{"atomic":true,"operations":[{"op":"delete_answer","answer_id":1}]}`, "u1"))
+
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.JSONEq(t, `{"code":"rate_limited","message":"Too many requests. Please slow down."}`, w.Body.String())
+}
+
+func TestHandler_Batch_UnexpectedError(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Run", mock.Anything, "u1", mock.Anything, true).Return(nil, errors.New("db down"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest(`{"atomic":true,"operations":[{"op":"delete_answer","answer_id":1}]}`, "u1"))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	batch "test-question/internal/entity/batch"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Run provides a mock function with given fields: ctx, userID, ops, atomic
+func (_m *UseCase) Run(ctx context.Context, userID string, ops []batch.Operation, atomic bool) ([]batch.Result, error) {
+	ret := _m.Called(ctx, userID, ops, atomic)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 []batch.Result
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []batch.Operation, bool) ([]batch.Result, error)); ok {
+		return rf(ctx, userID, ops, atomic)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, []batch.Operation, bool) []batch.Result); ok {
+		r0 = rf(ctx, userID, ops, atomic)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]batch.Result)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, []batch.Operation, bool) error); ok {
+		r1 = rf(ctx, userID, ops, atomic)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
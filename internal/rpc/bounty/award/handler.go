@@ -0,0 +1,70 @@
+package award
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entA "test-question/internal/entity/answer"
+	entB "test-question/internal/entity/bounty"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/bounty/offer"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Award(ctx context.Context, answerID int, userID string) (*entB.Bounty, error)
+	}
+)
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	aID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_answer_id")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	b, err := h.uc.Award(r.Context(), aID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entA.ErrAnswerNotFound):
+			rpc.WriteNotFound(w, "answer_not_found")
+			return
+		case errors.Is(err, entA.ErrRequestedQuestionNotFound):
+			rpc.WriteNotFound(w, "question_not_found")
+			return
+		case errors.Is(err, entB.ErrBountyNotFound):
+			rpc.WriteNotFound(w, "bounty_not_found")
+			return
+		case errors.Is(err, entB.ErrAccessDenied):
+			rpc.WriteForbidden(w)
+			return
+		case errors.Is(err, entB.ErrOwnAnswer):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("own_answer"))
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, offer.NewResponse(b))
+}
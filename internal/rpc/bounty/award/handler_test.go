@@ -0,0 +1,76 @@
+package award
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entB "test-question/internal/entity/bounty"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/bounty/award/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func request() *http.Request {
+	req := httptest.NewRequest("POST", "/answers/9/bounty", nil)
+	req.SetPathValue("id", "9")
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+}
+
+func TestHandler_Award_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mUC.On("Award", mock.Anything, 9, "user-1").Return(&entB.Bounty{
+		ID:         3,
+		QuestionID: 7,
+		Amount:     100,
+		Status:     entB.StatusAwarded,
+		AnswerID:   9,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(24 * time.Hour),
+		ClosedAt:   &now,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request())
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"id": 3,
+		"question_id": 7,
+		"amount": 100,
+		"status": "awarded",
+		"answer_id": 9,
+		"created_at": "2024-11-21T10:00:00Z",
+		"expires_at": "2024-11-22T10:00:00Z",
+		"closed_at": "2024-11-21T10:00:00Z"
+	}`, w.Body.String())
+}
+
+func TestHandler_Award_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		code int
+	}{
+		"no answer":     {entA.ErrAnswerNotFound, http.StatusNotFound},
+		"no bounty":     {entB.ErrBountyNotFound, http.StatusNotFound},
+		"not the asker": {entB.ErrAccessDenied, http.StatusForbidden},
+		"own answer":    {entB.ErrOwnAnswer, http.StatusConflict},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+
+			mUC.On("Award", mock.Anything, 9, "user-1").Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, request())
+
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
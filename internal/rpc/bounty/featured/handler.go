@@ -0,0 +1,70 @@
+package featured
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entB "test-question/internal/entity/bounty"
+	"test-question/internal/pkg/rpc"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		ListFeatured(ctx context.Context, limit int) ([]*entB.Featured, error)
+	}
+)
+
+type ResponseItem struct {
+	QuestionID int    `json:"question_id"`
+	Text       string `json:"text"`
+	BountyID   int    `json:"bounty_id"`
+	Amount     int    `json:"amount"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	limit := defaultLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxLimit {
+			rpc.WriteBadRequest(w, "invalid_limit")
+			return
+		}
+		limit = n
+	}
+
+	items, err := h.uc.ListFeatured(r.Context(), limit)
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	resp := make([]ResponseItem, len(items))
+	for i, f := range items {
+		resp[i] = ResponseItem{
+			QuestionID: f.QuestionID,
+			Text:       f.QuestionText,
+			BountyID:   f.ID,
+			Amount:     f.Amount,
+			ExpiresAt:  f.ExpiresAt.Format(time.RFC3339),
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, resp)
+}
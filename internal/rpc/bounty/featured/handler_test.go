@@ -0,0 +1,62 @@
+package featured
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entB "test-question/internal/entity/bounty"
+	"test-question/internal/rpc/bounty/featured/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Featured_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	expires := time.Date(2024, 11, 22, 10, 0, 0, 0, time.UTC)
+
+	mUC.On("ListFeatured", mock.Anything, 5).Return([]*entB.Featured{{
+		Bounty:       entB.Bounty{ID: 3, QuestionID: 7, Amount: 100, ExpiresAt: expires},
+		QuestionText: "why is it slow",
+	}}, nil)
+
+	req := httptest.NewRequest("GET", "/questions/bounties?limit=5", nil)
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{
+		"question_id": 7,
+		"text": "why is it slow",
+		"bounty_id": 3,
+		"amount": 100,
+		"expires_at": "2024-11-22T10:00:00Z"
+	}]`, w.Body.String())
+}
+
+func TestHandler_Featured_DefaultLimit(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("ListFeatured", mock.Anything, defaultLimit).Return([]*entB.Featured{}, nil)
+
+	req := httptest.NewRequest("GET", "/questions/bounties", nil)
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[]`, w.Body.String())
+}
+
+func TestHandler_Featured_InvalidLimit(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	for _, v := range []string{"0", "101", "x"} {
+		req := httptest.NewRequest("GET", "/questions/bounties?limit="+v, nil)
+		w := httptest.NewRecorder()
+		NewHandler(mUC).ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code, v)
+	}
+}
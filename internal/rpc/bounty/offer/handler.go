@@ -0,0 +1,117 @@
+package offer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entB "test-question/internal/entity/bounty"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Offer(ctx context.Context, questionID int, userID string, amount int) (*entB.Bounty, error)
+	}
+)
+
+type Request struct {
+	Amount int `json:"amount" validate:"required,gt=0"`
+}
+
+type Response struct {
+	ID         int     `json:"id"`
+	QuestionID int     `json:"question_id"`
+	Amount     int     `json:"amount"`
+	Status     string  `json:"status"`
+	AnswerID   *int    `json:"answer_id"`
+	CreatedAt  string  `json:"created_at"`
+	ExpiresAt  string  `json:"expires_at"`
+	ClosedAt   *string `json:"closed_at"`
+}
+
+func NewResponse(b *entB.Bounty) Response {
+	resp := Response{
+		ID:         b.ID,
+		QuestionID: b.QuestionID,
+		Amount:     b.Amount,
+		Status:     string(b.Status),
+		CreatedAt:  b.CreatedAt.Format(time.RFC3339),
+		ExpiresAt:  b.ExpiresAt.Format(time.RFC3339),
+	}
+	if b.AnswerID != 0 {
+		answerID := b.AnswerID
+		resp.AnswerID = &answerID
+	}
+	if b.ClosedAt != nil {
+		closedAt := b.ClosedAt.Format(time.RFC3339)
+		resp.ClosedAt = &closedAt
+	}
+	return resp
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	qID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_question_id")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	b, err := h.uc.Offer(r.Context(), qID, userID, req.Amount)
+	if err != nil {
+		switch {
+		case errors.Is(err, entB.ErrInvalidAmount):
+			rpc.WriteValidationError(w, map[string]string{"Amount": "out_of_range"})
+			return
+		case errors.Is(err, entQ.ErrQuestionNotFound):
+			rpc.WriteNotFound(w, "question_not_found")
+			return
+		case errors.Is(err, entB.ErrAccessDenied):
+			rpc.WriteForbidden(w)
+			return
+		case errors.Is(err, entQ.ErrQuestionClosed):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("question_closed"))
+			return
+		case errors.Is(err, entQ.ErrQuestionLocked):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("question_locked"))
+			return
+		case errors.Is(err, entB.ErrBountyExists):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("bounty_exists"))
+			return
+		case errors.Is(err, entR.ErrInsufficientReputation):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("insufficient_reputation"))
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusCreated, NewResponse(b))
+}
@@ -0,0 +1,88 @@
+package offer
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	entB "test-question/internal/entity/bounty"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/bounty/offer/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func request(body string) *http.Request {
+	req := httptest.NewRequest("POST", "/questions/7/bounty", strings.NewReader(body))
+	req.SetPathValue("id", "7")
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+}
+
+func TestHandler_Offer_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mUC.On("Offer", mock.Anything, 7, "user-1", 100).Return(&entB.Bounty{
+		ID:         3,
+		QuestionID: 7,
+		UserID:     "user-1",
+		Amount:     100,
+		Status:     entB.StatusOpen,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(24 * time.Hour),
+	}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request(`{"amount":100}`))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.JSONEq(t, `{
+		"id": 3,
+		"question_id": 7,
+		"amount": 100,
+		"status": "open",
+		"answer_id": null,
+		"created_at": "2024-11-21T10:00:00Z",
+		"expires_at": "2024-11-22T10:00:00Z",
+		"closed_at": null
+	}`, w.Body.String())
+}
+
+func TestHandler_Offer_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		code int
+	}{
+		"amount out of range":     {entB.ErrInvalidAmount, http.StatusUnprocessableEntity},
+		"no question":             {entQ.ErrQuestionNotFound, http.StatusNotFound},
+		"not the asker":           {entB.ErrAccessDenied, http.StatusForbidden},
+		"question closed":         {entQ.ErrQuestionClosed, http.StatusConflict},
+		"bounty already open":     {entB.ErrBountyExists, http.StatusConflict},
+		"insufficient reputation": {entR.ErrInsufficientReputation, http.StatusConflict},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+
+			mUC.On("Offer", mock.Anything, 7, "user-1", 100).Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, request(`{"amount":100}`))
+
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_Offer_InvalidBody(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request(`{"amount":-5}`))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
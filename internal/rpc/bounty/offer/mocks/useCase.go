@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	bounty "test-question/internal/entity/bounty"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Offer provides a mock function with given fields: ctx, questionID, userID, amount
+func (_m *UseCase) Offer(ctx context.Context, questionID int, userID string, amount int) (*bounty.Bounty, error) {
+	ret := _m.Called(ctx, questionID, userID, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Offer")
+	}
+
+	var r0 *bounty.Bounty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, int) (*bounty.Bounty, error)); ok {
+		return rf(ctx, questionID, userID, amount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, int) *bounty.Bounty); ok {
+		r0 = rf(ctx, questionID, userID, amount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bounty.Bounty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, int) error); ok {
+		r1 = rf(ctx, questionID, userID, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,54 @@
+package delete //nolint:predeclared
+
+import (
+	"context"
+	"net/http"
+
+	entD "test-question/internal/entity/draft"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Delete(ctx context.Context, userID string, key entD.Key) error
+	}
+)
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key, err := entD.ParseKey(r.PathValue("key"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_draft_key")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	if err = h.uc.Delete(r.Context(), userID, key); err != nil {
+		switch {
+		case errors.Is(err, entD.ErrDraftNotFound):
+			rpc.WriteNotFound(w, "draft_not_found")
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
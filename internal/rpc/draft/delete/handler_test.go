@@ -0,0 +1,42 @@
+package delete //nolint:predeclared
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entD "test-question/internal/entity/draft"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/draft/delete/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func request(key string) *http.Request {
+	req := httptest.NewRequest("DELETE", "/me/drafts/"+key, nil)
+	req.SetPathValue("key", key)
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+}
+
+func TestHandler_Delete_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("Delete", mock.Anything, "user-1", entD.AnswerKey(4)).Return(nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("answer-4"))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Delete_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("Delete", mock.Anything, "user-1", entD.QuestionKey).Return(entD.ErrDraftNotFound)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("question"))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,79 @@
+package get
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	entD "test-question/internal/entity/draft"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Get(ctx context.Context, userID string, key entD.Key) (*entD.Draft, error)
+	}
+)
+
+type Response struct {
+	Key           string    `json:"key"`
+	Text          string    `json:"text"`
+	AttachmentIDs []int     `json:"attachment_ids"`
+	UpdatedAt     time.Time `json:"updated_at"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+func NewResponse(d *entD.Draft) Response {
+	ids := d.AttachmentIDs
+	if ids == nil {
+		ids = []int{}
+	}
+
+	return Response{
+		Key:           d.Key.String(),
+		Text:          d.Text,
+		AttachmentIDs: ids,
+		UpdatedAt:     d.UpdatedAt,
+		ExpiresAt:     d.ExpiresAt,
+	}
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key, err := entD.ParseKey(r.PathValue("key"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_draft_key")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	d, err := h.uc.Get(r.Context(), userID, key)
+	if err != nil {
+		switch {
+		case errors.Is(err, entD.ErrDraftNotFound):
+			rpc.WriteNotFound(w, "draft_not_found")
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, NewResponse(d))
+}
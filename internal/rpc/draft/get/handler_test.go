@@ -0,0 +1,66 @@
+package get
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entD "test-question/internal/entity/draft"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/draft/get/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func request(key string) *http.Request {
+	req := httptest.NewRequest("GET", "/me/drafts/"+key, nil)
+	req.SetPathValue("key", key)
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+}
+
+func TestHandler_Get_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mUC.On("Get", mock.Anything, "user-1", entD.AnswerKey(7)).Return(&entD.Draft{
+		UserID:    "user-1",
+		Key:       entD.AnswerKey(7),
+		Text:      "try restarting it",
+		UpdatedAt: now,
+		ExpiresAt: now.Add(time.Hour),
+	}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("answer-7"))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"key": "answer-7",
+		"text": "try restarting it",
+		"attachment_ids": [],
+		"updated_at": "2024-11-21T10:00:00Z",
+		"expires_at": "2024-11-21T11:00:00Z"
+	}`, w.Body.String())
+}
+
+func TestHandler_Get_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("Get", mock.Anything, "user-1", entD.QuestionKey).Return(nil, entD.ErrDraftNotFound)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("question"))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_Get_InvalidKey(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("answer-x"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
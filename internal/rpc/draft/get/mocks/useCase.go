@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	draft "test-question/internal/entity/draft"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, userID, key
+func (_m *UseCase) Get(ctx context.Context, userID string, key draft.Key) (*draft.Draft, error) {
+	ret := _m.Called(ctx, userID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *draft.Draft
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, draft.Key) (*draft.Draft, error)); ok {
+		return rf(ctx, userID, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, draft.Key) *draft.Draft); ok {
+		r0 = rf(ctx, userID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*draft.Draft)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, draft.Key) error); ok {
+		r1 = rf(ctx, userID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,182 @@
+package publish
+
+import (
+	"context"
+	"net/http"
+
+	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entD "test-question/internal/entity/draft"
+	entM "test-question/internal/entity/mention"
+	entP "test-question/internal/entity/policy"
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		PublishQuestion(ctx context.Context, userID string, force bool) (*entQ.Question, []*entQ.SimilarQuestion, error)
+		PublishAnswer(ctx context.Context, userID string, questionID int) (*entA.Answer, error)
+	}
+)
+
+type Request struct {
+	// Force publishes a question draft even if similar questions exist.
+	Force bool `json:"force"`
+}
+
+// QuestionResponse is what POST /questions answers with.
+type QuestionResponse struct {
+	ID       int       `json:"id"`
+	Text     string    `json:"text"`
+	Mentions []Mention `json:"mentions"`
+	Warnings *Warnings `json:"warnings,omitempty"`
+}
+
+// AnswerResponse is what POST /questions/{id}/answers answers with.
+type AnswerResponse struct {
+	ID         int       `json:"id"`
+	Text       string    `json:"text"`
+	UserID     string    `json:"user_id"`
+	QuestionID int       `json:"question_id"`
+	Mentions   []Mention `json:"mentions"`
+}
+
+// Mention is a user the text mentions as @username.
+type Mention struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type Warnings struct {
+	PossibleDuplicates []Duplicate `json:"possible_duplicates"`
+}
+
+type Duplicate struct {
+	ID         int     `json:"id"`
+	Text       string  `json:"text"`
+	Similarity float64 `json:"similarity"`
+}
+
+type DuplicatesResponse struct {
+	rpc.BaseHTTPError
+	PossibleDuplicates []Duplicate `json:"possible_duplicates"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key, err := entD.ParseKey(r.PathValue("key"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_draft_key")
+		return
+	}
+
+	var req Request
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	if key.IsAnswer() {
+		h.publishAnswer(w, r, userID, key.QuestionID)
+		return
+	}
+
+	q, similar, err := h.uc.PublishQuestion(r.Context(), userID, req.Force)
+	if err != nil {
+		if errors.Is(err, entQ.ErrPossibleDuplicates) {
+			rpc.WriteJSON(w, http.StatusConflict, &DuplicatesResponse{
+				BaseHTTPError:      rpc.BaseHTTPError{Code: "possible_duplicates"},
+				PossibleDuplicates: toDuplicates(similar),
+			})
+			return
+		}
+
+		writeError(w, err)
+		return
+	}
+
+	resp := QuestionResponse{
+		ID:       q.ID,
+		Text:     q.Text,
+		Mentions: toMentions(q.Mentions),
+	}
+	if len(similar) > 0 {
+		resp.Warnings = &Warnings{PossibleDuplicates: toDuplicates(similar)}
+	}
+
+	rpc.WriteJSON(w, http.StatusCreated, resp)
+}
+
+func (h *Handler) publishAnswer(w http.ResponseWriter, r *http.Request, userID string, questionID int) {
+	a, err := h.uc.PublishAnswer(r.Context(), userID, questionID)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	rpc.WriteJSON(w, http.StatusCreated, AnswerResponse{
+		ID:         a.ID,
+		Text:       a.Text,
+		UserID:     a.UserID,
+		QuestionID: a.QuestionID,
+		Mentions:   toMentions(a.Mentions),
+	})
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	var violations entP.Violations
+
+	switch {
+	case errors.Is(err, entD.ErrDraftNotFound):
+		rpc.WriteNotFound(w, "draft_not_found")
+	case errors.As(err, &violations):
+		rpc.WriteValidationErrorWithParams(w, violations.Rules(), violations.Params())
+	case errors.Is(err, entAt.ErrUnavailable):
+		rpc.WriteValidationError(w, map[string]string{"AttachmentIDs": "unavailable"})
+	case errors.Is(err, entA.ErrRequestedQuestionNotFound):
+		rpc.WriteNotFound(w, "question_not_found")
+	case errors.Is(err, entQ.ErrQuestionClosed):
+		rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("question_closed"))
+	case errors.Is(err, entQ.ErrQuestionLocked):
+		rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("question_locked"))
+	default:
+		rpc.WriteUnexpectedError(w, err)
+	}
+}
+
+func toDuplicates(similar []*entQ.SimilarQuestion) []Duplicate {
+	out := make([]Duplicate, len(similar))
+	for i, s := range similar {
+		out[i] = Duplicate{
+			ID:         s.ID,
+			Text:       s.Text,
+			Similarity: s.Similarity,
+		}
+	}
+	return out
+}
+
+func toMentions(ms []*entM.Mention) []Mention {
+	out := make([]Mention, len(ms))
+	for i, m := range ms {
+		out[i] = Mention{UserID: m.UserID, Username: m.Username}
+	}
+	return out
+}
@@ -0,0 +1,112 @@
+package publish
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	entA "test-question/internal/entity/answer"
+	entD "test-question/internal/entity/draft"
+	entP "test-question/internal/entity/policy"
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/draft/publish/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func request(key, body string) *http.Request {
+	req := httptest.NewRequest("POST", "/me/drafts/"+key+"/publish", strings.NewReader(body))
+	req.SetPathValue("key", key)
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+}
+
+func TestHandler_PublishQuestion_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("PublishQuestion", mock.Anything, "user-1", true).
+		Return(&entQ.Question{ID: 7, Text: "why is it slow"}, []*entQ.SimilarQuestion{{ID: 2, Text: "slow", Similarity: 0.7}}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("question", `{"force":true}`))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.JSONEq(t, `{
+		"id": 7,
+		"text": "why is it slow",
+		"mentions": [],
+		"warnings": {"possible_duplicates": [{"id": 2, "text": "slow", "similarity": 0.7}]}
+	}`, w.Body.String())
+}
+
+func TestHandler_PublishQuestion_Duplicates(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("PublishQuestion", mock.Anything, "user-1", false).
+		Return(nil, []*entQ.SimilarQuestion{{ID: 2}}, entQ.ErrPossibleDuplicates)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("question", `{}`))
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	require.Contains(t, w.Body.String(), "possible_duplicates")
+}
+
+func TestHandler_PublishQuestion_Violations(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("PublishQuestion", mock.Anything, "user-1", false).
+		Return(nil, nil, entP.Violations{"Text": {Rule: "banned_word"}})
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("question", `{}`))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestHandler_PublishAnswer_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("PublishAnswer", mock.Anything, "user-1", 7).
+		Return(&entA.Answer{ID: 9, QuestionID: 7, UserID: "user-1", Text: "restart it"}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("answer-7", `{}`))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.JSONEq(t, `{"id": 9, "text": "restart it", "user_id": "user-1", "question_id": 7, "mentions": []}`, w.Body.String())
+}
+
+func TestHandler_PublishAnswer_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		code int
+	}{
+		"no draft":        {entD.ErrDraftNotFound, http.StatusNotFound},
+		"no question":     {entA.ErrRequestedQuestionNotFound, http.StatusNotFound},
+		"question closed": {entQ.ErrQuestionClosed, http.StatusConflict},
+		"question locked": {entQ.ErrQuestionLocked, http.StatusConflict},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+
+			mUC.On("PublishAnswer", mock.Anything, "user-1", 7).Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, request("answer-7", `{}`))
+
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_Publish_InvalidKey(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("answers-7", `{}`))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,100 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	answer "test-question/internal/entity/answer"
+
+	mock "github.com/stretchr/testify/mock"
+
+	question "test-question/internal/entity/question"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// PublishAnswer provides a mock function with given fields: ctx, userID, questionID
+func (_m *UseCase) PublishAnswer(ctx context.Context, userID string, questionID int) (*answer.Answer, error) {
+	ret := _m.Called(ctx, userID, questionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishAnswer")
+	}
+
+	var r0 *answer.Answer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (*answer.Answer, error)); ok {
+		return rf(ctx, userID, questionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *answer.Answer); ok {
+		r0 = rf(ctx, userID, questionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*answer.Answer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, userID, questionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PublishQuestion provides a mock function with given fields: ctx, userID, force
+func (_m *UseCase) PublishQuestion(ctx context.Context, userID string, force bool) (*question.Question, []*question.SimilarQuestion, error) {
+	ret := _m.Called(ctx, userID, force)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishQuestion")
+	}
+
+	var r0 *question.Question
+	var r1 []*question.SimilarQuestion
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (*question.Question, []*question.SimilarQuestion, error)); ok {
+		return rf(ctx, userID, force)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *question.Question); ok {
+		r0 = rf(ctx, userID, force)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*question.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) []*question.SimilarQuestion); ok {
+		r1 = rf(ctx, userID, force)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*question.SimilarQuestion)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, bool) error); ok {
+		r2 = rf(ctx, userID, force)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+package save
+
+import (
+	"context"
+	"net/http"
+
+	entD "test-question/internal/entity/draft"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/draft/get"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Save(ctx context.Context, userID string, key entD.Key, text string, attachmentIDs []int) (*entD.Draft, error)
+	}
+)
+
+type Request struct {
+	Text string `json:"text" validate:"required,min=1"`
+	// AttachmentIDs are the user's uploads, checked when the draft is published.
+	AttachmentIDs []int `json:"attachment_ids" validate:"max=10,dive,gt=0"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key, err := entD.ParseKey(r.PathValue("key"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_draft_key")
+		return
+	}
+
+	var req Request
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	d, err := h.uc.Save(r.Context(), userID, key, req.Text, req.AttachmentIDs)
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, get.NewResponse(d))
+}
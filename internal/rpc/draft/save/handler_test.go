@@ -0,0 +1,78 @@
+package save
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	entD "test-question/internal/entity/draft"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/draft/save/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func request(key, body string) *http.Request {
+	req := httptest.NewRequest("PUT", "/me/drafts/"+key, strings.NewReader(body))
+	req.SetPathValue("key", key)
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+}
+
+func TestHandler_Save_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mUC.On("Save", mock.Anything, "user-1", entD.QuestionKey, "why is it slow", []int{3}).Return(&entD.Draft{
+		UserID:        "user-1",
+		Key:           entD.QuestionKey,
+		Text:          "why is it slow",
+		AttachmentIDs: []int{3},
+		UpdatedAt:     now,
+		ExpiresAt:     now.Add(time.Hour),
+	}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("question", `{"text":"why is it slow","attachment_ids":[3]}`))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"key": "question",
+		"text": "why is it slow",
+		"attachment_ids": [3],
+		"updated_at": "2024-11-21T10:00:00Z",
+		"expires_at": "2024-11-21T11:00:00Z"
+	}`, w.Body.String())
+}
+
+func TestHandler_Save_InvalidKey(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("answer-0", `{"text":"hi"}`))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Save_EmptyText(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request("question", `{"text":""}`))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestHandler_Save_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	req := httptest.NewRequest("PUT", "/me/drafts/question", strings.NewReader(`{"text":"hi"}`))
+	req.SetPathValue("key", "question")
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
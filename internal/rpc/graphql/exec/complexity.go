@@ -0,0 +1,159 @@
+package exec
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// listFields are the fields whose first argument multiplies the cost of
+// their selections.
+var listFields = map[string]bool{"questions": true, "answers": true} //nolint:gochecknoglobals
+
+// complexity estimates how many fields a query resolves: every field costs
+// one plus the cost of its selections, times the page size for list fields.
+// The operation named operationName is measured, the costliest one when no
+// name is given. Measuring stops once the cost passes limit, so a cost above
+// limit is only a lower bound.
+func complexity(query, operationName string, variables map[string]any, limit int) (int, error) {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return 0, err
+	}
+
+	ops := doc.Operations
+	if operationName != "" {
+		op := doc.Operations.ForName(operationName)
+		if op == nil {
+			return 0, nil
+		}
+		ops = ast.OperationList{op}
+	}
+
+	var most int
+	for _, op := range ops {
+		m := &measure{
+			doc:       doc,
+			op:        op,
+			variables: variables,
+			limit:     limit,
+			fragments: map[string]int{},
+			visiting:  map[string]bool{},
+		}
+		most = max(most, m.selections(op.SelectionSet))
+		if most > limit {
+			break
+		}
+	}
+	return most, nil
+}
+
+type measure struct {
+	doc       *ast.QueryDocument
+	op        *ast.OperationDefinition
+	variables map[string]any
+	limit     int
+	// fragments caches the cost of each fragment by name: fragments take no
+	// arguments, so a fragment spread many times is measured once.
+	fragments map[string]int
+	// visiting guards against fragments that spread themselves, which
+	// validation refuses later anyway.
+	visiting map[string]bool
+}
+
+func (m *measure) selections(set ast.SelectionSet) int {
+	var cost int
+	for _, sel := range set {
+		switch s := sel.(type) {
+		case *ast.Field:
+			cost = saturatingAdd(cost, saturatingAdd(1, saturatingMul(m.multiplier(s), m.selections(s.SelectionSet))))
+		case *ast.InlineFragment:
+			cost = saturatingAdd(cost, m.selections(s.SelectionSet))
+		case *ast.FragmentSpread:
+			cost = saturatingAdd(cost, m.fragment(s.Name))
+		}
+		if cost > m.limit {
+			break
+		}
+	}
+	return cost
+}
+
+func (m *measure) fragment(name string) int {
+	if cost, ok := m.fragments[name]; ok {
+		return cost
+	}
+
+	f := m.doc.Fragments.ForName(name)
+	if f == nil || m.visiting[name] {
+		return 0
+	}
+
+	m.visiting[name] = true
+	cost := m.selections(f.SelectionSet)
+	delete(m.visiting, name)
+
+	m.fragments[name] = cost
+	return cost
+}
+
+// multiplier is the number of items a list field is asked for, one for any
+// other field.
+func (m *measure) multiplier(f *ast.Field) int {
+	if !listFields[f.Name] {
+		return 1
+	}
+
+	arg := f.Arguments.ForName("first")
+	if arg == nil {
+		return pageSize(0)
+	}
+	first, ok := m.intValue(arg.Value)
+	if !ok {
+		return pageSize(0)
+	}
+	return pageSize(first)
+}
+
+func (m *measure) intValue(v *ast.Value) (int32, bool) {
+	if v == nil {
+		return 0, false
+	}
+
+	switch v.Kind { //nolint:exhaustive
+	case ast.IntValue:
+		n, err := strconv.ParseInt(v.Raw, 10, 32)
+		return int32(n), err == nil
+	case ast.Variable:
+		switch n := m.variables[v.Raw].(type) {
+		case float64:
+			return clampInt32(n), true
+		case int:
+			return clampInt32(float64(n)), true
+		}
+		if def := m.op.VariableDefinitions.ForName(v.Raw); def != nil {
+			return m.intValue(def.DefaultValue)
+		}
+	}
+	return 0, false
+}
+
+func clampInt32(f float64) int32 {
+	return int32(max(math.MinInt32, min(math.MaxInt32, f)))
+}
+
+func saturatingAdd(a, b int) int {
+	if a > math.MaxInt-b {
+		return math.MaxInt
+	}
+	return a + b
+}
+
+func saturatingMul(a, b int) int {
+	if a != 0 && b > math.MaxInt/a {
+		return math.MaxInt
+	}
+	return a * b
+}
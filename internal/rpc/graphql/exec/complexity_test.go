@@ -0,0 +1,114 @@
+package exec
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestComplexity(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		operationName string
+		variables     map[string]any
+		want          int
+	}{
+		{
+			name:  "plain fields",
+			query: `{ me { id username } }`,
+			want:  3,
+		},
+		{
+			name:  "list with default page",
+			query: `{ questions { id } }`,
+			want:  1 + 20*1,
+		},
+		{
+			name:  "nested lists multiply",
+			query: `{ questions(first: 2) { id answers(first: 3) { id } } }`,
+			want:  1 + 2*(1+1+3*1),
+		},
+		{
+			name:  "first is clamped",
+			query: `{ questions(first: 1000) { id } }`,
+			want:  1 + 100,
+		},
+		{
+			name:      "first from a variable",
+			query:     `query($n: Int) { questions(first: $n) { id } }`,
+			variables: map[string]any{"n": float64(4)},
+			want:      1 + 4,
+		},
+		{
+			name:  "first from a variable default",
+			query: `query($n: Int = 3) { questions(first: $n) { id } }`,
+			want:  1 + 3,
+		},
+		{
+			name:  "fragments",
+			query: `{ questions(first: 2) { ...Q ... on Question { text } } } fragment Q on Question { id }`,
+			want:  1 + 2*2,
+		},
+		{
+			name:  "fragment spreading itself",
+			query: `{ me { ...U } } fragment U on User { id ...U }`,
+			want:  2,
+		},
+		{
+			name:          "named operation",
+			query:         `query A { me { id } } query B { questions(first: 1) { id } }`,
+			operationName: "A",
+			want:          2,
+		},
+		{
+			name:  "costliest operation",
+			query: `query A { me { id } } query B { questions(first: 5) { id } }`,
+			want:  6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := complexity(tt.query, tt.operationName, tt.variables, math.MaxInt)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestComplexity_ParseError(t *testing.T) {
+	_, err := complexity(`{ me {`, "", nil, math.MaxInt)
+	require.Error(t, err)
+}
+
+// doublingChain spreads each of n fragments twice into the next, so walking
+// every spread takes 2^n steps.
+func doublingChain(n int) string {
+	var b strings.Builder
+	b.WriteString("{ ...F0 }")
+	for i := range n {
+		fmt.Fprintf(&b, " fragment F%d on Query { ...F%d ...F%d }", i, i+1, i+1)
+	}
+	fmt.Fprintf(&b, " fragment F%d on Query { me { id } }", n)
+	return b.String()
+}
+
+func TestComplexity_FragmentsMeasuredOnce(t *testing.T) {
+	got, err := complexity(doublingChain(20), "", nil, math.MaxInt)
+	require.NoError(t, err)
+	require.Equal(t, 2<<20, got)
+
+	got, err = complexity(doublingChain(200), "", nil, math.MaxInt)
+	require.NoError(t, err)
+	require.Equal(t, math.MaxInt, got)
+}
+
+func TestComplexity_StopsPastLimit(t *testing.T) {
+	got, err := complexity(doublingChain(200), "", nil, 500)
+	require.NoError(t, err)
+	require.Greater(t, got, 500)
+}
@@ -0,0 +1,68 @@
+package exec
+
+import (
+	"context"
+	"log/slog"
+
+	entP "test-question/internal/entity/policy"
+	"test-question/internal/pkg/i18n"
+)
+
+// gqlError is a resolver error with the same code, and the same field codes,
+// the REST endpoints answer with; its message is in the language of the
+// response.
+type gqlError struct {
+	code    string
+	message string
+	fields  map[string]string
+	params  map[string]string
+	catalog *i18n.Catalog
+}
+
+func (e *gqlError) Error() string {
+	return e.message
+}
+
+func (e *gqlError) Extensions() map[string]any {
+	ext := map[string]any{"code": e.code}
+	if len(e.fields) > 0 {
+		messages := make(map[string]string, len(e.fields))
+		for field, code := range e.fields {
+			messages[field] = e.catalog.Field(code, "", e.params[field])
+		}
+		ext["fields"] = e.fields
+		ext["fieldMessages"] = messages
+	}
+	return ext
+}
+
+func newError(ctx context.Context, code string) *gqlError {
+	c := catalogOf(ctx)
+	return &gqlError{code: code, message: c.Error(code), catalog: c}
+}
+
+func newValidationError(ctx context.Context, fields map[string]string) *gqlError {
+	e := newError(ctx, "validation_failed")
+	e.fields = fields
+	return e
+}
+
+// newViolationError reports a content policy violation, with the limits of
+// the broken rules in the field messages.
+func newViolationError(ctx context.Context, violations entP.Violations) *gqlError {
+	e := newValidationError(ctx, violations.Rules())
+	e.params = violations.Params()
+	return e
+}
+
+func unexpectedError(ctx context.Context, err error) *gqlError {
+	slog.Info("unhandled error:", "err", err)
+	return newError(ctx, "internal_error")
+}
+
+func catalogOf(ctx context.Context) *i18n.Catalog {
+	if s := stateOf(ctx); s != nil {
+		return s.catalog
+	}
+	return i18n.Lookup(i18n.English)
+}
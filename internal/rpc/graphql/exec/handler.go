@@ -0,0 +1,168 @@
+package exec
+
+import (
+	"context"
+	_ "embed"
+	"net/http"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+	"test-question/internal/pkg/i18n"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/usecase/question/get_with_answers"
+
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+//go:generate mockery --name=questionReader --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=questionLister --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=questionCreator --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=questionDeleter --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerReader --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerCreator --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerDeleter --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=lookup --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=quota --output=mocks --outpkg=mocks --exported
+type (
+	questionReader interface {
+		GetQuestionWithAnswers(ctx context.Context, questionID int) (*get_with_answers.QuestionWithAnswers, error)
+	}
+
+	questionLister interface {
+		ListQuestions(ctx context.Context, includeDeleted bool) ([]*entQ.Question, error)
+	}
+
+	questionCreator interface {
+		CreateQuestion(
+			ctx context.Context,
+			userID string,
+			text string,
+			force bool,
+			attachmentIDs []int,
+		) (*entQ.Question, []*entQ.SimilarQuestion, error)
+	}
+
+	questionDeleter interface {
+		DeleteQuestion(ctx context.Context, questionID int, userID string) error
+	}
+
+	answerReader interface {
+		GetAnswer(ctx context.Context, answerID int) (*entA.Answer, error)
+	}
+
+	answerCreator interface {
+		CreateAnswer(
+			ctx context.Context,
+			questionID int,
+			userID string,
+			text string,
+			attachmentIDs []int,
+		) (*entA.Answer, error)
+	}
+
+	answerDeleter interface {
+		DeleteAnswer(ctx context.Context, answerID int, userID string) error
+	}
+
+	// lookup loads what is shown alongside many posts in one go.
+	lookup interface {
+		Questions(ctx context.Context, ids []int) (map[int]*entQ.Question, error)
+		Answers(ctx context.Context, questionIDs []int, sort entA.Sort, limit int) (map[int][]*entA.Answer, error)
+		AnswerCounts(ctx context.Context, questionIDs []int) (map[int]int, error)
+		Users(ctx context.Context, ids []string) (map[string]*entU.User, error)
+		Reputations(ctx context.Context, userIDs []string) (map[string]int, error)
+	}
+
+	// quota takes a token of a rate limit of the user.
+	quota interface {
+		Allow(ctx context.Context, route, userID string) bool
+	}
+)
+
+// UseCases are the use cases the schema maps onto.
+type UseCases struct {
+	GetQuestion    questionReader
+	ListQuestions  questionLister
+	CreateQuestion questionCreator
+	DeleteQuestion questionDeleter
+	GetAnswer      answerReader
+	CreateAnswer   answerCreator
+	DeleteAnswer   answerDeleter
+	Lookup         lookup
+}
+
+type Config struct {
+	// MaxDepth caps how deep selections nest.
+	MaxDepth int
+	// MaxComplexity caps the estimated number of fields a query resolves,
+	// see complexity.
+	MaxComplexity int
+}
+
+type Request struct {
+	Query         string         `json:"query" validate:"required"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Handler serves GraphQL queries and mutations over the use cases, as the
+// user the request is authenticated as. The request takes one token of the
+// "graphql" rate limit; on top of that createQuestion and createAnswer take
+// one of the "questions" or "answers" limit, like the REST create endpoints,
+// and fail with rate_limited when the bucket is empty.
+type Handler struct {
+	schema *graphql.Schema
+	uc     UseCases
+	cfg    Config
+}
+
+func NewHandler(uc UseCases, quota quota, cfg Config) *Handler {
+	schema := graphql.MustParseSchema(schemaSDL, &resolver{uc: uc, quota: quota},
+		graphql.MaxDepth(cfg.MaxDepth),
+		graphql.UseStringDescriptions(),
+	)
+	return &Handler{schema: schema, uc: uc, cfg: cfg}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	c := rpc.Catalog(w)
+
+	// documents that do not parse are left to Exec to report
+	if cost, err := complexity(req.Query, req.OperationName, req.Variables, h.cfg.MaxComplexity); err == nil && cost > h.cfg.MaxComplexity {
+		rpc.WriteJSON(w, http.StatusOK, &graphql.Response{Errors: []*gqlerrors.QueryError{{
+			Message:    c.Error("query_too_complex"),
+			Extensions: map[string]any{"code": "query_too_complex", "complexity": cost, "max": h.cfg.MaxComplexity},
+		}}})
+		return
+	}
+
+	ctx := withState(r.Context(), &state{loaders: newLoaders(h.uc.Lookup), catalog: c})
+	rpc.WriteJSON(w, http.StatusOK, h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+}
+
+// state is what the resolvers of one request share.
+type state struct {
+	loaders *loaders
+	catalog *i18n.Catalog
+}
+
+type stateKey struct{}
+
+func withState(ctx context.Context, s *state) context.Context {
+	return context.WithValue(ctx, stateKey{}, s)
+}
+
+func stateOf(ctx context.Context) *state {
+	s, _ := ctx.Value(stateKey{}).(*state)
+	return s
+}
@@ -0,0 +1,484 @@
+package exec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entP "test-question/internal/entity/policy"
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/pkg/rpc/rpc_i18n"
+	"test-question/internal/rpc/graphql/exec/mocks"
+	"test-question/internal/usecase/question/get_with_answers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ann = "11111111-1111-1111-1111-111111111111"
+	bob = "22222222-2222-2222-2222-222222222222"
+)
+
+type response struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+var cfg = Config{MaxDepth: 6, MaxComplexity: 500}
+
+// do runs the query on h as userID, anonymously when it is empty.
+func do(t *testing.T, h http.Handler, userID, query string, variables map[string]any) response {
+	t.Helper()
+
+	body, err := json.Marshal(Request{Query: query, Variables: variables})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewReader(body))
+	if userID != "" {
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), userID))
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	return resp
+}
+
+// sameKeys matches a batch of keys in any order.
+func sameKeys[K int | string](want ...K) any {
+	slices.Sort(want)
+	return mock.MatchedBy(func(got []K) bool {
+		got = slices.Clone(got)
+		slices.Sort(got)
+		return slices.Equal(got, want)
+	})
+}
+
+func TestHandler_Question_BatchesAuthors(t *testing.T) {
+	mGetQuestion := mocks.NewQuestionReader(t)
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	mGetQuestion.
+		On("GetQuestionWithAnswers", mock.Anything, 1).
+		Return(&get_with_answers.QuestionWithAnswers{
+			Question: &entQ.Question{ID: 1, Text: "why?", UserID: ann, Status: entQ.StatusOpen, CreatedAt: now},
+			Answers: []*entA.Answer{
+				{ID: 10, QuestionID: 1, UserID: bob, Text: "because", CreatedAt: now},
+				{ID: 11, QuestionID: 1, UserID: ann, Text: "indeed", CreatedAt: now},
+			},
+			AnswerCount: 2,
+		}, nil).
+		Once()
+	mLookup.
+		On("Users", mock.Anything, sameKeys(ann, bob)).
+		Return(map[string]*entU.User{
+			ann: {ID: ann, Username: "ann"},
+			bob: {ID: bob, Username: "bob"},
+		}, nil).
+		Once()
+	mLookup.
+		On("Reputations", mock.Anything, sameKeys(ann, bob)).
+		Return(map[string]int{ann: 5}, nil).
+		Once()
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		GetQuestion: mGetQuestion,
+		Lookup:      mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, "", `{
+		question(id: "1") {
+			id text status answerCount createdAt
+			author { username reputation }
+			answers { id author { username reputation } }
+		}
+	}`, nil)
+
+	require.Empty(t, resp.Errors)
+	require.Equal(t, map[string]any{
+		"id":          "1",
+		"text":        "why?",
+		"status":      "OPEN",
+		"answerCount": float64(2),
+		"createdAt":   "2025-01-02T03:04:05Z",
+		"author":      map[string]any{"username": "ann", "reputation": float64(5)},
+		"answers": []any{
+			map[string]any{"id": "10", "author": map[string]any{"username": "bob", "reputation": float64(0)}},
+			map[string]any{"id": "11", "author": map[string]any{"username": "ann", "reputation": float64(5)}},
+		},
+	}, resp.Data["question"])
+}
+
+func TestHandler_Question_NotFound(t *testing.T) {
+	mGetQuestion := mocks.NewQuestionReader(t)
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	mGetQuestion.
+		On("GetQuestionWithAnswers", mock.Anything, 7).
+		Return(nil, entQ.ErrQuestionNotFound)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		GetQuestion: mGetQuestion,
+		Lookup:      mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, "", `{ question(id: "7") { id } }`, nil)
+
+	require.Empty(t, resp.Errors)
+	require.Nil(t, resp.Data["question"])
+}
+
+func TestHandler_Question_InvalidID(t *testing.T) {
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		Lookup: mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, "", `{ question(id: "abc") { id } }`, nil)
+
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "invalid_question_id", resp.Errors[0].Extensions["code"])
+}
+
+func TestHandler_Questions_BatchesAnswers(t *testing.T) {
+	mListQuestions := mocks.NewQuestionLister(t)
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	mListQuestions.
+		On("ListQuestions", mock.Anything, false).
+		Return([]*entQ.Question{
+			{ID: 1, UserID: ann, Status: entQ.StatusOpen},
+			{ID: 2, UserID: bob, Status: entQ.StatusClosed},
+			{ID: 3, UserID: bob, Status: entQ.StatusOpen},
+		}, nil)
+	mLookup.
+		On("AnswerCounts", mock.Anything, sameKeys(1, 2)).
+		Return(map[int]int{1: 1, 2: 1}, nil).
+		Once()
+	mLookup.
+		On("Answers", mock.Anything, sameKeys(1, 2), entA.SortScore, 5).
+		Return(map[int][]*entA.Answer{
+			1: {{ID: 10, QuestionID: 1, UserID: bob}},
+			2: {{ID: 20, QuestionID: 2, UserID: ann}},
+		}, nil).
+		Once()
+	mLookup.
+		On("Users", mock.Anything, sameKeys(ann, bob)).
+		Return(map[string]*entU.User{
+			ann: {ID: ann, Username: "ann"},
+			bob: {ID: bob, Username: "bob"},
+		}, nil).
+		Once()
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		ListQuestions: mListQuestions,
+		Lookup:        mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, "", `query($n: Int) {
+		questions(first: $n) {
+			id status answerCount
+			answers(first: 5, sort: SCORE) { id author { username } }
+		}
+	}`, map[string]any{"n": 2})
+
+	require.Empty(t, resp.Errors)
+	require.Equal(t, []any{
+		map[string]any{
+			"id": "1", "status": "OPEN", "answerCount": float64(1),
+			"answers": []any{map[string]any{"id": "10", "author": map[string]any{"username": "bob"}}},
+		},
+		map[string]any{
+			"id": "2", "status": "CLOSED", "answerCount": float64(1),
+			"answers": []any{map[string]any{"id": "20", "author": map[string]any{"username": "ann"}}},
+		},
+	}, resp.Data["questions"])
+}
+
+func TestHandler_Me_Unauthorized(t *testing.T) {
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		Lookup: mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, "", `{ me { id } }`, nil)
+
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "unauthorized", resp.Errors[0].Extensions["code"])
+	require.Equal(t, "Authentication required.", resp.Errors[0].Message)
+}
+
+func TestHandler_CreateQuestion_Success(t *testing.T) {
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	mQuota.
+		On("Allow", mock.Anything, "questions", ann).
+		Return(true)
+
+	mCreateQuestion.
+		On("CreateQuestion", mock.Anything, ann, "how?", false, []int{3}).
+		Return(&entQ.Question{ID: 5, Text: "how?", UserID: ann, Status: entQ.StatusOpen},
+			[]*entQ.SimilarQuestion{{ID: 4, Text: "how so?", Similarity: 0.5}}, nil)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		CreateQuestion: mCreateQuestion,
+		Lookup:         mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, ann, `mutation {
+		createQuestion(text: "how?", attachmentIds: ["3"]) {
+			question { id text }
+			possibleDuplicates { id similarity }
+		}
+	}`, nil)
+
+	require.Empty(t, resp.Errors)
+	require.Equal(t, map[string]any{
+		"question":           map[string]any{"id": "5", "text": "how?"},
+		"possibleDuplicates": []any{map[string]any{"id": "4", "similarity": 0.5}},
+	}, resp.Data["createQuestion"])
+}
+
+func TestHandler_CreateQuestion_PossibleDuplicates(t *testing.T) {
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	mQuota.
+		On("Allow", mock.Anything, "questions", ann).
+		Return(true)
+
+	mCreateQuestion.
+		On("CreateQuestion", mock.Anything, ann, "how?", false, []int(nil)).
+		Return(nil, []*entQ.SimilarQuestion{{ID: 4, Text: "how so?", Similarity: 0.9}}, entQ.ErrPossibleDuplicates)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		CreateQuestion: mCreateQuestion,
+		Lookup:         mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, ann, `mutation {
+		createQuestion(text: "how?") { question { id } possibleDuplicates { id text } }
+	}`, nil)
+
+	require.Empty(t, resp.Errors)
+	require.Equal(t, map[string]any{
+		"question":           nil,
+		"possibleDuplicates": []any{map[string]any{"id": "4", "text": "how so?"}},
+	}, resp.Data["createQuestion"])
+}
+
+func TestHandler_CreateQuestion_Violations(t *testing.T) {
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	mQuota.
+		On("Allow", mock.Anything, "questions", ann).
+		Return(true)
+
+	mCreateQuestion.
+		On("CreateQuestion", mock.Anything, ann, "spam", false, []int(nil)).
+		Return(nil, nil, entP.Violations{"Text": {Rule: "banned_word"}})
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		CreateQuestion: mCreateQuestion,
+		Lookup:         mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, ann, `mutation { createQuestion(text: "spam") { question { id } } }`, nil)
+
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "validation_failed", resp.Errors[0].Extensions["code"])
+	require.Equal(t, map[string]any{"Text": "banned_word"}, resp.Errors[0].Extensions["fields"])
+	require.Equal(t, map[string]any{"Text": "contains a banned word"}, resp.Errors[0].Extensions["fieldMessages"])
+}
+
+func TestHandler_CreateQuestion_RateLimited(t *testing.T) {
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	mQuota.
+		On("Allow", mock.Anything, "questions", ann).
+		Return(false)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		Lookup: mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, ann, `mutation { createQuestion(text: "how?") { question { id } } }`, nil)
+
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "rate_limited", resp.Errors[0].Extensions["code"])
+	require.Nil(t, resp.Data["createQuestion"])
+}
+
+func TestHandler_CreateAnswer_QuestionClosed(t *testing.T) {
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	mQuota.
+		On("Allow", mock.Anything, "answers", bob).
+		Return(true)
+
+	mCreateAnswer.
+		On("CreateAnswer", mock.Anything, 1, bob, "late", []int(nil)).
+		Return(nil, entQ.ErrQuestionClosed)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		CreateAnswer: mCreateAnswer,
+		Lookup:       mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, bob, `mutation { createAnswer(questionId: "1", text: "late") { id } }`, nil)
+
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "question_closed", resp.Errors[0].Extensions["code"])
+}
+
+func TestHandler_CreateAnswer_RateLimited(t *testing.T) {
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	mQuota.
+		On("Allow", mock.Anything, "answers", bob).
+		Return(false)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		Lookup: mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, bob, `mutation { createAnswer(questionId: "1", text: "me too") { id } }`, nil)
+
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "rate_limited", resp.Errors[0].Extensions["code"])
+	require.Equal(t, "Too many requests. Please slow down.", resp.Errors[0].Message)
+}
+
+func TestHandler_DeleteAnswer(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode string
+	}{
+		{name: "deleted"},
+		{name: "not found", err: entA.ErrAnswerNotFound, wantCode: "answer_not_found"},
+		{name: "not the author", err: entA.ErrAccessDenied, wantCode: "access_denied"},
+		{name: "unexpected", err: context.DeadlineExceeded, wantCode: "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mDeleteAnswer := mocks.NewAnswerDeleter(t)
+			mLookup := mocks.NewLookup(t)
+			mQuota := mocks.NewQuota(t)
+
+			mDeleteAnswer.
+				On("DeleteAnswer", mock.Anything, 10, ann).
+				Return(tt.err)
+
+			h := rpc_i18n.Middleware(NewHandler(UseCases{
+				DeleteAnswer: mDeleteAnswer,
+				Lookup:       mLookup,
+			}, mQuota, cfg))
+
+			resp := do(t, h, ann, `mutation { deleteAnswer(id: "10") }`, nil)
+
+			if tt.wantCode == "" {
+				require.Empty(t, resp.Errors)
+				require.Equal(t, true, resp.Data["deleteAnswer"])
+				return
+			}
+			require.Len(t, resp.Errors, 1)
+			require.Equal(t, tt.wantCode, resp.Errors[0].Extensions["code"])
+		})
+	}
+}
+
+func TestHandler_DeleteQuestion_Unauthorized(t *testing.T) {
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		Lookup: mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, "", `mutation { deleteQuestion(id: "1") }`, nil)
+
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "unauthorized", resp.Errors[0].Extensions["code"])
+}
+
+func TestHandler_TooComplex(t *testing.T) {
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		Lookup: mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, "", `{
+		questions(first: 100) { answers(first: 100) { id text } }
+	}`, nil)
+
+	require.Nil(t, resp.Data)
+	require.Len(t, resp.Errors, 1)
+	require.Equal(t, "query_too_complex", resp.Errors[0].Extensions["code"])
+}
+
+func TestHandler_TooDeep(t *testing.T) {
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		Lookup: mLookup,
+	}, mQuota, cfg))
+
+	resp := do(t, h, "", `{
+		answer(id: "1") { question { answers(first: 1) { question { answers(first: 1) { question { id } } } } } }
+	}`, nil)
+
+	require.Nil(t, resp.Data)
+	require.NotEmpty(t, resp.Errors)
+}
+
+func TestHandler_InvalidBody(t *testing.T) {
+	mLookup := mocks.NewLookup(t)
+	mQuota := mocks.NewQuota(t)
+
+	h := rpc_i18n.Middleware(NewHandler(UseCases{
+		Lookup: mLookup,
+	}, mQuota, cfg))
+
+	req := httptest.NewRequest(http.MethodPost, "/graphql", bytes.NewBufferString(`{}`))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
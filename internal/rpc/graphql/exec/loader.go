@@ -0,0 +1,221 @@
+package exec
+
+import (
+	"context"
+	"sync"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+)
+
+// loader fetches values by key in batches and caches them for the request.
+// Resolvers of a list prime the keys their items will ask for, so the first
+// item to load a key fetches it with those of all its siblings.
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+	// fetched, if set, sees the values of every fetch before they are handed
+	// out, so it can prime the keys they lead to. It may prime but not load.
+	fetched func(vals map[K]V)
+
+	// fetching serializes fetches; mu guards pending and done and is never
+	// held while taking another lock.
+	fetching sync.Mutex
+	mu       sync.Mutex
+	pending  []K
+	done     map[K]result[V]
+}
+
+type result[V any] struct {
+	val V
+	err error
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{fetch: fetch, done: map[K]result[V]{}}
+}
+
+// prime registers keys to fetch with the next load.
+func (l *loader[K, V]) prime(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, k := range keys {
+		if _, ok := l.done[k]; !ok {
+			l.pending = append(l.pending, k)
+		}
+	}
+}
+
+// set caches a value already at hand.
+func (l *loader[K, V]) set(key K, val V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.done[key] = result[V]{val: val}
+}
+
+// load returns the value of key, the zero value for keys fetch skips. Other
+// loads wait for a running fetch, which may well bring their key too.
+func (l *loader[K, V]) load(ctx context.Context, key K) (V, error) {
+	if r, ok := l.cached(key); ok {
+		return r.val, r.err
+	}
+
+	l.fetching.Lock()
+	defer l.fetching.Unlock()
+
+	if r, ok := l.cached(key); ok {
+		return r.val, r.err
+	}
+
+	keys := l.takePending(key)
+	vals, err := l.fetch(ctx, keys)
+	if err == nil && l.fetched != nil {
+		l.fetched(vals)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, k := range keys {
+		l.done[k] = result[V]{val: vals[k], err: err}
+	}
+	r := l.done[key]
+	return r.val, r.err
+}
+
+func (l *loader[K, V]) cached(key K) (result[V], bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r, ok := l.done[key]
+	return r, ok
+}
+
+// takePending returns key and the pending keys not fetched yet, each once.
+func (l *loader[K, V]) takePending(key K) []K {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	seen := map[K]bool{}
+	keys := make([]K, 0, len(l.pending)+1)
+	for _, k := range append(l.pending, key) {
+		if _, ok := l.done[k]; !ok && !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	l.pending = nil
+	return keys
+}
+
+// answersArgs are the arguments of Question.answers; each set is loaded
+// in batches of its own.
+type answersArgs struct {
+	sort  entA.Sort
+	limit int
+}
+
+// loaders are the loaders of one request.
+type loaders struct {
+	lookup lookup
+
+	questions    *loader[int, *entQ.Question]
+	answerCounts *loader[int, int]
+	users        *loader[string, *entU.User]
+	reputations  *loader[string, int]
+
+	mu      sync.Mutex
+	answers map[answersArgs]*loader[int, []*entA.Answer]
+	seen    []int
+}
+
+func newLoaders(lookup lookup) *loaders {
+	l := &loaders{
+		lookup:       lookup,
+		questions:    newLoader(lookup.Questions),
+		answerCounts: newLoader(lookup.AnswerCounts),
+		users:        newLoader(lookup.Users),
+		reputations:  newLoader(lookup.Reputations),
+		answers:      map[answersArgs]*loader[int, []*entA.Answer]{},
+	}
+	l.questions.fetched = func(byID map[int]*entQ.Question) {
+		qs := make([]*entQ.Question, 0, len(byID))
+		for _, q := range byID {
+			qs = append(qs, q)
+		}
+		l.sawQuestions(qs)
+	}
+	return l
+}
+
+// sawQuestions primes what is loaded per question for the questions of a
+// list about to be resolved.
+func (l *loaders) sawQuestions(qs []*entQ.Question) {
+	ids := make([]int, 0, len(qs))
+	authors := make([]string, 0, len(qs))
+	var duplicates []int
+	for _, q := range qs {
+		ids = append(ids, q.ID)
+		authors = append(authors, q.UserID)
+		if q.DuplicateOfID != 0 {
+			duplicates = append(duplicates, q.DuplicateOfID)
+		}
+	}
+
+	l.answerCounts.prime(ids...)
+	l.sawUsers(authors)
+	l.questions.prime(duplicates...)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.seen = append(l.seen, ids...)
+	for _, al := range l.answers {
+		al.prime(ids...)
+	}
+}
+
+// sawAnswers primes what is loaded per answer for the answers of a list
+// about to be resolved.
+func (l *loaders) sawAnswers(as []*entA.Answer) {
+	questions := make([]int, 0, len(as))
+	authors := make([]string, 0, len(as))
+	for _, a := range as {
+		questions = append(questions, a.QuestionID)
+		authors = append(authors, a.UserID)
+	}
+
+	l.questions.prime(questions...)
+	l.sawUsers(authors)
+}
+
+func (l *loaders) sawUsers(ids []string) {
+	l.users.prime(ids...)
+	l.reputations.prime(ids...)
+}
+
+// answersOf returns the loader of answers listed with args, primed with
+// every question seen so far.
+func (l *loaders) answersOf(args answersArgs) *loader[int, []*entA.Answer] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	al, ok := l.answers[args]
+	if !ok {
+		al = newLoader(func(ctx context.Context, ids []int) (map[int][]*entA.Answer, error) {
+			return l.lookup.Answers(ctx, ids, args.sort, args.limit)
+		})
+		al.fetched = func(byQuestion map[int][]*entA.Answer) {
+			var as []*entA.Answer
+			for _, qas := range byQuestion {
+				as = append(as, qas...)
+			}
+			l.sawAnswers(as)
+		}
+		al.prime(l.seen...)
+		l.answers[args] = al
+	}
+	return al
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnswerDeleter is an autogenerated mock type for the answerDeleter type
+type AnswerDeleter struct {
+	mock.Mock
+}
+
+// DeleteAnswer provides a mock function with given fields: ctx, answerID, userID
+func (_m *AnswerDeleter) DeleteAnswer(ctx context.Context, answerID int, userID string) error {
+	ret := _m.Called(ctx, answerID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAnswer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, answerID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAnswerDeleter creates a new instance of AnswerDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnswerDeleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnswerDeleter {
+	mock := &AnswerDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	answer "test-question/internal/entity/answer"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnswerReader is an autogenerated mock type for the answerReader type
+type AnswerReader struct {
+	mock.Mock
+}
+
+// GetAnswer provides a mock function with given fields: ctx, answerID
+func (_m *AnswerReader) GetAnswer(ctx context.Context, answerID int) (*answer.Answer, error) {
+	ret := _m.Called(ctx, answerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAnswer")
+	}
+
+	var r0 *answer.Answer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*answer.Answer, error)); ok {
+		return rf(ctx, answerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *answer.Answer); ok {
+		r0 = rf(ctx, answerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*answer.Answer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, answerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAnswerReader creates a new instance of AnswerReader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnswerReader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnswerReader {
+	mock := &AnswerReader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
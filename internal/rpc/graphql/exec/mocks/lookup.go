@@ -0,0 +1,183 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	answer "test-question/internal/entity/answer"
+
+	mock "github.com/stretchr/testify/mock"
+
+	question "test-question/internal/entity/question"
+
+	user "test-question/internal/entity/user"
+)
+
+// Lookup is an autogenerated mock type for the lookup type
+type Lookup struct {
+	mock.Mock
+}
+
+// AnswerCounts provides a mock function with given fields: ctx, questionIDs
+func (_m *Lookup) AnswerCounts(ctx context.Context, questionIDs []int) (map[int]int, error) {
+	ret := _m.Called(ctx, questionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AnswerCounts")
+	}
+
+	var r0 map[int]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int]int, error)); ok {
+		return rf(ctx, questionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int]int); ok {
+		r0 = rf(ctx, questionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, questionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Answers provides a mock function with given fields: ctx, questionIDs, sort, limit
+func (_m *Lookup) Answers(ctx context.Context, questionIDs []int, sort answer.Sort, limit int) (map[int][]*answer.Answer, error) {
+	ret := _m.Called(ctx, questionIDs, sort, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Answers")
+	}
+
+	var r0 map[int][]*answer.Answer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, answer.Sort, int) (map[int][]*answer.Answer, error)); ok {
+		return rf(ctx, questionIDs, sort, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, answer.Sort, int) map[int][]*answer.Answer); ok {
+		r0 = rf(ctx, questionIDs, sort, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int][]*answer.Answer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, answer.Sort, int) error); ok {
+		r1 = rf(ctx, questionIDs, sort, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Questions provides a mock function with given fields: ctx, ids
+func (_m *Lookup) Questions(ctx context.Context, ids []int) (map[int]*question.Question, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Questions")
+	}
+
+	var r0 map[int]*question.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int]*question.Question, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int]*question.Question); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]*question.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reputations provides a mock function with given fields: ctx, userIDs
+func (_m *Lookup) Reputations(ctx context.Context, userIDs []string) (map[string]int, error) {
+	ret := _m.Called(ctx, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reputations")
+	}
+
+	var r0 map[string]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]int, error)); ok {
+		return rf(ctx, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]int); ok {
+		r0 = rf(ctx, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Users provides a mock function with given fields: ctx, ids
+func (_m *Lookup) Users(ctx context.Context, ids []string) (map[string]*user.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Users")
+	}
+
+	var r0 map[string]*user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]*user.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]*user.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*user.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLookup creates a new instance of Lookup. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLookup(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Lookup {
+	mock := &Lookup{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
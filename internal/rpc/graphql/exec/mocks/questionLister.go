@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	question "test-question/internal/entity/question"
+)
+
+// QuestionLister is an autogenerated mock type for the questionLister type
+type QuestionLister struct {
+	mock.Mock
+}
+
+// ListQuestions provides a mock function with given fields: ctx, includeDeleted
+func (_m *QuestionLister) ListQuestions(ctx context.Context, includeDeleted bool) ([]*question.Question, error) {
+	ret := _m.Called(ctx, includeDeleted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQuestions")
+	}
+
+	var r0 []*question.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]*question.Question, error)); ok {
+		return rf(ctx, includeDeleted)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []*question.Question); ok {
+		r0 = rf(ctx, includeDeleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*question.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, includeDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewQuestionLister creates a new instance of QuestionLister. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuestionLister(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuestionLister {
+	mock := &QuestionLister{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
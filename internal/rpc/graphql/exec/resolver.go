@@ -0,0 +1,307 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entP "test-question/internal/entity/policy"
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+)
+
+// Rate limits the create mutations are charged against.
+const (
+	questionsRoute = "questions"
+	answersRoute   = "answers"
+)
+
+// resolver resolves the fields of Query and Mutation.
+type resolver struct {
+	uc    UseCases
+	quota quota
+}
+
+type idArgs struct {
+	ID graphql.ID
+}
+
+func (r *resolver) Question(ctx context.Context, args idArgs) (*questionResolver, error) {
+	id, err := parseID(ctx, args.ID, "invalid_question_id")
+	if err != nil {
+		return nil, err
+	}
+
+	qa, err := r.uc.GetQuestion.GetQuestionWithAnswers(ctx, id)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return nil, nil
+		}
+		return nil, unexpectedError(ctx, err)
+	}
+
+	// the answers a question is read with are its first page, oldest first
+	l := stateOf(ctx).loaders
+	l.questions.set(id, qa.Question)
+	l.answerCounts.set(id, qa.AnswerCount)
+	l.answersOf(answersArgs{sort: entA.SortOldest, limit: entA.DefaultPageSize}).set(id, qa.Answers)
+	l.sawAnswers(qa.Answers)
+
+	return newQuestions(ctx, []*entQ.Question{qa.Question})[0], nil
+}
+
+type firstArgs struct {
+	First int32
+}
+
+func (r *resolver) Questions(ctx context.Context, args firstArgs) ([]*questionResolver, error) {
+	qs, err := r.uc.ListQuestions.ListQuestions(ctx, false)
+	if err != nil {
+		return nil, unexpectedError(ctx, err)
+	}
+
+	if n := pageSize(args.First); len(qs) > n {
+		qs = qs[:n]
+	}
+	return newQuestions(ctx, qs), nil
+}
+
+func (r *resolver) Answer(ctx context.Context, args idArgs) (*answerResolver, error) {
+	id, err := parseID(ctx, args.ID, "invalid_answer_id")
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := r.uc.GetAnswer.GetAnswer(ctx, id)
+	if err != nil {
+		if errors.Is(err, entA.ErrAnswerNotFound) {
+			return nil, nil
+		}
+		return nil, unexpectedError(ctx, err)
+	}
+
+	return newAnswers(ctx, []*entA.Answer{a})[0], nil
+}
+
+func (r *resolver) User(ctx context.Context, args idArgs) (*userResolver, error) {
+	if _, err := uuid.Parse(string(args.ID)); err != nil {
+		return nil, newError(ctx, "invalid_user_id")
+	}
+	return loadUser(ctx, string(args.ID))
+}
+
+func (r *resolver) Me(ctx context.Context) (*userResolver, error) {
+	userID := rpc_auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, newError(ctx, "unauthorized")
+	}
+	return loadUser(ctx, userID)
+}
+
+type createQuestionArgs struct {
+	Text          string
+	Force         bool
+	AttachmentIds *[]graphql.ID
+}
+
+func (r *resolver) CreateQuestion(ctx context.Context, args createQuestionArgs) (*createQuestionPayload, error) {
+	userID := rpc_auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, newError(ctx, "unauthorized")
+	}
+
+	attachmentIDs, err := parseIDs(ctx, args.AttachmentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePost(ctx, args.Text, attachmentIDs); err != nil {
+		return nil, err
+	}
+
+	if !r.quota.Allow(ctx, questionsRoute, userID) {
+		return nil, newError(ctx, "rate_limited")
+	}
+
+	q, similar, err := r.uc.CreateQuestion.CreateQuestion(ctx, userID, args.Text, args.Force, attachmentIDs)
+	if err != nil {
+		var violations entP.Violations
+
+		switch {
+		case errors.As(err, &violations):
+			return nil, newViolationError(ctx, violations)
+		case errors.Is(err, entAt.ErrUnavailable):
+			return nil, newValidationError(ctx, map[string]string{"AttachmentIDs": "unavailable"})
+		case errors.Is(err, entQ.ErrPossibleDuplicates):
+			// refused, so the client can look at the duplicates and retry with force
+			return &createQuestionPayload{similar: similar}, nil
+		default:
+			return nil, unexpectedError(ctx, err)
+		}
+	}
+
+	return &createQuestionPayload{
+		question: newQuestions(ctx, []*entQ.Question{q})[0],
+		similar:  similar,
+	}, nil
+}
+
+func (r *resolver) DeleteQuestion(ctx context.Context, args idArgs) (bool, error) {
+	userID := rpc_auth.GetUserID(ctx)
+	if userID == "" {
+		return false, newError(ctx, "unauthorized")
+	}
+
+	id, err := parseID(ctx, args.ID, "invalid_question_id")
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.uc.DeleteQuestion.DeleteQuestion(ctx, id, userID); err != nil {
+		switch {
+		case errors.Is(err, entQ.ErrQuestionNotFound):
+			return false, newError(ctx, "question_not_found")
+		case errors.Is(err, entQ.ErrAccessDenied):
+			return false, newError(ctx, "access_denied")
+		default:
+			return false, unexpectedError(ctx, err)
+		}
+	}
+	return true, nil
+}
+
+type createAnswerArgs struct {
+	QuestionId    graphql.ID
+	Text          string
+	AttachmentIds *[]graphql.ID
+}
+
+func (r *resolver) CreateAnswer(ctx context.Context, args createAnswerArgs) (*answerResolver, error) {
+	userID := rpc_auth.GetUserID(ctx)
+	if userID == "" {
+		return nil, newError(ctx, "unauthorized")
+	}
+
+	qID, err := parseID(ctx, args.QuestionId, "invalid_question_id")
+	if err != nil {
+		return nil, err
+	}
+
+	attachmentIDs, err := parseIDs(ctx, args.AttachmentIds)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validatePost(ctx, args.Text, attachmentIDs); err != nil {
+		return nil, err
+	}
+
+	if !r.quota.Allow(ctx, answersRoute, userID) {
+		return nil, newError(ctx, "rate_limited")
+	}
+
+	a, err := r.uc.CreateAnswer.CreateAnswer(ctx, qID, userID, args.Text, attachmentIDs)
+	if err != nil {
+		var violations entP.Violations
+
+		switch {
+		case errors.As(err, &violations):
+			return nil, newViolationError(ctx, violations)
+		case errors.Is(err, entAt.ErrUnavailable):
+			return nil, newValidationError(ctx, map[string]string{"AttachmentIDs": "unavailable"})
+		case errors.Is(err, entA.ErrRequestedQuestionNotFound):
+			return nil, newError(ctx, "question_not_found")
+		case errors.Is(err, entQ.ErrQuestionClosed):
+			return nil, newError(ctx, "question_closed")
+		case errors.Is(err, entQ.ErrQuestionLocked):
+			return nil, newError(ctx, "question_locked")
+		default:
+			return nil, unexpectedError(ctx, err)
+		}
+	}
+
+	return newAnswers(ctx, []*entA.Answer{a})[0], nil
+}
+
+func (r *resolver) DeleteAnswer(ctx context.Context, args idArgs) (bool, error) {
+	userID := rpc_auth.GetUserID(ctx)
+	if userID == "" {
+		return false, newError(ctx, "unauthorized")
+	}
+
+	id, err := parseID(ctx, args.ID, "invalid_answer_id")
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.uc.DeleteAnswer.DeleteAnswer(ctx, id, userID); err != nil {
+		switch {
+		case errors.Is(err, entA.ErrAnswerNotFound):
+			return false, newError(ctx, "answer_not_found")
+		case errors.Is(err, entA.ErrAccessDenied):
+			return false, newError(ctx, "access_denied")
+		default:
+			return false, unexpectedError(ctx, err)
+		}
+	}
+	return true, nil
+}
+
+// pageSize is the number of items a list field is asked for: first,
+// entA.DefaultPageSize when it is not positive, entA.MaxPageSize at most.
+func pageSize(first int32) int {
+	switch {
+	case first <= 0:
+		return entA.DefaultPageSize
+	case first > entA.MaxPageSize:
+		return entA.MaxPageSize
+	default:
+		return int(first)
+	}
+}
+
+// validatePost checks a new post the way the REST endpoints validate their
+// request bodies.
+func validatePost(ctx context.Context, text string, attachmentIDs []int) error {
+	switch {
+	case text == "":
+		return newValidationError(ctx, map[string]string{"Text": "required"})
+	case len(attachmentIDs) > entAt.MaxPerPost:
+		return newValidationError(ctx, map[string]string{"AttachmentIDs": "max"})
+	default:
+		return nil
+	}
+}
+
+func parseID(ctx context.Context, id graphql.ID, code string) (int, error) {
+	n, err := strconv.Atoi(string(id))
+	if err != nil || n <= 0 {
+		return 0, newError(ctx, code)
+	}
+	return n, nil
+}
+
+func parseIDs(ctx context.Context, ids *[]graphql.ID) ([]int, error) {
+	if ids == nil {
+		return nil, nil
+	}
+
+	out := make([]int, len(*ids))
+	for i, id := range *ids {
+		n, err := parseID(ctx, id, "invalid_attachment_id")
+		if err != nil {
+			return nil, err
+		}
+		out[i] = n
+	}
+	return out, nil
+}
+
+func formatID(id int) graphql.ID {
+	return graphql.ID(strconv.Itoa(id))
+}
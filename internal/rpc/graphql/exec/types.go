@@ -0,0 +1,205 @@
+package exec
+
+import (
+	"context"
+	"strings"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+
+	"github.com/graph-gophers/graphql-go"
+)
+
+type questionResolver struct {
+	q *entQ.Question
+}
+
+// newQuestions wraps questions about to be resolved together, priming the
+// loaders with what their fields load.
+func newQuestions(ctx context.Context, qs []*entQ.Question) []*questionResolver {
+	stateOf(ctx).loaders.sawQuestions(qs)
+
+	out := make([]*questionResolver, len(qs))
+	for i, q := range qs {
+		out[i] = &questionResolver{q: q}
+	}
+	return out
+}
+
+func (r *questionResolver) ID() graphql.ID {
+	return formatID(r.q.ID)
+}
+
+func (r *questionResolver) Text() string {
+	return r.q.Text
+}
+
+func (r *questionResolver) Status() string {
+	return strings.ToUpper(string(r.q.Status))
+}
+
+func (r *questionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.q.CreatedAt}
+}
+
+func (r *questionResolver) ViewCount() int32 {
+	return int32(r.q.ViewCount) //nolint:gosec
+}
+
+func (r *questionResolver) Author(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, r.q.UserID)
+}
+
+func (r *questionResolver) AcceptedAnswerId() *graphql.ID {
+	if r.q.AcceptedAnswerID == 0 {
+		return nil
+	}
+	id := formatID(r.q.AcceptedAnswerID)
+	return &id
+}
+
+func (r *questionResolver) DuplicateOf(ctx context.Context) (*questionResolver, error) {
+	if r.q.DuplicateOfID == 0 {
+		return nil, nil
+	}
+	return loadQuestion(ctx, r.q.DuplicateOfID)
+}
+
+func (r *questionResolver) AnswerCount(ctx context.Context) (int32, error) {
+	n, err := stateOf(ctx).loaders.answerCounts.load(ctx, r.q.ID)
+	if err != nil {
+		return 0, unexpectedError(ctx, err)
+	}
+	return int32(n), nil //nolint:gosec
+}
+
+type answersFieldArgs struct {
+	First int32
+	Sort  string
+}
+
+func (r *questionResolver) Answers(ctx context.Context, args answersFieldArgs) ([]*answerResolver, error) {
+	sort := entA.Sort(strings.ToLower(args.Sort))
+	as, err := stateOf(ctx).loaders.
+		answersOf(answersArgs{sort: sort, limit: pageSize(args.First)}).
+		load(ctx, r.q.ID)
+	if err != nil {
+		return nil, unexpectedError(ctx, err)
+	}
+	return newAnswers(ctx, as), nil
+}
+
+type answerResolver struct {
+	a *entA.Answer
+}
+
+// newAnswers wraps answers about to be resolved together, priming the
+// loaders with what their fields load.
+func newAnswers(ctx context.Context, as []*entA.Answer) []*answerResolver {
+	stateOf(ctx).loaders.sawAnswers(as)
+
+	out := make([]*answerResolver, len(as))
+	for i, a := range as {
+		out[i] = &answerResolver{a: a}
+	}
+	return out
+}
+
+func (r *answerResolver) ID() graphql.ID {
+	return formatID(r.a.ID)
+}
+
+func (r *answerResolver) Text() string {
+	return r.a.Text
+}
+
+func (r *answerResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.a.CreatedAt}
+}
+
+func (r *answerResolver) Author(ctx context.Context) (*userResolver, error) {
+	return loadUser(ctx, r.a.UserID)
+}
+
+func (r *answerResolver) Question(ctx context.Context) (*questionResolver, error) {
+	return loadQuestion(ctx, r.a.QuestionID)
+}
+
+type userResolver struct {
+	u *entU.User
+}
+
+func (r *userResolver) ID() graphql.ID {
+	return graphql.ID(r.u.ID)
+}
+
+func (r *userResolver) Username() string {
+	return r.u.Username
+}
+
+func (r *userResolver) Reputation(ctx context.Context) (int32, error) {
+	n, err := stateOf(ctx).loaders.reputations.load(ctx, r.u.ID)
+	if err != nil {
+		return 0, unexpectedError(ctx, err)
+	}
+	return int32(n), nil //nolint:gosec
+}
+
+type createQuestionPayload struct {
+	question *questionResolver
+	similar  []*entQ.SimilarQuestion
+}
+
+func (p *createQuestionPayload) Question() *questionResolver {
+	return p.question
+}
+
+func (p *createQuestionPayload) PossibleDuplicates() []*similarQuestionResolver {
+	out := make([]*similarQuestionResolver, len(p.similar))
+	for i, s := range p.similar {
+		out[i] = &similarQuestionResolver{s: s}
+	}
+	return out
+}
+
+type similarQuestionResolver struct {
+	s *entQ.SimilarQuestion
+}
+
+func (r *similarQuestionResolver) ID() graphql.ID {
+	return formatID(r.s.ID)
+}
+
+func (r *similarQuestionResolver) Text() string {
+	return r.s.Text
+}
+
+func (r *similarQuestionResolver) Similarity() float64 {
+	return r.s.Similarity
+}
+
+// loadQuestion resolves a question another post refers to; it is null when
+// readers cannot see it.
+func loadQuestion(ctx context.Context, id int) (*questionResolver, error) {
+	q, err := stateOf(ctx).loaders.questions.load(ctx, id)
+	if err != nil {
+		return nil, unexpectedError(ctx, err)
+	}
+	if q == nil {
+		return nil, nil
+	}
+	return newQuestions(ctx, []*entQ.Question{q})[0], nil
+}
+
+// loadUser resolves a user; it is null for users that do not exist.
+func loadUser(ctx context.Context, id string) (*userResolver, error) {
+	u, err := stateOf(ctx).loaders.users.load(ctx, id)
+	if err != nil {
+		return nil, unexpectedError(ctx, err)
+	}
+	if u == nil {
+		return nil, nil
+	}
+	return &userResolver{u: u}, nil
+}
@@ -0,0 +1,96 @@
+package list
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entM "test-question/internal/entity/mention"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		ListMentions(ctx context.Context, f entM.Filter) (*entM.Page, error)
+	}
+)
+
+type Response struct {
+	Items      []Item `json:"items"`
+	NextCursor int    `json:"next_cursor,omitempty"`
+}
+
+type Item struct {
+	ID         int    `json:"id"`
+	AuthorID   string `json:"author_id"`
+	QuestionID int    `json:"question_id"`
+	AnswerID   int    `json:"answer_id,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	f := entM.Filter{UserID: userID, Limit: defaultLimit}
+	query := r.URL.Query()
+
+	if v := query.Get("cursor"); v != "" {
+		cursor, err := strconv.Atoi(v)
+		if err != nil || cursor < 1 {
+			rpc.WriteBadRequest(w, "invalid_cursor")
+			return
+		}
+		f.BeforeID = cursor
+	}
+
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxLimit {
+			rpc.WriteBadRequest(w, "invalid_limit")
+			return
+		}
+		f.Limit = n
+	}
+
+	page, err := h.uc.ListMentions(r.Context(), f)
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	items := make([]Item, len(page.Items))
+	for i, m := range page.Items {
+		items[i] = Item{
+			ID:         m.ID,
+			AuthorID:   m.AuthorID,
+			QuestionID: m.QuestionID,
+			AnswerID:   m.AnswerID,
+			CreatedAt:  m.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, Response{
+		Items:      items,
+		NextCursor: page.NextCursor,
+	})
+}
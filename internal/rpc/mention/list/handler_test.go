@@ -0,0 +1,88 @@
+package list
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entM "test-question/internal/entity/mention"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/mention/list/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_List_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mUC.
+		On("ListMentions", mock.Anything, entM.Filter{UserID: "user-1", BeforeID: 10, Limit: 5}).
+		Return(&entM.Page{
+			Items: []*entM.Mention{
+				{ID: 9, UserID: "user-1", AuthorID: "user-2", QuestionID: 1, AnswerID: 4, CreatedAt: now},
+				{ID: 8, UserID: "user-1", AuthorID: "user-3", QuestionID: 2, CreatedAt: now},
+			},
+			NextCursor: 8,
+		}, nil)
+
+	req := httptest.NewRequest("GET", "/me/mentions?cursor=10&limit=5", nil)
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{
+		"items": [
+			{"id": 9, "author_id": "user-2", "question_id": 1, "answer_id": 4, "created_at": "2024-11-20T12:00:00Z"},
+			{"id": 8, "author_id": "user-3", "question_id": 2, "created_at": "2024-11-20T12:00:00Z"}
+		],
+		"next_cursor": 8
+	}`, w.Body.String())
+}
+
+func TestHandler_List_Defaults(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("ListMentions", mock.Anything, entM.Filter{UserID: "user-1", Limit: defaultLimit}).
+		Return(&entM.Page{}, nil)
+
+	req := httptest.NewRequest("GET", "/me/mentions", nil)
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Empty(t, resp.Items)
+	require.Zero(t, resp.NextCursor)
+}
+
+func TestHandler_List_InvalidQuery(t *testing.T) {
+	for _, q := range []string{"cursor=0", "cursor=x", "limit=0", "limit=1000"} {
+		mUC := mocks.NewUseCase(t)
+
+		req := httptest.NewRequest("GET", "/me/mentions?"+q, nil)
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+		w := httptest.NewRecorder()
+		NewHandler(mUC).ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code, q)
+	}
+}
+
+func TestHandler_List_Unauthorized(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewHandler(mocks.NewUseCase(t)).ServeHTTP(w, httptest.NewRequest("GET", "/me/mentions", nil))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,85 @@
+package queue
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entRp "test-question/internal/entity/report"
+	"test-question/internal/pkg/rpc"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Queue(ctx context.Context, limit int) ([]*entRp.QueueItem, error)
+	}
+)
+
+type Response struct {
+	Items []Item `json:"items"`
+}
+
+type Item struct {
+	TargetType      string   `json:"target_type"`
+	TargetID        int      `json:"target_id"`
+	AuthorID        string   `json:"author_id"`
+	Text            string   `json:"text"`
+	Hidden          bool     `json:"hidden"`
+	Reports         int      `json:"reports"`
+	Reasons         []string `json:"reasons"`
+	FirstReportedAt string   `json:"first_reported_at"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	limit := defaultLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxLimit {
+			rpc.WriteBadRequest(w, "invalid_limit")
+			return
+		}
+		limit = n
+	}
+
+	items, err := h.uc.Queue(r.Context(), limit)
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	out := make([]Item, len(items))
+	for i, it := range items {
+		reasons := make([]string, len(it.Reasons))
+		for j, reason := range it.Reasons {
+			reasons[j] = string(reason)
+		}
+
+		out[i] = Item{
+			TargetType:      string(it.TargetType),
+			TargetID:        it.TargetID,
+			AuthorID:        it.AuthorID,
+			Text:            it.Text,
+			Hidden:          it.Hidden,
+			Reports:         it.Reports,
+			Reasons:         reasons,
+			FirstReportedAt: it.FirstReportedAt.Format(time.RFC3339),
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, Response{Items: out})
+}
@@ -0,0 +1,66 @@
+package queue
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entRp "test-question/internal/entity/report"
+	"test-question/internal/rpc/moderation/queue/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Queue_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Queue", mock.Anything, defaultLimit).Return([]*entRp.QueueItem{{
+		TargetType:      entRp.TargetQuestion,
+		TargetID:        7,
+		AuthorID:        "author",
+		Text:            "buy now",
+		Hidden:          true,
+		Reports:         3,
+		Reasons:         []entRp.Reason{entRp.ReasonSpam},
+		FirstReportedAt: time.Now(),
+	}}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/moderation/queue", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Contains(t, w.Body.String(), `"reports":3`)
+	require.Contains(t, w.Body.String(), `"reasons":["spam"]`)
+}
+
+func TestHandler_Queue_Limit(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Queue", mock.Anything, 5).Return([]*entRp.QueueItem{}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/moderation/queue?limit=5", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"items":[]}`, w.Body.String())
+}
+
+func TestHandler_Queue_InvalidLimit(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/moderation/queue?limit=500", nil))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Queue_Error(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Queue", mock.Anything, defaultLimit).Return(nil, errors.New("boom"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/moderation/queue", nil))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
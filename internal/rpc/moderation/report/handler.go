@@ -0,0 +1,100 @@
+package report
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entRp "test-question/internal/entity/report"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Report(
+			ctx context.Context,
+			reporterID string,
+			targetType entRp.TargetType,
+			targetID int,
+			reason entRp.Reason,
+			note string,
+		) (*entRp.Report, error)
+	}
+)
+
+type ReportRequest struct {
+	Reason string `json:"reason" validate:"required"`
+	Note   string `json:"note" validate:"max=1000"`
+}
+
+type ReportResponse struct {
+	ID         int    `json:"id"`
+	TargetType string `json:"target_type"`
+	TargetID   int    `json:"target_id"`
+	Reason     string `json:"reason"`
+	Status     string `json:"status"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type Handler struct {
+	uc     useCase
+	target entRp.TargetType
+}
+
+func NewHandler(uc useCase, target entRp.TargetType) *Handler {
+	return &Handler{uc: uc, target: target}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_"+string(h.target)+"_id")
+		return
+	}
+
+	var req ReportRequest
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	out, err := h.uc.Report(r.Context(), userID, h.target, targetID, entRp.Reason(req.Reason), req.Note)
+	if err != nil {
+		switch {
+		case errors.Is(err, entQ.ErrQuestionNotFound):
+			rpc.WriteNotFound(w, "question_not_found")
+		case errors.Is(err, entA.ErrAnswerNotFound):
+			rpc.WriteNotFound(w, "answer_not_found")
+		case errors.Is(err, entRp.ErrInvalidReason):
+			rpc.WriteBadRequest(w, "invalid_reason")
+		case errors.Is(err, entRp.ErrSelfReport):
+			rpc.WriteJSON(w, http.StatusForbidden, rpc.NewBaseHTTPError("self_report"))
+		case errors.Is(err, entRp.ErrAlreadyReported):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("already_reported"))
+		default:
+			rpc.WriteUnexpectedError(w, err)
+		}
+		return
+	}
+
+	rpc.WriteJSON(w, http.StatusCreated, ReportResponse{
+		ID:         out.ID,
+		TargetType: string(out.TargetType),
+		TargetID:   out.TargetID,
+		Reason:     string(out.Reason),
+		Status:     string(out.Status),
+		CreatedAt:  out.CreatedAt.Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,101 @@
+package report
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entRp "test-question/internal/entity/report"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/moderation/report/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(id, body, userID string) *http.Request {
+	req := httptest.NewRequest("POST", "/answers/"+id+"/report", bytes.NewBufferString(body))
+	req.SetPathValue("id", id)
+	if userID != "" {
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), userID))
+	}
+	return req
+}
+
+func TestHandler_Report_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Report", mock.Anything, "u1", entRp.TargetAnswer, 5, entRp.ReasonSpam, "ads").
+		Return(&entRp.Report{
+			ID:         1,
+			TargetType: entRp.TargetAnswer,
+			TargetID:   5,
+			Reason:     entRp.ReasonSpam,
+			Status:     entRp.StatusOpen,
+			CreatedAt:  time.Now(),
+		}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entRp.TargetAnswer).ServeHTTP(w, newRequest("5", `{"reason":"spam","note":"ads"}`, "u1"))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Contains(t, w.Body.String(), `"status":"open"`)
+}
+
+func TestHandler_Report_InvalidID(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entRp.TargetAnswer).ServeHTTP(w, newRequest("x", `{"reason":"spam"}`, "u1"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Report_ValidationError(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entRp.TargetAnswer).ServeHTTP(w, newRequest("5", `{}`, "u1"))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestHandler_Report_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entRp.TargetAnswer).ServeHTTP(w, newRequest("5", `{"reason":"spam"}`, ""))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestHandler_Report_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "question_not_found", err: entQ.ErrQuestionNotFound, code: http.StatusNotFound},
+		{name: "answer_not_found", err: entA.ErrAnswerNotFound, code: http.StatusNotFound},
+		{name: "invalid_reason", err: entRp.ErrInvalidReason, code: http.StatusBadRequest},
+		{name: "self_report", err: entRp.ErrSelfReport, code: http.StatusForbidden},
+		{name: "already_reported", err: entRp.ErrAlreadyReported, code: http.StatusConflict},
+		{name: "unexpected", err: errors.New("boom"), code: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("Report", mock.Anything, "u1", entRp.TargetAnswer, 5, entRp.ReasonSpam, "").Return(nil, tt.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC, entRp.TargetAnswer).ServeHTTP(w, newRequest("5", `{"reason":"spam"}`, "u1"))
+
+			require.Equal(t, tt.code, w.Code)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	report "test-question/internal/entity/report"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Report provides a mock function with given fields: ctx, reporterID, targetType, targetID, reason, note
+func (_m *UseCase) Report(ctx context.Context, reporterID string, targetType report.TargetType, targetID int, reason report.Reason, note string) (*report.Report, error) {
+	ret := _m.Called(ctx, reporterID, targetType, targetID, reason, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Report")
+	}
+
+	var r0 *report.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, report.TargetType, int, report.Reason, string) (*report.Report, error)); ok {
+		return rf(ctx, reporterID, targetType, targetID, reason, note)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, report.TargetType, int, report.Reason, string) *report.Report); ok {
+		r0 = rf(ctx, reporterID, targetType, targetID, reason, note)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*report.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, report.TargetType, int, report.Reason, string) error); ok {
+		r1 = rf(ctx, reporterID, targetType, targetID, reason, note)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
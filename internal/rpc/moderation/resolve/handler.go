@@ -0,0 +1,83 @@
+package resolve
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entRp "test-question/internal/entity/report"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Resolve(
+			ctx context.Context,
+			targetType entRp.TargetType,
+			targetID int,
+			resolution entRp.Resolution,
+			moderatorID string,
+		) (int, error)
+	}
+)
+
+type ResolveRequest struct {
+	Action string `json:"action" validate:"required"`
+}
+
+type ResolveResponse struct {
+	Resolved int `json:"resolved"`
+}
+
+type Handler struct {
+	uc     useCase
+	target entRp.TargetType
+}
+
+func NewHandler(uc useCase, target entRp.TargetType) *Handler {
+	return &Handler{uc: uc, target: target}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_"+string(h.target)+"_id")
+		return
+	}
+
+	var req ResolveRequest
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	moderatorID := rpc_auth.GetUserID(r.Context())
+	if moderatorID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	n, err := h.uc.Resolve(r.Context(), h.target, targetID, entRp.Resolution(req.Action), moderatorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entQ.ErrQuestionNotFound):
+			rpc.WriteNotFound(w, "question_not_found")
+		case errors.Is(err, entA.ErrAnswerNotFound):
+			rpc.WriteNotFound(w, "answer_not_found")
+		case errors.Is(err, entRp.ErrInvalidResolution):
+			rpc.WriteBadRequest(w, "invalid_action")
+		case errors.Is(err, entRp.ErrNoOpenReports):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("no_open_reports"))
+		default:
+			rpc.WriteUnexpectedError(w, err)
+		}
+		return
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, ResolveResponse{Resolved: n})
+}
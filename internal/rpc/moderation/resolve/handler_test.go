@@ -0,0 +1,82 @@
+package resolve
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entRp "test-question/internal/entity/report"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/moderation/resolve/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(id, body, userID string) *http.Request {
+	req := httptest.NewRequest("POST", "/moderation/questions/"+id+"/resolve", bytes.NewBufferString(body))
+	req.SetPathValue("id", id)
+	if userID != "" {
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), userID))
+	}
+	return req
+}
+
+func TestHandler_Resolve_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Resolve", mock.Anything, entRp.TargetQuestion, 7, entRp.ResolutionDismiss, "mod").Return(3, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entRp.TargetQuestion).ServeHTTP(w, newRequest("7", `{"action":"dismiss"}`, "mod"))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"resolved":3}`, w.Body.String())
+}
+
+func TestHandler_Resolve_InvalidID(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entRp.TargetQuestion).ServeHTTP(w, newRequest("x", `{"action":"dismiss"}`, "mod"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Resolve_ValidationError(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entRp.TargetQuestion).ServeHTTP(w, newRequest("7", `{}`, "mod"))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestHandler_Resolve_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "question_not_found", err: entQ.ErrQuestionNotFound, code: http.StatusNotFound},
+		{name: "answer_not_found", err: entA.ErrAnswerNotFound, code: http.StatusNotFound},
+		{name: "invalid_action", err: entRp.ErrInvalidResolution, code: http.StatusBadRequest},
+		{name: "no_open_reports", err: entRp.ErrNoOpenReports, code: http.StatusConflict},
+		{name: "unexpected", err: errors.New("boom"), code: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("Resolve", mock.Anything, entRp.TargetQuestion, 7, entRp.ResolutionWarn, "mod").Return(0, tt.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC, entRp.TargetQuestion).ServeHTTP(w, newRequest("7", `{"action":"warn"}`, "mod"))
+
+			require.Equal(t, tt.code, w.Code)
+		})
+	}
+}
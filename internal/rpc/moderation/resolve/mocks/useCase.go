@@ -0,0 +1,57 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	report "test-question/internal/entity/report"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Resolve provides a mock function with given fields: ctx, targetType, targetID, resolution, moderatorID
+func (_m *UseCase) Resolve(ctx context.Context, targetType report.TargetType, targetID int, resolution report.Resolution, moderatorID string) (int, error) {
+	ret := _m.Called(ctx, targetType, targetID, resolution, moderatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Resolve")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, report.TargetType, int, report.Resolution, string) (int, error)); ok {
+		return rf(ctx, targetType, targetID, resolution, moderatorID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, report.TargetType, int, report.Resolution, string) int); ok {
+		r0 = rf(ctx, targetType, targetID, resolution, moderatorID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, report.TargetType, int, report.Resolution, string) error); ok {
+		r1 = rf(ctx, targetType, targetID, resolution, moderatorID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,53 @@
+package get_settings
+
+import (
+	"context"
+	"net/http"
+
+	entN "test-question/internal/entity/notification"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		GetSettings(ctx context.Context, userID string) (entN.Settings, error)
+	}
+)
+
+type Response struct {
+	Settings map[string]bool `json:"settings"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	s, err := h.uc.GetSettings(r.Context(), userID)
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, NewResponse(s))
+}
+
+func NewResponse(s entN.Settings) Response {
+	out := make(map[string]bool, len(s))
+	for t, enabled := range s {
+		out[string(t)] = enabled
+	}
+	return Response{Settings: out}
+}
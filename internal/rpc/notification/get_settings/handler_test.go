@@ -0,0 +1,29 @@
+package get_settings
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entN "test-question/internal/entity/notification"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/notification/get_settings/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_GetSettings_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("GetSettings", mock.Anything, "user-1").Return(entN.Settings{entN.TypeNewAnswer: true}, nil)
+
+	req := httptest.NewRequest("GET", "/me/notification-settings", nil)
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"settings":{"new_answer":true}}`, w.Body.String())
+}
@@ -0,0 +1,112 @@
+package list
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entN "test-question/internal/entity/notification"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		ListNotifications(ctx context.Context, f entN.Filter) (*entN.Page, error)
+	}
+)
+
+type Response struct {
+	Items      []Item `json:"items"`
+	NextCursor int    `json:"next_cursor,omitempty"`
+}
+
+type Item struct {
+	ID         int     `json:"id"`
+	Type       string  `json:"type"`
+	ActorID    string  `json:"actor_id"`
+	QuestionID int     `json:"question_id"`
+	AnswerID   int     `json:"answer_id,omitempty"`
+	ReadAt     *string `json:"read_at"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	f := entN.Filter{UserID: userID, Limit: defaultLimit}
+	query := r.URL.Query()
+
+	if v := query.Get("unread"); v != "" {
+		unread, err := strconv.ParseBool(v)
+		if err != nil {
+			rpc.WriteBadRequest(w, "invalid_unread")
+			return
+		}
+		f.UnreadOnly = unread
+	}
+
+	if v := query.Get("cursor"); v != "" {
+		cursor, err := strconv.Atoi(v)
+		if err != nil || cursor < 1 {
+			rpc.WriteBadRequest(w, "invalid_cursor")
+			return
+		}
+		f.BeforeID = cursor
+	}
+
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxLimit {
+			rpc.WriteBadRequest(w, "invalid_limit")
+			return
+		}
+		f.Limit = n
+	}
+
+	page, err := h.uc.ListNotifications(r.Context(), f)
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	items := make([]Item, len(page.Items))
+	for i, n := range page.Items {
+		items[i] = Item{
+			ID:         n.ID,
+			Type:       string(n.Type),
+			ActorID:    n.ActorID,
+			QuestionID: n.QuestionID,
+			AnswerID:   n.AnswerID,
+			CreatedAt:  n.CreatedAt.Format(time.RFC3339),
+		}
+		if n.ReadAt != nil {
+			readAt := n.ReadAt.Format(time.RFC3339)
+			items[i].ReadAt = &readAt
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, Response{
+		Items:      items,
+		NextCursor: page.NextCursor,
+	})
+}
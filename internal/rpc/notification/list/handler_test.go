@@ -0,0 +1,81 @@
+package list
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entN "test-question/internal/entity/notification"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/notification/list/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_List_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mUC.
+		On("ListNotifications", mock.Anything, entN.Filter{
+			UserID:     "user-1",
+			UnreadOnly: true,
+			BeforeID:   10,
+			Limit:      5,
+		}).
+		Return(&entN.Page{
+			Items: []*entN.Notification{
+				{ID: 9, Type: entN.TypeNewAnswer, ActorID: "user-2", QuestionID: 1, AnswerID: 4, CreatedAt: now},
+			},
+			NextCursor: 9,
+		}, nil)
+
+	req := httptest.NewRequest("GET", "/me/notifications?unread=true&cursor=10&limit=5", nil)
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 9, resp.NextCursor)
+	require.Len(t, resp.Items, 1)
+	require.Equal(t, "new_answer", resp.Items[0].Type)
+	require.Nil(t, resp.Items[0].ReadAt)
+}
+
+func TestHandler_List_Defaults(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("ListNotifications", mock.Anything, entN.Filter{UserID: "user-1", Limit: defaultLimit}).
+		Return(&entN.Page{}, nil)
+
+	req := httptest.NewRequest("GET", "/me/notifications", nil)
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"items":[]}`, w.Body.String())
+}
+
+func TestHandler_List_InvalidQuery(t *testing.T) {
+	for _, q := range []string{"unread=maybe", "cursor=0", "cursor=x", "limit=0", "limit=1000"} {
+		mUC := mocks.NewUseCase(t)
+
+		req := httptest.NewRequest("GET", "/me/notifications?"+q, nil)
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+		w := httptest.NewRecorder()
+		NewHandler(mUC).ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code, q)
+	}
+}
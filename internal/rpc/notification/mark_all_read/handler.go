@@ -0,0 +1,44 @@
+package mark_all_read
+
+import (
+	"context"
+	"net/http"
+
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		MarkAllRead(ctx context.Context, userID string) (int, error)
+	}
+)
+
+type Response struct {
+	Updated int `json:"updated"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	n, err := h.uc.MarkAllRead(r.Context(), userID)
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, Response{Updated: n})
+}
@@ -0,0 +1,28 @@
+package mark_all_read
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/notification/mark_all_read/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_MarkAllRead_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("MarkAllRead", mock.Anything, "user-1").Return(3, nil)
+
+	req := httptest.NewRequest("POST", "/me/notifications/read-all", nil)
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"updated":3}`, w.Body.String())
+}
@@ -0,0 +1,55 @@
+package mark_read
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entN "test-question/internal/entity/notification"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		MarkRead(ctx context.Context, userID string, notificationID int) error
+	}
+)
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_notification_id")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	if err = h.uc.MarkRead(r.Context(), userID, id); err != nil {
+		switch {
+		case errors.Is(err, entN.ErrNotificationNotFound):
+			rpc.WriteNotFound(w, "notification_not_found")
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
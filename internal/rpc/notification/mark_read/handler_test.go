@@ -0,0 +1,44 @@
+package mark_read
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entN "test-question/internal/entity/notification"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/notification/mark_read/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_MarkRead_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("MarkRead", mock.Anything, "user-1", 7).Return(nil)
+
+	req := httptest.NewRequest("POST", "/me/notifications/7/read", nil)
+	req.SetPathValue("id", "7")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_MarkRead_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("MarkRead", mock.Anything, "user-1", 7).Return(entN.ErrNotificationNotFound)
+
+	req := httptest.NewRequest("POST", "/me/notifications/7/read", nil)
+	req.SetPathValue("id", "7")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,64 @@
+package update_settings
+
+import (
+	"context"
+	"net/http"
+
+	entN "test-question/internal/entity/notification"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/notification/get_settings"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		UpdateSettings(ctx context.Context, userID string, s entN.Settings) (entN.Settings, error)
+	}
+)
+
+type Request struct {
+	Settings map[string]bool `json:"settings" validate:"required"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	update := make(entN.Settings, len(req.Settings))
+	for t, enabled := range req.Settings {
+		update[entN.Type(t)] = enabled
+	}
+
+	s, err := h.uc.UpdateSettings(r.Context(), userID, update)
+	if err != nil {
+		switch {
+		case errors.Is(err, entN.ErrUnknownType):
+			rpc.WriteBadRequest(w, "unknown_notification_type")
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, get_settings.NewResponse(s))
+}
@@ -0,0 +1,60 @@
+package update_settings
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	entN "test-question/internal/entity/notification"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/notification/update_settings/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_UpdateSettings_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("UpdateSettings", mock.Anything, "user-1", entN.Settings{entN.TypeNewAnswer: false}).
+		Return(entN.Settings{entN.TypeNewAnswer: false}, nil)
+
+	req := httptest.NewRequest("PUT", "/me/notification-settings", strings.NewReader(`{"settings":{"new_answer":false}}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"settings":{"new_answer":false}}`, w.Body.String())
+}
+
+func TestHandler_UpdateSettings_UnknownType(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("UpdateSettings", mock.Anything, "user-1", entN.Settings{"sms": true}).
+		Return(nil, entN.ErrUnknownType)
+
+	req := httptest.NewRequest("PUT", "/me/notification-settings", strings.NewReader(`{"settings":{"sms":true}}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_UpdateSettings_MissingBody(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	req := httptest.NewRequest("PUT", "/me/notification-settings", strings.NewReader(`{}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
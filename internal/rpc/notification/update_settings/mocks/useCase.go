@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	notification "test-question/internal/entity/notification"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// UpdateSettings provides a mock function with given fields: ctx, userID, s
+func (_m *UseCase) UpdateSettings(ctx context.Context, userID string, s notification.Settings) (notification.Settings, error) {
+	ret := _m.Called(ctx, userID, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSettings")
+	}
+
+	var r0 notification.Settings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, notification.Settings) (notification.Settings, error)); ok {
+		return rf(ctx, userID, s)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, notification.Settings) notification.Settings); ok {
+		r0 = rf(ctx, userID, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(notification.Settings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, notification.Settings) error); ok {
+		r1 = rf(ctx, userID, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,170 @@
+package answers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/usecase/question/get_with_answers"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		ListAnswers(ctx context.Context, f entA.Filter) (*get_with_answers.AnswerPage, error)
+	}
+)
+
+type Response struct {
+	Items      []Item `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type Item struct {
+	ID          int          `json:"id"`
+	Text        string       `json:"text"`
+	UserID      string       `json:"user_id"`
+	Score       int          `json:"score"`
+	CreatedAt   string       `json:"created_at"`
+	Mentions    []Mention    `json:"mentions"`
+	Attachments []Attachment `json:"attachments"`
+}
+
+// Mention is a user the text mentions as @username.
+type Mention struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type Attachment struct {
+	ID           int    `json:"id"`
+	Filename     string `json:"filename"`
+	ContentType  string `json:"content_type"`
+	Size         int64  `json:"size"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_question_id")
+		return
+	}
+
+	query := r.URL.Query()
+
+	sort, err := entA.ParseSort(query.Get("sort"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_sort")
+		return
+	}
+
+	f := entA.Filter{QuestionID: id, Sort: sort, Limit: entA.DefaultPageSize}
+
+	if v := query.Get("cursor"); v != "" {
+		cursor, errCursor := entA.ParseCursor(v)
+		if errCursor != nil {
+			rpc.WriteBadRequest(w, "invalid_cursor")
+			return
+		}
+		// the next page keeps the sort of the cursor unless another is asked for
+		if query.Get("sort") == "" {
+			f.Sort = cursor.Sort
+		}
+		f.After = &cursor
+	}
+
+	if v := query.Get("limit"); v != "" {
+		n, errLimit := strconv.Atoi(v)
+		if errLimit != nil || n < 1 || n > entA.MaxPageSize {
+			rpc.WriteBadRequest(w, "invalid_limit")
+			return
+		}
+		f.Limit = n
+	}
+
+	page, err := h.uc.ListAnswers(r.Context(), f)
+	if err != nil {
+		switch {
+		case errors.Is(err, entQ.ErrQuestionNotFound):
+			rpc.WriteNotFound(w, "question_not_found")
+		case errors.Is(err, entA.ErrInvalidCursor):
+			rpc.WriteBadRequest(w, "invalid_cursor")
+		default:
+			rpc.WriteUnexpectedError(w, err)
+		}
+		return
+	}
+
+	atts := groupAttachments(page.Attachments)
+
+	items := make([]Item, len(page.Answers))
+	for i, a := range page.Answers {
+		items[i] = Item{
+			ID:          a.ID,
+			Text:        a.Text,
+			UserID:      a.UserID,
+			Score:       a.Score,
+			CreatedAt:   a.CreatedAt.Format(time.RFC3339),
+			Mentions:    toMentions(a.Mentions),
+			Attachments: atts[a.ID],
+		}
+		if items[i].Attachments == nil {
+			items[i].Attachments = []Attachment{}
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, Response{
+		Items:      items,
+		NextCursor: page.NextCursor,
+	})
+}
+
+func groupAttachments(atts []*entAt.Attachment) map[int][]Attachment {
+	out := map[int][]Attachment{}
+
+	for _, at := range atts {
+		if at.AnswerID == nil {
+			continue
+		}
+
+		a := Attachment{
+			ID:          at.ID,
+			Filename:    at.Filename,
+			ContentType: at.ContentType,
+			Size:        at.Size,
+			URL:         "/attachments/" + strconv.Itoa(at.ID),
+		}
+		if at.HasThumbnail() {
+			a.ThumbnailURL = a.URL + "/thumbnail"
+		}
+		out[*at.AnswerID] = append(out[*at.AnswerID], a)
+	}
+
+	return out
+}
+
+func toMentions(ms []*entM.Mention) []Mention {
+	out := make([]Mention, len(ms))
+	for i, m := range ms {
+		out[i] = Mention{UserID: m.UserID, Username: m.Username}
+	}
+	return out
+}
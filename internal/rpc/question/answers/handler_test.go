@@ -0,0 +1,117 @@
+package answers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/rpc/question/answers/mocks"
+	"test-question/internal/usecase/question/get_with_answers"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func serve(h http.Handler, target string) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	mux.Handle("GET /questions/{id}/answers", h)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", target, nil))
+	return w
+}
+
+func TestHandler_Answers_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+	answerID := 1
+
+	mUC.
+		On("ListAnswers", mock.Anything, entA.Filter{QuestionID: 10, Sort: entA.SortScore, Limit: 2}).
+		Return(&get_with_answers.AnswerPage{
+			Answers: []*entA.Answer{
+				{ID: 1, Text: "top", UserID: "u1", Score: 4, CreatedAt: now,
+					Mentions: []*entM.Mention{{UserID: "u2", Username: "ann"}}},
+				{ID: 2, Text: "next", UserID: "u2", Score: 1, CreatedAt: now},
+			},
+			NextCursor: "abc",
+			Attachments: []*entAt.Attachment{
+				{ID: 5, Filename: "log.txt", ContentType: "text/plain", Size: 3, AnswerID: &answerID},
+			},
+		}, nil)
+
+	w := serve(NewHandler(mUC), "/questions/10/answers?sort=score&limit=2")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, Response{
+		Items: []Item{
+			{
+				ID: 1, Text: "top", UserID: "u1", Score: 4, CreatedAt: now.Format(time.RFC3339),
+				Mentions:    []Mention{{UserID: "u2", Username: "ann"}},
+				Attachments: []Attachment{{ID: 5, Filename: "log.txt", ContentType: "text/plain", Size: 3, URL: "/attachments/5"}},
+			},
+			{
+				ID: 2, Text: "next", UserID: "u2", Score: 1, CreatedAt: now.Format(time.RFC3339),
+				Mentions: []Mention{}, Attachments: []Attachment{},
+			},
+		},
+		NextCursor: "abc",
+	}, resp)
+}
+
+func TestHandler_Answers_CursorKeepsItsSort(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	cursor := entA.Cursor{Sort: entA.SortNewest, CreatedAt: time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC), ID: 7}
+
+	mUC.
+		On("ListAnswers", mock.Anything, entA.Filter{
+			QuestionID: 10, Sort: entA.SortNewest, After: &cursor, Limit: entA.DefaultPageSize,
+		}).
+		Return(&get_with_answers.AnswerPage{}, nil)
+
+	w := serve(NewHandler(mUC), "/questions/10/answers?cursor="+cursor.String())
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `{"items":[]}`, w.Body.String())
+}
+
+func TestHandler_Answers_BadRequest(t *testing.T) {
+	for _, target := range []string{
+		"/questions/x/answers",
+		"/questions/10/answers?sort=random",
+		"/questions/10/answers?cursor=!!",
+		"/questions/10/answers?limit=0",
+		"/questions/10/answers?limit=101",
+	} {
+		w := serve(NewHandler(mocks.NewUseCase(t)), target)
+		require.Equal(t, http.StatusBadRequest, w.Code, target)
+	}
+}
+
+func TestHandler_Answers_Errors(t *testing.T) {
+	tests := []struct {
+		err  error
+		code int
+	}{
+		{entQ.ErrQuestionNotFound, http.StatusNotFound},
+		{entA.ErrInvalidCursor, http.StatusBadRequest},
+		{errors.New("boom"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		mUC := mocks.NewUseCase(t)
+		mUC.On("ListAnswers", mock.Anything, mock.Anything).Return(nil, tt.err)
+
+		w := serve(NewHandler(mUC), "/questions/10/answers")
+		require.Equal(t, tt.code, w.Code, tt.err.Error())
+	}
+}
@@ -0,0 +1,62 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	answer "test-question/internal/entity/answer"
+
+	context "context"
+
+	get_with_answers "test-question/internal/usecase/question/get_with_answers"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// ListAnswers provides a mock function with given fields: ctx, f
+func (_m *UseCase) ListAnswers(ctx context.Context, f answer.Filter) (*get_with_answers.AnswerPage, error) {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAnswers")
+	}
+
+	var r0 *get_with_answers.AnswerPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, answer.Filter) (*get_with_answers.AnswerPage, error)); ok {
+		return rf(ctx, f)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, answer.Filter) *get_with_answers.AnswerPage); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*get_with_answers.AnswerPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, answer.Filter) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
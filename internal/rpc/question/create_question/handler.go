@@ -4,25 +4,62 @@ import (
 	"context"
 	"net/http"
 
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
+	entP "test-question/internal/entity/policy"
 	entQ "test-question/internal/entity/question"
 	"test-question/internal/pkg/rpc"
 	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
 )
 
 //go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
 type (
 	useCase interface {
-		CreateQuestion(ctx context.Context, userID, text string) (*entQ.Question, error)
+		CreateQuestion(
+			ctx context.Context,
+			userID, text string,
+			force bool,
+			attachmentIDs []int,
+		) (*entQ.Question, []*entQ.SimilarQuestion, error)
 	}
 )
 
 type CreateQuestionRequest struct {
 	Text string `json:"text" validate:"required,min=1"`
+	// Force creates the question even if similar ones exist.
+	Force bool `json:"force"`
+	// AttachmentIDs are the user's uploads, at most attachment.MaxPerPost.
+	AttachmentIDs []int `json:"attachment_ids" validate:"max=10,dive,gt=0"`
 }
 
 type CreateQuestionResponse struct {
-	ID   int    `json:"id"`
-	Text string `json:"text"`
+	ID       int       `json:"id"`
+	Text     string    `json:"text"`
+	Mentions []Mention `json:"mentions"`
+	Warnings *Warnings `json:"warnings,omitempty"`
+}
+
+// Mention is a user the text mentions as @username.
+type Mention struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type Warnings struct {
+	PossibleDuplicates []Duplicate `json:"possible_duplicates"`
+}
+
+type Duplicate struct {
+	ID         int     `json:"id"`
+	Text       string  `json:"text"`
+	Similarity float64 `json:"similarity"`
+}
+
+type DuplicatesResponse struct {
+	rpc.BaseHTTPError
+	PossibleDuplicates []Duplicate `json:"possible_duplicates"`
 }
 
 type Handler struct {
@@ -45,14 +82,59 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	q, err := h.uc.CreateQuestion(r.Context(), userID, req.Text)
+	q, similar, err := h.uc.CreateQuestion(r.Context(), userID, req.Text, req.Force, req.AttachmentIDs)
 	if err != nil {
+		var violations entP.Violations
+		if errors.As(err, &violations) {
+			rpc.WriteValidationErrorWithParams(w, violations.Rules(), violations.Params())
+			return
+		}
+
+		if errors.Is(err, entAt.ErrUnavailable) {
+			rpc.WriteValidationError(w, map[string]string{"AttachmentIDs": "unavailable"})
+			return
+		}
+
+		if errors.Is(err, entQ.ErrPossibleDuplicates) {
+			rpc.WriteJSON(w, http.StatusConflict, &DuplicatesResponse{
+				BaseHTTPError:      rpc.BaseHTTPError{Code: "possible_duplicates"},
+				PossibleDuplicates: toDuplicates(similar),
+			})
+			return
+		}
+
 		rpc.WriteUnexpectedError(w, err)
 		return
 	}
 
-	rpc.WriteJSON(w, http.StatusCreated, CreateQuestionResponse{
-		ID:   q.ID,
-		Text: q.Text,
-	})
+	resp := CreateQuestionResponse{
+		ID:       q.ID,
+		Text:     q.Text,
+		Mentions: toMentions(q.Mentions),
+	}
+	if len(similar) > 0 {
+		resp.Warnings = &Warnings{PossibleDuplicates: toDuplicates(similar)}
+	}
+
+	rpc.WriteJSON(w, http.StatusCreated, resp)
+}
+
+func toDuplicates(similar []*entQ.SimilarQuestion) []Duplicate {
+	out := make([]Duplicate, len(similar))
+	for i, s := range similar {
+		out[i] = Duplicate{
+			ID:         s.ID,
+			Text:       s.Text,
+			Similarity: s.Similarity,
+		}
+	}
+	return out
+}
+
+func toMentions(ms []*entM.Mention) []Mention {
+	out := make([]Mention, len(ms))
+	for i, m := range ms {
+		out[i] = Mention{UserID: m.UserID, Username: m.Username}
+	}
+	return out
 }
@@ -8,6 +8,8 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	entAt "test-question/internal/entity/attachment"
+	entP "test-question/internal/entity/policy"
 	entQ "test-question/internal/entity/question"
 	"test-question/internal/pkg/rpc/rpc_auth"
 	"test-question/internal/rpc/question/create_question/mocks"
@@ -53,12 +55,28 @@ func TestHandler_Create_ValidationError(t *testing.T) {
 	err := json.Unmarshal(w.Body.Bytes(), &body)
 	require.NoError(t, err)
 
-	require.Equal(t, "validation_failed", body["message"])
+	require.Equal(t, "validation_failed", body["code"])
 
 	fields := body["fields"].(map[string]any) //nolint:forcetypeassert
 	require.Equal(t, "required", fields["Text"])
 }
 
+func TestHandler_Create_PolicyViolation(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.
+		On("CreateQuestion", mock.Anything, "test-user", "buy now", false, []int(nil)).
+		Return(nil, nil, entP.Violations{"Text": {Rule: entP.RuleBannedWord}})
+
+	req := httptest.NewRequest("POST", "/questions", bytes.NewBufferString(`{"text":"buy now"}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "test-user"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, `{"code":"validation_failed","message":"The request contains invalid fields.","fields":{"Text":"banned_word"},"field_messages":{"Text":"contains a banned word"}}`, w.Body.String())
+}
+
 func TestHandler_Create_UseCaseError(t *testing.T) {
 	mUC := mocks.NewUseCase(t)
 
@@ -67,7 +85,9 @@ func TestHandler_Create_UseCaseError(t *testing.T) {
 		mock.AnythingOfType("*context.valueCtx"),
 		"test-user",
 		"hello",
-	).Return(nil, errors.New("fail"))
+		false,
+		[]int(nil),
+	).Return(nil, nil, errors.New("fail"))
 
 	h := NewHandler(mUC)
 
@@ -92,7 +112,9 @@ func TestHandler_Create_Success(t *testing.T) {
 		mock.AnythingOfType("*context.valueCtx"),
 		"test-user",
 		"hello",
-	).Return(&entQ.Question{ID: 10, Text: "hello"}, nil)
+		false,
+		[]int(nil),
+	).Return(&entQ.Question{ID: 10, Text: "hello"}, nil, nil)
 
 	h := NewHandler(mUC)
 
@@ -114,4 +136,103 @@ func TestHandler_Create_Success(t *testing.T) {
 
 	require.Equal(t, 10, resp.ID)
 	require.Equal(t, "hello", resp.Text)
+	require.Nil(t, resp.Warnings)
+	require.Contains(t, w.Body.String(), `"mentions":[]`)
+}
+
+func TestHandler_Create_PossibleDuplicates(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On(
+		"CreateQuestion",
+		mock.Anything,
+		"test-user",
+		"hello",
+		false,
+		[]int(nil),
+	).Return(nil, []*entQ.SimilarQuestion{{ID: 3, Text: "hello!", Similarity: 0.8}}, entQ.ErrPossibleDuplicates)
+
+	req := httptest.NewRequest("POST", "/questions", bytes.NewBufferString(`{"text":"hello"}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "test-user"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusConflict, w.Code)
+
+	var resp DuplicatesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "possible_duplicates", resp.Code)
+	require.Equal(t, []Duplicate{{ID: 3, Text: "hello!", Similarity: 0.8}}, resp.PossibleDuplicates)
+}
+
+func TestHandler_Create_ForcedWithWarnings(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On(
+		"CreateQuestion",
+		mock.Anything,
+		"test-user",
+		"hello",
+		true,
+		[]int(nil),
+	).Return(&entQ.Question{ID: 10, Text: "hello"}, []*entQ.SimilarQuestion{{ID: 3, Text: "hello!", Similarity: 0.8}}, nil)
+
+	req := httptest.NewRequest("POST", "/questions", bytes.NewBufferString(`{"text":"hello","force":true}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "test-user"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp CreateQuestionResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 10, resp.ID)
+	require.NotNil(t, resp.Warnings)
+	require.Len(t, resp.Warnings.PossibleDuplicates, 1)
+	require.Equal(t, 3, resp.Warnings.PossibleDuplicates[0].ID)
+}
+
+func TestHandler_Create_Attachments(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.
+		On("CreateQuestion", mock.Anything, "test-user", "see log", false, []int{3, 4}).
+		Return(&entQ.Question{ID: 1, Text: "see log"}, nil, nil)
+
+	req := httptest.NewRequest("POST", "/questions", bytes.NewBufferString(`{"text":"see log","attachment_ids":[3,4]}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "test-user"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestHandler_Create_AttachmentsUnavailable(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.
+		On("CreateQuestion", mock.Anything, "test-user", "see log", false, []int{9}).
+		Return(nil, nil, entAt.ErrUnavailable)
+
+	req := httptest.NewRequest("POST", "/questions", bytes.NewBufferString(`{"text":"see log","attachment_ids":[9]}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "test-user"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, `{"code":"validation_failed","message":"The request contains invalid fields.","fields":{"AttachmentIDs":"unavailable"},"field_messages":{"AttachmentIDs":"refers to attachments you cannot use"}}`, w.Body.String())
+}
+
+func TestHandler_Create_TooManyAttachments(t *testing.T) {
+	req := httptest.NewRequest("POST", "/questions",
+		bytes.NewBufferString(`{"text":"x","attachment_ids":[1,2,3,4,5,6,7,8,9,10,11]}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "test-user"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mocks.NewUseCase(t)).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	require.JSONEq(t, `{"code":"validation_failed","message":"The request contains invalid fields.","fields":{"AttachmentIDs":"max"},"field_messages":{"AttachmentIDs":"must contain at most 10 items"}}`, w.Body.String())
 }
@@ -0,0 +1,121 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	entS "test-question/internal/entity/stream"
+	"test-question/internal/pkg/broker"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		SubscribeQuestion(ctx context.Context, questionID int, userID string, lastEventID int64) (*broker.Subscription, []entS.Event, error)
+	}
+)
+
+// clients reconnect after this many milliseconds when the stream drops
+const retryMillis = 3000
+
+type Handler struct {
+	uc        useCase
+	heartbeat time.Duration
+}
+
+func NewHandler(uc useCase, heartbeat time.Duration) *Handler {
+	return &Handler{uc: uc, heartbeat: heartbeat}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	qID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_question_id")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	// an unparsable Last-Event-ID is treated as a fresh subscription
+	lastID, _ := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64)
+
+	sub, missed, err := h.uc.SubscribeQuestion(r.Context(), qID, userID, lastID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entQ.ErrQuestionNotFound):
+			rpc.WriteNotFound(w, "question_not_found")
+			return
+		case errors.Is(err, entS.ErrTooManyStreams):
+			rpc.WriteJSON(w, http.StatusTooManyRequests, rpc.NewBaseHTTPError("too_many_streams"))
+			return
+		case errors.Is(err, entS.ErrStreamsClosed):
+			rpc.WriteJSON(w, http.StatusServiceUnavailable, rpc.NewBaseHTTPError("shutting_down"))
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+	defer sub.Close()
+
+	rc := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err = fmt.Fprintf(w, "retry: %d\n\n", retryMillis); err != nil {
+		return
+	}
+	for _, e := range missed {
+		if err = writeEvent(w, e); err != nil {
+			return
+		}
+	}
+	if err = rc.Flush(); err != nil {
+		return
+	}
+
+	ticker := time.NewTicker(h.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e, ok := <-sub.Events():
+			if !ok {
+				// shutdown or too slow: the client reconnects with Last-Event-ID
+				return
+			}
+			err = writeEvent(w, e)
+		case <-ticker.C:
+			_, err = fmt.Fprint(w, ": ping\n\n")
+		}
+
+		if err == nil {
+			err = rc.Flush()
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func writeEvent(w http.ResponseWriter, e entS.Event) error {
+	_, err := fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.ID, e.Type, e.Data)
+	return err
+}
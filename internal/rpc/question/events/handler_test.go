@@ -0,0 +1,80 @@
+package events
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	entS "test-question/internal/entity/stream"
+	"test-question/internal/pkg/broker"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/question/events/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(id string) *http.Request {
+	req := httptest.NewRequest("GET", "/questions/"+id+"/events", nil)
+	req.SetPathValue("id", id)
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+}
+
+func TestHandler_Events_ReplaysAndStreams(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	b := broker.New(broker.Config{Backlog: 10, Buffer: 10, MaxPerUser: 1})
+	sub, _, err := b.Subscribe(entS.QuestionTopic(3), "user-1", 0)
+	require.NoError(t, err)
+
+	missed := []entS.Event{{ID: 4, Type: entS.TypeAnswerCreated, Data: []byte(`{"id":1}`)}}
+	mUC.On("SubscribeQuestion", mock.Anything, 3, "user-1", int64(3)).Return(sub, missed, nil)
+
+	// queued live event, then shutdown ends the stream
+	b.Publish(entS.QuestionTopic(3), entS.Event{ID: 5, Type: entS.TypeAnswerDeleted, Data: []byte(`{"id":1}`)})
+	b.Close()
+
+	req := newRequest("3")
+	req.Header.Set("Last-Event-ID", "3")
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, time.Minute).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	require.Equal(t, "retry: 3000\n\n"+
+		"id: 4\nevent: answer.created\ndata: {\"id\":1}\n\n"+
+		"id: 5\nevent: answer.deleted\ndata: {\"id\":1}\n\n",
+		w.Body.String())
+}
+
+func TestHandler_Events_TooManyStreams(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("SubscribeQuestion", mock.Anything, 3, "user-1", int64(0)).Return(nil, nil, entS.ErrTooManyStreams)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, time.Minute).ServeHTTP(w, newRequest("3"))
+
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+}
+
+func TestHandler_Events_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("SubscribeQuestion", mock.Anything, 3, "user-1", int64(0)).Return(nil, nil, entQ.ErrQuestionNotFound)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, time.Minute).ServeHTTP(w, newRequest("3"))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_Events_InvalidID(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewHandler(mocks.NewUseCase(t), time.Minute).ServeHTTP(w, newRequest("abc"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,70 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	broker "test-question/internal/pkg/broker"
+
+	mock "github.com/stretchr/testify/mock"
+
+	stream "test-question/internal/entity/stream"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// SubscribeQuestion provides a mock function with given fields: ctx, questionID, userID, lastEventID
+func (_m *UseCase) SubscribeQuestion(ctx context.Context, questionID int, userID string, lastEventID int64) (*broker.Subscription, []stream.Event, error) {
+	ret := _m.Called(ctx, questionID, userID, lastEventID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SubscribeQuestion")
+	}
+
+	var r0 *broker.Subscription
+	var r1 []stream.Event
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, int64) (*broker.Subscription, []stream.Event, error)); ok {
+		return rf(ctx, questionID, userID, lastEventID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, int64) *broker.Subscription); ok {
+		r0 = rf(ctx, questionID, userID, lastEventID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*broker.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, int64) []stream.Event); ok {
+		r1 = rf(ctx, questionID, userID, lastEventID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]stream.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int, string, int64) error); ok {
+		r2 = rf(ctx, questionID, userID, lastEventID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,56 @@
+package follow
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/question/follow/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Follow_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("Follow", mock.Anything, 3, "user-1").Return(nil)
+
+	req := httptest.NewRequest("POST", "/questions/3/follow", nil)
+	req.SetPathValue("id", "3")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Follow_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("Follow", mock.Anything, 3, "user-1").Return(entQ.ErrQuestionNotFound)
+
+	req := httptest.NewRequest("POST", "/questions/3/follow", nil)
+	req.SetPathValue("id", "3")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_Follow_InvalidID(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	req := httptest.NewRequest("POST", "/questions/abc/follow", nil)
+	req.SetPathValue("id", "abc")
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -3,17 +3,22 @@ package get
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"strconv"
 	"time"
 
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
 	entQ "test-question/internal/entity/question"
 	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
 	"test-question/internal/usecase/question/get_with_answers"
 
 	"github.com/pkg/errors"
 )
 
 //go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=viewRecorder --output=mocks --outpkg=mocks --exported
 type (
 	useCase interface {
 		GetQuestionWithAnswers(
@@ -21,36 +26,68 @@ type (
 			questionID int,
 		) (*get_with_answers.QuestionWithAnswers, error)
 	}
+
+	viewRecorder interface {
+		Record(questionID int, viewer string)
+	}
 )
 
 type Response struct {
-	ID        int       `json:"id"`
-	Text      string    `json:"text"`
-	CreatedAt string    `json:"created_at"`
-	UserID    string    `json:"user_id"`
-	Answers   []Answers `json:"answers"`
+	ID               int       `json:"id"`
+	Text             string    `json:"text"`
+	CreatedAt        string    `json:"created_at"`
+	UserID           string    `json:"user_id"`
+	Status           string    `json:"status"`
+	ViewCount        int       `json:"view_count"`
+	AcceptedAnswerID int       `json:"accepted_answer_id,omitempty"`
+	DuplicateOf      int       `json:"duplicate_of,omitempty"`
+	Answers          []Answers `json:"answers"`
+	AnswerCount      int       `json:"answer_count"`
+	AnswersNext      string    `json:"answers_next,omitempty"`
+	Mentions         []Mention `json:"mentions"`
+	// Attachments belong to the question itself; answers list their own.
+	Attachments []Attachment `json:"attachments"`
 }
 
 type Answers struct {
-	ID        int    `json:"id"`
-	Text      string `json:"text"`
-	UserID    string `json:"user_id"`
-	CreatedAt string `json:"created_at"`
+	ID          int          `json:"id"`
+	Text        string       `json:"text"`
+	UserID      string       `json:"user_id"`
+	Score       int          `json:"score"`
+	CreatedAt   string       `json:"created_at"`
+	Mentions    []Mention    `json:"mentions"`
+	Attachments []Attachment `json:"attachments"`
+}
+
+// Mention is a user the text mentions as @username.
+type Mention struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+type Attachment struct {
+	ID           int    `json:"id"`
+	Filename     string `json:"filename"`
+	ContentType  string `json:"content_type"`
+	Size         int64  `json:"size"`
+	URL          string `json:"url"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
 }
 
 type Handler struct {
-	uc useCase
+	uc    useCase
+	views viewRecorder
 }
 
-func NewHandler(uc useCase) *Handler {
-	return &Handler{uc: uc}
+func NewHandler(uc useCase, views viewRecorder) *Handler {
+	return &Handler{uc: uc, views: views}
 }
 
 func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
 	id, err := strconv.Atoi(idStr)
 	if err != nil {
-		rpc.WriteBadRequest(w, "invalid question id")
+		rpc.WriteBadRequest(w, "invalid_question_id")
 		return
 	}
 
@@ -66,23 +103,110 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Readers of a duplicate are sent to the canonical question;
+	// ?redirect=false shows the duplicate itself.
+	if q.Question.DuplicateOfID != 0 && r.URL.Query().Get("redirect") != "false" {
+		http.Redirect(w, r, "/questions/"+strconv.Itoa(q.Question.DuplicateOfID), http.StatusFound)
+		return
+	}
+
+	// a revalidated copy is a view too
+	h.views.Record(q.Question.ID, rpc_auth.ClientKey(r))
+
+	if rpc.WriteNotModified(w, r, ETag(q)) {
+		return
+	}
+
+	questionAtts, answerAtts := groupAttachments(q.Attachments)
+
 	answers := make([]Answers, len(q.Answers))
 	for i, a := range q.Answers {
 		answers[i] = Answers{
-			ID:        a.ID,
-			Text:      a.Text,
-			UserID:    a.UserID,
-			CreatedAt: a.CreatedAt.Format(time.RFC3339),
+			ID:          a.ID,
+			Text:        a.Text,
+			UserID:      a.UserID,
+			Score:       a.Score,
+			CreatedAt:   a.CreatedAt.Format(time.RFC3339),
+			Mentions:    toMentions(a.Mentions),
+			Attachments: orEmpty(answerAtts[a.ID]),
 		}
 	}
 
 	resp := Response{
-		ID:        q.Question.ID,
-		Text:      q.Question.Text,
-		CreatedAt: q.Question.CreatedAt.Format(time.RFC3339),
-		UserID:    q.Question.UserID,
-		Answers:   answers,
+		ID:               q.Question.ID,
+		Text:             q.Question.Text,
+		CreatedAt:        q.Question.CreatedAt.Format(time.RFC3339),
+		UserID:           q.Question.UserID,
+		Status:           string(q.Question.Status),
+		ViewCount:        q.Question.ViewCount,
+		AcceptedAnswerID: q.Question.AcceptedAnswerID,
+		DuplicateOf:      q.Question.DuplicateOfID,
+		Answers:          answers,
+		AnswerCount:      q.AnswerCount,
+		Mentions:         toMentions(q.Question.Mentions),
+		Attachments:      orEmpty(questionAtts),
+	}
+	if q.NextCursor != "" {
+		resp.AnswersNext = "/questions/" + strconv.Itoa(q.Question.ID) + "/answers?cursor=" + url.QueryEscape(q.NextCursor)
 	}
 
 	rpc.WriteJSON(w, http.StatusOK, resp)
 }
+
+// groupAttachments splits attachments into the question's and those of
+// each answer.
+func groupAttachments(atts []*entAt.Attachment) ([]Attachment, map[int][]Attachment) {
+	var question []Attachment
+	answers := map[int][]Attachment{}
+
+	for _, at := range atts {
+		out := Attachment{
+			ID:          at.ID,
+			Filename:    at.Filename,
+			ContentType: at.ContentType,
+			Size:        at.Size,
+			URL:         "/attachments/" + strconv.Itoa(at.ID),
+		}
+		if at.HasThumbnail() {
+			out.ThumbnailURL = out.URL + "/thumbnail"
+		}
+
+		if at.AnswerID != nil {
+			answers[*at.AnswerID] = append(answers[*at.AnswerID], out)
+		} else {
+			question = append(question, out)
+		}
+	}
+
+	return question, answers
+}
+
+func toMentions(ms []*entM.Mention) []Mention {
+	out := make([]Mention, len(ms))
+	for i, m := range ms {
+		out[i] = Mention{UserID: m.UserID, Username: m.Username}
+	}
+	return out
+}
+
+func orEmpty(atts []Attachment) []Attachment {
+	if atts == nil {
+		return []Attachment{}
+	}
+	return atts
+}
+
+// ETag identifies the question as shown with its first page of answers: it
+// changes with the question's revision, with the answer count and with any
+// listed answer added, removed, changed or voted on. The view count is left
+// out, as a tag moving with every flush would defeat revalidation of exactly
+// the popular questions; since the body does carry it, the tag is weak.
+func ETag(q *get_with_answers.QuestionWithAnswers) string {
+	parts := make([]string, 0, 4+len(q.Answers))
+	parts = append(parts, "question", strconv.Itoa(q.Question.ID), strconv.Itoa(q.Question.Revision),
+		strconv.Itoa(q.AnswerCount))
+	for _, a := range q.Answers {
+		parts = append(parts, strconv.Itoa(a.ID)+":"+strconv.Itoa(a.Revision)+":"+strconv.Itoa(a.Score))
+	}
+	return rpc.WeakETag(parts...)
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
 	entQ "test-question/internal/entity/question"
 	"test-question/internal/rpc/question/get"
 	"test-question/internal/rpc/question/get/mocks"
@@ -34,7 +36,9 @@ func TestHandler_Get_Success(t *testing.T) {
 				ID:        10,
 				Text:      "hello",
 				UserID:    "user-1",
+				ViewCount: 12,
 				CreatedAt: now,
+				Mentions:  []*entM.Mention{{UserID: "a1", Username: "ann"}},
 			},
 			Answers: []*entA.Answer{
 				{
@@ -42,12 +46,19 @@ func TestHandler_Get_Success(t *testing.T) {
 					QuestionID: 10,
 					UserID:     "a1",
 					Text:       "first",
+					Score:      3,
 					CreatedAt:  now.Add(time.Minute),
+					Mentions:   []*entM.Mention{{UserID: "user-1", Username: "bob"}},
 				},
 			},
+			AnswerCount: 21,
+			NextCursor:  "b2xk+ZXN0",
 		}, nil)
 
-	h := get.NewHandler(mUC)
+	views := mocks.NewViewRecorder(t)
+	views.On("Record", 10, "ip:192.0.2.1").Return()
+
+	h := get.NewHandler(mUC, views)
 	mux := http.NewServeMux()
 	mux.Handle("GET /questions/{id}", h)
 
@@ -65,17 +76,66 @@ func TestHandler_Get_Success(t *testing.T) {
 	require.Equal(t, "hello", resp.Text)
 	require.Equal(t, "user-1", resp.UserID)
 	require.Equal(t, now.Format(time.RFC3339), resp.CreatedAt)
+	require.Equal(t, 12, resp.ViewCount)
 
 	require.Len(t, resp.Answers, 1)
 	require.Equal(t, 1, resp.Answers[0].ID)
 	require.Equal(t, "first", resp.Answers[0].Text)
 	require.Equal(t, "a1", resp.Answers[0].UserID)
 	require.Equal(t, now.Add(time.Minute).Format(time.RFC3339), resp.Answers[0].CreatedAt)
+	require.Equal(t, 3, resp.Answers[0].Score)
+
+	require.Equal(t, 21, resp.AnswerCount)
+	require.Equal(t, "/questions/10/answers?cursor=b2xk%2BZXN0", resp.AnswersNext)
+
+	require.Equal(t, []get.Mention{{UserID: "a1", Username: "ann"}}, resp.Mentions)
+	require.Equal(t, []get.Mention{{UserID: "user-1", Username: "bob"}}, resp.Answers[0].Mentions)
+}
+
+func TestHandler_Get_Attachments(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	answerID := 1
+	mUC.
+		On("GetQuestionWithAnswers", mock.Anything, 10).
+		Return(&qwa.QuestionWithAnswers{
+			Question: &entQ.Question{ID: 10, Text: "hello"},
+			Answers: []*entA.Answer{
+				{ID: 1, QuestionID: 10, Text: "first"},
+				{ID: 2, QuestionID: 10, Text: "second"},
+			},
+			Attachments: []*entAt.Attachment{
+				{ID: 5, Filename: "shot.png", ContentType: "image/png", Size: 10, ThumbnailKey: "thumbnails/k.png"},
+				{ID: 6, Filename: "app.log", ContentType: "text/plain; charset=utf-8", Size: 3, AnswerID: &answerID},
+			},
+		}, nil)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /questions/{id}", get.NewHandler(mUC, anyViews(t)))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/questions/10", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp get.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+
+	require.Equal(t, []get.Attachment{{
+		ID: 5, Filename: "shot.png", ContentType: "image/png", Size: 10,
+		URL: "/attachments/5", ThumbnailURL: "/attachments/5/thumbnail",
+	}}, resp.Attachments)
+	require.Equal(t, []get.Attachment{{
+		ID: 6, Filename: "app.log", ContentType: "text/plain; charset=utf-8", Size: 3,
+		URL: "/attachments/6",
+	}}, resp.Answers[0].Attachments)
+	require.Empty(t, resp.Answers[1].Attachments)
+	require.Contains(t, w.Body.String(), `"id":2,"text":"second","user_id":"","score":0,"created_at":"0001-01-01T00:00:00Z","mentions":[],"attachments":[]`)
 }
 
 func TestHandler_Get_InvalidID(t *testing.T) {
 	mUC := mocks.NewUseCase(t)
-	h := get.NewHandler(mUC)
+	h := get.NewHandler(mUC, mocks.NewViewRecorder(t))
 
 	mux := http.NewServeMux()
 	mux.Handle("GET /questions/{id}", h)
@@ -90,7 +150,7 @@ func TestHandler_Get_InvalidID(t *testing.T) {
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
 
-	require.Equal(t, "invalid question id", resp["message"])
+	require.Equal(t, "invalid_question_id", resp["code"])
 }
 
 func TestHandler_Get_NotFound(t *testing.T) {
@@ -103,7 +163,7 @@ func TestHandler_Get_NotFound(t *testing.T) {
 		).
 		Return(nil, entQ.ErrQuestionNotFound)
 
-	h := get.NewHandler(mUC)
+	h := get.NewHandler(mUC, mocks.NewViewRecorder(t))
 	mux := http.NewServeMux()
 	mux.Handle("GET /questions/{id}", h)
 
@@ -116,7 +176,7 @@ func TestHandler_Get_NotFound(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "question_not_found", resp["message"])
+	require.Equal(t, "question_not_found", resp["code"])
 }
 
 func TestHandler_Get_UnexpectedError(t *testing.T) {
@@ -129,7 +189,7 @@ func TestHandler_Get_UnexpectedError(t *testing.T) {
 		).
 		Return(nil, fmt.Errorf("boom"))
 
-	h := get.NewHandler(mUC)
+	h := get.NewHandler(mUC, mocks.NewViewRecorder(t))
 	mux := http.NewServeMux()
 	mux.Handle("GET /questions/{id}", h)
 
@@ -143,5 +203,132 @@ func TestHandler_Get_UnexpectedError(t *testing.T) {
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
 
-	require.Equal(t, "internal error", resp["message"])
+	require.Equal(t, "internal_error", resp["code"])
+}
+
+func TestHandler_Get_DuplicateRedirects(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("GetQuestionWithAnswers", mock.Anything, 11).
+		Return(&qwa.QuestionWithAnswers{
+			Question: &entQ.Question{ID: 11, Text: "again", DuplicateOfID: 10},
+		}, nil)
+
+	mux := http.NewServeMux()
+	// the view is counted when the reader lands on the canonical question
+	mux.Handle("GET /questions/{id}", get.NewHandler(mUC, mocks.NewViewRecorder(t)))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/questions/11", nil))
+
+	require.Equal(t, http.StatusFound, w.Code)
+	require.Equal(t, "/questions/10", w.Header().Get("Location"))
+}
+
+func TestHandler_Get_DuplicateWithoutRedirect(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("GetQuestionWithAnswers", mock.Anything, 11).
+		Return(&qwa.QuestionWithAnswers{
+			Question: &entQ.Question{ID: 11, Text: "again", DuplicateOfID: 10},
+		}, nil)
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /questions/{id}", get.NewHandler(mUC, anyViews(t)))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/questions/11?redirect=false", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp get.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 10, resp.DuplicateOf)
+}
+
+func TestHandler_Get_ETag(t *testing.T) {
+	q := &qwa.QuestionWithAnswers{
+		Question: &entQ.Question{ID: 10, Text: "hello", Revision: 2},
+		Answers:  []*entA.Answer{{ID: 1, QuestionID: 10, Text: "first", Revision: 1}},
+	}
+
+	mUC := mocks.NewUseCase(t)
+	mUC.On("GetQuestionWithAnswers", mock.Anything, 10).Return(q, nil)
+
+	// a revalidation is a view as well
+	views := mocks.NewViewRecorder(t)
+	views.On("Record", 10, "ip:192.0.2.1").Return().Twice()
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /questions/{id}", get.NewHandler(mUC, views))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", "/questions/10", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	require.Equal(t, get.ETag(q), etag)
+	require.Equal(t, "private, no-cache", w.Header().Get("Cache-Control"))
+
+	req := httptest.NewRequest("GET", "/questions/10", nil)
+	req.Header.Set("If-None-Match", etag)
+
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Empty(t, w.Body.String())
+	require.Equal(t, etag, w.Header().Get("ETag"))
+}
+
+func TestETag_ChangesWithQuestionAndAnswers(t *testing.T) {
+	base := &qwa.QuestionWithAnswers{
+		Question: &entQ.Question{ID: 10, Revision: 1},
+		Answers:  []*entA.Answer{{ID: 1, Revision: 1}},
+	}
+	revised := &qwa.QuestionWithAnswers{
+		Question: &entQ.Question{ID: 10, Revision: 2},
+		Answers:  base.Answers,
+	}
+	answered := &qwa.QuestionWithAnswers{
+		Question: base.Question,
+		Answers:  []*entA.Answer{{ID: 1, Revision: 1}, {ID: 2, Revision: 1}},
+	}
+	editedAnswer := &qwa.QuestionWithAnswers{
+		Question: base.Question,
+		Answers:  []*entA.Answer{{ID: 1, Revision: 2}},
+	}
+	moreAnswers := &qwa.QuestionWithAnswers{
+		Question:    base.Question,
+		Answers:     base.Answers,
+		AnswerCount: 30,
+	}
+	votedAnswer := &qwa.QuestionWithAnswers{
+		Question: base.Question,
+		Answers:  []*entA.Answer{{ID: 1, Revision: 1, Score: 1}},
+	}
+	viewed := &qwa.QuestionWithAnswers{
+		Question: &entQ.Question{ID: 10, Revision: 1, ViewCount: 5},
+		Answers:  base.Answers,
+	}
+
+	require.Equal(t, get.ETag(base), get.ETag(&qwa.QuestionWithAnswers{
+		Question: &entQ.Question{ID: 10, Revision: 1},
+		Answers:  []*entA.Answer{{ID: 1, Revision: 1}},
+	}))
+	require.NotEqual(t, get.ETag(base), get.ETag(revised))
+	require.NotEqual(t, get.ETag(base), get.ETag(answered))
+	require.NotEqual(t, get.ETag(base), get.ETag(editedAnswer))
+	require.NotEqual(t, get.ETag(base), get.ETag(moreAnswers))
+	require.NotEqual(t, get.ETag(base), get.ETag(votedAnswer))
+	// flushed views alone do not invalidate cached copies
+	require.Equal(t, get.ETag(base), get.ETag(viewed))
+}
+
+func anyViews(t *testing.T) *mocks.ViewRecorder {
+	views := mocks.NewViewRecorder(t)
+	views.On("Record", mock.Anything, mock.Anything).Return().Maybe()
+	return views
 }
@@ -0,0 +1,29 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ViewRecorder is an autogenerated mock type for the viewRecorder type
+type ViewRecorder struct {
+	mock.Mock
+}
+
+// Record provides a mock function with given fields: questionID, viewer
+func (_m *ViewRecorder) Record(questionID int, viewer string) {
+	_m.Called(questionID, viewer)
+}
+
+// NewViewRecorder creates a new instance of ViewRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewViewRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ViewRecorder {
+	mock := &ViewRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,76 @@
+package history
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		History(ctx context.Context, questionID int) ([]*entQ.Transition, error)
+	}
+)
+
+type Item struct {
+	ID        int    `json:"id"`
+	Action    string `json:"action"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	ActorID   string `json:"actor_id"`
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+func ToItem(t *entQ.Transition) Item {
+	return Item{
+		ID:        t.ID,
+		Action:    string(t.Action),
+		From:      string(t.From),
+		To:        string(t.To),
+		ActorID:   t.ActorID,
+		Reason:    t.Reason,
+		CreatedAt: t.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	qID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_question_id")
+		return
+	}
+
+	history, err := h.uc.History(r.Context(), qID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			rpc.WriteNotFound(w, "question_not_found")
+			return
+		}
+
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	resp := make([]Item, len(history))
+	for i, t := range history {
+		resp[i] = ToItem(t)
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,68 @@
+package history
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/rpc/question/history/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(id string) *http.Request {
+	req := httptest.NewRequest("GET", "/questions/"+id+"/history", nil)
+	req.SetPathValue("id", id)
+	return req
+}
+
+func TestHandler_History_Success(t *testing.T) {
+	now := time.Now()
+
+	mUC := mocks.NewUseCase(t)
+	mUC.On("History", mock.Anything, 5).Return([]*entQ.Transition{
+		{ID: 1, Action: entQ.ActionClose, From: entQ.StatusOpen, To: entQ.StatusClosed, ActorID: "u1", Reason: "dup", CreatedAt: now},
+		{ID: 2, Action: entQ.ActionReopen, From: entQ.StatusClosed, To: entQ.StatusOpen, ActorID: "u1", CreatedAt: now},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5"))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp []Item
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 2)
+	require.Equal(t, Item{
+		ID:        1,
+		Action:    "close",
+		From:      "open",
+		To:        "closed",
+		ActorID:   "u1",
+		Reason:    "dup",
+		CreatedAt: now.Format(time.RFC3339),
+	}, resp[0])
+}
+
+func TestHandler_History_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("History", mock.Anything, 5).Return(nil, entQ.ErrQuestionNotFound)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5"))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_History_InvalidID(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("x"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
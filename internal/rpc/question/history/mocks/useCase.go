@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	question "test-question/internal/entity/question"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// History provides a mock function with given fields: ctx, questionID
+func (_m *UseCase) History(ctx context.Context, questionID int) ([]*question.Transition, error) {
+	ret := _m.Called(ctx, questionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for History")
+	}
+
+	var r0 []*question.Transition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*question.Transition, error)); ok {
+		return rf(ctx, questionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*question.Transition); ok {
+		r0 = rf(ctx, questionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*question.Transition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, questionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
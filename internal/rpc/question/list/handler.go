@@ -6,21 +6,27 @@ import (
 	"time"
 
 	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
 	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
 )
 
 //go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
 type (
 	useCase interface {
-		ListQuestions(ctx context.Context) ([]*entQ.Question, error)
+		ListQuestions(ctx context.Context, includeDeleted bool) ([]*entQ.Question, error)
+		ListHot(ctx context.Context) ([]*entQ.Question, error)
 	}
 )
 
 type ResponseItem struct {
-	ID        int    `json:"id"`
-	Text      string `json:"text"`
-	UserID    string `json:"user_id"`
-	CreatedAt string `json:"created_at"`
+	ID        int     `json:"id"`
+	Text      string  `json:"text"`
+	UserID    string  `json:"user_id"`
+	Status    string  `json:"status"`
+	ViewCount int     `json:"view_count"`
+	CreatedAt string  `json:"created_at"`
+	DeletedAt *string `json:"deleted_at,omitempty"`
 }
 
 type Handler struct {
@@ -37,7 +43,30 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	qs, err := h.uc.ListQuestions(r.Context())
+	includeDeleted := r.URL.Query().Get("include_deleted") == "true"
+	if includeDeleted && rpc_auth.GetUserRole(r.Context()) != entU.RoleAdmin {
+		rpc.WriteForbidden(w)
+		return
+	}
+
+	var (
+		qs  []*entQ.Question
+		err error
+	)
+	switch r.URL.Query().Get("sort") {
+	case "", "new":
+		qs, err = h.uc.ListQuestions(r.Context(), includeDeleted)
+	case "hot":
+		// deleted questions are not ranked
+		if includeDeleted {
+			rpc.WriteBadRequest(w, "invalid_sort")
+			return
+		}
+		qs, err = h.uc.ListHot(r.Context())
+	default:
+		rpc.WriteBadRequest(w, "invalid_sort")
+		return
+	}
 	if err != nil {
 		rpc.WriteUnexpectedError(w, err)
 		return
@@ -49,8 +78,14 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			ID:        q.ID,
 			Text:      q.Text,
 			UserID:    q.UserID,
+			Status:    string(q.Status),
+			ViewCount: q.ViewCount,
 			CreatedAt: q.CreatedAt.Format(time.RFC3339),
 		}
+		if q.DeletedAt != nil {
+			deletedAt := q.DeletedAt.Format(time.RFC3339)
+			resp[i].DeletedAt = &deletedAt
+		}
 	}
 
 	rpc.WriteJSON(w, http.StatusOK, resp)
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+	"test-question/internal/pkg/rpc/rpc_auth"
 	"test-question/internal/rpc/question/list/mocks"
 
 	"github.com/stretchr/testify/mock"
@@ -23,9 +25,10 @@ func TestHandler_List_Success(t *testing.T) {
 	mUC.
 		On("ListQuestions",
 			mock.Anything, // ← ВАЖНО!
+			false,
 		).
 		Return([]*entQ.Question{
-			{ID: 1, Text: "hello", UserID: "u1", CreatedAt: now},
+			{ID: 1, Text: "hello", UserID: "u1", ViewCount: 7, CreatedAt: now},
 			{ID: 2, Text: "world", UserID: "u2", CreatedAt: now.Add(-time.Hour)},
 		}, nil)
 
@@ -46,6 +49,7 @@ func TestHandler_List_Success(t *testing.T) {
 	require.Equal(t, "hello", resp[0].Text)
 	require.Equal(t, "u1", resp[0].UserID)
 	require.Equal(t, now.Format(time.RFC3339), resp[0].CreatedAt)
+	require.Equal(t, 7, resp[0].ViewCount)
 }
 
 func TestHandler_List_Error(t *testing.T) {
@@ -54,6 +58,7 @@ func TestHandler_List_Error(t *testing.T) {
 	mUC.
 		On("ListQuestions",
 			mock.Anything, // ← ТАКЖЕ ВАЖНО
+			false,
 		).
 		Return(nil, assertErr())
 
@@ -68,7 +73,82 @@ func TestHandler_List_Error(t *testing.T) {
 
 	var resp map[string]any
 	json.Unmarshal(w.Body.Bytes(), &resp)
-	require.Equal(t, "internal error", resp["message"])
+	require.Equal(t, "internal_error", resp["code"])
+}
+
+func TestHandler_List_IncludeDeleted(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	now := time.Now()
+
+	mUC.
+		On("ListQuestions", mock.Anything, true).
+		Return([]*entQ.Question{
+			{ID: 1, Text: "alive", UserID: "u1", CreatedAt: now},
+			{ID: 2, Text: "trashed", UserID: "u2", CreatedAt: now, DeletedAt: &now},
+		}, nil)
+
+	h := NewHandler(mUC)
+
+	req := httptest.NewRequest("GET", "/questions?include_deleted=true", nil)
+	req = req.WithContext(rpc_auth.InjectUserRole(rpc_auth.InjectUserID(req.Context(), "admin"), entU.RoleAdmin))
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp []ResponseItem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 2)
+	require.Nil(t, resp[0].DeletedAt)
+	require.NotNil(t, resp[1].DeletedAt)
+	require.Equal(t, now.Format(time.RFC3339), *resp[1].DeletedAt)
+}
+
+func TestHandler_List_IncludeDeletedForbidden(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	h := NewHandler(mUC)
+
+	req := httptest.NewRequest("GET", "/questions?include_deleted=true", nil)
+	req = req.WithContext(rpc_auth.InjectUserRole(rpc_auth.InjectUserID(req.Context(), "u1"), entU.RoleUser))
+	w := httptest.NewRecorder()
+
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusForbidden, w.Code)
+	mUC.AssertNotCalled(t, "ListQuestions", mock.Anything, mock.Anything)
+}
+
+func TestHandler_List_Hot(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("ListHot", mock.Anything).Return([]*entQ.Question{{ID: 2}, {ID: 1}}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/questions?sort=hot", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp []ResponseItem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 2)
+	require.Equal(t, 2, resp[0].ID)
+}
+
+func TestHandler_List_InvalidSort(t *testing.T) {
+	for _, query := range []string{"?sort=random", "?sort=hot&include_deleted=true"} {
+		mUC := mocks.NewUseCase(t)
+
+		req := httptest.NewRequest("GET", "/questions"+query, nil)
+		req = req.WithContext(rpc_auth.InjectUserRole(req.Context(), entU.RoleAdmin))
+		w := httptest.NewRecorder()
+
+		NewHandler(mUC).ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusBadRequest, w.Code, query)
+	}
 }
 
 func assertErr() error { return fmt.Errorf("boom") }
@@ -15,12 +15,12 @@ type UseCase struct {
 	mock.Mock
 }
 
-// ListQuestions provides a mock function with given fields: ctx
-func (_m *UseCase) ListQuestions(ctx context.Context) ([]*question.Question, error) {
+// ListHot provides a mock function with given fields: ctx
+func (_m *UseCase) ListHot(ctx context.Context) ([]*question.Question, error) {
 	ret := _m.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListQuestions")
+		panic("no return value specified for ListHot")
 	}
 
 	var r0 []*question.Question
@@ -45,6 +45,36 @@ func (_m *UseCase) ListQuestions(ctx context.Context) ([]*question.Question, err
 	return r0, r1
 }
 
+// ListQuestions provides a mock function with given fields: ctx, includeDeleted
+func (_m *UseCase) ListQuestions(ctx context.Context, includeDeleted bool) ([]*question.Question, error) {
+	ret := _m.Called(ctx, includeDeleted)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListQuestions")
+	}
+
+	var r0 []*question.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, bool) ([]*question.Question, error)); ok {
+		return rf(ctx, includeDeleted)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, bool) []*question.Question); ok {
+		r0 = rf(ctx, includeDeleted)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*question.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, bool) error); ok {
+		r1 = rf(ctx, includeDeleted)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewUseCase(t interface {
@@ -0,0 +1,74 @@
+package mark_duplicate
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		MarkDuplicate(ctx context.Context, questionID, canonicalID int, moderatorID string) error
+	}
+)
+
+type MarkDuplicateRequest struct {
+	DuplicateOf int `json:"duplicate_of" validate:"required,min=1"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	qID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_question_id")
+		return
+	}
+
+	var req MarkDuplicateRequest
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	moderatorID := rpc_auth.GetUserID(r.Context())
+	if moderatorID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	err = h.uc.MarkDuplicate(r.Context(), qID, req.DuplicateOf, moderatorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entQ.ErrQuestionNotFound):
+			rpc.WriteNotFound(w, "question_not_found")
+			return
+
+		case errors.Is(err, entQ.ErrCanonicalNotFound):
+			rpc.WriteNotFound(w, "canonical_not_found")
+			return
+
+		case errors.Is(err, entQ.ErrSelfDuplicate):
+			rpc.WriteBadRequest(w, "self_duplicate")
+			return
+
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
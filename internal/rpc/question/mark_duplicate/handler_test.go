@@ -0,0 +1,78 @@
+package mark_duplicate
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/question/mark_duplicate/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(id, body, userID string) *http.Request {
+	req := httptest.NewRequest("POST", "/questions/"+id+"/duplicate", bytes.NewBufferString(body))
+	req.SetPathValue("id", id)
+	if userID != "" {
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), userID))
+	}
+	return req
+}
+
+func TestHandler_MarkDuplicate_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("MarkDuplicate", mock.Anything, 5, 2, "mod").Return(nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5", `{"duplicate_of":2}`, "mod"))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_MarkDuplicate_InvalidID(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("x", `{"duplicate_of":2}`, "mod"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_MarkDuplicate_ValidationError(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5", `{}`, "mod"))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestHandler_MarkDuplicate_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "question_not_found", err: entQ.ErrQuestionNotFound, code: http.StatusNotFound},
+		{name: "canonical_not_found", err: entQ.ErrCanonicalNotFound, code: http.StatusNotFound},
+		{name: "self_duplicate", err: entQ.ErrSelfDuplicate, code: http.StatusBadRequest},
+		{name: "unexpected", err: errors.New("boom"), code: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("MarkDuplicate", mock.Anything, 5, 2, "mod").Return(tt.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, newRequest("5", `{"duplicate_of":2}`, "mod"))
+
+			require.Equal(t, tt.code, w.Code)
+		})
+	}
+}
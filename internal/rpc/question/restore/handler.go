@@ -0,0 +1,73 @@
+package restore
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		RestoreQuestion(ctx context.Context, questionID int, userID string) error
+	}
+)
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	questionID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_question_id")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	err = h.uc.RestoreQuestion(r.Context(), questionID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, entQ.ErrQuestionNotFound):
+			rpc.WriteNotFound(w, "question_not_found")
+			return
+
+		case errors.Is(err, entQ.ErrAccessDenied):
+			rpc.WriteForbidden(w)
+			return
+
+		case errors.Is(err, entQ.ErrNotDeleted):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("question_not_deleted"))
+			return
+
+		case errors.Is(err, entQ.ErrRemovedByModerator):
+			rpc.WriteJSON(w, http.StatusForbidden, rpc.NewBaseHTTPError("removed_by_moderator"))
+			return
+
+		case errors.Is(err, entQ.ErrRestoreExpired):
+			rpc.WriteJSON(w, http.StatusGone, rpc.NewBaseHTTPError("restore_period_expired"))
+			return
+
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,79 @@
+package restore
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/question/restore/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(id, userID string) *http.Request {
+	req := httptest.NewRequest("POST", "/questions/"+id+"/restore", nil)
+	req.SetPathValue("id", id)
+	if userID != "" {
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), userID))
+	}
+	return req
+}
+
+func TestHandler_Restore_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("RestoreQuestion", mock.Anything, 5, "owner").Return(nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5", "owner"))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Restore_InvalidID(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("x", "owner"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Restore_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5", ""))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestHandler_Restore_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "not_found", err: entQ.ErrQuestionNotFound, code: http.StatusNotFound},
+		{name: "forbidden", err: entQ.ErrAccessDenied, code: http.StatusForbidden},
+		{name: "not_deleted", err: entQ.ErrNotDeleted, code: http.StatusConflict},
+		{name: "removed_by_moderator", err: entQ.ErrRemovedByModerator, code: http.StatusForbidden},
+		{name: "expired", err: entQ.ErrRestoreExpired, code: http.StatusGone},
+		{name: "unexpected", err: errors.New("boom"), code: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("RestoreQuestion", mock.Anything, 5, "owner").Return(tt.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, newRequest("5", "owner"))
+
+			require.Equal(t, tt.code, w.Code)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package transition
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/question/history"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Transition(
+			ctx context.Context,
+			questionID int,
+			action entQ.Action,
+			actorID string,
+			role entU.Role,
+			reason string,
+		) (*entQ.Transition, error)
+	}
+)
+
+// TransitionRequest is optional: reopen and unlock need no reason.
+type TransitionRequest struct {
+	Reason string `json:"reason"`
+}
+
+// Handler serves one lifecycle action, e.g. POST /questions/{id}/close.
+type Handler struct {
+	uc     useCase
+	action entQ.Action
+}
+
+func NewHandler(uc useCase, action entQ.Action) *Handler {
+	return &Handler{uc: uc, action: action}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	qID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_question_id")
+		return
+	}
+
+	var req TransitionRequest
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		rpc.WriteBadRequest(w, "invalid_json")
+		return
+	}
+
+	actorID := rpc_auth.GetUserID(r.Context())
+	if actorID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	t, err := h.uc.Transition(r.Context(), qID, h.action, actorID, rpc_auth.GetUserRole(r.Context()), req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, entQ.ErrQuestionNotFound):
+			rpc.WriteNotFound(w, "question_not_found")
+		case errors.Is(err, entQ.ErrAccessDenied):
+			rpc.WriteForbidden(w)
+		case errors.Is(err, entQ.ErrReasonRequired):
+			rpc.WriteBadRequest(w, "reason_required")
+		case errors.Is(err, entQ.ErrInvalidTransition):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("invalid_transition"))
+		default:
+			rpc.WriteUnexpectedError(w, err)
+		}
+		return
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, history.ToItem(t))
+}
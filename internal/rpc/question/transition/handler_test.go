@@ -0,0 +1,116 @@
+package transition
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/question/history"
+	"test-question/internal/rpc/question/transition/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(id, body, userID string, role entU.Role) *http.Request {
+	req := httptest.NewRequest("POST", "/questions/"+id+"/close", bytes.NewBufferString(body))
+	req.SetPathValue("id", id)
+	if userID != "" {
+		ctx := rpc_auth.InjectUserID(req.Context(), userID)
+		req = req.WithContext(rpc_auth.InjectUserRole(ctx, role))
+	}
+	return req
+}
+
+func TestHandler_Transition_Success(t *testing.T) {
+	now := time.Now()
+
+	mUC := mocks.NewUseCase(t)
+	mUC.
+		On("Transition", mock.Anything, 5, entQ.ActionClose, "owner", entU.RoleUser, "off-topic").
+		Return(&entQ.Transition{
+			ID:         1,
+			QuestionID: 5,
+			Action:     entQ.ActionClose,
+			From:       entQ.StatusOpen,
+			To:         entQ.StatusClosed,
+			ActorID:    "owner",
+			Reason:     "off-topic",
+			CreatedAt:  now,
+		}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entQ.ActionClose).ServeHTTP(w, newRequest("5", `{"reason":"off-topic"}`, "owner", entU.RoleUser))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp history.Item
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, "closed", resp.To)
+	require.Equal(t, "off-topic", resp.Reason)
+}
+
+func TestHandler_Transition_EmptyBody(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.
+		On("Transition", mock.Anything, 5, entQ.ActionReopen, "owner", entU.RoleUser, "").
+		Return(&entQ.Transition{ID: 2, To: entQ.StatusOpen}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entQ.ActionReopen).ServeHTTP(w, newRequest("5", "", "owner", entU.RoleUser))
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_Transition_InvalidJSON(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entQ.ActionClose).ServeHTTP(w, newRequest("5", `{`, "owner", entU.RoleUser))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Transition_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entQ.ActionClose).ServeHTTP(w, newRequest("5", `{"reason":"x"}`, "", ""))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestHandler_Transition_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{name: "not_found", err: entQ.ErrQuestionNotFound, code: http.StatusNotFound},
+		{name: "forbidden", err: entQ.ErrAccessDenied, code: http.StatusForbidden},
+		{name: "reason_required", err: entQ.ErrReasonRequired, code: http.StatusBadRequest},
+		{name: "invalid_transition", err: entQ.ErrInvalidTransition, code: http.StatusConflict},
+		{name: "unexpected", err: errors.New("boom"), code: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.
+				On("Transition", mock.Anything, 5, entQ.ActionLock, "mod", entU.RoleModerator, "x").
+				Return(nil, tt.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC, entQ.ActionLock).ServeHTTP(w, newRequest("5", `{"reason":"x"}`, "mod", entU.RoleModerator))
+
+			require.Equal(t, tt.code, w.Code)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	question "test-question/internal/entity/question"
+
+	mock "github.com/stretchr/testify/mock"
+
+	user "test-question/internal/entity/user"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Transition provides a mock function with given fields: ctx, questionID, action, actorID, role, reason
+func (_m *UseCase) Transition(ctx context.Context, questionID int, action question.Action, actorID string, role user.Role, reason string) (*question.Transition, error) {
+	ret := _m.Called(ctx, questionID, action, actorID, role, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Transition")
+	}
+
+	var r0 *question.Transition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, question.Action, string, user.Role, string) (*question.Transition, error)); ok {
+		return rf(ctx, questionID, action, actorID, role, reason)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, question.Action, string, user.Role, string) *question.Transition); ok {
+		r0 = rf(ctx, questionID, action, actorID, role, reason)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*question.Transition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, question.Action, string, user.Role, string) error); ok {
+		r1 = rf(ctx, questionID, action, actorID, role, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
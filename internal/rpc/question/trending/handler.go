@@ -0,0 +1,72 @@
+package trending
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc"
+)
+
+const (
+	defaultLimit = 20
+	maxLimit     = 100
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		ListTrending(ctx context.Context, limit int) ([]*entQ.Question, error)
+	}
+)
+
+type ResponseItem struct {
+	ID        int    `json:"id"`
+	Text      string `json:"text"`
+	UserID    string `json:"user_id"`
+	Status    string `json:"status"`
+	ViewCount int    `json:"view_count"`
+	CreatedAt string `json:"created_at"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	limit := defaultLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxLimit {
+			rpc.WriteBadRequest(w, "invalid_limit")
+			return
+		}
+		limit = n
+	}
+
+	qs, err := h.uc.ListTrending(r.Context(), limit)
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	resp := make([]ResponseItem, len(qs))
+	for i, q := range qs {
+		resp[i] = ResponseItem{
+			ID:        q.ID,
+			Text:      q.Text,
+			UserID:    q.UserID,
+			Status:    string(q.Status),
+			ViewCount: q.ViewCount,
+			CreatedAt: q.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, resp)
+}
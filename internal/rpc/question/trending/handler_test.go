@@ -0,0 +1,68 @@
+package trending
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/rpc/question/trending/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Trending(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	now := time.Now()
+	mUC.On("ListTrending", mock.Anything, defaultLimit).Return([]*entQ.Question{
+		{ID: 2, Text: "busy", UserID: "u1", Status: entQ.StatusOpen, ViewCount: 40, CreatedAt: now},
+		{ID: 1, Text: "quiet", UserID: "u2", Status: entQ.StatusOpen, CreatedAt: now},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/questions/trending", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp []ResponseItem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, []ResponseItem{
+		{ID: 2, Text: "busy", UserID: "u1", Status: "open", ViewCount: 40, CreatedAt: now.Format(time.RFC3339)},
+		{ID: 1, Text: "quiet", UserID: "u2", Status: "open", CreatedAt: now.Format(time.RFC3339)},
+	}, resp)
+}
+
+func TestHandler_Trending_Limit(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("ListTrending", mock.Anything, 5).Return([]*entQ.Question{}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/questions/trending?limit=5", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[]`, w.Body.String())
+}
+
+func TestHandler_Trending_InvalidLimit(t *testing.T) {
+	for _, limit := range []string{"0", "101", "x"} {
+		w := httptest.NewRecorder()
+		NewHandler(mocks.NewUseCase(t)).ServeHTTP(w, httptest.NewRequest("GET", "/questions/trending?limit="+limit, nil))
+
+		require.Equal(t, http.StatusBadRequest, w.Code, limit)
+	}
+}
+
+func TestHandler_Trending_Error(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("ListTrending", mock.Anything, defaultLimit).Return(nil, errors.New("boom"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/questions/trending", nil))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,46 @@
+package unfollow
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Unfollow(ctx context.Context, questionID int, userID string) error
+	}
+)
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	qID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_question_id")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	if err = h.uc.Unfollow(r.Context(), qID, userID); err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
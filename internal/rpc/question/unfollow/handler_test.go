@@ -0,0 +1,40 @@
+package unfollow
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/question/unfollow/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Unfollow_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("Unfollow", mock.Anything, 3, "user-1").Return(nil)
+
+	req := httptest.NewRequest("DELETE", "/questions/3/follow", nil)
+	req.SetPathValue("id", "3")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Unfollow_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	req := httptest.NewRequest("DELETE", "/questions/3/follow", nil)
+	req.SetPathValue("id", "3")
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,55 @@
+package unmark_duplicate
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		UnmarkDuplicate(ctx context.Context, questionID int, moderatorID string) error
+	}
+)
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	qID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_question_id")
+		return
+	}
+
+	moderatorID := rpc_auth.GetUserID(r.Context())
+	if moderatorID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	err = h.uc.UnmarkDuplicate(r.Context(), qID, moderatorID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			rpc.WriteNotFound(w, "question_not_found")
+			return
+		}
+
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,52 @@
+package unmark_duplicate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entQ "test-question/internal/entity/question"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/question/unmark_duplicate/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(id, userID string) *http.Request {
+	req := httptest.NewRequest("DELETE", "/questions/"+id+"/duplicate", nil)
+	req.SetPathValue("id", id)
+	if userID != "" {
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), userID))
+	}
+	return req
+}
+
+func TestHandler_UnmarkDuplicate_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("UnmarkDuplicate", mock.Anything, 5, "mod").Return(nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5", "mod"))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_UnmarkDuplicate_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("UnmarkDuplicate", mock.Anything, 5, "mod").Return(entQ.ErrQuestionNotFound)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5", "mod"))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_UnmarkDuplicate_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, newRequest("5", ""))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
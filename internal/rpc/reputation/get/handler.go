@@ -0,0 +1,104 @@
+package get
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entU "test-question/internal/entity/user"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/usecase/reputation/get_by_user"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultHistoryLimit = 50
+	maxHistoryLimit     = 200
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		GetUserReputation(
+			ctx context.Context,
+			userID string,
+			limit int,
+		) (*get_by_user.UserReputation, error)
+	}
+)
+
+type Response struct {
+	UserID  string         `json:"user_id"`
+	Total   int            `json:"total"`
+	History []HistoryEntry `json:"history"`
+}
+
+type HistoryEntry struct {
+	ID          int    `json:"id"`
+	Reason      string `json:"reason"`
+	Delta       int    `json:"delta"`
+	SubjectType string `json:"subject_type"`
+	SubjectID   int    `json:"subject_id"`
+	ReversalOf  int    `json:"reversal_of,omitempty"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID := r.PathValue("id")
+	if _, err := uuid.Parse(userID); err != nil {
+		rpc.WriteBadRequest(w, "invalid_user_id")
+		return
+	}
+
+	limit := defaultHistoryLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > maxHistoryLimit {
+			rpc.WriteBadRequest(w, "invalid_limit")
+			return
+		}
+		limit = n
+	}
+
+	rep, err := h.uc.GetUserReputation(r.Context(), userID, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, entU.ErrUserNotFound):
+			rpc.WriteNotFound(w, "user_not_found")
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	history := make([]HistoryEntry, len(rep.History))
+	for i, e := range rep.History {
+		history[i] = HistoryEntry{
+			ID:          e.ID,
+			Reason:      string(e.Reason),
+			Delta:       e.Delta,
+			SubjectType: string(e.SubjectType),
+			SubjectID:   e.SubjectID,
+			ReversalOf:  e.ReversalOf,
+			CreatedAt:   e.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, Response{
+		UserID:  rep.UserID,
+		Total:   rep.Total,
+		History: history,
+	})
+}
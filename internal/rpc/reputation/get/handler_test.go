@@ -0,0 +1,102 @@
+package get_test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entR "test-question/internal/entity/reputation"
+	entU "test-question/internal/entity/user"
+	"test-question/internal/rpc/reputation/get"
+	"test-question/internal/rpc/reputation/get/mocks"
+	"test-question/internal/usecase/reputation/get_by_user"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const userID = "11111111-1111-1111-1111-111111111111"
+
+func serve(h http.Handler, target string) *httptest.ResponseRecorder {
+	mux := http.NewServeMux()
+	mux.Handle("GET /users/{id}/reputation", h)
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest("GET", target, nil))
+	return w
+}
+
+func TestHandler_Get_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Now()
+
+	mUC.
+		On("GetUserReputation", mock.Anything, userID, 10).
+		Return(&get_by_user.UserReputation{
+			UserID: userID,
+			Total:  0,
+			History: []*entR.Entry{
+				{ID: 2, Reason: entR.ReasonAnswerUpvoted, Delta: -10, SubjectType: entR.SubjectAnswer, SubjectID: 4, ReversalOf: 1, CreatedAt: now},
+				{ID: 1, Reason: entR.ReasonAnswerUpvoted, Delta: 10, SubjectType: entR.SubjectAnswer, SubjectID: 4, CreatedAt: now},
+			},
+		}, nil)
+
+	w := serve(get.NewHandler(mUC), "/users/"+userID+"/reputation?limit=10")
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp get.Response
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, userID, resp.UserID)
+	require.Len(t, resp.History, 2)
+	require.Equal(t, 1, resp.History[0].ReversalOf)
+	require.Equal(t, "answer_upvoted", resp.History[1].Reason)
+	require.Equal(t, now.Format(time.RFC3339), resp.History[1].CreatedAt)
+}
+
+func TestHandler_Get_DefaultLimit(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("GetUserReputation", mock.Anything, userID, 50).
+		Return(&get_by_user.UserReputation{UserID: userID}, nil)
+
+	w := serve(get.NewHandler(mUC), "/users/"+userID+"/reputation")
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_Get_BadRequest(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	require.Equal(t, http.StatusBadRequest, serve(get.NewHandler(mUC), "/users/abc/reputation").Code)
+	require.Equal(t, http.StatusBadRequest, serve(get.NewHandler(mUC), "/users/"+userID+"/reputation?limit=0").Code)
+	require.Equal(t, http.StatusBadRequest, serve(get.NewHandler(mUC), "/users/"+userID+"/reputation?limit=x").Code)
+}
+
+func TestHandler_Get_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("GetUserReputation", mock.Anything, userID, 50).
+		Return(nil, entU.ErrUserNotFound)
+
+	w := serve(get.NewHandler(mUC), "/users/"+userID+"/reputation")
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_Get_UnexpectedError(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("GetUserReputation", mock.Anything, userID, 50).
+		Return(nil, errors.New("boom"))
+
+	w := serve(get.NewHandler(mUC), "/users/"+userID+"/reputation")
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
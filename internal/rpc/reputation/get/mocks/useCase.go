@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	get_by_user "test-question/internal/usecase/reputation/get_by_user"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// GetUserReputation provides a mock function with given fields: ctx, userID, limit
+func (_m *UseCase) GetUserReputation(ctx context.Context, userID string, limit int) (*get_by_user.UserReputation, error) {
+	ret := _m.Called(ctx, userID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUserReputation")
+	}
+
+	var r0 *get_by_user.UserReputation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (*get_by_user.UserReputation, error)); ok {
+		return rf(ctx, userID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *get_by_user.UserReputation); ok {
+		r0 = rf(ctx, userID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*get_by_user.UserReputation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, userID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,64 @@
+package leaderboard
+
+import (
+	"context"
+	"net/http"
+
+	entR "test-question/internal/entity/reputation"
+	"test-question/internal/pkg/rpc"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Leaderboard(ctx context.Context, period entR.Period) ([]*entR.LeaderboardItem, error)
+	}
+)
+
+type ResponseItem struct {
+	Rank     int    `json:"rank"`
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	Points   int    `json:"points"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	period := entR.PeriodAll
+	if v := r.URL.Query().Get("period"); v != "" {
+		period = entR.Period(v)
+	}
+
+	items, err := h.uc.Leaderboard(r.Context(), period)
+	if err != nil {
+		switch {
+		case errors.Is(err, entR.ErrInvalidPeriod):
+			rpc.WriteBadRequest(w, "invalid_period")
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	resp := make([]ResponseItem, len(items))
+	for i, it := range items {
+		resp[i] = ResponseItem{
+			Rank:     i + 1,
+			UserID:   it.UserID,
+			Username: it.Username,
+			Points:   it.Points,
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, resp)
+}
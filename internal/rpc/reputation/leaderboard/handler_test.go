@@ -0,0 +1,76 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entR "test-question/internal/entity/reputation"
+	"test-question/internal/rpc/reputation/leaderboard/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Leaderboard_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("Leaderboard", mock.Anything, entR.PeriodWeek).
+		Return([]*entR.LeaderboardItem{
+			{UserID: "u1", Username: "alice", Points: 30},
+			{UserID: "u2", Username: "bob", Points: 10},
+		}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/leaderboard?period=week", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp []ResponseItem
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, resp, 2)
+	require.Equal(t, ResponseItem{Rank: 1, UserID: "u1", Username: "alice", Points: 30}, resp[0])
+	require.Equal(t, 2, resp[1].Rank)
+}
+
+func TestHandler_Leaderboard_DefaultPeriod(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("Leaderboard", mock.Anything, entR.PeriodAll).
+		Return([]*entR.LeaderboardItem{}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/leaderboard", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestHandler_Leaderboard_InvalidPeriod(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("Leaderboard", mock.Anything, entR.Period("year")).
+		Return(nil, entR.ErrInvalidPeriod)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/leaderboard?period=year", nil))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Leaderboard_UnexpectedError(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("Leaderboard", mock.Anything, entR.PeriodAll).
+		Return(nil, errors.New("boom"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, httptest.NewRequest("GET", "/leaderboard", nil))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	reputation "test-question/internal/entity/reputation"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Leaderboard provides a mock function with given fields: ctx, period
+func (_m *UseCase) Leaderboard(ctx context.Context, period reputation.Period) ([]*reputation.LeaderboardItem, error) {
+	ret := _m.Called(ctx, period)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Leaderboard")
+	}
+
+	var r0 []*reputation.LeaderboardItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, reputation.Period) ([]*reputation.LeaderboardItem, error)); ok {
+		return rf(ctx, period)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, reputation.Period) []*reputation.LeaderboardItem); ok {
+		r0 = rf(ctx, period)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*reputation.LeaderboardItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, reputation.Period) error); ok {
+		r1 = rf(ctx, period)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
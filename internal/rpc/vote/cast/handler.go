@@ -0,0 +1,84 @@
+package cast
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entV "test-question/internal/entity/vote"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Vote(
+			ctx context.Context,
+			userID string,
+			targetType entV.TargetType,
+			targetID int,
+			value int,
+		) error
+	}
+)
+
+type VoteRequest struct {
+	Value int `json:"value" validate:"oneof=-1 1"`
+}
+
+type Handler struct {
+	uc     useCase
+	target entV.TargetType
+}
+
+func NewHandler(uc useCase, target entV.TargetType) *Handler {
+	return &Handler{uc: uc, target: target}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req VoteRequest
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	targetID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_"+string(h.target)+"_id")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	err = h.uc.Vote(r.Context(), userID, h.target, targetID, req.Value)
+	if err != nil {
+		WriteVoteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// WriteVoteError maps vote use case errors, shared with the retract handler.
+func WriteVoteError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, entQ.ErrQuestionNotFound):
+		rpc.WriteNotFound(w, "question_not_found")
+	case errors.Is(err, entA.ErrAnswerNotFound):
+		rpc.WriteNotFound(w, "answer_not_found")
+	case errors.Is(err, entV.ErrSelfVote):
+		rpc.WriteJSON(w, http.StatusForbidden, rpc.NewBaseHTTPError("self_vote"))
+	case errors.Is(err, entQ.ErrQuestionLocked):
+		rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("question_locked"))
+	default:
+		rpc.WriteUnexpectedError(w, err)
+	}
+}
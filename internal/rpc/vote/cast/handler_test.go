@@ -0,0 +1,102 @@
+package cast
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entV "test-question/internal/entity/vote"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/vote/cast/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRequest(body, id string, userID string) *http.Request {
+	req := httptest.NewRequest("PUT", "/answers/"+id+"/vote", bytes.NewBufferString(body))
+	req.SetPathValue("id", id)
+	if userID != "" {
+		req = req.WithContext(rpc_auth.InjectUserID(req.Context(), userID))
+	}
+	return req
+}
+
+func TestHandler_Vote_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("Vote", mock.Anything, "user-1", entV.TargetAnswer, 10, -1).
+		Return(nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entV.TargetAnswer).ServeHTTP(w, newRequest(`{"value":-1}`, "10", "user-1"))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Vote_ValidationError(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entV.TargetAnswer).ServeHTTP(w, newRequest(`{"value":2}`, "10", "user-1"))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestHandler_Vote_InvalidID(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entV.TargetAnswer).ServeHTTP(w, newRequest(`{"value":1}`, "abc", "user-1"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+
+	var resp map[string]any
+	json.Unmarshal(w.Body.Bytes(), &resp)
+	require.Equal(t, "invalid_answer_id", resp["code"])
+}
+
+func TestHandler_Vote_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entV.TargetAnswer).ServeHTTP(w, newRequest(`{"value":1}`, "10", ""))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestHandler_Vote_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    int
+		message string
+	}{
+		{name: "not_found", err: entA.ErrAnswerNotFound, code: http.StatusNotFound, message: "answer_not_found"},
+		{name: "self_vote", err: entV.ErrSelfVote, code: http.StatusForbidden, message: "self_vote"},
+		{name: "locked", err: entQ.ErrQuestionLocked, code: http.StatusConflict, message: "question_locked"},
+		{name: "unexpected", err: errors.New("boom"), code: http.StatusInternalServerError, message: "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("Vote", mock.Anything, "user-1", entV.TargetAnswer, 10, 1).Return(tt.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC, entV.TargetAnswer).ServeHTTP(w, newRequest(`{"value":1}`, "10", "user-1"))
+
+			require.Equal(t, tt.code, w.Code)
+
+			var resp map[string]any
+			json.Unmarshal(w.Body.Bytes(), &resp)
+			require.Equal(t, tt.message, resp["code"])
+		})
+	}
+}
@@ -0,0 +1,47 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	vote "test-question/internal/entity/vote"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Vote provides a mock function with given fields: ctx, userID, targetType, targetID, value
+func (_m *UseCase) Vote(ctx context.Context, userID string, targetType vote.TargetType, targetID int, value int) error {
+	ret := _m.Called(ctx, userID, targetType, targetID, value)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Vote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, vote.TargetType, int, int) error); ok {
+		r0 = rf(ctx, userID, targetType, targetID, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
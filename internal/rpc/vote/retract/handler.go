@@ -0,0 +1,55 @@
+package retract
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entV "test-question/internal/entity/vote"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/vote/cast"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Vote(
+			ctx context.Context,
+			userID string,
+			targetType entV.TargetType,
+			targetID int,
+			value int,
+		) error
+	}
+)
+
+type Handler struct {
+	uc     useCase
+	target entV.TargetType
+}
+
+func NewHandler(uc useCase, target entV.TargetType) *Handler {
+	return &Handler{uc: uc, target: target}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	targetID, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_"+string(h.target)+"_id")
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	if err = h.uc.Vote(r.Context(), userID, h.target, targetID, 0); err != nil {
+		cast.WriteVoteError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
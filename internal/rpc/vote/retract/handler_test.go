@@ -0,0 +1,61 @@
+package retract
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entQ "test-question/internal/entity/question"
+	entV "test-question/internal/entity/vote"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/vote/retract/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Retract_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("Vote", mock.Anything, "user-1", entV.TargetQuestion, 3, 0).
+		Return(nil)
+
+	req := httptest.NewRequest("DELETE", "/questions/3/vote", nil)
+	req.SetPathValue("id", "3")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entV.TargetQuestion).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Retract_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("Vote", mock.Anything, "user-1", entV.TargetQuestion, 3, 0).
+		Return(entQ.ErrQuestionNotFound)
+
+	req := httptest.NewRequest("DELETE", "/questions/3/vote", nil)
+	req.SetPathValue("id", "3")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entV.TargetQuestion).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestHandler_Retract_Unauthorized(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	req := httptest.NewRequest("DELETE", "/questions/3/vote", nil)
+	req.SetPathValue("id", "3")
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC, entV.TargetQuestion).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,78 @@
+package create
+
+import (
+	"context"
+	"net/http"
+
+	entW "test-question/internal/entity/webhook"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/webhook/list"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		CreateSubscription(
+			ctx context.Context,
+			adminID string,
+			url string,
+			events []entW.Event,
+			secret string,
+		) (*entW.Subscription, error)
+	}
+)
+
+type CreateWebhookRequest struct {
+	URL    string   `json:"url" validate:"required,url"`
+	Events []string `json:"events" validate:"required,min=1"`
+	Secret string   `json:"secret" validate:"required,min=16"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req CreateWebhookRequest
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	adminID := rpc_auth.GetUserID(r.Context())
+	if adminID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	events := make([]entW.Event, len(req.Events))
+	for i, e := range req.Events {
+		events[i] = entW.Event(e)
+	}
+
+	s, err := h.uc.CreateSubscription(r.Context(), adminID, req.URL, events, req.Secret)
+	if err != nil {
+		switch {
+		case errors.Is(err, entW.ErrInvalidURL):
+			rpc.WriteBadRequest(w, "invalid_webhook_url")
+			return
+		case errors.Is(err, entW.ErrNoEvents):
+			rpc.WriteBadRequest(w, "no_webhook_events")
+			return
+		case errors.Is(err, entW.ErrUnknownEvent):
+			rpc.WriteBadRequest(w, "unknown_webhook_event")
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusCreated, list.NewItem(s))
+}
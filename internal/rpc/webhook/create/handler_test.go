@@ -0,0 +1,82 @@
+package create
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/webhook/create/mocks"
+	"test-question/internal/rpc/webhook/list"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const body = `{"url":"https://hooks.example.com","events":["answer.created"],"secret":"0123456789abcdef"}`
+
+func TestHandler_Create_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mUC.
+		On("CreateSubscription", mock.Anything, "admin-1", "https://hooks.example.com",
+			[]entW.Event{entW.EventAnswerCreated}, "0123456789abcdef").
+		Return(&entW.Subscription{
+			ID:        1,
+			URL:       "https://hooks.example.com",
+			Events:    []entW.Event{entW.EventAnswerCreated},
+			Secret:    "0123456789abcdef",
+			Active:    true,
+			CreatedBy: "admin-1",
+			CreatedAt: now,
+		}, nil)
+
+	req := httptest.NewRequest("POST", "/admin/webhooks", strings.NewReader(body))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "admin-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.NotContains(t, w.Body.String(), "0123456789abcdef")
+
+	var resp list.Item
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Equal(t, 1, resp.ID)
+	require.Equal(t, []string{"answer.created"}, resp.Events)
+}
+
+func TestHandler_Create_UnknownEvent(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.
+		On("CreateSubscription", mock.Anything, "admin-1", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, entW.ErrUnknownEvent)
+
+	req := httptest.NewRequest("POST", "/admin/webhooks", strings.NewReader(body))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "admin-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	require.Contains(t, w.Body.String(), `"code":"unknown_webhook_event"`)
+}
+
+func TestHandler_Create_ShortSecret(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	req := httptest.NewRequest("POST", "/admin/webhooks",
+		strings.NewReader(`{"url":"https://hooks.example.com","events":["answer.created"],"secret":"short"}`))
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "admin-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
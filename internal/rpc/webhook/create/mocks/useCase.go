@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	webhook "test-question/internal/entity/webhook"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// CreateSubscription provides a mock function with given fields: ctx, adminID, url, events, secret
+func (_m *UseCase) CreateSubscription(ctx context.Context, adminID string, url string, events []webhook.Event, secret string) (*webhook.Subscription, error) {
+	ret := _m.Called(ctx, adminID, url, events, secret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubscription")
+	}
+
+	var r0 *webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []webhook.Event, string) (*webhook.Subscription, error)); ok {
+		return rf(ctx, adminID, url, events, secret)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []webhook.Event, string) *webhook.Subscription); ok {
+		r0 = rf(ctx, adminID, url, events, secret)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []webhook.Event, string) error); ok {
+		r1 = rf(ctx, adminID, url, events, secret)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+package delete //nolint:predeclared
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	entW "test-question/internal/entity/webhook"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		DeleteSubscription(ctx context.Context, id int, adminID string) error
+	}
+)
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_webhook_id")
+		return
+	}
+
+	adminID := rpc_auth.GetUserID(r.Context())
+	if adminID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	if err = h.uc.DeleteSubscription(r.Context(), id, adminID); err != nil {
+		switch {
+		case errors.Is(err, entW.ErrSubscriptionNotFound):
+			rpc.WriteNotFound(w, "webhook_not_found")
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,44 @@
+package delete //nolint:predeclared
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entW "test-question/internal/entity/webhook"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/webhook/delete/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_Delete_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("DeleteSubscription", mock.Anything, 4, "admin-1").Return(nil)
+
+	req := httptest.NewRequest("DELETE", "/admin/webhooks/4", nil)
+	req.SetPathValue("id", "4")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "admin-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Delete_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("DeleteSubscription", mock.Anything, 4, "admin-1").Return(entW.ErrSubscriptionNotFound)
+
+	req := httptest.NewRequest("DELETE", "/admin/webhooks/4", nil)
+	req.SetPathValue("id", "4")
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "admin-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,66 @@
+package list
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+	"test-question/internal/pkg/rpc"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		ListSubscriptions(ctx context.Context) ([]*entW.Subscription, error)
+	}
+)
+
+// Item describes a subscription. The secret is write-only and never returned.
+type Item struct {
+	ID        int      `json:"id"`
+	URL       string   `json:"url"`
+	Events    []string `json:"events"`
+	Active    bool     `json:"active"`
+	CreatedBy string   `json:"created_by"`
+	CreatedAt string   `json:"created_at"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	subs, err := h.uc.ListSubscriptions(r.Context())
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	items := make([]Item, len(subs))
+	for i, s := range subs {
+		items[i] = NewItem(s)
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, items)
+}
+
+func NewItem(s *entW.Subscription) Item {
+	events := make([]string, len(s.Events))
+	for i, e := range s.Events {
+		events[i] = string(e)
+	}
+
+	return Item{
+		ID:        s.ID,
+		URL:       s.URL,
+		Events:    events,
+		Active:    s.Active,
+		CreatedBy: s.CreatedBy,
+		CreatedAt: s.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,46 @@
+package list
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+	"test-question/internal/rpc/webhook/list/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_List_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mUC.
+		On("ListSubscriptions", mock.Anything).
+		Return([]*entW.Subscription{{
+			ID:        1,
+			URL:       "https://hooks.example.com",
+			Events:    []entW.Event{entW.EventQuestionCreated},
+			Secret:    "0123456789abcdef",
+			Active:    true,
+			CreatedBy: "admin-1",
+			CreatedAt: now,
+		}}, nil)
+
+	req := httptest.NewRequest("GET", "/admin/webhooks", nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{
+		"id": 1,
+		"url": "https://hooks.example.com",
+		"events": ["question.created"],
+		"active": true,
+		"created_by": "admin-1",
+		"created_at": "2024-11-20T12:00:00Z"
+	}]`, w.Body.String())
+}
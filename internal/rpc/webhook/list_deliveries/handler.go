@@ -0,0 +1,98 @@
+package list_deliveries
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+	"test-question/internal/pkg/rpc"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		ListDeliveries(ctx context.Context, subscriptionID int, limit int) ([]*entW.Delivery, error)
+	}
+)
+
+type Item struct {
+	ID             int     `json:"id"`
+	Event          string  `json:"event"`
+	Status         string  `json:"status"`
+	Attempts       int     `json:"attempts"`
+	LastStatusCode int     `json:"last_status_code,omitempty"`
+	LastError      string  `json:"last_error,omitempty"`
+	NextAttemptAt  *string `json:"next_attempt_at,omitempty"`
+	DeliveredAt    *string `json:"delivered_at,omitempty"`
+	CreatedAt      string  `json:"created_at"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		rpc.WriteBadRequest(w, "invalid_webhook_id")
+		return
+	}
+
+	limit := defaultLimit
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, errConv := strconv.Atoi(v)
+		if errConv != nil || n < 1 || n > maxLimit {
+			rpc.WriteBadRequest(w, "invalid_limit")
+			return
+		}
+		limit = n
+	}
+
+	ds, err := h.uc.ListDeliveries(r.Context(), id, limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, entW.ErrSubscriptionNotFound):
+			rpc.WriteNotFound(w, "webhook_not_found")
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	items := make([]Item, len(ds))
+	for i, d := range ds {
+		items[i] = Item{
+			ID:             d.ID,
+			Event:          string(d.Event),
+			Status:         string(d.Status),
+			Attempts:       d.Attempts,
+			LastStatusCode: d.LastStatusCode,
+			LastError:      d.LastError,
+			CreatedAt:      d.CreatedAt.Format(time.RFC3339),
+		}
+		if d.Status == entW.StatusPending {
+			next := d.NextAttemptAt.Format(time.RFC3339)
+			items[i].NextAttemptAt = &next
+		}
+		if d.DeliveredAt != nil {
+			delivered := d.DeliveredAt.Format(time.RFC3339)
+			items[i].DeliveredAt = &delivered
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, items)
+}
@@ -0,0 +1,71 @@
+package list_deliveries
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+	"test-question/internal/rpc/webhook/list_deliveries/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_ListDeliveries_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mUC.
+		On("ListDeliveries", mock.Anything, 4, 10).
+		Return([]*entW.Delivery{
+			{
+				ID:             2,
+				Event:          entW.EventAnswerCreated,
+				Status:         entW.StatusPending,
+				Attempts:       1,
+				NextAttemptAt:  now.Add(time.Minute),
+				LastStatusCode: 503,
+				LastError:      "unexpected status 503",
+				CreatedAt:      now,
+			},
+			{
+				ID:          1,
+				Event:       entW.EventQuestionCreated,
+				Status:      entW.StatusDelivered,
+				Attempts:    1,
+				DeliveredAt: &now,
+				CreatedAt:   now,
+			},
+		}, nil)
+
+	req := httptest.NewRequest("GET", "/admin/webhooks/4/deliveries?limit=10", nil)
+	req.SetPathValue("id", "4")
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[
+		{"id": 2, "event": "answer.created", "status": "pending", "attempts": 1,
+		 "last_status_code": 503, "last_error": "unexpected status 503",
+		 "next_attempt_at": "2024-11-20T12:01:00Z", "created_at": "2024-11-20T12:00:00Z"},
+		{"id": 1, "event": "question.created", "status": "delivered", "attempts": 1,
+		 "delivered_at": "2024-11-20T12:00:00Z", "created_at": "2024-11-20T12:00:00Z"}
+	]`, w.Body.String())
+}
+
+func TestHandler_ListDeliveries_NotFound(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+
+	mUC.On("ListDeliveries", mock.Anything, 4, defaultLimit).Return(nil, entW.ErrSubscriptionNotFound)
+
+	req := httptest.NewRequest("GET", "/admin/webhooks/4/deliveries", nil)
+	req.SetPathValue("id", "4")
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	webhook "test-question/internal/entity/webhook"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// ListDeliveries provides a mock function with given fields: ctx, subscriptionID, limit
+func (_m *UseCase) ListDeliveries(ctx context.Context, subscriptionID int, limit int) ([]*webhook.Delivery, error) {
+	ret := _m.Called(ctx, subscriptionID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []*webhook.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*webhook.Delivery, error)); ok {
+		return rf(ctx, subscriptionID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*webhook.Delivery); ok {
+		r0 = rf(ctx, subscriptionID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, subscriptionID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
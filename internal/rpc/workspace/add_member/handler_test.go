@@ -0,0 +1,70 @@
+package add_member
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entU "test-question/internal/entity/user"
+	entW "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/workspace/add_member/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const memberID = "22222222-2222-2222-2222-222222222222"
+
+func request(userID string) *http.Request {
+	req := httptest.NewRequest("PUT", "/workspaces/platform/members/"+userID, nil)
+	req.SetPathValue("slug", "platform")
+	req.SetPathValue("user_id", userID)
+	ctx := rpc_auth.InjectUserRole(rpc_auth.InjectUserID(req.Context(), "owner-1"), entU.RoleUser)
+	return req.WithContext(ctx)
+}
+
+func TestHandler_Add_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Add", mock.Anything, "platform", "owner-1", entU.RoleUser, memberID).Return(nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request(memberID))
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Add_InvalidUserID(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewHandler(mocks.NewUseCase(t)).ServeHTTP(w, request("bob"))
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandler_Add_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		code int
+		body string
+	}{
+		"workspace not found": {entW.ErrWorkspaceNotFound, http.StatusNotFound, `{"code":"workspace_not_found","message":"Workspace not found."}`},
+		"user not found":      {entU.ErrUserNotFound, http.StatusNotFound, `{"code":"user_not_found","message":"User not found."}`},
+		"not the owner":       {entW.ErrAccessDenied, http.StatusForbidden, ""},
+		"default workspace":   {entW.ErrDefaultWorkspace, http.StatusConflict, `{"code":"default_workspace","message":"The default workspace cannot be changed."}`},
+		"unexpected":          {errors.New("db down"), http.StatusInternalServerError, ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("Add", mock.Anything, "platform", "owner-1", entU.RoleUser, memberID).Return(tc.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, request(memberID))
+
+			require.Equal(t, tc.code, w.Code)
+			if tc.body != "" {
+				require.JSONEq(t, tc.body, w.Body.String())
+			}
+		})
+	}
+}
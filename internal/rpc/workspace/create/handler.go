@@ -0,0 +1,79 @@
+package create
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	entW "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Create(ctx context.Context, userID, slug, name string) (*entW.Workspace, error)
+	}
+)
+
+type Request struct {
+	Slug string `json:"slug" validate:"required"`
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type Response struct {
+	ID        int    `json:"id"`
+	Slug      string `json:"slug"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+}
+
+func NewResponse(w *entW.Workspace) Response {
+	return Response{
+		ID:        w.ID,
+		Slug:      w.Slug,
+		Name:      w.Name,
+		CreatedAt: w.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req Request
+	if !rpc.ShouldBindJSON(r, w, &req) {
+		return
+	}
+
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	ws, err := h.uc.Create(r.Context(), userID, req.Slug, req.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, entW.ErrInvalidSlug):
+			rpc.WriteValidationError(w, map[string]string{"Slug": "invalid"})
+			return
+		case errors.Is(err, entW.ErrSlugTaken):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("slug_taken"))
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusCreated, NewResponse(ws))
+}
@@ -0,0 +1,64 @@
+package create
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	entW "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/workspace/create/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func request(body string) *http.Request {
+	req := httptest.NewRequest("POST", "/workspaces", strings.NewReader(body))
+	return req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+}
+
+func TestHandler_Create_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mUC.On("Create", mock.Anything, "user-1", "platform", "Platform team").
+		Return(&entW.Workspace{ID: 2, Slug: "platform", Name: "Platform team", CreatedAt: now}, nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request(`{"slug":"platform","name":"Platform team"}`))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.JSONEq(t, `{"id":2,"slug":"platform","name":"Platform team","created_at":"2024-11-21T10:00:00Z"}`, w.Body.String())
+}
+
+func TestHandler_Create_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		code int
+	}{
+		"invalid slug": {entW.ErrInvalidSlug, http.StatusUnprocessableEntity},
+		"slug taken":   {entW.ErrSlugTaken, http.StatusConflict},
+		"unexpected":   {errors.New("db down"), http.StatusInternalServerError},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("Create", mock.Anything, "user-1", "platform", "Platform team").Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, request(`{"slug":"platform","name":"Platform team"}`))
+
+			require.Equal(t, tc.code, w.Code)
+		})
+	}
+}
+
+func TestHandler_Create_MissingName(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewHandler(mocks.NewUseCase(t)).ServeHTTP(w, request(`{"slug":"platform"}`))
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
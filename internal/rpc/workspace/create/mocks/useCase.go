@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	workspace "test-question/internal/entity/workspace"
+)
+
+// UseCase is an autogenerated mock type for the useCase type
+type UseCase struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, userID, slug, name
+func (_m *UseCase) Create(ctx context.Context, userID string, slug string, name string) (*workspace.Workspace, error) {
+	ret := _m.Called(ctx, userID, slug, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *workspace.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (*workspace.Workspace, error)); ok {
+		return rf(ctx, userID, slug, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *workspace.Workspace); ok {
+		r0 = rf(ctx, userID, slug, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workspace.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, userID, slug, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUseCase creates a new instance of UseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UseCase {
+	mock := &UseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+package list
+
+import (
+	"context"
+	"net/http"
+
+	entW "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/workspace/create"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		ListMine(ctx context.Context, userID string) ([]*entW.Membership, error)
+	}
+)
+
+type ResponseItem struct {
+	create.Response
+	Role string `json:"role"`
+}
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userID := rpc_auth.GetUserID(r.Context())
+	if userID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	items, err := h.uc.ListMine(r.Context(), userID)
+	if err != nil {
+		rpc.WriteUnexpectedError(w, err)
+		return
+	}
+
+	resp := make([]ResponseItem, len(items))
+	for i, m := range items {
+		resp[i] = ResponseItem{
+			Response: create.NewResponse(&m.Workspace),
+			Role:     string(m.Role),
+		}
+	}
+
+	rpc.WriteJSON(w, http.StatusOK, resp)
+}
@@ -0,0 +1,47 @@
+package list
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entW "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/workspace/list/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_List(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mUC.On("ListMine", mock.Anything, "user-1").Return([]*entW.Membership{{
+		Workspace: entW.Workspace{ID: 2, Slug: "platform", Name: "Platform team", CreatedAt: now},
+		Role:      entW.RoleOwner,
+	}}, nil)
+
+	req := httptest.NewRequest("GET", "/me/workspaces", nil)
+	req = req.WithContext(rpc_auth.InjectUserID(req.Context(), "user-1"))
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	require.JSONEq(t, `[{
+		"id": 2,
+		"slug": "platform",
+		"name": "Platform team",
+		"created_at": "2024-11-21T10:00:00Z",
+		"role": "owner"
+	}]`, w.Body.String())
+}
+
+func TestHandler_List_Unauthorized(t *testing.T) {
+	w := httptest.NewRecorder()
+	NewHandler(mocks.NewUseCase(t)).ServeHTTP(w, httptest.NewRequest("GET", "/me/workspaces", nil))
+
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+}
@@ -0,0 +1,62 @@
+package remove_member
+
+import (
+	"context"
+	"net/http"
+
+	entU "test-question/internal/entity/user"
+	entW "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/rpc"
+	"test-question/internal/pkg/rpc/rpc_auth"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=useCase --output=mocks --outpkg=mocks --exported
+type (
+	useCase interface {
+		Remove(ctx context.Context, slug, actorID string, actorRole entU.Role, userID string) error
+	}
+)
+
+type Handler struct {
+	uc useCase
+}
+
+func NewHandler(uc useCase) *Handler {
+	return &Handler{uc: uc}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	actorID := rpc_auth.GetUserID(r.Context())
+	if actorID == "" {
+		rpc.WriteUnauthorized(w)
+		return
+	}
+
+	err := h.uc.Remove(r.Context(), r.PathValue("slug"), actorID, rpc_auth.GetUserRole(r.Context()), r.PathValue("user_id"))
+	if err != nil {
+		switch {
+		case errors.Is(err, entW.ErrWorkspaceNotFound):
+			rpc.WriteNotFound(w, "workspace_not_found")
+			return
+		case errors.Is(err, entW.ErrMemberNotFound):
+			rpc.WriteNotFound(w, "member_not_found")
+			return
+		case errors.Is(err, entW.ErrAccessDenied):
+			rpc.WriteForbidden(w)
+			return
+		case errors.Is(err, entW.ErrDefaultWorkspace):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("default_workspace"))
+			return
+		case errors.Is(err, entW.ErrOwner):
+			rpc.WriteJSON(w, http.StatusConflict, rpc.NewBaseHTTPError("owner"))
+			return
+		default:
+			rpc.WriteUnexpectedError(w, err)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,62 @@
+package remove_member
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	entU "test-question/internal/entity/user"
+	entW "test-question/internal/entity/workspace"
+	"test-question/internal/pkg/rpc/rpc_auth"
+	"test-question/internal/rpc/workspace/remove_member/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func request() *http.Request {
+	req := httptest.NewRequest("DELETE", "/workspaces/platform/members/user-2", nil)
+	req.SetPathValue("slug", "platform")
+	req.SetPathValue("user_id", "user-2")
+	ctx := rpc_auth.InjectUserRole(rpc_auth.InjectUserID(req.Context(), "owner-1"), entU.RoleUser)
+	return req.WithContext(ctx)
+}
+
+func TestHandler_Remove_Success(t *testing.T) {
+	mUC := mocks.NewUseCase(t)
+	mUC.On("Remove", mock.Anything, "platform", "owner-1", entU.RoleUser, "user-2").Return(nil)
+
+	w := httptest.NewRecorder()
+	NewHandler(mUC).ServeHTTP(w, request())
+
+	require.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestHandler_Remove_Errors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err  error
+		code int
+		body string
+	}{
+		"workspace not found": {entW.ErrWorkspaceNotFound, http.StatusNotFound, `{"code":"workspace_not_found","message":"Workspace not found."}`},
+		"member not found":    {entW.ErrMemberNotFound, http.StatusNotFound, `{"code":"member_not_found","message":"The user is not a member of the workspace."}`},
+		"not the owner":       {entW.ErrAccessDenied, http.StatusForbidden, ""},
+		"default workspace":   {entW.ErrDefaultWorkspace, http.StatusConflict, `{"code":"default_workspace","message":"The default workspace cannot be changed."}`},
+		"owner":               {entW.ErrOwner, http.StatusConflict, `{"code":"owner","message":"The workspace owner cannot be removed."}`},
+		"unexpected":          {errors.New("db down"), http.StatusInternalServerError, ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mUC := mocks.NewUseCase(t)
+			mUC.On("Remove", mock.Anything, "platform", "owner-1", entU.RoleUser, "user-2").Return(tc.err)
+
+			w := httptest.NewRecorder()
+			NewHandler(mUC).ServeHTTP(w, request())
+
+			require.Equal(t, tc.code, w.Code)
+			if tc.body != "" {
+				require.JSONEq(t, tc.body, w.Body.String())
+			}
+		})
+	}
+}
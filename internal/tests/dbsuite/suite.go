@@ -15,3 +15,19 @@ func (s *DBSuite) ResetTables(tables ...string) {
 		)
 	}
 }
+
+// ResetWorkspaces drops every workspace but the default one, which the
+// migrations create and content falls back to. Content of the dropped
+// workspaces must be reset before.
+func (s *DBSuite) ResetWorkspaces() {
+	s.Require().NoError(s.DB.Exec("DELETE FROM workspaces WHERE slug <> 'default'").Error)
+}
+
+// Workspace creates a workspace and returns its id.
+func (s *DBSuite) Workspace(slug string) int {
+	var id int
+	s.Require().NoError(s.DB.Raw(
+		"INSERT INTO workspaces (slug, name) VALUES (?, ?) RETURNING id", slug, slug,
+	).Scan(&id).Error)
+	return id
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -15,6 +16,7 @@ import (
 
 	"test-question/cmd"
 	"test-question/internal/infra"
+	"test-question/internal/pkg/worker"
 	"test-question/internal/tests/dbsuite"
 
 	tc "github.com/testcontainers/testcontainers-go"
@@ -29,6 +31,9 @@ type E2ESuite struct {
 	Server    *httptest.Server
 	Client    *http.Client
 
+	stopWorkers context.CancelFunc
+	workers     *worker.Group
+
 	// roles
 	currentUser *AuthUser
 	Users       map[string]*AuthUser
@@ -55,6 +60,11 @@ func (s *E2ESuite) IAmAlice() *E2ESuite {
 	return s
 }
 
+func (s *E2ESuite) IAmAdmin() *E2ESuite {
+	s.currentUser = s.Users["admin"]
+	return s
+}
+
 func (s *E2ESuite) IAmNobody() *E2ESuite {
 	s.currentUser = nil
 	return s
@@ -103,23 +113,47 @@ func (s *E2ESuite) SetupSuite() {
 	os.Setenv("LISTEN_PORT", ":9999")                    //nolint:errcheck,gosec
 	os.Setenv("MIGRATION_PATH", resolveMigrationsPath()) //nolint:errcheck,gosec
 
+	// background workers poll fast so e2e tests don't wait for them
+	os.Setenv("OUTBOX_POLL_INTERVAL", "100ms")  //nolint:errcheck,gosec
+	os.Setenv("OUTBOX_BACKOFF_BASE", "100ms")   //nolint:errcheck,gosec
+	os.Setenv("WEBHOOK_POLL_INTERVAL", "100ms") //nolint:errcheck,gosec
+	os.Setenv("WEBHOOK_BACKOFF_BASE", "100ms")  //nolint:errcheck,gosec
+	os.Setenv("VIEW_FLUSH_INTERVAL", "100ms")   //nolint:errcheck,gosec
+	os.Setenv("RANKING_INTERVAL", "100ms")      //nolint:errcheck,gosec
+	os.Setenv("STREAM_MAX_PER_USER", "2")       //nolint:errcheck,gosec
+	// two reporters are enough to hide content with the test users
+	os.Setenv("REPORT_HIDE_THRESHOLD", "2") //nolint:errcheck,gosec
+	// the flows write faster than any real user
+	os.Setenv("RATE_LIMIT_QUESTIONS", "1000/1m") //nolint:errcheck,gosec
+	os.Setenv("RATE_LIMIT_ANSWERS", "1000/1m")   //nolint:errcheck,gosec
+	os.Setenv("RATE_LIMIT_GRAPHQL", "1000/1m")   //nolint:errcheck,gosec
+	// a single upvote is enough to fund a bounty
+	os.Setenv("BOUNTY_MIN_AMOUNT", "5") //nolint:errcheck,gosec
+	// uploads go to a directory removed with the suite
+	os.Setenv("ATTACHMENT_LOCAL_DIR", s.T().TempDir()) //nolint:errcheck,gosec
+
 	// --- init resources
 	res, err := infra.Init(s.Ctx)
 	s.Require().NoError(err)
 	s.Resources = res
 	s.DB = res.DB
 
-	// roles created by migration add_test_users.sql
+	// roles created by migrations add_test_users.sql and webhooks.sql
 	s.Users = map[string]*AuthUser{
 		"bob": {
 			Username: "bob",
 			Password: "bob123",
-			UserID:   "11111111-1111-1111-1111-111111111111",
+			UserID:   "22222222-2222-2222-2222-222222222222",
 		},
 		"alice": {
 			Username: "alice",
 			Password: "alice123",
-			UserID:   "22222222-2222-2222-2222-222222222222",
+			UserID:   "11111111-1111-1111-1111-111111111111",
+		},
+		"admin": {
+			Username: "admin",
+			Password: "admin123",
+			UserID:   "33333333-3333-3333-3333-333333333333",
 		},
 	}
 
@@ -133,18 +167,27 @@ func (s *E2ESuite) SetupSuite() {
 	}
 	s.Server = httptest.NewServer(srv.Handler)
 
+	// --- start background workers
+	var workersCtx context.Context
+	workersCtx, s.stopWorkers = context.WithCancel(s.Ctx)
+	s.workers = cmd.SetupWorkers(res)
+	s.workers.Start(workersCtx)
+
 	s.Client = &http.Client{Timeout: 5 * time.Second}
 }
 
 func (s *E2ESuite) TearDownSuite() {
+	s.Resources.Streams.Close()
 	s.Server.Close()
+	s.stopWorkers()
+	s.workers.Wait()
 }
 
 // ==========================
 //         HTTP HELPERS
 // ==========================
 
-func (s *E2ESuite) request(method, path string, body any) *http.Response {
+func (s *E2ESuite) request(method, path string, body any, header http.Header) *http.Response {
 	var r io.Reader
 	if body != nil {
 		b, _ := json.Marshal(body) //nolint:errchkjson
@@ -155,7 +198,14 @@ func (s *E2ESuite) request(method, path string, body any) *http.Response {
 	s.Require().NoError(err)
 
 	req.Header.Set("Content-Type", "application/json")
+	for k, v := range header {
+		req.Header[k] = v
+	}
 
+	return s.do(req)
+}
+
+func (s *E2ESuite) do(req *http.Request) *http.Response {
 	// Apply BasicAuth based on role
 	if s.currentUser != nil {
 		req.SetBasicAuth(s.currentUser.Username, s.currentUser.Password)
@@ -168,15 +218,80 @@ func (s *E2ESuite) request(method, path string, body any) *http.Response {
 }
 
 func (s *E2ESuite) GET(path string) *http.Response {
-	return s.request("GET", path, nil)
+	return s.request("GET", path, nil, nil)
+}
+
+// GETIfNoneMatch sends a conditional GET for a previously read ETag.
+func (s *E2ESuite) GETIfNoneMatch(path, etag string) *http.Response {
+	return s.request("GET", path, nil, http.Header{"If-None-Match": {etag}})
+}
+
+// GETInWorkspace sends a GET naming the workspace in the X-Workspace header.
+func (s *E2ESuite) GETInWorkspace(path, slug string) *http.Response {
+	return s.request("GET", path, nil, http.Header{"X-Workspace": {slug}})
+}
+
+// GETInLanguage sends a GET with an Accept-Language header.
+func (s *E2ESuite) GETInLanguage(path, lang string) *http.Response {
+	return s.request("GET", path, nil, http.Header{"Accept-Language": {lang}})
+}
+
+// POSTInLanguage sends a POST with an Accept-Language header.
+func (s *E2ESuite) POSTInLanguage(path, lang string, body any) *http.Response {
+	return s.request("POST", path, body, http.Header{"Accept-Language": {lang}})
 }
 
 func (s *E2ESuite) POST(path string, body any) *http.Response {
-	return s.request("POST", path, body)
+	return s.request("POST", path, body, nil)
+}
+
+// POSTWithKey sends a POST carrying an Idempotency-Key header.
+func (s *E2ESuite) POSTWithKey(path, key string, body any) *http.Response {
+	return s.request("POST", path, body, http.Header{"Idempotency-Key": {key}})
+}
+
+// Upload posts a file to POST /attachments as multipart/form-data.
+func (s *E2ESuite) Upload(filename string, data []byte) *http.Response {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("file", filename)
+	s.Require().NoError(err)
+	_, err = part.Write(data)
+	s.Require().NoError(err)
+	s.Require().NoError(mw.Close())
+
+	req, err := http.NewRequest(http.MethodPost, s.Server.URL+"/attachments", &body) //nolint:noctx
+	s.Require().NoError(err)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	return s.do(req)
+}
+
+func (s *E2ESuite) PUT(path string, body any) *http.Response {
+	return s.request("PUT", path, body, nil)
 }
 
 func (s *E2ESuite) DELETE(path string) *http.Response {
-	return s.request("DELETE", path, nil)
+	return s.request("DELETE", path, nil, nil)
+}
+
+// OpenStream starts a long-lived GET, e.g. an SSE stream, without the
+// client timeout. The caller closes the body to end it.
+func (s *E2ESuite) OpenStream(path, lastEventID string) *http.Response {
+	req, err := http.NewRequest("GET", s.Server.URL+path, nil) //nolint:noctx
+	s.Require().NoError(err)
+
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	if s.currentUser != nil {
+		req.SetBasicAuth(s.currentUser.Username, s.currentUser.Password)
+	}
+
+	resp, err := (&http.Client{}).Do(req)
+	s.Require().NoError(err)
+
+	return resp
 }
 
 // ==========================
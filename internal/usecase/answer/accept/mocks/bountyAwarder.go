@@ -0,0 +1,49 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	answer "test-question/internal/entity/answer"
+
+	mock "github.com/stretchr/testify/mock"
+
+	question "test-question/internal/entity/question"
+)
+
+// BountyAwarder is an autogenerated mock type for the bountyAwarder type
+type BountyAwarder struct {
+	mock.Mock
+}
+
+// AwardAccepted provides a mock function with given fields: ctx, q, a
+func (_m *BountyAwarder) AwardAccepted(ctx context.Context, q *question.Question, a *answer.Answer) error {
+	ret := _m.Called(ctx, q, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AwardAccepted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *question.Question, *answer.Answer) error); ok {
+		r0 = rf(ctx, q, a)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewBountyAwarder creates a new instance of BountyAwarder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBountyAwarder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BountyAwarder {
+	mock := &BountyAwarder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
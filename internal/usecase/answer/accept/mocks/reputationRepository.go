@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	reputation "test-question/internal/entity/reputation"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReputationRepository is an autogenerated mock type for the reputationRepository type
+type ReputationRepository struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: ctx, e
+func (_m *ReputationRepository) Add(ctx context.Context, e *reputation.Entry) (*reputation.Entry, error) {
+	ret := _m.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 *reputation.Entry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *reputation.Entry) (*reputation.Entry, error)); ok {
+		return rf(ctx, e)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *reputation.Entry) *reputation.Entry); ok {
+		r0 = rf(ctx, e)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*reputation.Entry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *reputation.Entry) error); ok {
+		r1 = rf(ctx, e)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reverse provides a mock function with given fields: ctx, f
+func (_m *ReputationRepository) Reverse(ctx context.Context, f reputation.ReverseFilter) error {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reverse")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, reputation.ReverseFilter) error); ok {
+		r0 = rf(ctx, f)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewReputationRepository creates a new instance of ReputationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReputationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReputationRepository {
+	mock := &ReputationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,160 @@
+package accept
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=bountyAwarder --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	answerRepository interface {
+		GetByID(ctx context.Context, id int) (*entA.Answer, error)
+	}
+
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+		SetAcceptedAnswer(ctx context.Context, questionID, answerID int) error
+	}
+
+	reputationRepository interface {
+		Add(ctx context.Context, e *entR.Entry) (*entR.Entry, error)
+		Reverse(ctx context.Context, f entR.ReverseFilter) error
+	}
+
+	bountyAwarder interface {
+		AwardAccepted(ctx context.Context, q *entQ.Question, a *entA.Answer) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	answers    answerRepository
+	questions  questionRepository
+	reputation reputationRepository
+	bounties   bountyAwarder
+	uow        unitOfWork
+	timer      timer
+	logger     logger
+}
+
+func NewUseCase(
+	answers answerRepository,
+	questions questionRepository,
+	reputation reputationRepository,
+	bounties bountyAwarder,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		answers:    answers,
+		questions:  questions,
+		reputation: reputation,
+		bounties:   bounties,
+		uow:        uow,
+		timer:      timer,
+		logger:     logger,
+	}
+}
+
+// AcceptAnswer marks the answer as accepted for its question. Only the question
+// owner may accept; accepting another answer moves the points to its author.
+// An open bounty of the question is paid to the accepted answer's author.
+func (uc *UseCase) AcceptAnswer(
+	ctx context.Context,
+	answerID int,
+	userID string,
+) error {
+	a, err := uc.answers.GetByID(ctx, answerID)
+	if err != nil {
+		if errors.Is(err, entA.ErrAnswerNotFound) {
+			return err
+		}
+		return fmt.Errorf("get answer: %w", err)
+	}
+
+	q, err := uc.questions.GetByID(ctx, a.QuestionID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return entA.ErrRequestedQuestionNotFound
+		}
+		return fmt.Errorf("get question: %w", err)
+	}
+
+	if q.UserID != userID {
+		return entA.ErrAccessDenied
+	}
+
+	if q.AcceptedAnswerID == answerID {
+		return nil
+	}
+
+	return uc.uow.Do(ctx, func(ctx context.Context) error {
+		if q.AcceptedAnswerID != 0 {
+			err = uc.reputation.Reverse(ctx, entR.ReverseFilter{
+				SubjectType: entR.SubjectAnswer,
+				SubjectID:   q.AcceptedAnswerID,
+				Reasons:     []entR.Reason{entR.ReasonAnswerAccepted},
+			})
+			if err != nil {
+				return fmt.Errorf("reverse previous acceptance: %w", err)
+			}
+		}
+
+		if err = uc.questions.SetAcceptedAnswer(ctx, q.ID, answerID); err != nil {
+			return fmt.Errorf("set accepted answer: %w", err)
+		}
+
+		if a.UserID != userID {
+			_, err = uc.reputation.Add(ctx, &entR.Entry{
+				UserID:      a.UserID,
+				ActorID:     userID,
+				Reason:      entR.ReasonAnswerAccepted,
+				Delta:       entR.PointsFor(entR.ReasonAnswerAccepted),
+				SubjectType: entR.SubjectAnswer,
+				SubjectID:   answerID,
+				CreatedAt:   uc.timer.Now(),
+			})
+			if err != nil {
+				return fmt.Errorf("add reputation: %w", err)
+			}
+		}
+
+		if err = uc.bounties.AwardAccepted(ctx, q, a); err != nil {
+			return fmt.Errorf("award bounty: %w", err)
+		}
+
+		uc.logger.DebugContext(ctx, "answer accepted",
+			"answer_id", answerID,
+			"question_id", q.ID,
+			"user_id", userID,
+		)
+
+		return nil
+	})
+}
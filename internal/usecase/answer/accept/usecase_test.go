@@ -0,0 +1,169 @@
+package accept_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+	uc "test-question/internal/usecase/answer/accept"
+	"test-question/internal/usecase/answer/accept/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testMocks struct {
+	answers    *mocks.AnswerRepository
+	questions  *mocks.QuestionRepository
+	reputation *mocks.ReputationRepository
+	bounties   *mocks.BountyAwarder
+	uow        *mocks.UnitOfWork
+	timer      *mocks.Timer
+	logger     *mocks.Logger
+}
+
+func newMocks(t *testing.T) *testMocks { //nolint:thelper
+	m := &testMocks{
+		answers:    mocks.NewAnswerRepository(t),
+		questions:  mocks.NewQuestionRepository(t),
+		reputation: mocks.NewReputationRepository(t),
+		bounties:   mocks.NewBountyAwarder(t),
+		uow:        mocks.NewUnitOfWork(t),
+		timer:      mocks.NewTimer(t),
+		logger:     mocks.NewLogger(t),
+	}
+
+	m.uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+
+	return m
+}
+
+func (m *testMocks) useCase() *uc.UseCase {
+	return uc.NewUseCase(m.answers, m.questions, m.reputation, m.bounties, m.uow, m.timer, m.logger)
+}
+
+func TestAcceptAnswer_Success(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "author"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, UserID: "owner", AcceptedAnswerID: 4}, nil)
+	m.reputation.
+		On("Reverse", ctx, entR.ReverseFilter{
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   4,
+			Reasons:     []entR.Reason{entR.ReasonAnswerAccepted},
+		}).
+		Return(nil)
+	m.questions.On("SetAcceptedAnswer", ctx, 1, 5).Return(nil)
+	m.timer.On("Now").Return(now)
+	m.reputation.
+		On("Add", ctx, &entR.Entry{
+			UserID:      "author",
+			ActorID:     "owner",
+			Reason:      entR.ReasonAnswerAccepted,
+			Delta:       15,
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   5,
+			CreatedAt:   now,
+		}).
+		Return(&entR.Entry{ID: 1}, nil)
+	m.bounties.
+		On("AwardAccepted", ctx,
+			&entQ.Question{ID: 1, UserID: "owner", AcceptedAnswerID: 4},
+			&entA.Answer{ID: 5, QuestionID: 1, UserID: "author"},
+		).
+		Return(nil)
+	m.logger.
+		On("DebugContext", ctx, "answer accepted",
+			"answer_id", 5,
+			"question_id", 1,
+			"user_id", "owner",
+		).
+		Return()
+
+	err := m.useCase().AcceptAnswer(ctx, 5, "owner")
+	require.NoError(t, err)
+}
+
+func TestAcceptAnswer_OwnAnswerEarnsNothing(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "owner"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, UserID: "owner"}, nil)
+	m.questions.On("SetAcceptedAnswer", ctx, 1, 5).Return(nil)
+	m.bounties.On("AwardAccepted", ctx, mock.Anything, mock.Anything).Return(nil)
+	m.logger.On("DebugContext", ctx, "answer accepted", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := m.useCase().AcceptAnswer(ctx, 5, "owner")
+	require.NoError(t, err)
+}
+
+func TestAcceptAnswer_AlreadyAccepted(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "author"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, UserID: "owner", AcceptedAnswerID: 5}, nil)
+
+	err := m.useCase().AcceptAnswer(ctx, 5, "owner")
+	require.NoError(t, err)
+}
+
+func TestAcceptAnswer_AccessDenied(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "author"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, UserID: "owner"}, nil)
+
+	err := m.useCase().AcceptAnswer(ctx, 5, "stranger")
+	require.ErrorIs(t, err, entA.ErrAccessDenied)
+}
+
+func TestAcceptAnswer_NotFound(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(nil, entA.ErrAnswerNotFound)
+
+	err := m.useCase().AcceptAnswer(ctx, 5, "owner")
+	require.ErrorIs(t, err, entA.ErrAnswerNotFound)
+}
+
+func TestAcceptAnswer_SetError(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "author"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, UserID: "owner"}, nil)
+	m.questions.On("SetAcceptedAnswer", ctx, 1, 5).Return(errors.New("db down"))
+
+	err := m.useCase().AcceptAnswer(ctx, 5, "owner")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "set accepted answer")
+}
+
+func TestAcceptAnswer_BountyError(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "owner"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, UserID: "owner"}, nil)
+	m.questions.On("SetAcceptedAnswer", ctx, 1, 5).Return(nil)
+	m.bounties.On("AwardAccepted", ctx, mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	err := m.useCase().AcceptAnswer(ctx, 5, "owner")
+	require.ErrorContains(t, err, "award bounty")
+}
@@ -0,0 +1,42 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// ContentPolicy is an autogenerated mock type for the contentPolicy type
+type ContentPolicy struct {
+	mock.Mock
+}
+
+// Check provides a mock function with given fields: field, text
+func (_m *ContentPolicy) Check(field string, text string) error {
+	ret := _m.Called(field, text)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Check")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(field, text)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewContentPolicy creates a new instance of ContentPolicy. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewContentPolicy(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ContentPolicy {
+	mock := &ContentPolicy{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
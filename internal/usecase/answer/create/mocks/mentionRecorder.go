@@ -0,0 +1,62 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mention "test-question/internal/entity/mention"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MentionRecorder is an autogenerated mock type for the mentionRecorder type
+type MentionRecorder struct {
+	mock.Mock
+}
+
+// Record provides a mock function with given fields: ctx, authorID, questionID, answerID, text, at
+func (_m *MentionRecorder) Record(ctx context.Context, authorID string, questionID int, answerID int, text string, at time.Time) ([]*mention.Mention, error) {
+	ret := _m.Called(ctx, authorID, questionID, answerID, text, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 []*mention.Mention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string, time.Time) ([]*mention.Mention, error)); ok {
+		return rf(ctx, authorID, questionID, answerID, text, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int, string, time.Time) []*mention.Mention); ok {
+		r0 = rf(ctx, authorID, questionID, answerID, text, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*mention.Mention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int, string, time.Time) error); ok {
+		r1 = rf(ctx, authorID, questionID, answerID, text, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMentionRecorder creates a new instance of MentionRecorder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMentionRecorder(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MentionRecorder {
+	mock := &MentionRecorder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -6,21 +6,42 @@ import (
 	"time"
 
 	entA "test-question/internal/entity/answer"
+	entM "test-question/internal/entity/mention"
+	entO "test-question/internal/entity/outbox"
 	entQ "test-question/internal/entity/question"
 
 	"github.com/pkg/errors"
 )
 
 //go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=attachmentRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=mentionRecorder --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=outboxRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=contentPolicy --output=mocks --outpkg=mocks --exported
 
 type (
 	answerRepository interface {
 		Create(ctx context.Context, a *entA.Answer) (*entA.Answer, error)
 	}
 
+	attachmentRepository interface {
+		AttachToAnswer(ctx context.Context, userID string, answerID int, ids []int) error
+	}
+
+	mentionRecorder interface {
+		Record(
+			ctx context.Context,
+			authorID string,
+			questionID, answerID int,
+			text string,
+			at time.Time,
+		) ([]*entM.Mention, error)
+	}
+
 	questionRepository interface {
 		GetByID(ctx context.Context, id int) (*entQ.Question, error)
 	}
@@ -32,36 +53,72 @@ type (
 	timer interface {
 		Now() time.Time
 	}
+
+	outboxRepository interface {
+		Record(ctx context.Context, events ...entO.Event) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	contentPolicy interface {
+		Check(field, text string) error
+	}
 )
 
 type UseCase struct {
-	repo      answerRepository
-	questions questionRepository
-	timer     timer
-	logger    logger
+	repo        answerRepository
+	questions   questionRepository
+	attachments attachmentRepository
+	mentions    mentionRecorder
+	outbox      outboxRepository
+	uow         unitOfWork
+	policy      contentPolicy
+	timer       timer
+	logger      logger
 }
 
 func NewUseCase(
 	answers answerRepository,
 	questions questionRepository,
+	attachments attachmentRepository,
+	mentions mentionRecorder,
+	outbox outboxRepository,
+	uow unitOfWork,
+	policy contentPolicy,
 	timer timer,
 	logger logger,
 ) *UseCase {
 	return &UseCase{
-		repo:      answers,
-		questions: questions,
-		timer:     timer,
-		logger:    logger,
+		repo:        answers,
+		questions:   questions,
+		attachments: attachments,
+		mentions:    mentions,
+		outbox:      outbox,
+		uow:         uow,
+		policy:      policy,
+		timer:       timer,
+		logger:      logger,
 	}
 }
 
+// CreateAnswer answers an open question. The user's uploads given by
+// attachmentIDs are attached to the answer; if any is unavailable nothing
+// is created. Users mentioned as @username are linked to the answer and
+// notified once it is created.
 func (uc *UseCase) CreateAnswer(
 	ctx context.Context,
 	questionID int,
 	userID string,
 	text string,
+	attachmentIDs []int,
 ) (*entA.Answer, error) {
-	_, err := uc.questions.GetByID(ctx, questionID)
+	if err := uc.policy.Check("Text", text); err != nil {
+		return nil, err
+	}
+
+	q, err := uc.questions.GetByID(ctx, questionID)
 	if err != nil {
 		if errors.Is(err, entQ.ErrQuestionNotFound) {
 			return nil, entA.ErrRequestedQuestionNotFound
@@ -69,6 +126,13 @@ func (uc *UseCase) CreateAnswer(
 		return nil, fmt.Errorf("check question exists: %w", err)
 	}
 
+	switch q.Status {
+	case entQ.StatusClosed:
+		return nil, entQ.ErrQuestionClosed
+	case entQ.StatusLocked:
+		return nil, entQ.ErrQuestionLocked
+	}
+
 	a := &entA.Answer{
 		QuestionID: questionID,
 		UserID:     userID,
@@ -76,9 +140,39 @@ func (uc *UseCase) CreateAnswer(
 		CreatedAt:  uc.timer.Now(),
 	}
 
-	out, err := uc.repo.Create(ctx, a)
+	var out *entA.Answer
+
+	err = uc.uow.Do(ctx, func(ctx context.Context) error {
+		out, err = uc.repo.Create(ctx, a)
+		if err != nil {
+			return fmt.Errorf("create answer: %w", err)
+		}
+
+		if len(attachmentIDs) > 0 {
+			err = uc.attachments.AttachToAnswer(ctx, userID, out.ID, attachmentIDs)
+			if err != nil {
+				return fmt.Errorf("attach files: %w", err)
+			}
+		}
+
+		out.Mentions, err = uc.mentions.Record(ctx, userID, questionID, out.ID, text, out.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("record mentions: %w", err)
+		}
+
+		err = uc.outbox.Record(ctx, entO.AnswerCreated{
+			Question:   *q,
+			Answer:     *out,
+			OccurredAt: out.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("record answer created: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create answer: %w", err)
+		return nil, err
 	}
 
 	uc.logger.DebugContext(ctx, "answer created",
@@ -7,13 +7,45 @@ import (
 	"time"
 
 	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
+	entO "test-question/internal/entity/outbox"
+	entP "test-question/internal/entity/policy"
 	entQ "test-question/internal/entity/question"
 	uc "test-question/internal/usecase/answer/create"
 	"test-question/internal/usecase/answer/create/mocks"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+func newUnitOfWork(t *testing.T) *mocks.UnitOfWork { //nolint:thelper
+	mUow := mocks.NewUnitOfWork(t)
+	mUow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+	return mUow
+}
+
+func allowedContent(t *testing.T) *mocks.ContentPolicy { //nolint:thelper
+	mPolicy := mocks.NewContentPolicy(t)
+	mPolicy.On("Check", "Text", mock.Anything).Return(nil)
+	return mPolicy
+}
+
+// noMentions stands in for texts that mention nobody.
+func noMentions(t *testing.T) *mocks.MentionRecorder { //nolint:thelper
+	mMentions := mocks.NewMentionRecorder(t)
+	mMentions.
+		On("Record", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Maybe()
+	return mMentions
+}
+
 func TestCreateAnswer_Success(t *testing.T) {
 	ctx := context.Background()
 
@@ -23,10 +55,14 @@ func TestCreateAnswer_Success(t *testing.T) {
 	mQuestions := mocks.NewQuestionRepository(t)
 	mTimer := mocks.NewTimer(t)
 	mLogger := mocks.NewLogger(t)
+	mOutbox := mocks.NewOutboxRepository(t)
+	mUow := newUnitOfWork(t)
+
+	question := &entQ.Question{ID: 10, UserID: "owner"}
 
 	mQuestions.
 		On("GetByID", ctx, 10).
-		Return(&entQ.Question{ID: 10}, nil)
+		Return(question, nil)
 
 	mTimer.
 		On("Now").
@@ -39,15 +75,25 @@ func TestCreateAnswer_Success(t *testing.T) {
 		CreatedAt:  now,
 	}
 
+	created := &entA.Answer{
+		ID:         55,
+		QuestionID: 10,
+		UserID:     "u1",
+		Text:       "hello",
+		CreatedAt:  now,
+	}
+
 	mAnswers.
 		On("Create", ctx, expectedInput).
-		Return(&entA.Answer{
-			ID:         55,
-			QuestionID: 10,
-			UserID:     "u1",
-			Text:       "hello",
-			CreatedAt:  now,
-		}, nil)
+		Return(created, nil)
+
+	mOutbox.
+		On("Record", ctx, entO.AnswerCreated{
+			Question:   *question,
+			Answer:     *created,
+			OccurredAt: now,
+		}).
+		Return(nil)
 
 	mLogger.
 		On("DebugContext",
@@ -59,9 +105,9 @@ func TestCreateAnswer_Success(t *testing.T) {
 		).
 		Return()
 
-	ucase := uc.NewUseCase(mAnswers, mQuestions, mTimer, mLogger)
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mocks.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, allowedContent(t), mTimer, mLogger)
 
-	out, err := ucase.CreateAnswer(ctx, 10, "u1", "hello")
+	out, err := ucase.CreateAnswer(ctx, 10, "u1", "hello", nil)
 	require.NoError(t, err)
 	require.NotNil(t, out)
 	require.Equal(t, 55, out.ID)
@@ -78,19 +124,56 @@ func TestCreateAnswer_QuestionNotFound(t *testing.T) {
 	mQuestions := mocks.NewQuestionRepository(t)
 	mTimer := mocks.NewTimer(t)
 	mLogger := mocks.NewLogger(t)
+	mOutbox := mocks.NewOutboxRepository(t)
+	mUow := newUnitOfWork(t)
 
 	mQuestions.
 		On("GetByID", ctx, 99).
 		Return(nil, entQ.ErrQuestionNotFound)
 
-	ucase := uc.NewUseCase(mAnswers, mQuestions, mTimer, mLogger)
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mocks.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, allowedContent(t), mTimer, mLogger)
 
-	out, err := ucase.CreateAnswer(ctx, 99, "u1", "aaa")
+	out, err := ucase.CreateAnswer(ctx, 99, "u1", "aaa", nil)
 
 	require.Nil(t, out)
 	require.ErrorIs(t, err, entA.ErrRequestedQuestionNotFound)
 }
 
+func TestCreateAnswer_QuestionNotOpen(t *testing.T) {
+	tests := []struct {
+		status entQ.Status
+		err    error
+	}{
+		{status: entQ.StatusClosed, err: entQ.ErrQuestionClosed},
+		{status: entQ.StatusLocked, err: entQ.ErrQuestionLocked},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			ctx := context.Background()
+
+			mAnswers := mocks.NewAnswerRepository(t)
+			mQuestions := mocks.NewQuestionRepository(t)
+			mTimer := mocks.NewTimer(t)
+			mLogger := mocks.NewLogger(t)
+			mOutbox := mocks.NewOutboxRepository(t)
+			mUow := newUnitOfWork(t)
+
+			mQuestions.
+				On("GetByID", ctx, 10).
+				Return(&entQ.Question{ID: 10, Status: tt.status}, nil)
+
+			ucase := uc.NewUseCase(mAnswers, mQuestions, mocks.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, allowedContent(t), mTimer, mLogger)
+
+			out, err := ucase.CreateAnswer(ctx, 10, "u1", "aaa", nil)
+
+			require.Nil(t, out)
+			require.ErrorIs(t, err, tt.err)
+			mAnswers.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestCreateAnswer_QuestionRepoError(t *testing.T) {
 	ctx := context.Background()
 
@@ -98,14 +181,16 @@ func TestCreateAnswer_QuestionRepoError(t *testing.T) {
 	mQuestions := mocks.NewQuestionRepository(t)
 	mTimer := mocks.NewTimer(t)
 	mLogger := mocks.NewLogger(t)
+	mOutbox := mocks.NewOutboxRepository(t)
+	mUow := newUnitOfWork(t)
 
 	mQuestions.
 		On("GetByID", ctx, 5).
 		Return(nil, errors.New("db down"))
 
-	ucase := uc.NewUseCase(mAnswers, mQuestions, mTimer, mLogger)
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mocks.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, allowedContent(t), mTimer, mLogger)
 
-	out, err := ucase.CreateAnswer(ctx, 5, "u1", "aaa")
+	out, err := ucase.CreateAnswer(ctx, 5, "u1", "aaa", nil)
 
 	require.Nil(t, out)
 	require.Error(t, err)
@@ -121,6 +206,8 @@ func TestCreateAnswer_CreateError(t *testing.T) {
 	mQuestions := mocks.NewQuestionRepository(t)
 	mTimer := mocks.NewTimer(t)
 	mLogger := mocks.NewLogger(t)
+	mOutbox := mocks.NewOutboxRepository(t)
+	mUow := newUnitOfWork(t)
 
 	mQuestions.
 		On("GetByID", ctx, 7).
@@ -141,11 +228,174 @@ func TestCreateAnswer_CreateError(t *testing.T) {
 		On("Create", ctx, expectedInput).
 		Return(nil, errors.New("insert failed"))
 
-	ucase := uc.NewUseCase(mAnswers, mQuestions, mTimer, mLogger)
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mocks.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, allowedContent(t), mTimer, mLogger)
 
-	out, err := ucase.CreateAnswer(ctx, 7, "u1", "xxx")
+	out, err := ucase.CreateAnswer(ctx, 7, "u1", "xxx", nil)
 
 	require.Nil(t, out)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "create answer")
 }
+
+func TestCreateAnswer_RecordError(t *testing.T) {
+	ctx := context.Background()
+
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+	mOutbox := mocks.NewOutboxRepository(t)
+	mUow := newUnitOfWork(t)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7}, nil)
+
+	mTimer.
+		On("Now").
+		Return(time.Now())
+
+	mAnswers.
+		On("Create", ctx, mock.Anything).
+		Return(&entA.Answer{ID: 1, QuestionID: 7}, nil)
+
+	mOutbox.
+		On("Record", ctx, mock.Anything).
+		Return(errors.New("insert failed"))
+
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mocks.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, allowedContent(t), mTimer, mLogger)
+
+	out, err := ucase.CreateAnswer(ctx, 7, "u1", "xxx", nil)
+
+	require.Nil(t, out)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "record answer created")
+}
+
+func TestCreateAnswer_PolicyViolation(t *testing.T) {
+	ctx := context.Background()
+
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mPolicy := mocks.NewContentPolicy(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+	mOutbox := mocks.NewOutboxRepository(t)
+	mUow := newUnitOfWork(t)
+
+	mPolicy.
+		On("Check", "Text", "see http://spam.example").
+		Return(entP.Violations{"Text": {Rule: entP.RuleBlockedDomain}})
+
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mocks.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, mPolicy, mTimer, mLogger)
+
+	out, err := ucase.CreateAnswer(ctx, 1, "u1", "see http://spam.example", nil)
+	require.Nil(t, out)
+	require.ErrorIs(t, err, entP.ErrViolation)
+	mQuestions.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestCreateAnswer_AttachesFiles(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+	mOutbox := mocks.NewOutboxRepository(t)
+
+	mQuestions.On("GetByID", ctx, 10).Return(&entQ.Question{ID: 10}, nil)
+	mTimer.On("Now").Return(now)
+	mAnswers.On("Create", ctx, mock.Anything).Return(&entA.Answer{ID: 55, QuestionID: 10, UserID: "u1"}, nil)
+	mAttachments.On("AttachToAnswer", ctx, "u1", 55, []int{7}).Return(nil)
+	mOutbox.On("Record", ctx, mock.Anything).Return(nil)
+	mLogger.On("DebugContext", ctx, "answer created", "answer_id", 55, "question_id", 10, "user_id", "u1").Return()
+
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mAttachments, noMentions(t), mOutbox, newUnitOfWork(t), allowedContent(t), mTimer, mLogger)
+
+	out, err := ucase.CreateAnswer(ctx, 10, "u1", "hello", []int{7})
+	require.NoError(t, err)
+	require.Equal(t, 55, out.ID)
+}
+
+func TestCreateAnswer_AttachmentsUnavailable(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mTimer := mocks.NewTimer(t)
+
+	mQuestions.On("GetByID", ctx, 10).Return(&entQ.Question{ID: 10}, nil)
+	mTimer.On("Now").Return(now)
+	mAnswers.On("Create", ctx, mock.Anything).Return(&entA.Answer{ID: 55}, nil)
+	mAttachments.On("AttachToAnswer", ctx, "u1", 55, []int{7}).Return(entAt.ErrUnavailable)
+
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mAttachments, noMentions(t), mocks.NewOutboxRepository(t), newUnitOfWork(t),
+		allowedContent(t), mTimer, mocks.NewLogger(t))
+
+	out, err := ucase.CreateAnswer(ctx, 10, "u1", "hello", []int{7})
+	require.Nil(t, out)
+	require.ErrorIs(t, err, entAt.ErrUnavailable)
+}
+
+func TestCreateAnswer_RecordsMentions(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+	q := &entQ.Question{ID: 10}
+	mentions := []*entM.Mention{{ID: 1, UserID: "u2", Username: "alice", AuthorID: "u1", QuestionID: 10, AnswerID: 55}}
+
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mMentions := mocks.NewMentionRecorder(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+	mOutbox := mocks.NewOutboxRepository(t)
+
+	mQuestions.On("GetByID", ctx, 10).Return(q, nil)
+	mTimer.On("Now").Return(now)
+	mAnswers.On("Create", ctx, mock.Anything).
+		Return(&entA.Answer{ID: 55, QuestionID: 10, UserID: "u1", Text: "ask @alice", CreatedAt: now}, nil)
+	mMentions.On("Record", ctx, "u1", 10, 55, "ask @alice", now).Return(mentions, nil)
+	mOutbox.On("Record", ctx, entO.AnswerCreated{
+		Question: *q,
+		Answer: entA.Answer{
+			ID: 55, QuestionID: 10, UserID: "u1", Text: "ask @alice", CreatedAt: now, Mentions: mentions,
+		},
+		OccurredAt: now,
+	}).Return(nil)
+	mLogger.On("DebugContext", ctx, "answer created", "answer_id", 55, "question_id", 10, "user_id", "u1").Return()
+
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mocks.NewAttachmentRepository(t), mMentions, mOutbox,
+		newUnitOfWork(t), allowedContent(t), mTimer, mLogger)
+
+	out, err := ucase.CreateAnswer(ctx, 10, "u1", "ask @alice", nil)
+	require.NoError(t, err)
+	require.Equal(t, mentions, out.Mentions)
+}
+
+func TestCreateAnswer_MentionsError(t *testing.T) {
+	ctx := context.Background()
+
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mMentions := mocks.NewMentionRecorder(t)
+	mTimer := mocks.NewTimer(t)
+
+	mQuestions.On("GetByID", ctx, 10).Return(&entQ.Question{ID: 10}, nil)
+	mTimer.On("Now").Return(time.Now())
+	mAnswers.On("Create", ctx, mock.Anything).Return(&entA.Answer{ID: 55}, nil)
+	mMentions.On("Record", ctx, "u1", 10, 55, "ask @alice", mock.Anything).Return(nil, errors.New("db down"))
+
+	ucase := uc.NewUseCase(mAnswers, mQuestions, mocks.NewAttachmentRepository(t), mMentions,
+		mocks.NewOutboxRepository(t), newUnitOfWork(t), allowedContent(t), mTimer, mocks.NewLogger(t))
+
+	_, err := ucase.CreateAnswer(ctx, 10, "u1", "ask @alice", nil)
+	require.ErrorContains(t, err, "record mentions")
+}
@@ -62,6 +62,24 @@ func (_m *AnswerRepository) GetByID(ctx context.Context, id int) (*answer.Answer
 	return r0, r1
 }
 
+// Remove provides a mock function with given fields: ctx, id
+func (_m *AnswerRepository) Remove(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewAnswerRepository creates a new instance of AnswerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAnswerRepository(t interface {
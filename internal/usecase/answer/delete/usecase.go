@@ -3,19 +3,43 @@ package delete_answer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	entA "test-question/internal/entity/answer"
+	entO "test-question/internal/entity/outbox"
+	entR "test-question/internal/entity/reputation"
 
 	"github.com/pkg/errors"
 )
 
 //go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=outboxRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
 
 type (
 	answerRepository interface {
 		GetByID(ctx context.Context, id int) (*entA.Answer, error)
 		Delete(ctx context.Context, id int) error
+		Remove(ctx context.Context, id int) error
+	}
+
+	reputationRepository interface {
+		Reverse(ctx context.Context, f entR.ReverseFilter) error
+	}
+
+	outboxRepository interface {
+		Record(ctx context.Context, events ...entO.Event) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
 	}
 
 	logger interface {
@@ -25,15 +49,27 @@ type (
 
 type UseCase struct {
 	answerRepo answerRepository
+	reputation reputationRepository
+	outbox     outboxRepository
+	uow        unitOfWork
+	timer      timer
 	logger     logger
 }
 
 func NewUseCase(
 	answerRepo answerRepository,
+	reputation reputationRepository,
+	outbox outboxRepository,
+	uow unitOfWork,
+	timer timer,
 	logger logger,
 ) *UseCase {
 	return &UseCase{
 		answerRepo: answerRepo,
+		reputation: reputation,
+		outbox:     outbox,
+		uow:        uow,
+		timer:      timer,
 		logger:     logger,
 	}
 }
@@ -55,14 +91,62 @@ func (uc *UseCase) DeleteAnswer(
 		return entA.ErrAccessDenied
 	}
 
-	if err = uc.answerRepo.Delete(ctx, answerID); err != nil {
-		return fmt.Errorf("delete answer: %w", err)
+	return uc.remove(ctx, a, userID, uc.answerRepo.Delete)
+}
+
+// RemoveAnswer deletes the answer on a moderator's decision; the ownership
+// check does not apply and the author cannot restore it.
+func (uc *UseCase) RemoveAnswer(
+	ctx context.Context,
+	answerID int,
+	moderatorID string,
+) error {
+	a, err := uc.answerRepo.GetByID(ctx, answerID)
+	if err != nil {
+		if errors.Is(err, entA.ErrAnswerNotFound) {
+			return err
+		}
+		return fmt.Errorf("get answer: %w", err)
 	}
 
-	uc.logger.DebugContext(ctx, "answer deleted",
-		"answer_id", answerID,
-		"user_id", userID,
-	)
+	return uc.remove(ctx, a, moderatorID, uc.answerRepo.Remove)
+}
+
+// remove trashes the answer with trash, the repository's Delete or Remove,
+// and reverses the reputation it earned.
+func (uc *UseCase) remove(
+	ctx context.Context,
+	a *entA.Answer,
+	userID string,
+	trash func(ctx context.Context, id int) error,
+) error {
+	answerID := a.ID
+
+	return uc.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+
+		if err = trash(ctx, answerID); err != nil {
+			return fmt.Errorf("delete answer: %w", err)
+		}
+
+		err = uc.reputation.Reverse(ctx, entR.ReverseFilter{
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   answerID,
+		})
+		if err != nil {
+			return fmt.Errorf("reverse reputation: %w", err)
+		}
+
+		err = uc.outbox.Record(ctx, entO.AnswerDeleted{Answer: *a, OccurredAt: uc.timer.Now()})
+		if err != nil {
+			return fmt.Errorf("record answer deleted: %w", err)
+		}
+
+		uc.logger.DebugContext(ctx, "answer deleted",
+			"answer_id", answerID,
+			"user_id", userID,
+		)
 
-	return nil
+		return nil
+	})
 }
@@ -4,18 +4,38 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	entA "test-question/internal/entity/answer"
+	entO "test-question/internal/entity/outbox"
+	entR "test-question/internal/entity/reputation"
 	"test-question/internal/usecase/answer/delete/mocks"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+func newMocks(t *testing.T) (*mocks.AnswerRepository, *mocks.ReputationRepository, *mocks.OutboxRepository, *mocks.UnitOfWork, *mocks.Timer, *mocks.Logger) { //nolint:thelper
+	return mocks.NewAnswerRepository(t),
+		mocks.NewReputationRepository(t),
+		mocks.NewOutboxRepository(t),
+		mocks.NewUnitOfWork(t),
+		mocks.NewTimer(t),
+		mocks.NewLogger(t)
+}
+
+func runInTx(ctx context.Context, t *testing.T) func(args mock.Arguments) { //nolint:thelper
+	return func(args mock.Arguments) {
+		fn := args.Get(1).(func(context.Context) error) //nolint:forcetypeassert
+		require.NoError(t, fn(ctx))
+	}
+}
+
 func TestDeleteAnswer_Success(t *testing.T) {
 	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
 
-	mRepo := mocks.NewAnswerRepository(t)
-	mLogger := mocks.NewLogger(t)
+	mRepo, mRep, mOutbox, mUow, mTimer, mLogger := newMocks(t)
 
 	mRepo.
 		On("GetByID", ctx, 10).
@@ -28,6 +48,29 @@ func TestDeleteAnswer_Success(t *testing.T) {
 		On("Delete", ctx, 10).
 		Return(nil)
 
+	mRep.
+		On("Reverse", ctx, entR.ReverseFilter{
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   10,
+		}).
+		Return(nil)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mOutbox.
+		On("Record", ctx, entO.AnswerDeleted{
+			Answer:     entA.Answer{ID: 10, UserID: "owner-1"},
+			OccurredAt: now,
+		}).
+		Return(nil)
+
+	mUow.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(runInTx(ctx, t)).
+		Return(nil)
+
 	mLogger.
 		On("DebugContext",
 			ctx,
@@ -36,7 +79,7 @@ func TestDeleteAnswer_Success(t *testing.T) {
 			"user_id", "owner-1",
 		).Return()
 
-	ucase := NewUseCase(mRepo, mLogger)
+	ucase := NewUseCase(mRepo, mRep, mOutbox, mUow, mTimer, mLogger)
 
 	err := ucase.DeleteAnswer(ctx, 10, "owner-1")
 	require.NoError(t, err)
@@ -45,14 +88,13 @@ func TestDeleteAnswer_Success(t *testing.T) {
 func TestDeleteAnswer_NotFound(t *testing.T) {
 	ctx := context.Background()
 
-	mRepo := mocks.NewAnswerRepository(t)
-	mLogger := mocks.NewLogger(t)
+	mRepo, mRep, mOutbox, mUow, mTimer, mLogger := newMocks(t)
 
 	mRepo.
 		On("GetByID", ctx, 99).
 		Return(nil, entA.ErrAnswerNotFound)
 
-	ucase := NewUseCase(mRepo, mLogger)
+	ucase := NewUseCase(mRepo, mRep, mOutbox, mUow, mTimer, mLogger)
 
 	err := ucase.DeleteAnswer(ctx, 99, "user-x")
 	require.ErrorIs(t, err, entA.ErrAnswerNotFound)
@@ -61,8 +103,7 @@ func TestDeleteAnswer_NotFound(t *testing.T) {
 func TestDeleteAnswer_AccessDenied(t *testing.T) {
 	ctx := context.Background()
 
-	mRepo := mocks.NewAnswerRepository(t)
-	mLogger := mocks.NewLogger(t)
+	mRepo, mRep, mOutbox, mUow, mTimer, mLogger := newMocks(t)
 
 	mRepo.
 		On("GetByID", ctx, 7).
@@ -71,7 +112,7 @@ func TestDeleteAnswer_AccessDenied(t *testing.T) {
 			UserID: "owner-7",
 		}, nil)
 
-	ucase := NewUseCase(mRepo, mLogger)
+	ucase := NewUseCase(mRepo, mRep, mOutbox, mUow, mTimer, mLogger)
 
 	err := ucase.DeleteAnswer(ctx, 7, "another-user")
 	require.ErrorIs(t, err, entA.ErrAccessDenied)
@@ -80,14 +121,13 @@ func TestDeleteAnswer_AccessDenied(t *testing.T) {
 func TestDeleteAnswer_GetByIDError(t *testing.T) {
 	ctx := context.Background()
 
-	mRepo := mocks.NewAnswerRepository(t)
-	mLogger := mocks.NewLogger(t)
+	mRepo, mRep, mOutbox, mUow, mTimer, mLogger := newMocks(t)
 
 	mRepo.
 		On("GetByID", ctx, 5).
 		Return(nil, errors.New("db down"))
 
-	ucase := NewUseCase(mRepo, mLogger)
+	ucase := NewUseCase(mRepo, mRep, mOutbox, mUow, mTimer, mLogger)
 
 	err := ucase.DeleteAnswer(ctx, 5, "u1")
 	require.Error(t, err)
@@ -97,8 +137,7 @@ func TestDeleteAnswer_GetByIDError(t *testing.T) {
 func TestDeleteAnswer_DeleteError(t *testing.T) {
 	ctx := context.Background()
 
-	mRepo := mocks.NewAnswerRepository(t)
-	mLogger := mocks.NewLogger(t)
+	mRepo, mRep, mOutbox, mUow, mTimer, mLogger := newMocks(t)
 
 	mRepo.
 		On("GetByID", ctx, 12).
@@ -111,9 +150,134 @@ func TestDeleteAnswer_DeleteError(t *testing.T) {
 		On("Delete", ctx, 12).
 		Return(errors.New("delete fail"))
 
-	ucase := NewUseCase(mRepo, mLogger)
+	mUow.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	ucase := NewUseCase(mRepo, mRep, mOutbox, mUow, mTimer, mLogger)
 
 	err := ucase.DeleteAnswer(ctx, 12, "user12")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "delete answer")
 }
+
+func TestDeleteAnswer_ReverseError(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo, mRep, mOutbox, mUow, mTimer, mLogger := newMocks(t)
+
+	mRepo.
+		On("GetByID", ctx, 13).
+		Return(&entA.Answer{
+			ID:     13,
+			UserID: "user13",
+		}, nil)
+
+	mRepo.
+		On("Delete", ctx, 13).
+		Return(nil)
+
+	mRep.
+		On("Reverse", ctx, mock.Anything).
+		Return(errors.New("ledger down"))
+
+	mUow.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	ucase := NewUseCase(mRepo, mRep, mOutbox, mUow, mTimer, mLogger)
+
+	err := ucase.DeleteAnswer(ctx, 13, "user13")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "reverse reputation")
+}
+
+func TestDeleteAnswer_RecordError(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo, mRep, mOutbox, mUow, mTimer, mLogger := newMocks(t)
+
+	mRepo.
+		On("GetByID", ctx, 14).
+		Return(&entA.Answer{
+			ID:     14,
+			UserID: "user14",
+		}, nil)
+
+	mRepo.
+		On("Delete", ctx, 14).
+		Return(nil)
+
+	mRep.
+		On("Reverse", ctx, mock.Anything).
+		Return(nil)
+
+	mTimer.
+		On("Now").
+		Return(time.Now())
+
+	mOutbox.
+		On("Record", ctx, mock.Anything).
+		Return(errors.New("insert failed"))
+
+	mUow.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	ucase := NewUseCase(mRepo, mRep, mOutbox, mUow, mTimer, mLogger)
+
+	err := ucase.DeleteAnswer(ctx, 14, "user14")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "record answer deleted")
+}
+
+func TestRemoveAnswer_SkipsOwnership(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mRepo, mRep, mOutbox, mUow, mTimer, mLogger := newMocks(t)
+
+	mRepo.
+		On("GetByID", ctx, 10).
+		Return(&entA.Answer{ID: 10, UserID: "owner-1"}, nil)
+	mRepo.
+		On("Remove", ctx, 10).
+		Return(nil)
+	mRep.
+		On("Reverse", ctx, entR.ReverseFilter{
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   10,
+		}).
+		Return(nil)
+	mTimer.
+		On("Now").
+		Return(now)
+	mOutbox.
+		On("Record", ctx, entO.AnswerDeleted{
+			Answer:     entA.Answer{ID: 10, UserID: "owner-1"},
+			OccurredAt: now,
+		}).
+		Return(nil)
+	mUow.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Run(runInTx(ctx, t)).
+		Return(nil)
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"answer deleted",
+			"answer_id", 10,
+			"user_id", "moderator-1",
+		).Return()
+
+	ucase := NewUseCase(mRepo, mRep, mOutbox, mUow, mTimer, mLogger)
+
+	err := ucase.RemoveAnswer(ctx, 10, "moderator-1")
+	require.NoError(t, err)
+}
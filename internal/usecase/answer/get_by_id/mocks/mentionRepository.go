@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mention "test-question/internal/entity/mention"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MentionRepository is an autogenerated mock type for the mentionRepository type
+type MentionRepository struct {
+	mock.Mock
+}
+
+// ListByAnswerID provides a mock function with given fields: ctx, answerID
+func (_m *MentionRepository) ListByAnswerID(ctx context.Context, answerID int) ([]*mention.Mention, error) {
+	ret := _m.Called(ctx, answerID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByAnswerID")
+	}
+
+	var r0 []*mention.Mention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*mention.Mention, error)); ok {
+		return rf(ctx, answerID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*mention.Mention); ok {
+		r0 = rf(ctx, answerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*mention.Mention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, answerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMentionRepository creates a new instance of MentionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMentionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MentionRepository {
+	mock := &MentionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -5,11 +5,13 @@ import (
 	"fmt"
 
 	entA "test-question/internal/entity/answer"
+	entM "test-question/internal/entity/mention"
 
 	"github.com/pkg/errors"
 )
 
 //go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=mentionRepository --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
 
 type (
@@ -17,23 +19,30 @@ type (
 		GetByID(ctx context.Context, id int) (*entA.Answer, error)
 	}
 
+	mentionRepository interface {
+		ListByAnswerID(ctx context.Context, answerID int) ([]*entM.Mention, error)
+	}
+
 	logger interface {
 		DebugContext(ctx context.Context, msg string, args ...any)
 	}
 )
 
 type UseCase struct {
-	repo   answerRepository
-	logger logger
+	repo     answerRepository
+	mentions mentionRepository
+	logger   logger
 }
 
 func NewUseCase(
 	repo answerRepository,
+	mentions mentionRepository,
 	logger logger,
 ) *UseCase {
 	return &UseCase{
-		repo:   repo,
-		logger: logger,
+		repo:     repo,
+		mentions: mentions,
+		logger:   logger,
 	}
 }
 
@@ -49,6 +58,16 @@ func (uc *UseCase) GetAnswer(
 		return nil, fmt.Errorf("get answer: %w", err)
 	}
 
+	// hidden by reports until a moderator reviews it
+	if a.HiddenAt != nil {
+		return nil, entA.ErrAnswerNotFound
+	}
+
+	a.Mentions, err = uc.mentions.ListByAnswerID(ctx, answerID)
+	if err != nil {
+		return nil, fmt.Errorf("list mentions: %w", err)
+	}
+
 	uc.logger.DebugContext(ctx, "answer loaded",
 		"answer_id", answerID,
 		"user_id", a.UserID,
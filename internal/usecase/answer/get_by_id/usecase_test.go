@@ -7,6 +7,7 @@ import (
 	"time"
 
 	entA "test-question/internal/entity/answer"
+	entM "test-question/internal/entity/mention"
 	"test-question/internal/usecase/answer/get_by_id/mocks"
 
 	"github.com/stretchr/testify/mock"
@@ -41,7 +42,12 @@ func TestGetAnswer_Success(t *testing.T) {
 			"user_id", "u1",
 		)
 
-	uc := NewUseCase(mRepo, mLogger)
+	mMentions := mocks.NewMentionRepository(t)
+	mMentions.
+		On("ListByAnswerID", mock.Anything, 10).
+		Return([]*entM.Mention{{ID: 3, UserID: "u2", Username: "bob", AnswerID: 10}}, nil)
+
+	uc := NewUseCase(mRepo, mMentions, mLogger)
 
 	out, err := uc.GetAnswer(ctx, 10)
 	require.NoError(t, err)
@@ -49,6 +55,8 @@ func TestGetAnswer_Success(t *testing.T) {
 	require.Equal(t, 10, out.ID)
 	require.Equal(t, "hello", out.Text)
 	require.Equal(t, "u1", out.UserID)
+	require.Len(t, out.Mentions, 1)
+	require.Equal(t, "bob", out.Mentions[0].Username)
 }
 
 func TestGetAnswer_NotFound(t *testing.T) {
@@ -64,7 +72,28 @@ func TestGetAnswer_NotFound(t *testing.T) {
 		).
 		Return(nil, entA.ErrAnswerNotFound)
 
-	uc := NewUseCase(mRepo, mLogger)
+	uc := NewUseCase(mRepo, mocks.NewMentionRepository(t), mLogger)
+
+	out, err := uc.GetAnswer(ctx, 50)
+	require.Nil(t, out)
+	require.ErrorIs(t, err, entA.ErrAnswerNotFound)
+}
+
+func TestGetAnswer_Hidden(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewAnswerRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	hiddenAt := time.Now()
+	mRepo.
+		On("GetByID",
+			mock.MatchedBy(func(ctx context.Context) bool { return true }),
+			50,
+		).
+		Return(&entA.Answer{ID: 50, UserID: "u1", HiddenAt: &hiddenAt}, nil)
+
+	uc := NewUseCase(mRepo, mocks.NewMentionRepository(t), mLogger)
 
 	out, err := uc.GetAnswer(ctx, 50)
 	require.Nil(t, out)
@@ -84,7 +113,7 @@ func TestGetAnswer_GetError(t *testing.T) {
 		).
 		Return(nil, errors.New("db down"))
 
-	uc := NewUseCase(mRepo, mLogger)
+	uc := NewUseCase(mRepo, mocks.NewMentionRepository(t), mLogger)
 
 	out, err := uc.GetAnswer(ctx, 77)
 	require.Nil(t, out)
@@ -116,8 +145,25 @@ func TestGetAnswer_LoggerCalled(t *testing.T) {
 			"answer_id", 1,
 			"user_id", "u1")
 
-	uc := NewUseCase(mRepo, mLogger)
+	mMentions := mocks.NewMentionRepository(t)
+	mMentions.On("ListByAnswerID", mock.Anything, 1).Return(nil, nil)
+
+	uc := NewUseCase(mRepo, mMentions, mLogger)
 
 	_, err := uc.GetAnswer(ctx, 1)
 	require.NoError(t, err)
 }
+
+func TestGetAnswer_ListMentionsError(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewAnswerRepository(t)
+	mMentions := mocks.NewMentionRepository(t)
+
+	mRepo.On("GetByID", ctx, 1).Return(&entA.Answer{ID: 1}, nil)
+	mMentions.On("ListByAnswerID", ctx, 1).Return(nil, errors.New("db down"))
+
+	out, err := NewUseCase(mRepo, mMentions, mocks.NewLogger(t)).GetAnswer(ctx, 1)
+	require.Nil(t, out)
+	require.ErrorContains(t, err, "list mentions")
+}
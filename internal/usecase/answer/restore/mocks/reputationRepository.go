@@ -0,0 +1,48 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ReputationRepository is an autogenerated mock type for the reputationRepository type
+type ReputationRepository struct {
+	mock.Mock
+}
+
+// ReinstateAnswer provides a mock function with given fields: ctx, answerID, deletedAt
+func (_m *ReputationRepository) ReinstateAnswer(ctx context.Context, answerID int, deletedAt time.Time) error {
+	ret := _m.Called(ctx, answerID, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReinstateAnswer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time) error); ok {
+		r0 = rf(ctx, answerID, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewReputationRepository creates a new instance of ReputationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReputationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReputationRepository {
+	mock := &ReputationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
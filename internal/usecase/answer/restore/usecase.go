@@ -0,0 +1,134 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	answerRepository interface {
+		GetByIDWithDeleted(ctx context.Context, id int) (*entA.Answer, error)
+		Restore(ctx context.Context, id int) error
+	}
+
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+	}
+
+	reputationRepository interface {
+		ReinstateAnswer(ctx context.Context, answerID int, deletedAt time.Time) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	answers     answerRepository
+	questions   questionRepository
+	reputation  reputationRepository
+	uow         unitOfWork
+	timer       timer
+	logger      logger
+	gracePeriod time.Duration
+}
+
+func NewUseCase(
+	answers answerRepository,
+	questions questionRepository,
+	reputation reputationRepository,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+	gracePeriod time.Duration,
+) *UseCase {
+	return &UseCase{
+		answers:     answers,
+		questions:   questions,
+		reputation:  reputation,
+		uow:         uow,
+		timer:       timer,
+		logger:      logger,
+		gracePeriod: gracePeriod,
+	}
+}
+
+// RestoreAnswer takes the owner's answer out of the trash within the grace
+// period. The question must not be in the trash itself: restoring the
+// question brings back the answers deleted with it. An answer removed by a
+// moderator cannot be restored.
+func (uc *UseCase) RestoreAnswer(
+	ctx context.Context,
+	answerID int,
+	userID string,
+) error {
+	a, err := uc.answers.GetByIDWithDeleted(ctx, answerID)
+	if err != nil {
+		if errors.Is(err, entA.ErrAnswerNotFound) {
+			return err
+		}
+		return fmt.Errorf("get answer: %w", err)
+	}
+
+	if a.UserID != userID {
+		return entA.ErrAccessDenied
+	}
+
+	if a.DeletedAt == nil {
+		return entA.ErrNotDeleted
+	}
+
+	if a.RemovedByModerator {
+		return entA.ErrRemovedByModerator
+	}
+
+	if uc.timer.Now().Sub(*a.DeletedAt) > uc.gracePeriod {
+		return entA.ErrRestoreExpired
+	}
+
+	if _, err = uc.questions.GetByID(ctx, a.QuestionID); err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return entA.ErrRequestedQuestionNotFound
+		}
+		return fmt.Errorf("check question exists: %w", err)
+	}
+
+	return uc.uow.Do(ctx, func(ctx context.Context) error {
+		if err = uc.answers.Restore(ctx, answerID); err != nil {
+			return fmt.Errorf("restore answer: %w", err)
+		}
+
+		if err = uc.reputation.ReinstateAnswer(ctx, answerID, *a.DeletedAt); err != nil {
+			return fmt.Errorf("reinstate reputation: %w", err)
+		}
+
+		uc.logger.DebugContext(ctx, "answer restored",
+			"answer_id", answerID,
+			"user_id", userID,
+		)
+
+		return nil
+	})
+}
@@ -0,0 +1,213 @@
+package restore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	uc "test-question/internal/usecase/answer/restore"
+	"test-question/internal/usecase/answer/restore/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const grace = 7 * 24 * time.Hour
+
+func newMocks(t *testing.T) (*mocks.AnswerRepository, *mocks.QuestionRepository, *mocks.ReputationRepository, *mocks.UnitOfWork, *mocks.Timer, *mocks.Logger) { //nolint:thelper
+	return mocks.NewAnswerRepository(t),
+		mocks.NewQuestionRepository(t),
+		mocks.NewReputationRepository(t),
+		mocks.NewUnitOfWork(t),
+		mocks.NewTimer(t),
+		mocks.NewLogger(t)
+}
+
+func TestRestoreAnswer_Success(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	aRepo, qRepo, rRepo, uow, tm, log := newMocks(t)
+
+	aRepo.
+		On("GetByIDWithDeleted", mock.Anything, 3).
+		Return(&entA.Answer{ID: 3, QuestionID: 1, UserID: "u1", DeletedAt: &deletedAt}, nil)
+
+	tm.
+		On("Now").
+		Return(deletedAt.Add(time.Hour))
+
+	qRepo.
+		On("GetByID", mock.Anything, 1).
+		Return(&entQ.Question{ID: 1}, nil)
+
+	aRepo.
+		On("Restore", mock.Anything, 3).
+		Return(nil)
+
+	rRepo.
+		On("ReinstateAnswer", mock.Anything, 3, deletedAt).
+		Return(nil)
+
+	uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	log.
+		On("DebugContext",
+			mock.Anything,
+			"answer restored",
+			"answer_id", 3,
+			"user_id", "u1",
+		).Return()
+
+	ucase := uc.NewUseCase(aRepo, qRepo, rRepo, uow, tm, log, grace)
+
+	require.NoError(t, ucase.RestoreAnswer(ctx, 3, "u1"))
+}
+
+func TestRestoreAnswer_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	aRepo, qRepo, rRepo, uow, tm, log := newMocks(t)
+
+	aRepo.
+		On("GetByIDWithDeleted", mock.Anything, 99).
+		Return(nil, entA.ErrAnswerNotFound)
+
+	ucase := uc.NewUseCase(aRepo, qRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreAnswer(ctx, 99, "u1")
+	require.ErrorIs(t, err, entA.ErrAnswerNotFound)
+}
+
+func TestRestoreAnswer_AccessDenied(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Now()
+
+	aRepo, qRepo, rRepo, uow, tm, log := newMocks(t)
+
+	aRepo.
+		On("GetByIDWithDeleted", mock.Anything, 3).
+		Return(&entA.Answer{ID: 3, UserID: "u1", DeletedAt: &deletedAt}, nil)
+
+	ucase := uc.NewUseCase(aRepo, qRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreAnswer(ctx, 3, "u2")
+	require.ErrorIs(t, err, entA.ErrAccessDenied)
+}
+
+func TestRestoreAnswer_NotDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	aRepo, qRepo, rRepo, uow, tm, log := newMocks(t)
+
+	aRepo.
+		On("GetByIDWithDeleted", mock.Anything, 3).
+		Return(&entA.Answer{ID: 3, UserID: "u1"}, nil)
+
+	ucase := uc.NewUseCase(aRepo, qRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreAnswer(ctx, 3, "u1")
+	require.ErrorIs(t, err, entA.ErrNotDeleted)
+}
+
+func TestRestoreAnswer_RemovedByModerator(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	aRepo, qRepo, rRepo, uow, tm, log := newMocks(t)
+
+	aRepo.
+		On("GetByIDWithDeleted", mock.Anything, 3).
+		Return(&entA.Answer{ID: 3, UserID: "u1", DeletedAt: &deletedAt, RemovedByModerator: true}, nil)
+
+	ucase := uc.NewUseCase(aRepo, qRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreAnswer(ctx, 3, "u1")
+	require.ErrorIs(t, err, entA.ErrRemovedByModerator)
+}
+
+func TestRestoreAnswer_Expired(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 11, 1, 10, 0, 0, 0, time.UTC)
+
+	aRepo, qRepo, rRepo, uow, tm, log := newMocks(t)
+
+	aRepo.
+		On("GetByIDWithDeleted", mock.Anything, 3).
+		Return(&entA.Answer{ID: 3, UserID: "u1", DeletedAt: &deletedAt}, nil)
+
+	tm.
+		On("Now").
+		Return(deletedAt.Add(grace + time.Second))
+
+	ucase := uc.NewUseCase(aRepo, qRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreAnswer(ctx, 3, "u1")
+	require.ErrorIs(t, err, entA.ErrRestoreExpired)
+}
+
+func TestRestoreAnswer_QuestionDeleted(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	aRepo, qRepo, rRepo, uow, tm, log := newMocks(t)
+
+	aRepo.
+		On("GetByIDWithDeleted", mock.Anything, 3).
+		Return(&entA.Answer{ID: 3, QuestionID: 1, UserID: "u1", DeletedAt: &deletedAt}, nil)
+
+	tm.
+		On("Now").
+		Return(deletedAt)
+
+	qRepo.
+		On("GetByID", mock.Anything, 1).
+		Return(nil, entQ.ErrQuestionNotFound)
+
+	ucase := uc.NewUseCase(aRepo, qRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreAnswer(ctx, 3, "u1")
+	require.ErrorIs(t, err, entA.ErrRequestedQuestionNotFound)
+}
+
+func TestRestoreAnswer_RestoreError(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	aRepo, qRepo, rRepo, uow, tm, log := newMocks(t)
+
+	aRepo.
+		On("GetByIDWithDeleted", mock.Anything, 3).
+		Return(&entA.Answer{ID: 3, QuestionID: 1, UserID: "u1", DeletedAt: &deletedAt}, nil)
+
+	tm.
+		On("Now").
+		Return(deletedAt)
+
+	qRepo.
+		On("GetByID", mock.Anything, 1).
+		Return(&entQ.Question{ID: 1}, nil)
+
+	aRepo.
+		On("Restore", mock.Anything, 3).
+		Return(errors.New("boom"))
+
+	uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	ucase := uc.NewUseCase(aRepo, qRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreAnswer(ctx, 3, "u1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "restore answer")
+}
@@ -0,0 +1,102 @@
+package collect
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ent "test-question/internal/entity/attachment"
+)
+
+//go:generate mockery --name=attachmentRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=fileStorage --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	attachmentRepository interface {
+		ListOrphans(ctx context.Context, before time.Time, limit int) ([]*ent.Attachment, error)
+		DeleteByIDs(ctx context.Context, ids []int) (int, error)
+	}
+
+	fileStorage interface {
+		Delete(ctx context.Context, key string) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+		WarnContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type Config struct {
+	// OrphanTTL is how long an upload may wait for a post to reference it.
+	OrphanTTL time.Duration
+	BatchSize int
+}
+
+type UseCase struct {
+	repo    attachmentRepository
+	storage fileStorage
+	timer   timer
+	logger  logger
+	cfg     Config
+}
+
+func NewUseCase(repo attachmentRepository, storage fileStorage, timer timer, logger logger, cfg Config) *UseCase {
+	return &UseCase{
+		repo:    repo,
+		storage: storage,
+		timer:   timer,
+		logger:  logger,
+		cfg:     cfg,
+	}
+}
+
+// Collect deletes one batch of orphaned attachments, their files first, and
+// returns how many were deleted. An attachment whose files could not be
+// deleted is kept for the next run.
+func (uc *UseCase) Collect(ctx context.Context) (int, error) {
+	orphans, err := uc.repo.ListOrphans(ctx, uc.timer.Now().Add(-uc.cfg.OrphanTTL), uc.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("list orphaned attachments: %w", err)
+	}
+
+	ids := make([]int, 0, len(orphans))
+	for _, a := range orphans {
+		if err = uc.deleteFiles(ctx, a); err != nil {
+			uc.logger.WarnContext(ctx, "delete attachment files",
+				"attachment_id", a.ID,
+				"err", err,
+			)
+			continue
+		}
+		ids = append(ids, a.ID)
+	}
+
+	n, err := uc.repo.DeleteByIDs(ctx, ids)
+	if err != nil {
+		return 0, fmt.Errorf("delete orphaned attachments: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "orphaned attachments collected",
+		"found", len(orphans),
+		"deleted", n,
+	)
+
+	return n, nil
+}
+
+func (uc *UseCase) deleteFiles(ctx context.Context, a *ent.Attachment) error {
+	if err := uc.storage.Delete(ctx, a.StorageKey); err != nil {
+		return err
+	}
+	if a.HasThumbnail() {
+		return uc.storage.Delete(ctx, a.ThumbnailKey)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package collect_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/attachment"
+	uc "test-question/internal/usecase/attachment/collect"
+	"test-question/internal/usecase/attachment/collect/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+func TestCollect(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewAttachmentRepository(t)
+	st := mocks.NewFileStorage(t)
+	tm := mocks.NewTimer(t)
+	log := mocks.NewLogger(t)
+
+	tm.On("Now").Return(now)
+	repo.On("ListOrphans", ctx, now.Add(-24*time.Hour), 50).Return([]*ent.Attachment{
+		{ID: 1, StorageKey: "attachments/a", ThumbnailKey: "thumbnails/a.png"},
+		{ID: 2, StorageKey: "attachments/b"},
+		{ID: 3, StorageKey: "attachments/c"},
+	}, nil)
+
+	st.On("Delete", ctx, "attachments/a").Return(nil)
+	st.On("Delete", ctx, "thumbnails/a.png").Return(nil)
+	st.On("Delete", ctx, "attachments/b").Return(errors.New("unreachable"))
+	st.On("Delete", ctx, "attachments/c").Return(nil)
+
+	log.On("WarnContext", ctx, "delete attachment files", "attachment_id", 2, "err", mock.Anything).Return()
+	log.On("DebugContext", ctx, "orphaned attachments collected", "found", 3, "deleted", 2).Return()
+
+	// the attachment whose file survived stays for the next run
+	repo.On("DeleteByIDs", ctx, []int{1, 3}).Return(2, nil)
+
+	u := uc.NewUseCase(repo, st, tm, log, uc.Config{OrphanTTL: 24 * time.Hour, BatchSize: 50})
+
+	n, err := u.Collect(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+}
+
+func TestCollect_ListError(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewAttachmentRepository(t)
+	tm := mocks.NewTimer(t)
+
+	tm.On("Now").Return(now)
+	repo.On("ListOrphans", ctx, mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	u := uc.NewUseCase(repo, mocks.NewFileStorage(t), tm, mocks.NewLogger(t), uc.Config{BatchSize: 50})
+
+	_, err := u.Collect(ctx)
+	require.ErrorContains(t, err, "db down")
+}
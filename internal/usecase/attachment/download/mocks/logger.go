@@ -0,0 +1,36 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Logger is an autogenerated mock type for the logger type
+type Logger struct {
+	mock.Mock
+}
+
+// WarnContext provides a mock function with given fields: ctx, msg, args
+func (_m *Logger) WarnContext(ctx context.Context, msg string, args ...interface{}) {
+	var _ca []interface{}
+	_ca = append(_ca, ctx, msg)
+	_ca = append(_ca, args...)
+	_m.Called(_ca...)
+}
+
+// NewLogger creates a new instance of Logger. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLogger(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Logger {
+	mock := &Logger{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,78 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	ent "test-question/internal/entity/attachment"
+	"test-question/internal/pkg/storage"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=attachmentRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=fileStorage --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	attachmentRepository interface {
+		GetByID(ctx context.Context, id int) (*ent.Attachment, error)
+	}
+
+	fileStorage interface {
+		Open(ctx context.Context, key string) (io.ReadCloser, error)
+	}
+
+	logger interface {
+		WarnContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo    attachmentRepository
+	storage fileStorage
+	logger  logger
+}
+
+func NewUseCase(repo attachmentRepository, storage fileStorage, logger logger) *UseCase {
+	return &UseCase{repo: repo, storage: storage, logger: logger}
+}
+
+// Open returns the attachment with its content, or with its thumbnail when
+// thumb is set. Attachments the user may not see are reported as not found.
+func (uc *UseCase) Open(ctx context.Context, userID string, id int, thumb bool) (*ent.Attachment, io.ReadCloser, error) {
+	a, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, ent.ErrAttachmentNotFound) {
+			return nil, nil, ent.ErrAttachmentNotFound
+		}
+		return nil, nil, fmt.Errorf("get attachment: %w", err)
+	}
+
+	if !a.VisibleTo(userID) {
+		return nil, nil, ent.ErrAttachmentNotFound
+	}
+
+	key := a.StorageKey
+	if thumb {
+		if !a.HasThumbnail() {
+			return nil, nil, ent.ErrAttachmentNotFound
+		}
+		key = a.ThumbnailKey
+	}
+
+	rc, err := uc.storage.Open(ctx, key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			uc.logger.WarnContext(ctx, "attachment object missing",
+				"attachment_id", a.ID,
+				"key", key,
+			)
+			return nil, nil, ent.ErrAttachmentNotFound
+		}
+		return nil, nil, fmt.Errorf("open attachment: %w", err)
+	}
+
+	return a, rc, nil
+}
@@ -0,0 +1,158 @@
+package download_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	ent "test-question/internal/entity/attachment"
+	"test-question/internal/pkg/storage"
+	uc "test-question/internal/usecase/attachment/download"
+	"test-question/internal/usecase/attachment/download/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpen_Published(t *testing.T) {
+	ctx := context.Background()
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mLogger := mocks.NewLogger(t)
+
+	a := &ent.Attachment{ID: 1, UserID: "u1", StorageKey: "attachments/k", Published: true}
+
+	mAttachments.
+		On("GetByID", ctx, 1).
+		Return(a, nil)
+
+	mStorage.
+		On("Open", ctx, "attachments/k").
+		Return(io.NopCloser(strings.NewReader("data")), nil)
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mLogger)
+
+	got, rc, err := ucase.Open(ctx, "u2", 1, false)
+	require.NoError(t, err)
+	require.Equal(t, a, got)
+	body, _ := io.ReadAll(rc)
+	require.Equal(t, "data", string(body))
+}
+
+func TestOpen_Thumbnail(t *testing.T) {
+	ctx := context.Background()
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAttachments.
+		On("GetByID", ctx, 1).
+		Return(&ent.Attachment{ID: 1, UserID: "u1", ThumbnailKey: "thumbnails/k.png"}, nil)
+
+	mStorage.
+		On("Open", ctx, "thumbnails/k.png").
+		Return(io.NopCloser(strings.NewReader("png")), nil)
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mLogger)
+
+	// uploaders see their attachments before they are published
+	_, _, err := ucase.Open(ctx, "u1", 1, true)
+	require.NoError(t, err)
+}
+
+func TestOpen_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		a      *ent.Attachment
+		userID string
+		thumb  bool
+	}{
+		{name: "unpublished of another user", a: &ent.Attachment{UserID: "u1"}, userID: "u2"},
+		{name: "no thumbnail", a: &ent.Attachment{UserID: "u1", Published: true}, userID: "u1", thumb: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mAttachments := mocks.NewAttachmentRepository(t)
+			mStorage := mocks.NewFileStorage(t)
+			mLogger := mocks.NewLogger(t)
+
+			mAttachments.
+				On("GetByID", ctx, 1).
+				Return(tt.a, nil)
+
+			ucase := uc.NewUseCase(mAttachments, mStorage, mLogger)
+
+			_, _, err := ucase.Open(ctx, tt.userID, 1, tt.thumb)
+			require.ErrorIs(t, err, ent.ErrAttachmentNotFound)
+		})
+	}
+}
+
+func TestOpen_GetError(t *testing.T) {
+	ctx := context.Background()
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAttachments.
+		On("GetByID", ctx, 1).
+		Return(nil, ent.ErrAttachmentNotFound)
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mLogger)
+
+	_, _, err := ucase.Open(ctx, "u1", 1, false)
+	require.ErrorIs(t, err, ent.ErrAttachmentNotFound)
+}
+
+func TestOpen_ObjectMissing(t *testing.T) {
+	ctx := context.Background()
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAttachments.
+		On("GetByID", ctx, 1).
+		Return(&ent.Attachment{ID: 1, UserID: "u1", StorageKey: "k"}, nil)
+
+	mStorage.
+		On("Open", ctx, "k").
+		Return(nil, storage.ErrNotFound)
+
+	mLogger.
+		On("WarnContext", ctx, "attachment object missing", "attachment_id", 1, "key", "k").
+		Return()
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mLogger)
+
+	_, _, err := ucase.Open(ctx, "u1", 1, false)
+	require.ErrorIs(t, err, ent.ErrAttachmentNotFound)
+}
+
+func TestOpen_StorageError(t *testing.T) {
+	ctx := context.Background()
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAttachments.
+		On("GetByID", ctx, 1).
+		Return(&ent.Attachment{ID: 1, UserID: "u1", StorageKey: "k"}, nil)
+
+	mStorage.
+		On("Open", ctx, "k").
+		Return(nil, errors.New("timeout"))
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mLogger)
+
+	_, _, err := ucase.Open(ctx, "u1", 1, false)
+	require.ErrorContains(t, err, "timeout")
+}
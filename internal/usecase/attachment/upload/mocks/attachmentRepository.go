@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	attachment "test-question/internal/entity/attachment"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepository is an autogenerated mock type for the attachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, a
+func (_m *AttachmentRepository) Create(ctx context.Context, a *attachment.Attachment) (*attachment.Attachment, error) {
+	ret := _m.Called(ctx, a)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *attachment.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *attachment.Attachment) (*attachment.Attachment, error)); ok {
+		return rf(ctx, a)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *attachment.Attachment) *attachment.Attachment); ok {
+		r0 = rf(ctx, a)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*attachment.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *attachment.Attachment) error); ok {
+		r1 = rf(ctx, a)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
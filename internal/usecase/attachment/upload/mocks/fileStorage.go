@@ -0,0 +1,65 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FileStorage is an autogenerated mock type for the fileStorage type
+type FileStorage struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, key
+func (_m *FileStorage) Delete(ctx context.Context, key string) error {
+	ret := _m.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Put provides a mock function with given fields: ctx, key, r, size, contentType
+func (_m *FileStorage) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	ret := _m.Called(ctx, key, r, size, contentType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Put")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader, int64, string) error); ok {
+		r0 = rf(ctx, key, r, size, contentType)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewFileStorage creates a new instance of FileStorage. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFileStorage(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FileStorage {
+	mock := &FileStorage{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
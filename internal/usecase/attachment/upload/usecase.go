@@ -0,0 +1,184 @@
+package upload
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	ent "test-question/internal/entity/attachment"
+	"test-question/internal/pkg/thumbnail"
+
+	"github.com/google/uuid"
+)
+
+//go:generate mockery --name=attachmentRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=fileStorage --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	attachmentRepository interface {
+		Create(ctx context.Context, a *ent.Attachment) (*ent.Attachment, error)
+	}
+
+	fileStorage interface {
+		Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+		Delete(ctx context.Context, key string) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+		WarnContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+const maxFilenameLength = 255
+
+type Config struct {
+	// MaxSize is the largest file accepted, in bytes.
+	MaxSize int64
+	// AllowedTypes lists media types, e.g. "image/png", as sniffed from
+	// the content.
+	AllowedTypes []string
+	// ThumbnailSize bounds both sides of image thumbnails; zero turns
+	// thumbnails off.
+	ThumbnailSize int
+}
+
+type UseCase struct {
+	repo    attachmentRepository
+	storage fileStorage
+	timer   timer
+	logger  logger
+	cfg     Config
+}
+
+func NewUseCase(repo attachmentRepository, storage fileStorage, timer timer, logger logger, cfg Config) *UseCase {
+	return &UseCase{
+		repo:    repo,
+		storage: storage,
+		timer:   timer,
+		logger:  logger,
+		cfg:     cfg,
+	}
+}
+
+// Upload stores a file of the user as an orphaned attachment, to be
+// referenced by a question or answer later. The type is sniffed from the
+// content and must be allowed; images get a thumbnail when one can be made.
+func (uc *UseCase) Upload(ctx context.Context, userID, filename string, r io.Reader) (*ent.Attachment, error) {
+	data, err := io.ReadAll(io.LimitReader(r, uc.cfg.MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read upload: %w", err)
+	}
+	if len(data) == 0 {
+		return nil, ent.ErrEmptyFile
+	}
+	if int64(len(data)) > uc.cfg.MaxSize {
+		return nil, ent.ErrTooLarge
+	}
+
+	contentType := http.DetectContentType(data)
+	if !slices.Contains(uc.cfg.AllowedTypes, ent.MediaType(contentType)) {
+		uc.logger.DebugContext(ctx, "attachment type not allowed",
+			"user_id", userID,
+			"content_type", contentType,
+		)
+		return nil, ent.ErrUnsupportedType
+	}
+
+	id := uuid.NewString()
+	a := &ent.Attachment{
+		UserID:      userID,
+		Filename:    cleanFilename(filename),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		StorageKey:  "attachments/" + id,
+		CreatedAt:   uc.timer.Now(),
+	}
+
+	if err = uc.storage.Put(ctx, a.StorageKey, bytes.NewReader(data), a.Size, contentType); err != nil {
+		return nil, fmt.Errorf("store attachment: %w", err)
+	}
+
+	if thumb := uc.thumbnail(ctx, data, contentType); thumb != nil {
+		key := "thumbnails/" + id + ".png"
+		err = uc.storage.Put(ctx, key, bytes.NewReader(thumb), int64(len(thumb)), thumbnail.ContentType)
+		if err != nil {
+			uc.discard(ctx, a.StorageKey)
+			return nil, fmt.Errorf("store thumbnail: %w", err)
+		}
+		a.ThumbnailKey = key
+	}
+
+	out, err := uc.repo.Create(ctx, a)
+	if err != nil {
+		uc.discard(ctx, a.StorageKey, a.ThumbnailKey)
+		return nil, fmt.Errorf("create attachment: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "attachment uploaded",
+		"attachment_id", out.ID,
+		"user_id", userID,
+		"content_type", contentType,
+		"size", out.Size,
+	)
+
+	return out, nil
+}
+
+// thumbnail returns nil for non-images and for images that cannot be
+// scaled; the upload itself still succeeds.
+func (uc *UseCase) thumbnail(ctx context.Context, data []byte, contentType string) []byte {
+	if uc.cfg.ThumbnailSize <= 0 || !ent.IsImage(contentType) {
+		return nil
+	}
+
+	thumb, err := thumbnail.Generate(data, uc.cfg.ThumbnailSize)
+	if err != nil {
+		uc.logger.WarnContext(ctx, "thumbnail not generated", "err", err)
+		return nil
+	}
+
+	return thumb
+}
+
+// discard removes objects of a failed upload; what it misses only takes
+// up space.
+func (uc *UseCase) discard(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := uc.storage.Delete(context.WithoutCancel(ctx), key); err != nil {
+			uc.logger.WarnContext(ctx, "discard uploaded object", "key", key, "err", err)
+		}
+	}
+}
+
+// cleanFilename keeps the last path element of the client's file name,
+// cut to fit the column.
+func cleanFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(strings.TrimSpace(name), `\`, "/"))
+	if name == "." || name == "/" || name == "" {
+		return "file"
+	}
+
+	for len(name) > maxFilenameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+
+	return name
+}
@@ -0,0 +1,356 @@
+package upload_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/attachment"
+	uc "test-question/internal/usecase/attachment/upload"
+	"test-question/internal/usecase/attachment/upload/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var cfg = uc.Config{ //nolint:gochecknoglobals
+	MaxSize:       1 << 20,
+	AllowedTypes:  []string{"image/png", "text/plain"},
+	ThumbnailSize: 16,
+}
+
+func pngImage(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, w, h))))
+	return buf.Bytes()
+}
+
+func TestUpload_TextFile(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mStorage.
+		On("Put", ctx, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "attachments/")
+		}), mock.Anything, int64(11), "text/plain; charset=utf-8").
+		Return(nil)
+
+	mAttachments.
+		On("Create", ctx, mock.MatchedBy(func(a *ent.Attachment) bool {
+			return a.UserID == "u1" &&
+				a.Filename == "app.log" &&
+				a.ContentType == "text/plain; charset=utf-8" &&
+				a.Size == 11 &&
+				a.ThumbnailKey == "" &&
+				a.CreatedAt.Equal(now)
+		})).
+		Return(func(_ context.Context, a *ent.Attachment) (*ent.Attachment, error) {
+			out := *a
+			out.ID = 5
+			return &out, nil
+		})
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"attachment uploaded",
+			"attachment_id", 5,
+			"user_id", "u1",
+			"content_type", "text/plain; charset=utf-8",
+			"size", int64(11),
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mTimer, mLogger, cfg)
+
+	got, err := ucase.Upload(ctx, "u1", `C:\logs\app.log`, strings.NewReader("hello world"))
+	require.NoError(t, err)
+	require.Equal(t, 5, got.ID)
+}
+
+func TestUpload_ImageGetsThumbnail(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	img := pngImage(t, 64, 32)
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mStorage.
+		On("Put", ctx, mock.AnythingOfType("string"), mock.Anything, int64(len(img)), "image/png").
+		Return(nil).
+		Once()
+
+	var thumb []byte
+	mStorage.
+		On("Put", ctx, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "thumbnails/") && strings.HasSuffix(key, ".png")
+		}), mock.Anything, mock.Anything, "image/png").
+		Run(func(args mock.Arguments) {
+			var err error
+			thumb, err = io.ReadAll(args.Get(2).(io.Reader)) //nolint:forcetypeassert
+			require.NoError(t, err)
+		}).
+		Return(nil).
+		Once()
+
+	mAttachments.
+		On("Create", ctx, mock.Anything).
+		Return(func(_ context.Context, a *ent.Attachment) (*ent.Attachment, error) {
+			return a, nil
+		})
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"attachment uploaded",
+			"attachment_id", 0,
+			"user_id", "u1",
+			"content_type", "image/png",
+			"size", int64(len(img)),
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mTimer, mLogger, cfg)
+
+	got, err := ucase.Upload(ctx, "u1", "shot.png", bytes.NewReader(img))
+	require.NoError(t, err)
+	require.True(t, got.HasThumbnail())
+
+	tcfg, err := png.DecodeConfig(bytes.NewReader(thumb))
+	require.NoError(t, err)
+	require.Equal(t, 16, tcfg.Width)
+	require.Equal(t, 8, tcfg.Height)
+}
+
+func TestUpload_Refused(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		body []byte
+		want error
+	}{
+		{name: "empty", body: nil, want: ent.ErrEmptyFile},
+		{name: "too large", body: bytes.Repeat([]byte("a"), int(cfg.MaxSize)+1), want: ent.ErrTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mAttachments := mocks.NewAttachmentRepository(t)
+			mStorage := mocks.NewFileStorage(t)
+			mTimer := mocks.NewTimer(t)
+			mLogger := mocks.NewLogger(t)
+
+			ucase := uc.NewUseCase(mAttachments, mStorage, mTimer, mLogger, cfg)
+
+			_, err := ucase.Upload(ctx, "u1", "notes.txt", bytes.NewReader(tt.body))
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestUpload_SniffedTypeRefused(t *testing.T) {
+	ctx := context.Background()
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"attachment type not allowed",
+			"user_id", "u1",
+			"content_type", "application/pdf",
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mTimer, mLogger, cfg)
+
+	// the file name says text, the content says otherwise
+	_, err := ucase.Upload(ctx, "u1", "notes.txt", strings.NewReader("%PDF-1.7 ..."))
+	require.ErrorIs(t, err, ent.ErrUnsupportedType)
+}
+
+func TestUpload_BrokenImageStoredWithoutThumbnail(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	broken := pngImage(t, 4, 4)[:40]
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mStorage.
+		On("Put", ctx, mock.Anything, mock.Anything, int64(len(broken)), "image/png").
+		Return(nil).
+		Once()
+
+	mAttachments.
+		On("Create", ctx, mock.Anything).
+		Return(func(_ context.Context, a *ent.Attachment) (*ent.Attachment, error) {
+			return a, nil
+		})
+
+	mLogger.
+		On("WarnContext", ctx, "thumbnail not generated", "err", mock.Anything).
+		Return()
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"attachment uploaded",
+			"attachment_id", 0,
+			"user_id", "u1",
+			"content_type", "image/png",
+			"size", int64(len(broken)),
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mTimer, mLogger, cfg)
+
+	got, err := ucase.Upload(ctx, "u1", "shot.png", bytes.NewReader(broken))
+	require.NoError(t, err)
+	require.False(t, got.HasThumbnail())
+}
+
+func TestUpload_RepoErrorDiscardsFiles(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	img := pngImage(t, 4, 4)
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mStorage.
+		On("Put", ctx, mock.Anything, mock.Anything, mock.Anything, "image/png").
+		Return(nil).
+		Twice()
+
+	mStorage.
+		On("Delete", mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "attachments/")
+		})).
+		Return(nil).
+		Once()
+
+	mStorage.
+		On("Delete", mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "thumbnails/")
+		})).
+		Return(nil).
+		Once()
+
+	mAttachments.
+		On("Create", ctx, mock.Anything).
+		Return(nil, errors.New("db down"))
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mTimer, mLogger, cfg)
+
+	_, err := ucase.Upload(ctx, "u1", "shot.png", bytes.NewReader(img))
+	require.ErrorContains(t, err, "db down")
+}
+
+func TestUpload_StorageError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mAttachments := mocks.NewAttachmentRepository(t)
+	mStorage := mocks.NewFileStorage(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mStorage.
+		On("Put", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("disk full"))
+
+	ucase := uc.NewUseCase(mAttachments, mStorage, mTimer, mLogger, cfg)
+
+	_, err := ucase.Upload(ctx, "u1", "a.txt", strings.NewReader("text"))
+	require.ErrorContains(t, err, "disk full")
+}
+
+func TestUpload_FilenameCleaned(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	for in, want := range map[string]string{
+		"../../etc/passwd":             "passwd",
+		"   ":                          "file",
+		"/":                            "file",
+		strings.Repeat("я", 200) + "x": strings.Repeat("я", 127),
+	} {
+		mAttachments := mocks.NewAttachmentRepository(t)
+		mStorage := mocks.NewFileStorage(t)
+		mTimer := mocks.NewTimer(t)
+		mLogger := mocks.NewLogger(t)
+
+		mTimer.
+			On("Now").
+			Return(now)
+
+		mStorage.
+			On("Put", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(nil)
+
+		mAttachments.
+			On("Create", ctx, mock.Anything).
+			Return(func(_ context.Context, a *ent.Attachment) (*ent.Attachment, error) {
+				return a, nil
+			})
+
+		mLogger.
+			On("DebugContext", ctx, "attachment uploaded",
+				"attachment_id", 0,
+				"user_id", "u1",
+				"content_type", "text/plain; charset=utf-8",
+				"size", int64(4),
+			).
+			Return()
+
+		ucase := uc.NewUseCase(mAttachments, mStorage, mTimer, mLogger, cfg)
+
+		got, err := ucase.Upload(ctx, "u1", in, strings.NewReader("text"))
+		require.NoError(t, err)
+		require.Equal(t, want, got.Filename)
+	}
+}
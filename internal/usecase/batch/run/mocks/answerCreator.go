@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	answer "test-question/internal/entity/answer"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnswerCreator is an autogenerated mock type for the answerCreator type
+type AnswerCreator struct {
+	mock.Mock
+}
+
+// CreateAnswer provides a mock function with given fields: ctx, questionID, userID, text, attachmentIDs
+func (_m *AnswerCreator) CreateAnswer(ctx context.Context, questionID int, userID string, text string, attachmentIDs []int) (*answer.Answer, error) {
+	ret := _m.Called(ctx, questionID, userID, text, attachmentIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAnswer")
+	}
+
+	var r0 *answer.Answer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, []int) (*answer.Answer, error)); ok {
+		return rf(ctx, questionID, userID, text, attachmentIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string, []int) *answer.Answer); ok {
+		r0 = rf(ctx, questionID, userID, text, attachmentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*answer.Answer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string, string, []int) error); ok {
+		r1 = rf(ctx, questionID, userID, text, attachmentIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAnswerCreator creates a new instance of AnswerCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnswerCreator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnswerCreator {
+	mock := &AnswerCreator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
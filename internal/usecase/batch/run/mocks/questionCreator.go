@@ -0,0 +1,68 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	question "test-question/internal/entity/question"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QuestionCreator is an autogenerated mock type for the questionCreator type
+type QuestionCreator struct {
+	mock.Mock
+}
+
+// CreateQuestion provides a mock function with given fields: ctx, userID, text, force, attachmentIDs
+func (_m *QuestionCreator) CreateQuestion(ctx context.Context, userID string, text string, force bool, attachmentIDs []int) (*question.Question, []*question.SimilarQuestion, error) {
+	ret := _m.Called(ctx, userID, text, force, attachmentIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateQuestion")
+	}
+
+	var r0 *question.Question
+	var r1 []*question.SimilarQuestion
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, []int) (*question.Question, []*question.SimilarQuestion, error)); ok {
+		return rf(ctx, userID, text, force, attachmentIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool, []int) *question.Question); ok {
+		r0 = rf(ctx, userID, text, force, attachmentIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*question.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, bool, []int) []*question.SimilarQuestion); ok {
+		r1 = rf(ctx, userID, text, force, attachmentIDs)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]*question.SimilarQuestion)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, bool, []int) error); ok {
+		r2 = rf(ctx, userID, text, force, attachmentIDs)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewQuestionCreator creates a new instance of QuestionCreator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuestionCreator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuestionCreator {
+	mock := &QuestionCreator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QuestionDeleter is an autogenerated mock type for the questionDeleter type
+type QuestionDeleter struct {
+	mock.Mock
+}
+
+// DeleteQuestion provides a mock function with given fields: ctx, questionID, userID
+func (_m *QuestionDeleter) DeleteQuestion(ctx context.Context, questionID int, userID string) error {
+	ret := _m.Called(ctx, questionID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteQuestion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, questionID, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewQuestionDeleter creates a new instance of QuestionDeleter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuestionDeleter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuestionDeleter {
+	mock := &QuestionDeleter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Quota is an autogenerated mock type for the quota type
+type Quota struct {
+	mock.Mock
+}
+
+// AllowN provides a mock function with given fields: ctx, route, userID, n
+func (_m *Quota) AllowN(ctx context.Context, route string, userID string, n int) bool {
+	ret := _m.Called(ctx, route, userID, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AllowN")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) bool); ok {
+		r0 = rf(ctx, route, userID, n)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// NewQuota creates a new instance of Quota. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuota(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Quota {
+	mock := &Quota{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
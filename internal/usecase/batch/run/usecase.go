@@ -0,0 +1,214 @@
+package run
+
+import (
+	"context"
+	"fmt"
+
+	entA "test-question/internal/entity/answer"
+	entB "test-question/internal/entity/batch"
+	entQ "test-question/internal/entity/question"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionCreator --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerCreator --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=questionDeleter --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerDeleter --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=quota --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionCreator interface {
+		CreateQuestion(ctx context.Context, userID, text string, force bool, attachmentIDs []int) (*entQ.Question, []*entQ.SimilarQuestion, error)
+	}
+
+	answerCreator interface {
+		CreateAnswer(ctx context.Context, questionID int, userID, text string, attachmentIDs []int) (*entA.Answer, error)
+	}
+
+	questionDeleter interface {
+		DeleteQuestion(ctx context.Context, questionID int, userID string) error
+	}
+
+	answerDeleter interface {
+		DeleteAnswer(ctx context.Context, answerID int, userID string) error
+	}
+
+	quota interface {
+		AllowN(ctx context.Context, route, userID string, n int) bool
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+// operationsRoute names the rate limit every operation of a batch takes a
+// token of. It is a bucket of its own, sized for imports and migrations,
+// rather than the buckets of the single endpoints.
+const operationsRoute = "batch_operations"
+
+type Config struct {
+	// MaxOperations bounds the number of operations in one batch.
+	MaxOperations int
+}
+
+type UseCase struct {
+	createQuestion questionCreator
+	createAnswer   answerCreator
+	deleteQuestion questionDeleter
+	deleteAnswer   answerDeleter
+	quota          quota
+	uow            unitOfWork
+	logger         logger
+	cfg            Config
+}
+
+func NewUseCase(
+	createQuestion questionCreator,
+	createAnswer answerCreator,
+	deleteQuestion questionDeleter,
+	deleteAnswer answerDeleter,
+	quota quota,
+	uow unitOfWork,
+	logger logger,
+	cfg Config,
+) *UseCase {
+	return &UseCase{
+		createQuestion: createQuestion,
+		createAnswer:   createAnswer,
+		deleteQuestion: deleteQuestion,
+		deleteAnswer:   deleteAnswer,
+		quota:          quota,
+		uow:            uow,
+		logger:         logger,
+		cfg:            cfg,
+	}
+}
+
+// errAborted rolls back an atomic batch after an operation failed.
+var errAborted = errors.New("batch aborted")
+
+// Run executes the operations in order on behalf of the user through the
+// regular use cases. Without atomic every operation commits on its own and
+// gets its own result. With atomic the batch runs in one transaction that
+// is rolled back on the first failure: the failed operation keeps its
+// error, the ones before it get ErrRolledBack and the rest ErrSkipped.
+// Every operation takes a token of the user's batch operations limit: an
+// operation over it fails with ErrRateLimited, while an atomic batch takes
+// the tokens of all its operations before it starts and fails as a whole
+// with ErrRateLimited when they are not there.
+// An invalid batch fails as a whole with an OperationError.
+func (uc *UseCase) Run(ctx context.Context, userID string, ops []entB.Operation, atomic bool) ([]entB.Result, error) {
+	if err := uc.validate(ops); err != nil {
+		return nil, err
+	}
+
+	if atomic && !uc.quota.AllowN(ctx, operationsRoute, userID, len(ops)) {
+		return nil, entB.ErrRateLimited
+	}
+
+	results := make([]entB.Result, len(ops))
+
+	if !atomic {
+		for i, op := range ops {
+			if !uc.quota.AllowN(ctx, operationsRoute, userID, 1) {
+				results[i] = entB.Result{Err: entB.ErrRateLimited}
+				continue
+			}
+			results[i] = uc.exec(ctx, userID, op, results)
+		}
+		uc.logDone(ctx, userID, results, atomic)
+		return results, nil
+	}
+
+	err := uc.uow.Do(ctx, func(ctx context.Context) error {
+		for i, op := range ops {
+			results[i] = uc.exec(ctx, userID, op, results)
+			if results[i].Err == nil {
+				continue
+			}
+
+			for j := range i {
+				results[j] = entB.Result{Err: entB.ErrRolledBack}
+			}
+			for j := i + 1; j < len(ops); j++ {
+				results[j] = entB.Result{Err: entB.ErrSkipped}
+			}
+			return errAborted
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errAborted) {
+		return nil, fmt.Errorf("run batch: %w", err)
+	}
+
+	uc.logDone(ctx, userID, results, atomic)
+
+	return results, nil
+}
+
+func (uc *UseCase) validate(ops []entB.Operation) error {
+	if len(ops) > uc.cfg.MaxOperations {
+		return &entB.TooManyOperationsError{Max: uc.cfg.MaxOperations}
+	}
+
+	for i, op := range ops {
+		if err := op.Validate(i); err != nil {
+			return &entB.OperationError{Index: i, Err: err}
+		}
+		if op.QuestionRef != nil && ops[*op.QuestionRef].Type != entB.OpCreateQuestion {
+			return &entB.OperationError{Index: i, Err: entB.ErrInvalidRef}
+		}
+	}
+
+	return nil
+}
+
+// exec runs one operation; done holds the results of the earlier ones.
+func (uc *UseCase) exec(ctx context.Context, userID string, op entB.Operation, done []entB.Result) entB.Result {
+	var res entB.Result
+
+	switch op.Type {
+	case entB.OpCreateQuestion:
+		res.Question, res.Similar, res.Err = uc.createQuestion.CreateQuestion(ctx, userID, op.Text, op.Force, op.AttachmentIDs)
+	case entB.OpCreateAnswer:
+		questionID := op.QuestionID
+		if op.QuestionRef != nil {
+			ref := done[*op.QuestionRef]
+			if ref.Err != nil {
+				return entB.Result{Err: entB.ErrRefFailed}
+			}
+			questionID = ref.Question.ID
+		}
+		res.Answer, res.Err = uc.createAnswer.CreateAnswer(ctx, questionID, userID, op.Text, op.AttachmentIDs)
+	case entB.OpDeleteQuestion:
+		res.Err = uc.deleteQuestion.DeleteQuestion(ctx, op.QuestionID, userID)
+	case entB.OpDeleteAnswer:
+		res.Err = uc.deleteAnswer.DeleteAnswer(ctx, op.AnswerID, userID)
+	}
+
+	return res
+}
+
+func (uc *UseCase) logDone(ctx context.Context, userID string, results []entB.Result, atomic bool) {
+	failed := 0
+	for _, r := range results {
+		if r.Err != nil {
+			failed++
+		}
+	}
+
+	uc.logger.DebugContext(ctx, "batch done",
+		"user_id", userID,
+		"operations", len(results),
+		"failed", failed,
+		"atomic", atomic,
+	)
+}
@@ -0,0 +1,430 @@
+package run_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entA "test-question/internal/entity/answer"
+	entB "test-question/internal/entity/batch"
+	entQ "test-question/internal/entity/question"
+	uc "test-question/internal/usecase/batch/run"
+	"test-question/internal/usecase/batch/run/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ref(i int) *int { return &i }
+
+func TestRun_NonAtomic_EachOperationOwnResult(t *testing.T) {
+	ctx := context.Background()
+
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mDeleteQuestion := mocks.NewQuestionDeleter(t)
+	mDeleteAnswer := mocks.NewAnswerDeleter(t)
+	mQuota := mocks.NewQuota(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mLogger := mocks.NewLogger(t)
+
+	q := &entQ.Question{ID: 7, Text: "first"}
+
+	mCreateQuestion.
+		On("CreateQuestion", ctx, "u1", "first", false, []int(nil)).
+		Return(q, nil, nil)
+
+	mDeleteQuestion.
+		On("DeleteQuestion", ctx, 3, "u1").
+		Return(entQ.ErrAccessDenied)
+
+	mCreateAnswer.
+		On("CreateAnswer", ctx, 7, "u1", "reply", []int(nil)).
+		Return(&entA.Answer{ID: 9, QuestionID: 7}, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"batch done",
+			"user_id", "u1",
+			"operations", 3,
+			"failed", 1,
+			"atomic", false,
+		).
+		Return()
+
+	mQuota.
+		On("AllowN", ctx, "batch_operations", "u1", 1).
+		Return(true).
+		Times(3)
+
+	ucase := uc.NewUseCase(mCreateQuestion, mCreateAnswer, mDeleteQuestion, mDeleteAnswer, mQuota, mUoW, mLogger, uc.Config{MaxOperations: 3})
+
+	results, err := ucase.Run(ctx, "u1", []entB.Operation{
+		{Type: entB.OpCreateQuestion, Text: "first"},
+		{Type: entB.OpDeleteQuestion, QuestionID: 3},
+		{Type: entB.OpCreateAnswer, Text: "reply", QuestionRef: ref(0)},
+	}, false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	require.Equal(t, q, results[0].Question)
+	require.NoError(t, results[0].Err)
+	require.ErrorIs(t, results[1].Err, entQ.ErrAccessDenied)
+	require.Equal(t, 9, results[2].Answer.ID)
+}
+
+func TestRun_NonAtomic_RefFailed(t *testing.T) {
+	ctx := context.Background()
+
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mDeleteQuestion := mocks.NewQuestionDeleter(t)
+	mDeleteAnswer := mocks.NewAnswerDeleter(t)
+	mQuota := mocks.NewQuota(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mLogger := mocks.NewLogger(t)
+
+	mCreateQuestion.
+		On("CreateQuestion", ctx, "u1", "dup", false, []int(nil)).
+		Return(nil, []*entQ.SimilarQuestion{{ID: 1}}, entQ.ErrPossibleDuplicates)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"batch done",
+			"user_id", "u1",
+			"operations", 2,
+			"failed", 2,
+			"atomic", false,
+		).
+		Return()
+
+	mQuota.
+		On("AllowN", ctx, "batch_operations", "u1", 1).
+		Return(true).
+		Times(2)
+
+	ucase := uc.NewUseCase(mCreateQuestion, mCreateAnswer, mDeleteQuestion, mDeleteAnswer, mQuota, mUoW, mLogger, uc.Config{MaxOperations: 3})
+
+	results, err := ucase.Run(ctx, "u1", []entB.Operation{
+		{Type: entB.OpCreateQuestion, Text: "dup"},
+		{Type: entB.OpCreateAnswer, Text: "reply", QuestionRef: ref(0)},
+	}, false)
+	require.NoError(t, err)
+	require.ErrorIs(t, results[0].Err, entQ.ErrPossibleDuplicates)
+	require.Len(t, results[0].Similar, 1)
+	require.ErrorIs(t, results[1].Err, entB.ErrRefFailed)
+}
+
+func TestRun_NonAtomic_RateLimited(t *testing.T) {
+	ctx := context.Background()
+
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mDeleteQuestion := mocks.NewQuestionDeleter(t)
+	mDeleteAnswer := mocks.NewAnswerDeleter(t)
+	mQuota := mocks.NewQuota(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuota.
+		On("AllowN", ctx, "batch_operations", "u1", 1).
+		Return(true).
+		Once()
+
+	mQuota.
+		On("AllowN", ctx, "batch_operations", "u1", 1).
+		Return(false).
+		Once()
+
+	mQuota.
+		On("AllowN", ctx, "batch_operations", "u1", 1).
+		Return(true).
+		Once()
+
+	mCreateQuestion.
+		On("CreateQuestion", ctx, "u1", "first", false, []int(nil)).
+		Return(&entQ.Question{ID: 7}, nil, nil)
+
+	mDeleteAnswer.
+		On("DeleteAnswer", ctx, 5, "u1").
+		Return(nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"batch done",
+			"user_id", "u1",
+			"operations", 3,
+			"failed", 1,
+			"atomic", false,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mCreateQuestion, mCreateAnswer, mDeleteQuestion, mDeleteAnswer, mQuota, mUoW, mLogger, uc.Config{MaxOperations: 3})
+
+	results, err := ucase.Run(ctx, "u1", []entB.Operation{
+		{Type: entB.OpCreateQuestion, Text: "first"},
+		{Type: entB.OpCreateQuestion, Text: "second"},
+		{Type: entB.OpDeleteAnswer, AnswerID: 5},
+	}, false)
+	require.NoError(t, err)
+
+	require.NoError(t, results[0].Err)
+	require.ErrorIs(t, results[1].Err, entB.ErrRateLimited)
+	require.NoError(t, results[2].Err)
+}
+
+func TestRun_Atomic_Success(t *testing.T) {
+	ctx := context.Background()
+
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mDeleteQuestion := mocks.NewQuestionDeleter(t)
+	mDeleteAnswer := mocks.NewAnswerDeleter(t)
+	mQuota := mocks.NewQuota(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mLogger := mocks.NewLogger(t)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Once()
+
+	mCreateQuestion.
+		On("CreateQuestion", ctx, "u1", "first", true, []int(nil)).
+		Return(&entQ.Question{ID: 7}, nil, nil)
+
+	mCreateAnswer.
+		On("CreateAnswer", ctx, 7, "u1", "reply", []int(nil)).
+		Return(&entA.Answer{ID: 9}, nil)
+
+	mDeleteAnswer.
+		On("DeleteAnswer", ctx, 5, "u1").
+		Return(nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"batch done",
+			"user_id", "u1",
+			"operations", 3,
+			"failed", 0,
+			"atomic", true,
+		).
+		Return()
+
+	mQuota.
+		On("AllowN", ctx, "batch_operations", "u1", 3).
+		Return(true)
+
+	ucase := uc.NewUseCase(mCreateQuestion, mCreateAnswer, mDeleteQuestion, mDeleteAnswer, mQuota, mUoW, mLogger, uc.Config{MaxOperations: 3})
+
+	results, err := ucase.Run(ctx, "u1", []entB.Operation{
+		{Type: entB.OpCreateQuestion, Text: "first", Force: true},
+		{Type: entB.OpCreateAnswer, Text: "reply", QuestionRef: ref(0)},
+		{Type: entB.OpDeleteAnswer, AnswerID: 5},
+	}, true)
+	require.NoError(t, err)
+	for _, r := range results {
+		require.NoError(t, r.Err)
+	}
+}
+
+func TestRun_Atomic_RollsBackOnFirstFailure(t *testing.T) {
+	ctx := context.Background()
+
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mDeleteQuestion := mocks.NewQuestionDeleter(t)
+	mDeleteAnswer := mocks.NewAnswerDeleter(t)
+	mQuota := mocks.NewQuota(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mLogger := mocks.NewLogger(t)
+
+	var rolledBack bool
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			err := fn(ctx)
+			rolledBack = err != nil
+			return err
+		})
+
+	mCreateQuestion.
+		On("CreateQuestion", ctx, "u1", "first", false, []int(nil)).
+		Return(&entQ.Question{ID: 7}, nil, nil)
+
+	mCreateAnswer.
+		On("CreateAnswer", ctx, 8, "u1", "reply", []int(nil)).
+		Return(nil, entQ.ErrQuestionClosed)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"batch done",
+			"user_id", "u1",
+			"operations", 3,
+			"failed", 3,
+			"atomic", true,
+		).
+		Return()
+
+	mQuota.
+		On("AllowN", ctx, "batch_operations", "u1", 3).
+		Return(true)
+
+	ucase := uc.NewUseCase(mCreateQuestion, mCreateAnswer, mDeleteQuestion, mDeleteAnswer, mQuota, mUoW, mLogger, uc.Config{MaxOperations: 3})
+
+	results, err := ucase.Run(ctx, "u1", []entB.Operation{
+		{Type: entB.OpCreateQuestion, Text: "first"},
+		{Type: entB.OpCreateAnswer, Text: "reply", QuestionID: 8},
+		{Type: entB.OpDeleteAnswer, AnswerID: 5},
+	}, true)
+	require.NoError(t, err)
+	require.True(t, rolledBack)
+
+	require.ErrorIs(t, results[0].Err, entB.ErrRolledBack)
+	require.Nil(t, results[0].Question)
+	require.ErrorIs(t, results[1].Err, entQ.ErrQuestionClosed)
+	require.ErrorIs(t, results[2].Err, entB.ErrSkipped)
+}
+
+func TestRun_Atomic_RateLimitedBeforeStart(t *testing.T) {
+	ctx := context.Background()
+
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mDeleteQuestion := mocks.NewQuestionDeleter(t)
+	mDeleteAnswer := mocks.NewAnswerDeleter(t)
+	mQuota := mocks.NewQuota(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuota.
+		On("AllowN", ctx, "batch_operations", "u1", 2).
+		Return(false)
+
+	ucase := uc.NewUseCase(mCreateQuestion, mCreateAnswer, mDeleteQuestion, mDeleteAnswer, mQuota, mUoW, mLogger, uc.Config{MaxOperations: 3})
+
+	_, err := ucase.Run(ctx, "u1", []entB.Operation{
+		{Type: entB.OpCreateQuestion, Text: "first"},
+		{Type: entB.OpDeleteAnswer, AnswerID: 5},
+	}, true)
+	require.ErrorIs(t, err, entB.ErrRateLimited)
+}
+
+func TestRun_Atomic_CommitError(t *testing.T) {
+	ctx := context.Background()
+
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mDeleteQuestion := mocks.NewQuestionDeleter(t)
+	mDeleteAnswer := mocks.NewAnswerDeleter(t)
+	mQuota := mocks.NewQuota(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuota.
+		On("AllowN", ctx, "batch_operations", "u1", 1).
+		Return(true)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(errors.New("db down"))
+
+	ucase := uc.NewUseCase(mCreateQuestion, mCreateAnswer, mDeleteQuestion, mDeleteAnswer, mQuota, mUoW, mLogger, uc.Config{MaxOperations: 3})
+
+	_, err := ucase.Run(ctx, "u1", []entB.Operation{{Type: entB.OpDeleteAnswer, AnswerID: 5}}, true)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "run batch")
+}
+
+func TestRun_Invalid(t *testing.T) {
+	ctx := context.Background()
+
+	cases := []struct {
+		name  string
+		ops   []entB.Operation
+		index int
+		err   error
+	}{
+		{
+			name:  "missing_text",
+			ops:   []entB.Operation{{Type: entB.OpCreateQuestion}},
+			index: 0,
+			err:   entB.ErrInvalidOperation,
+		},
+		{
+			name:  "unknown_type",
+			ops:   []entB.Operation{{Type: "edit_question", QuestionID: 1}},
+			index: 0,
+			err:   entB.ErrInvalidOperation,
+		},
+		{
+			name: "both_question_id_and_ref",
+			ops: []entB.Operation{
+				{Type: entB.OpCreateQuestion, Text: "q"},
+				{Type: entB.OpCreateAnswer, Text: "a", QuestionID: 1, QuestionRef: ref(0)},
+			},
+			index: 1,
+			err:   entB.ErrInvalidOperation,
+		},
+		{
+			name:  "forward_ref",
+			ops:   []entB.Operation{{Type: entB.OpCreateAnswer, Text: "a", QuestionRef: ref(1)}, {Type: entB.OpCreateQuestion, Text: "q"}},
+			index: 0,
+			err:   entB.ErrInvalidRef,
+		},
+		{
+			name: "ref_to_non_question",
+			ops: []entB.Operation{
+				{Type: entB.OpDeleteAnswer, AnswerID: 1},
+				{Type: entB.OpCreateAnswer, Text: "a", QuestionRef: ref(0)},
+			},
+			index: 1,
+			err:   entB.ErrInvalidRef,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mCreateQuestion := mocks.NewQuestionCreator(t)
+			mCreateAnswer := mocks.NewAnswerCreator(t)
+			mDeleteQuestion := mocks.NewQuestionDeleter(t)
+			mDeleteAnswer := mocks.NewAnswerDeleter(t)
+			mQuota := mocks.NewQuota(t)
+			mUoW := mocks.NewUnitOfWork(t)
+			mLogger := mocks.NewLogger(t)
+
+			ucase := uc.NewUseCase(mCreateQuestion, mCreateAnswer, mDeleteQuestion, mDeleteAnswer, mQuota, mUoW, mLogger, uc.Config{MaxOperations: 3})
+
+			_, err := ucase.Run(ctx, "u1", tc.ops, false)
+
+			var opErr *entB.OperationError
+			require.ErrorAs(t, err, &opErr)
+			require.Equal(t, tc.index, opErr.Index)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestRun_TooManyOperations(t *testing.T) {
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mDeleteQuestion := mocks.NewQuestionDeleter(t)
+	mDeleteAnswer := mocks.NewAnswerDeleter(t)
+	mQuota := mocks.NewQuota(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mLogger := mocks.NewLogger(t)
+
+	ucase := uc.NewUseCase(mCreateQuestion, mCreateAnswer, mDeleteQuestion, mDeleteAnswer, mQuota, mUoW, mLogger, uc.Config{MaxOperations: 3})
+
+	ops := make([]entB.Operation, 4)
+	_, err := ucase.Run(context.Background(), "u1", ops, true)
+	require.ErrorIs(t, err, entB.ErrTooManyOperations)
+	require.Equal(t, &entB.TooManyOperationsError{Max: 3}, err)
+}
@@ -0,0 +1,137 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	bounty "test-question/internal/entity/bounty"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// BountyRepository is an autogenerated mock type for the bountyRepository type
+type BountyRepository struct {
+	mock.Mock
+}
+
+// Close provides a mock function with given fields: ctx, id, status, answerID, at
+func (_m *BountyRepository) Close(ctx context.Context, id int, status bounty.Status, answerID int, at time.Time) error {
+	ret := _m.Called(ctx, id, status, answerID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, bounty.Status, int, time.Time) error); ok {
+		r0 = rf(ctx, id, status, answerID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetOpen provides a mock function with given fields: ctx, questionID
+func (_m *BountyRepository) GetOpen(ctx context.Context, questionID int) (*bounty.Bounty, error) {
+	ret := _m.Called(ctx, questionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOpen")
+	}
+
+	var r0 *bounty.Bounty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*bounty.Bounty, error)); ok {
+		return rf(ctx, questionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *bounty.Bounty); ok {
+		r0 = rf(ctx, questionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bounty.Bounty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, questionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExpired provides a mock function with given fields: ctx, now, limit
+func (_m *BountyRepository) ListExpired(ctx context.Context, now time.Time, limit int) ([]*bounty.Bounty, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExpired")
+	}
+
+	var r0 []*bounty.Bounty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*bounty.Bounty, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*bounty.Bounty); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*bounty.Bounty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TopAnswer provides a mock function with given fields: ctx, questionID, since, exceptUserID
+func (_m *BountyRepository) TopAnswer(ctx context.Context, questionID int, since time.Time, exceptUserID string) (int, error) {
+	ret := _m.Called(ctx, questionID, since, exceptUserID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopAnswer")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, string) (int, error)); ok {
+		return rf(ctx, questionID, since, exceptUserID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, time.Time, string) int); ok {
+		r0 = rf(ctx, questionID, since, exceptUserID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, time.Time, string) error); ok {
+		r1 = rf(ctx, questionID, since, exceptUserID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBountyRepository creates a new instance of BountyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBountyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BountyRepository {
+	mock := &BountyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,265 @@
+package award
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entB "test-question/internal/entity/bounty"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=bountyRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	bountyRepository interface {
+		GetOpen(ctx context.Context, questionID int) (*entB.Bounty, error)
+		Close(ctx context.Context, id int, status entB.Status, answerID int, at time.Time) error
+		ListExpired(ctx context.Context, now time.Time, limit int) ([]*entB.Bounty, error)
+		TopAnswer(ctx context.Context, questionID int, since time.Time, exceptUserID string) (int, error)
+	}
+
+	answerRepository interface {
+		GetByID(ctx context.Context, id int) (*entA.Answer, error)
+	}
+
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+	}
+
+	reputationRepository interface {
+		Add(ctx context.Context, e *entR.Entry) (*entR.Entry, error)
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type Config struct {
+	// BatchSize bounds the expired bounties settled per run.
+	BatchSize int
+}
+
+type UseCase struct {
+	bounties   bountyRepository
+	answers    answerRepository
+	questions  questionRepository
+	reputation reputationRepository
+	uow        unitOfWork
+	timer      timer
+	logger     logger
+	cfg        Config
+}
+
+func NewUseCase(
+	bounties bountyRepository,
+	answers answerRepository,
+	questions questionRepository,
+	reputation reputationRepository,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+	cfg Config,
+) *UseCase {
+	return &UseCase{
+		bounties:   bounties,
+		answers:    answers,
+		questions:  questions,
+		reputation: reputation,
+		uow:        uow,
+		timer:      timer,
+		logger:     logger,
+		cfg:        cfg,
+	}
+}
+
+// Award pays the open bounty of the answer's question to the answer's
+// author. Only the asker may pick the answer, and not one of their own.
+func (uc *UseCase) Award(ctx context.Context, answerID int, userID string) (*entB.Bounty, error) {
+	a, err := uc.answers.GetByID(ctx, answerID)
+	if err != nil {
+		if errors.Is(err, entA.ErrAnswerNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("get answer: %w", err)
+	}
+	if a.HiddenAt != nil {
+		return nil, entA.ErrAnswerNotFound
+	}
+
+	q, err := uc.questions.GetByID(ctx, a.QuestionID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return nil, entA.ErrRequestedQuestionNotFound
+		}
+		return nil, fmt.Errorf("get question: %w", err)
+	}
+
+	if q.UserID != userID {
+		return nil, entB.ErrAccessDenied
+	}
+	if a.UserID == userID {
+		return nil, entB.ErrOwnAnswer
+	}
+
+	var b *entB.Bounty
+
+	err = uc.uow.Do(ctx, func(ctx context.Context) error {
+		b, err = uc.bounties.GetOpen(ctx, q.ID)
+		if err != nil {
+			return fmt.Errorf("get bounty: %w", err)
+		}
+
+		return uc.pay(ctx, b, a.ID, a.UserID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// AwardAccepted pays the open bounty of q, if any, to the author of the
+// accepted answer a. It is meant to run in the transaction accepting a;
+// accepting the asker's own answer leaves the bounty open.
+func (uc *UseCase) AwardAccepted(ctx context.Context, q *entQ.Question, a *entA.Answer) error {
+	if a.UserID == q.UserID {
+		return nil
+	}
+
+	b, err := uc.bounties.GetOpen(ctx, q.ID)
+	if errors.Is(err, entB.ErrBountyNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get bounty: %w", err)
+	}
+
+	return uc.pay(ctx, b, a.ID, a.UserID)
+}
+
+// Expire settles bounties that ran out: each goes to the highest voted
+// answer posted while it was open, or back to the asker when no answer has
+// a positive score. It returns the number of bounties settled.
+func (uc *UseCase) Expire(ctx context.Context) (int, error) {
+	now := uc.timer.Now()
+
+	expired, err := uc.bounties.ListExpired(ctx, now, uc.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("list expired bounties: %w", err)
+	}
+
+	var settled int
+
+	for _, b := range expired {
+		err = uc.uow.Do(ctx, func(ctx context.Context) error {
+			return uc.settle(ctx, b)
+		})
+		if errors.Is(err, entB.ErrBountyNotFound) {
+			continue
+		}
+		if err != nil {
+			return settled, err
+		}
+		settled++
+	}
+
+	uc.logger.DebugContext(ctx, "expired bounties settled", "count", settled)
+
+	return settled, nil
+}
+
+func (uc *UseCase) settle(ctx context.Context, b *entB.Bounty) error {
+	answerID, err := uc.bounties.TopAnswer(ctx, b.QuestionID, b.CreatedAt, b.UserID)
+	if err != nil {
+		return fmt.Errorf("find top answer: %w", err)
+	}
+
+	if answerID != 0 {
+		a, err := uc.answers.GetByID(ctx, answerID)
+		if err != nil {
+			return fmt.Errorf("get answer: %w", err)
+		}
+		return uc.pay(ctx, b, a.ID, a.UserID)
+	}
+
+	now := uc.timer.Now()
+	if err = uc.bounties.Close(ctx, b.ID, entB.StatusRefunded, 0, now); err != nil {
+		return fmt.Errorf("close bounty: %w", err)
+	}
+
+	_, err = uc.reputation.Add(ctx, &entR.Entry{
+		UserID:      b.UserID,
+		ActorID:     b.UserID,
+		Reason:      entR.ReasonBountyRefunded,
+		Delta:       b.Amount,
+		SubjectType: entR.SubjectQuestion,
+		SubjectID:   b.QuestionID,
+		CreatedAt:   now,
+	})
+	if err != nil {
+		return fmt.Errorf("add reputation: %w", err)
+	}
+
+	b.Status = entB.StatusRefunded
+	b.ClosedAt = &now
+
+	uc.logger.DebugContext(ctx, "bounty refunded",
+		"bounty_id", b.ID,
+		"question_id", b.QuestionID,
+	)
+
+	return nil
+}
+
+// pay closes b in favour of the answer and credits its author.
+func (uc *UseCase) pay(ctx context.Context, b *entB.Bounty, answerID int, authorID string) error {
+	now := uc.timer.Now()
+	if err := uc.bounties.Close(ctx, b.ID, entB.StatusAwarded, answerID, now); err != nil {
+		return fmt.Errorf("close bounty: %w", err)
+	}
+
+	_, err := uc.reputation.Add(ctx, &entR.Entry{
+		UserID:      authorID,
+		ActorID:     b.UserID,
+		Reason:      entR.ReasonBountyAwarded,
+		Delta:       b.Amount,
+		SubjectType: entR.SubjectAnswer,
+		SubjectID:   answerID,
+		CreatedAt:   now,
+	})
+	if err != nil {
+		return fmt.Errorf("add reputation: %w", err)
+	}
+
+	b.Status = entB.StatusAwarded
+	b.AnswerID = answerID
+	b.ClosedAt = &now
+
+	uc.logger.DebugContext(ctx, "bounty awarded",
+		"bounty_id", b.ID,
+		"question_id", b.QuestionID,
+		"answer_id", answerID,
+	)
+
+	return nil
+}
@@ -0,0 +1,520 @@
+package award_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entB "test-question/internal/entity/bounty"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+	uc "test-question/internal/usecase/bounty/award"
+	"test-question/internal/usecase/bounty/award/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var cfg = uc.Config{BatchSize: 10}
+
+func TestAward(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAnswers.
+		On("GetByID", ctx, 9).
+		Return(&entA.Answer{ID: 9, QuestionID: 7, UserID: "u2"}, nil)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "asker"}, nil)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mBounties.
+		On("GetOpen", ctx, 7).
+		Return(&entB.Bounty{ID: 3, QuestionID: 7, UserID: "asker", Amount: 100, Status: entB.StatusOpen}, nil)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mBounties.
+		On("Close", ctx, 3, entB.StatusAwarded, 9, now).
+		Return(nil)
+
+	mReputation.
+		On("Add", ctx, &entR.Entry{
+			UserID:      "u2",
+			ActorID:     "asker",
+			Reason:      entR.ReasonBountyAwarded,
+			Delta:       100,
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   9,
+			CreatedAt:   now,
+		}).
+		Return(&entR.Entry{}, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"bounty awarded",
+			"bounty_id", 3,
+			"question_id", 7,
+			"answer_id", 9,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	b, err := ucase.Award(ctx, 9, "asker")
+	require.NoError(t, err)
+	require.Equal(t, entB.StatusAwarded, b.Status)
+	require.Equal(t, 9, b.AnswerID)
+	require.Equal(t, &now, b.ClosedAt)
+}
+
+func TestAward_NotAsker(t *testing.T) {
+	ctx := context.Background()
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAnswers.
+		On("GetByID", ctx, 9).
+		Return(&entA.Answer{ID: 9, QuestionID: 7, UserID: "u2"}, nil)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "asker"}, nil)
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Award(ctx, 9, "u3")
+	require.ErrorIs(t, err, entB.ErrAccessDenied)
+}
+
+func TestAward_OwnAnswer(t *testing.T) {
+	ctx := context.Background()
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAnswers.
+		On("GetByID", ctx, 9).
+		Return(&entA.Answer{ID: 9, QuestionID: 7, UserID: "asker"}, nil)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "asker"}, nil)
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Award(ctx, 9, "asker")
+	require.ErrorIs(t, err, entB.ErrOwnAnswer)
+}
+
+func TestAward_HiddenAnswer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAnswers.
+		On("GetByID", ctx, 9).
+		Return(&entA.Answer{ID: 9, QuestionID: 7, UserID: "u2", HiddenAt: &now}, nil)
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Award(ctx, 9, "asker")
+	require.ErrorIs(t, err, entA.ErrAnswerNotFound)
+}
+
+func TestAward_NoOpenBounty(t *testing.T) {
+	ctx := context.Background()
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAnswers.
+		On("GetByID", ctx, 9).
+		Return(&entA.Answer{ID: 9, QuestionID: 7, UserID: "u2"}, nil)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "asker"}, nil)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mBounties.
+		On("GetOpen", ctx, 7).
+		Return(nil, entB.ErrBountyNotFound)
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Award(ctx, 9, "asker")
+	require.ErrorIs(t, err, entB.ErrBountyNotFound)
+}
+
+func TestAwardAccepted(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mBounties.
+		On("GetOpen", ctx, 7).
+		Return(&entB.Bounty{ID: 3, QuestionID: 7, UserID: "asker", Amount: 100, Status: entB.StatusOpen}, nil)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mBounties.
+		On("Close", ctx, 3, entB.StatusAwarded, 9, now).
+		Return(nil)
+
+	mReputation.
+		On("Add", ctx, &entR.Entry{
+			UserID:      "u2",
+			ActorID:     "asker",
+			Reason:      entR.ReasonBountyAwarded,
+			Delta:       100,
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   9,
+			CreatedAt:   now,
+		}).
+		Return(&entR.Entry{}, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"bounty awarded",
+			"bounty_id", 3,
+			"question_id", 7,
+			"answer_id", 9,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	err := ucase.AwardAccepted(ctx, &entQ.Question{ID: 7, UserID: "asker"}, &entA.Answer{ID: 9, UserID: "u2"})
+	require.NoError(t, err)
+}
+
+func TestAwardAccepted_NoBounty(t *testing.T) {
+	ctx := context.Background()
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mBounties.
+		On("GetOpen", ctx, 7).
+		Return(nil, entB.ErrBountyNotFound)
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	err := ucase.AwardAccepted(ctx, &entQ.Question{ID: 7, UserID: "asker"}, &entA.Answer{ID: 9, UserID: "u2"})
+	require.NoError(t, err)
+}
+
+func TestAwardAccepted_OwnAnswerKeepsBounty(t *testing.T) {
+	ctx := context.Background()
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	err := ucase.AwardAccepted(ctx, &entQ.Question{ID: 7, UserID: "asker"}, &entA.Answer{ID: 9, UserID: "asker"})
+	require.NoError(t, err)
+}
+
+func TestExpire_AwardsTopAnswer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	started := now.Add(-7 * 24 * time.Hour)
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mBounties.
+		On("ListExpired", ctx, now, 10).
+		Return([]*entB.Bounty{{ID: 3, QuestionID: 7, UserID: "asker", Amount: 100, Status: entB.StatusOpen, CreatedAt: started}}, nil)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mBounties.
+		On("TopAnswer", ctx, 7, started, "asker").
+		Return(9, nil)
+
+	mAnswers.
+		On("GetByID", ctx, 9).
+		Return(&entA.Answer{ID: 9, QuestionID: 7, UserID: "u2"}, nil)
+
+	mBounties.
+		On("Close", ctx, 3, entB.StatusAwarded, 9, now).
+		Return(nil)
+
+	mReputation.
+		On("Add", ctx, &entR.Entry{
+			UserID:      "u2",
+			ActorID:     "asker",
+			Reason:      entR.ReasonBountyAwarded,
+			Delta:       100,
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   9,
+			CreatedAt:   now,
+		}).
+		Return(&entR.Entry{}, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"bounty awarded",
+			"bounty_id", 3,
+			"question_id", 7,
+			"answer_id", 9,
+		).
+		Return()
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"expired bounties settled",
+			"count", 1,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	n, err := ucase.Expire(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func TestExpire_RefundsWithoutAnswer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	started := now.Add(-7 * 24 * time.Hour)
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mBounties.
+		On("ListExpired", ctx, now, 10).
+		Return([]*entB.Bounty{{ID: 3, QuestionID: 7, UserID: "asker", Amount: 100, Status: entB.StatusOpen, CreatedAt: started}}, nil)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mBounties.
+		On("TopAnswer", ctx, 7, started, "asker").
+		Return(0, nil)
+
+	mBounties.
+		On("Close", ctx, 3, entB.StatusRefunded, 0, now).
+		Return(nil)
+
+	mReputation.
+		On("Add", ctx, &entR.Entry{
+			UserID:      "asker",
+			ActorID:     "asker",
+			Reason:      entR.ReasonBountyRefunded,
+			Delta:       100,
+			SubjectType: entR.SubjectQuestion,
+			SubjectID:   7,
+			CreatedAt:   now,
+		}).
+		Return(&entR.Entry{}, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"bounty refunded",
+			"bounty_id", 3,
+			"question_id", 7,
+		).
+		Return()
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"expired bounties settled",
+			"count", 1,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	n, err := ucase.Expire(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func TestExpire_SkipsBountyClosedMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	started := now.Add(-7 * 24 * time.Hour)
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mBounties.
+		On("ListExpired", ctx, now, 10).
+		Return([]*entB.Bounty{{ID: 3, QuestionID: 7, UserID: "asker", Amount: 100, Status: entB.StatusOpen, CreatedAt: started}}, nil)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mBounties.
+		On("TopAnswer", ctx, 7, started, "asker").
+		Return(0, nil)
+
+	mBounties.
+		On("Close", ctx, 3, entB.StatusRefunded, 0, now).
+		Return(entB.ErrBountyNotFound)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"expired bounties settled",
+			"count", 0,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	n, err := ucase.Expire(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestExpire_Error(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	started := now.Add(-7 * 24 * time.Hour)
+
+	mBounties := mocks.NewBountyRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mQuestions := mocks.NewQuestionRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mBounties.
+		On("ListExpired", ctx, now, 10).
+		Return([]*entB.Bounty{{ID: 3, QuestionID: 7, UserID: "asker", Amount: 100, Status: entB.StatusOpen, CreatedAt: started}}, nil)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mBounties.
+		On("TopAnswer", ctx, 7, started, "asker").
+		Return(0, errors.New("db down"))
+
+	ucase := uc.NewUseCase(mBounties, mAnswers, mQuestions, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Expire(ctx)
+	require.ErrorContains(t, err, "find top answer")
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	bounty "test-question/internal/entity/bounty"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// BountyRepository is an autogenerated mock type for the bountyRepository type
+type BountyRepository struct {
+	mock.Mock
+}
+
+// ListFeatured provides a mock function with given fields: ctx, now, limit
+func (_m *BountyRepository) ListFeatured(ctx context.Context, now time.Time, limit int) ([]*bounty.Featured, error) {
+	ret := _m.Called(ctx, now, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFeatured")
+	}
+
+	var r0 []*bounty.Featured
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*bounty.Featured, error)); ok {
+		return rf(ctx, now, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*bounty.Featured); ok {
+		r0 = rf(ctx, now, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*bounty.Featured)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, now, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBountyRepository creates a new instance of BountyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBountyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BountyRepository {
+	mock := &BountyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,48 @@
+package featured
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entB "test-question/internal/entity/bounty"
+)
+
+//go:generate mockery --name=bountyRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	bountyRepository interface {
+		ListFeatured(ctx context.Context, now time.Time, limit int) ([]*entB.Featured, error)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo   bountyRepository
+	timer  timer
+	logger logger
+}
+
+func NewUseCase(repo bountyRepository, timer timer, logger logger) *UseCase {
+	return &UseCase{repo: repo, timer: timer, logger: logger}
+}
+
+// ListFeatured returns up to limit open bounties, those ending soonest first.
+func (uc *UseCase) ListFeatured(ctx context.Context, limit int) ([]*entB.Featured, error) {
+	out, err := uc.repo.ListFeatured(ctx, uc.timer.Now(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("list featured bounties: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "featured bounties listed", "count", len(out))
+	return out, nil
+}
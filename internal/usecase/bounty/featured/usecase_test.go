@@ -0,0 +1,69 @@
+package featured_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entB "test-question/internal/entity/bounty"
+	uc "test-question/internal/usecase/bounty/featured"
+	"test-question/internal/usecase/bounty/featured/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListFeatured(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mBounties := mocks.NewBountyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	items := []*entB.Featured{{Bounty: entB.Bounty{ID: 3}, QuestionText: "why"}}
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mBounties.
+		On("ListFeatured", ctx, now, 20).
+		Return(items, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"featured bounties listed",
+			"count", 1,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mBounties, mTimer, mLogger)
+
+	got, err := ucase.ListFeatured(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, items, got)
+}
+
+func TestListFeatured_Error(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mBounties := mocks.NewBountyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mBounties.
+		On("ListFeatured", ctx, now, 20).
+		Return(nil, errors.New("db down"))
+
+	ucase := uc.NewUseCase(mBounties, mTimer, mLogger)
+
+	_, err := ucase.ListFeatured(ctx, 20)
+	require.ErrorContains(t, err, "list featured bounties")
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	bounty "test-question/internal/entity/bounty"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BountyRepository is an autogenerated mock type for the bountyRepository type
+type BountyRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, b
+func (_m *BountyRepository) Create(ctx context.Context, b *bounty.Bounty) (*bounty.Bounty, error) {
+	ret := _m.Called(ctx, b)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *bounty.Bounty
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *bounty.Bounty) (*bounty.Bounty, error)); ok {
+		return rf(ctx, b)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *bounty.Bounty) *bounty.Bounty); ok {
+		r0 = rf(ctx, b)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bounty.Bounty)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *bounty.Bounty) error); ok {
+		r1 = rf(ctx, b)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBountyRepository creates a new instance of BountyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBountyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BountyRepository {
+	mock := &BountyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
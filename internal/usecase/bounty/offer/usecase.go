@@ -0,0 +1,149 @@
+package offer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entB "test-question/internal/entity/bounty"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=bountyRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+	}
+
+	bountyRepository interface {
+		Create(ctx context.Context, b *entB.Bounty) (*entB.Bounty, error)
+	}
+
+	reputationRepository interface {
+		Spend(ctx context.Context, e *entR.Entry) (*entR.Entry, error)
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	questions  questionRepository
+	bounties   bountyRepository
+	reputation reputationRepository
+	uow        unitOfWork
+	timer      timer
+	logger     logger
+	cfg        entB.Config
+}
+
+func NewUseCase(
+	questions questionRepository,
+	bounties bountyRepository,
+	reputation reputationRepository,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+	cfg entB.Config,
+) *UseCase {
+	return &UseCase{
+		questions:  questions,
+		bounties:   bounties,
+		reputation: reputation,
+		uow:        uow,
+		timer:      timer,
+		logger:     logger,
+		cfg:        cfg,
+	}
+}
+
+// Offer opens a bounty of amount points on an open question of the user,
+// taking the points from the user's reputation in the same transaction.
+// A balance short of amount fails with ErrInsufficientReputation.
+func (uc *UseCase) Offer(ctx context.Context, questionID int, userID string, amount int) (*entB.Bounty, error) {
+	if err := uc.cfg.CheckAmount(amount); err != nil {
+		return nil, err
+	}
+
+	q, err := uc.questions.GetByID(ctx, questionID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("get question: %w", err)
+	}
+
+	if q.UserID != userID {
+		return nil, entB.ErrAccessDenied
+	}
+
+	switch q.Status {
+	case entQ.StatusClosed:
+		return nil, entQ.ErrQuestionClosed
+	case entQ.StatusLocked:
+		return nil, entQ.ErrQuestionLocked
+	}
+
+	now := uc.timer.Now()
+	b := &entB.Bounty{
+		QuestionID: questionID,
+		UserID:     userID,
+		Amount:     amount,
+		Status:     entB.StatusOpen,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(uc.cfg.Duration),
+	}
+
+	var out *entB.Bounty
+
+	err = uc.uow.Do(ctx, func(ctx context.Context) error {
+		out, err = uc.bounties.Create(ctx, b)
+		if err != nil {
+			return fmt.Errorf("create bounty: %w", err)
+		}
+
+		_, err = uc.reputation.Spend(ctx, &entR.Entry{
+			UserID:      userID,
+			ActorID:     userID,
+			Reason:      entR.ReasonBountyOffered,
+			Delta:       -amount,
+			SubjectType: entR.SubjectQuestion,
+			SubjectID:   questionID,
+			CreatedAt:   now,
+		})
+		if err != nil {
+			return fmt.Errorf("spend reputation: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.DebugContext(ctx, "bounty offered",
+		"bounty_id", out.ID,
+		"question_id", questionID,
+		"amount", amount,
+	)
+
+	return out, nil
+}
@@ -0,0 +1,280 @@
+package offer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entB "test-question/internal/entity/bounty"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+	uc "test-question/internal/usecase/bounty/offer"
+	"test-question/internal/usecase/bounty/offer/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var cfg = entB.Config{MinAmount: 50, MaxAmount: 500, Duration: 7 * 24 * time.Hour}
+
+func TestOffer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mBounties := mocks.NewBountyRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	want := &entB.Bounty{
+		QuestionID: 7,
+		UserID:     "u1",
+		Amount:     100,
+		Status:     entB.StatusOpen,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(cfg.Duration),
+	}
+	created := *want
+	created.ID = 3
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "u1", Status: entQ.StatusOpen}, nil)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mBounties.
+		On("Create", ctx, want).
+		Return(&created, nil)
+
+	mReputation.
+		On("Spend", ctx, &entR.Entry{
+			UserID:      "u1",
+			ActorID:     "u1",
+			Reason:      entR.ReasonBountyOffered,
+			Delta:       -100,
+			SubjectType: entR.SubjectQuestion,
+			SubjectID:   7,
+			CreatedAt:   now,
+		}).
+		Return(&entR.Entry{ID: 1}, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"bounty offered",
+			"bounty_id", 3,
+			"question_id", 7,
+			"amount", 100,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mQuestions, mBounties, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	got, err := ucase.Offer(ctx, 7, "u1", 100)
+	require.NoError(t, err)
+	require.Equal(t, &created, got)
+}
+
+func TestOffer_InvalidAmount(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mBounties := mocks.NewBountyRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	ucase := uc.NewUseCase(mQuestions, mBounties, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	for _, amount := range []int{0, 49, 501} {
+		_, err := ucase.Offer(ctx, 7, "u1", amount)
+		require.ErrorIs(t, err, entB.ErrInvalidAmount, amount)
+	}
+}
+
+func TestOffer_NotAsker(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mBounties := mocks.NewBountyRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "u2", Status: entQ.StatusOpen}, nil)
+
+	ucase := uc.NewUseCase(mQuestions, mBounties, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Offer(ctx, 7, "u1", 100)
+	require.ErrorIs(t, err, entB.ErrAccessDenied)
+}
+
+func TestOffer_QuestionClosed(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mBounties := mocks.NewBountyRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "u1", Status: entQ.StatusClosed}, nil)
+
+	ucase := uc.NewUseCase(mQuestions, mBounties, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Offer(ctx, 7, "u1", 100)
+	require.ErrorIs(t, err, entQ.ErrQuestionClosed)
+}
+
+func TestOffer_QuestionLocked(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mBounties := mocks.NewBountyRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "u1", Status: entQ.StatusLocked}, nil)
+
+	ucase := uc.NewUseCase(mQuestions, mBounties, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Offer(ctx, 7, "u1", 100)
+	require.ErrorIs(t, err, entQ.ErrQuestionLocked)
+}
+
+func TestOffer_QuestionNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mBounties := mocks.NewBountyRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(nil, entQ.ErrQuestionNotFound)
+
+	ucase := uc.NewUseCase(mQuestions, mBounties, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Offer(ctx, 7, "u1", 100)
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
+
+func TestOffer_AlreadyOpen(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mBounties := mocks.NewBountyRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "u1", Status: entQ.StatusOpen}, nil)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mBounties.
+		On("Create", ctx, mock.AnythingOfType("*bounty.Bounty")).
+		Return(nil, entB.ErrBountyExists)
+
+	ucase := uc.NewUseCase(mQuestions, mBounties, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Offer(ctx, 7, "u1", 100)
+	require.ErrorIs(t, err, entB.ErrBountyExists)
+}
+
+func TestOffer_InsufficientReputation(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mBounties := mocks.NewBountyRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(&entQ.Question{ID: 7, UserID: "u1", Status: entQ.StatusOpen}, nil)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mBounties.
+		On("Create", ctx, mock.AnythingOfType("*bounty.Bounty")).
+		Return(&entB.Bounty{ID: 3}, nil)
+
+	mReputation.
+		On("Spend", ctx, mock.AnythingOfType("*reputation.Entry")).
+		Return(nil, entR.ErrInsufficientReputation)
+
+	ucase := uc.NewUseCase(mQuestions, mBounties, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Offer(ctx, 7, "u1", 100)
+	require.ErrorIs(t, err, entR.ErrInsufficientReputation)
+}
+
+func TestOffer_QuestionRepoError(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mBounties := mocks.NewBountyRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.
+		On("GetByID", ctx, 7).
+		Return(nil, errors.New("db down"))
+
+	ucase := uc.NewUseCase(mQuestions, mBounties, mReputation, mUoW, mTimer, mLogger, cfg)
+
+	_, err := ucase.Offer(ctx, 7, "u1", 100)
+	require.ErrorContains(t, err, "get question")
+}
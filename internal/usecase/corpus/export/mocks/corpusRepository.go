@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	corpus "test-question/internal/entity/corpus"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CorpusRepository is an autogenerated mock type for the corpusRepository type
+type CorpusRepository struct {
+	mock.Mock
+}
+
+// ExportPage provides a mock function with given fields: ctx, afterID, limit
+func (_m *CorpusRepository) ExportPage(ctx context.Context, afterID int, limit int) ([]*corpus.Question, error) {
+	ret := _m.Called(ctx, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportPage")
+	}
+
+	var r0 []*corpus.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*corpus.Question, error)); ok {
+		return rf(ctx, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*corpus.Question); ok {
+		r0 = rf(ctx, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*corpus.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCorpusRepository creates a new instance of CorpusRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCorpusRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CorpusRepository {
+	mock := &CorpusRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
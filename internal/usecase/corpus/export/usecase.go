@@ -0,0 +1,70 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	ent "test-question/internal/entity/corpus"
+)
+
+//go:generate mockery --name=corpusRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	corpusRepository interface {
+		ExportPage(ctx context.Context, afterID, limit int) ([]*ent.Question, error)
+	}
+
+	logger interface {
+		InfoContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type Config struct {
+	// PageSize is how many questions are read per query.
+	PageSize int
+}
+
+type UseCase struct {
+	repo   corpusRepository
+	logger logger
+	cfg    Config
+}
+
+func NewUseCase(repo corpusRepository, logger logger, cfg Config) *UseCase {
+	return &UseCase{repo: repo, logger: logger, cfg: cfg}
+}
+
+// Export streams every visible question with its answers to w, one JSON
+// object per line in id order, and returns how many questions it wrote.
+func (uc *UseCase) Export(ctx context.Context, w io.Writer) (int, error) {
+	enc := json.NewEncoder(w)
+	written, answers, afterID := 0, 0, 0
+
+	for {
+		page, err := uc.repo.ExportPage(ctx, afterID, uc.cfg.PageSize)
+		if err != nil {
+			return written, fmt.Errorf("export page after %d: %w", afterID, err)
+		}
+		if len(page) == 0 {
+			break
+		}
+
+		for _, q := range page {
+			if err := enc.Encode(q); err != nil {
+				return written, fmt.Errorf("write question %d: %w", q.ID, err)
+			}
+			written++
+			answers += len(q.Answers)
+		}
+		afterID = page[len(page)-1].ID
+
+		uc.logger.InfoContext(ctx, "export progress", "questions", written, "answers", answers)
+	}
+
+	uc.logger.InfoContext(ctx, "corpus exported", "questions", written, "answers", answers)
+
+	return written, nil
+}
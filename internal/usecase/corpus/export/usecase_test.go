@@ -0,0 +1,65 @@
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/corpus"
+	uc "test-question/internal/usecase/corpus/export"
+	"test-question/internal/usecase/corpus/export/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport_StreamsPages(t *testing.T) {
+	ctx := context.Background()
+	created := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	repo := mocks.NewCorpusRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("ExportPage", mock.Anything, 0, 2).Return([]*ent.Question{
+		{ID: 1, Text: "one", Author: "alice", Status: "open", CreatedAt: created,
+			Answers: []ent.Answer{{ID: 5, Text: "a", Author: "bob", CreatedAt: created}}},
+		{ID: 3, Text: "three", Author: "bob", Status: "closed", CreatedAt: created, Answers: []ent.Answer{}},
+	}, nil)
+	repo.On("ExportPage", mock.Anything, 3, 2).Return([]*ent.Question{
+		{ID: 4, Text: "four", Author: "alice", Status: "open", CreatedAt: created, Answers: []ent.Answer{}},
+	}, nil)
+	repo.On("ExportPage", mock.Anything, 4, 2).Return(nil, nil)
+
+	log.On("InfoContext", mock.Anything, "export progress", "questions", 2, "answers", 1).Return()
+	log.On("InfoContext", mock.Anything, "export progress", "questions", 3, "answers", 1).Return()
+	log.On("InfoContext", mock.Anything, "corpus exported", "questions", 3, "answers", 1).Return()
+
+	var buf bytes.Buffer
+	n, err := uc.NewUseCase(repo, log, uc.Config{PageSize: 2}).Export(ctx, &buf)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+
+	var first ent.Question
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &first))
+	require.Equal(t, "one", first.Text)
+	require.Equal(t, "bob", first.Answers[0].Author)
+	require.True(t, created.Equal(first.CreatedAt))
+}
+
+func TestExport_RepoError(t *testing.T) {
+	repo := mocks.NewCorpusRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("ExportPage", mock.Anything, 0, 100).Return(nil, errors.New("db down"))
+
+	_, err := uc.NewUseCase(repo, log, uc.Config{PageSize: 100}).Export(context.Background(), &bytes.Buffer{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "export page after 0")
+}
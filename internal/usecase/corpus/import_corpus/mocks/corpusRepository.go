@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	corpus "test-question/internal/entity/corpus"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CorpusRepository is an autogenerated mock type for the corpusRepository type
+type CorpusRepository struct {
+	mock.Mock
+}
+
+// Load provides a mock function with given fields: ctx, plan
+func (_m *CorpusRepository) Load(ctx context.Context, plan *corpus.Plan) error {
+	ret := _m.Called(ctx, plan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Load")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *corpus.Plan) error); ok {
+		r0 = rf(ctx, plan)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserIDs provides a mock function with given fields: ctx, usernames
+func (_m *CorpusRepository) UserIDs(ctx context.Context, usernames []string) (map[string]string, error) {
+	ret := _m.Called(ctx, usernames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UserIDs")
+	}
+
+	var r0 map[string]string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (map[string]string, error)); ok {
+		return rf(ctx, usernames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) map[string]string); ok {
+		r0 = rf(ctx, usernames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, usernames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCorpusRepository creates a new instance of CorpusRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCorpusRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CorpusRepository {
+	mock := &CorpusRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,276 @@
+package import_corpus
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	ent "test-question/internal/entity/corpus"
+	entQ "test-question/internal/entity/question"
+)
+
+//go:generate mockery --name=corpusRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	corpusRepository interface {
+		UserIDs(ctx context.Context, usernames []string) (map[string]string, error)
+		Load(ctx context.Context, plan *ent.Plan) error
+	}
+
+	logger interface {
+		InfoContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+// maxLineSize bounds one corpus line, a question with all its answers.
+const maxLineSize = 64 << 20
+
+type Config struct {
+	// ProgressEvery is how many lines are read between progress logs.
+	ProgressEvery int
+}
+
+type UseCase struct {
+	repo   corpusRepository
+	logger logger
+	cfg    Config
+}
+
+func NewUseCase(repo corpusRepository, logger logger, cfg Config) *UseCase {
+	return &UseCase{repo: repo, logger: logger, cfg: cfg}
+}
+
+// record is a parsed line with its position in the file.
+type record struct {
+	line int
+	q    ent.Question
+}
+
+// Import validates the JSONL corpus read from r and bulk-loads it in one
+// transaction: authors are resolved by username, timestamps kept and ids
+// remapped. Any invalid line fails the whole import with ErrInvalidCorpus;
+// the report lists the problems by line. With dryRun nothing is written.
+func (uc *UseCase) Import(ctx context.Context, r io.Reader, dryRun bool) (*ent.Report, error) {
+	report := &ent.Report{DryRun: dryRun}
+
+	records, err := uc.read(ctx, r, report)
+	if err != nil {
+		return report, err
+	}
+
+	userIDs, err := uc.resolveAuthors(ctx, records)
+	if err != nil {
+		return report, err
+	}
+
+	plan := buildPlan(records, userIDs, report)
+	if len(report.Errors) > 0 {
+		return report, ent.ErrInvalidCorpus
+	}
+
+	report.Questions = len(plan.Questions)
+	report.Answers = len(plan.Answers)
+
+	if dryRun {
+		uc.logger.InfoContext(ctx, "corpus validated",
+			"questions", report.Questions,
+			"answers", report.Answers,
+		)
+		return report, nil
+	}
+
+	if err := uc.repo.Load(ctx, plan); err != nil {
+		return report, fmt.Errorf("load corpus: %w", err)
+	}
+
+	uc.logger.InfoContext(ctx, "corpus imported",
+		"questions", report.Questions,
+		"answers", report.Answers,
+		"dropped_refs", report.DroppedRefs,
+	)
+
+	return report, nil
+}
+
+// read parses and checks every line on its own.
+func (uc *UseCase) read(ctx context.Context, r io.Reader, report *ent.Report) ([]record, error) {
+	var records []record
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	for sc.Scan() {
+		report.Lines++
+		if uc.cfg.ProgressEvery > 0 && report.Lines%uc.cfg.ProgressEvery == 0 {
+			uc.logger.InfoContext(ctx, "import progress", "lines", report.Lines)
+		}
+
+		if len(sc.Bytes()) == 0 {
+			continue
+		}
+
+		var q ent.Question
+		if err := json.Unmarshal(sc.Bytes(), &q); err != nil {
+			report.Errors = append(report.Errors, ent.LineError{
+				Line: report.Lines,
+				Err:  fmt.Errorf("%w: %v", ent.ErrInvalidRecord, err),
+			})
+			continue
+		}
+
+		if err := validate(&q); err != nil {
+			report.Errors = append(report.Errors, ent.LineError{Line: report.Lines, Err: err})
+			continue
+		}
+
+		records = append(records, record{line: report.Lines, q: q})
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("read corpus: %w", err)
+	}
+
+	return records, nil
+}
+
+func validate(q *ent.Question) error {
+	if q.ID <= 0 || q.Text == "" || q.Author == "" || q.CreatedAt.IsZero() {
+		return fmt.Errorf("%w: question needs id, text, author and created_at", ent.ErrInvalidRecord)
+	}
+
+	switch entQ.Status(q.Status) {
+	case "":
+		q.Status = string(entQ.StatusOpen)
+	case entQ.StatusOpen, entQ.StatusClosed, entQ.StatusLocked:
+	default:
+		return fmt.Errorf("%w: unknown status %q", ent.ErrInvalidRecord, q.Status)
+	}
+
+	accepted := q.AcceptedAnswerID == 0
+	for _, a := range q.Answers {
+		if a.ID <= 0 || a.Text == "" || a.Author == "" || a.CreatedAt.IsZero() {
+			return fmt.Errorf("%w: answer needs id, text, author and created_at", ent.ErrInvalidRecord)
+		}
+		accepted = accepted || a.ID == q.AcceptedAnswerID
+	}
+	if !accepted {
+		return ent.ErrInvalidAccepted
+	}
+
+	return nil
+}
+
+func (uc *UseCase) resolveAuthors(ctx context.Context, records []record) (map[string]string, error) {
+	seen := map[string]struct{}{}
+	for _, rec := range records {
+		seen[rec.q.Author] = struct{}{}
+		for _, a := range rec.q.Answers {
+			seen[a.Author] = struct{}{}
+		}
+	}
+
+	usernames := make([]string, 0, len(seen))
+	for name := range seen {
+		usernames = append(usernames, name)
+	}
+	sort.Strings(usernames)
+
+	ids, err := uc.repo.UserIDs(ctx, usernames)
+	if err != nil {
+		return nil, fmt.Errorf("resolve authors: %w", err)
+	}
+
+	return ids, nil
+}
+
+// buildPlan checks the records against each other and the resolved authors and
+// turns source ids into positions. Problems go to the report.
+func buildPlan(records []record, userIDs map[string]string, report *ent.Report) *ent.Plan {
+	plan := &ent.Plan{
+		Questions: make([]ent.PlannedQuestion, 0, len(records)),
+	}
+
+	questionIndex := make(map[int]int, len(records))
+	answerSeen := map[int]struct{}{}
+
+	for _, rec := range records {
+		if err := checkRecord(rec.q, userIDs, questionIndex, answerSeen); err != nil {
+			report.Errors = append(report.Errors, ent.LineError{Line: rec.line, Err: err})
+			continue
+		}
+
+		qi := len(plan.Questions)
+		questionIndex[rec.q.ID] = qi
+
+		accepted := -1
+		for _, a := range rec.q.Answers {
+			answerSeen[a.ID] = struct{}{}
+			if a.ID == rec.q.AcceptedAnswerID {
+				accepted = len(plan.Answers)
+			}
+			plan.Answers = append(plan.Answers, ent.PlannedAnswer{
+				Question:  qi,
+				UserID:    userIDs[a.Author],
+				Text:      a.Text,
+				CreatedAt: a.CreatedAt,
+			})
+		}
+
+		plan.Questions = append(plan.Questions, ent.PlannedQuestion{
+			Text:           rec.q.Text,
+			UserID:         userIDs[rec.q.Author],
+			Status:         rec.q.Status,
+			CreatedAt:      rec.q.CreatedAt,
+			AcceptedAnswer: accepted,
+			DuplicateOf:    -1,
+		})
+	}
+
+	// duplicate marks may point forward, so they are resolved last
+	for _, rec := range records {
+		qi, ok := questionIndex[rec.q.ID]
+		if !ok || rec.q.DuplicateOf == 0 {
+			continue
+		}
+
+		target, ok := questionIndex[rec.q.DuplicateOf]
+		if !ok || target == qi {
+			report.DroppedRefs++
+			continue
+		}
+		plan.Questions[qi].DuplicateOf = target
+	}
+
+	return plan
+}
+
+func checkRecord(q ent.Question, userIDs map[string]string, questions map[int]int, answers map[int]struct{}) error {
+	if _, ok := questions[q.ID]; ok {
+		return fmt.Errorf("%w: question %d", ent.ErrDuplicateID, q.ID)
+	}
+
+	if _, ok := userIDs[q.Author]; !ok {
+		return fmt.Errorf("%w: %q", ent.ErrUnknownAuthor, q.Author)
+	}
+
+	ids := make(map[int]struct{}, len(q.Answers))
+	for _, a := range q.Answers {
+		if _, ok := answers[a.ID]; ok {
+			return fmt.Errorf("%w: answer %d", ent.ErrDuplicateID, a.ID)
+		}
+		if _, ok := ids[a.ID]; ok {
+			return fmt.Errorf("%w: answer %d", ent.ErrDuplicateID, a.ID)
+		}
+		ids[a.ID] = struct{}{}
+
+		if _, ok := userIDs[a.Author]; !ok {
+			return fmt.Errorf("%w: %q", ent.ErrUnknownAuthor, a.Author)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package import_corpus_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/corpus"
+	uc "test-question/internal/usecase/corpus/import_corpus"
+	"test-question/internal/usecase/corpus/import_corpus/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var created = time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+
+var users = map[string]string{
+	"alice": "11111111-1111-1111-1111-111111111111",
+	"bob":   "22222222-2222-2222-2222-222222222222",
+}
+
+const corpus = `{"id":10,"text":"canonical","author":"alice","status":"closed","accepted_answer_id":21,"created_at":"2023-05-01T12:00:00Z","answers":[{"id":20,"text":"first","author":"bob","created_at":"2023-05-01T12:00:00Z"},{"id":21,"text":"second","author":"alice","created_at":"2023-05-01T12:00:00Z"}]}
+
+{"id":11,"text":"duplicate","author":"bob","duplicate_of":10,"created_at":"2023-05-01T12:00:00Z","answers":[]}
+{"id":12,"text":"dangling","author":"bob","duplicate_of":99,"created_at":"2023-05-01T12:00:00Z"}
+`
+
+func TestImport_LoadsPlan(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewCorpusRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("UserIDs", ctx, []string{"alice", "bob"}).
+		Return(users, nil)
+
+	mRepo.
+		On("Load", ctx, &ent.Plan{
+			Questions: []ent.PlannedQuestion{
+				{Text: "canonical", UserID: users["alice"], Status: "closed", CreatedAt: created, AcceptedAnswer: 1, DuplicateOf: -1},
+				{Text: "duplicate", UserID: users["bob"], Status: "open", CreatedAt: created, AcceptedAnswer: -1, DuplicateOf: 0},
+				{Text: "dangling", UserID: users["bob"], Status: "open", CreatedAt: created, AcceptedAnswer: -1, DuplicateOf: -1},
+			},
+			Answers: []ent.PlannedAnswer{
+				{Question: 0, UserID: users["bob"], Text: "first", CreatedAt: created},
+				{Question: 0, UserID: users["alice"], Text: "second", CreatedAt: created},
+			},
+		}).
+		Return(nil)
+
+	mLogger.
+		On("InfoContext", ctx, "import progress", "lines", 2).
+		Return()
+
+	mLogger.
+		On("InfoContext", ctx, "import progress", "lines", 4).
+		Return()
+
+	mLogger.
+		On("InfoContext",
+			ctx,
+			"corpus imported",
+			"questions", 3,
+			"answers", 2,
+			"dropped_refs", 1,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mRepo, mLogger, uc.Config{ProgressEvery: 2})
+
+	report, err := ucase.Import(ctx, strings.NewReader(corpus), false)
+	require.NoError(t, err)
+	require.Equal(t, &ent.Report{Lines: 4, Questions: 3, Answers: 2, DroppedRefs: 1}, report)
+}
+
+func TestImport_DryRunWritesNothing(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewCorpusRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("UserIDs", ctx, []string{"alice", "bob"}).
+		Return(users, nil)
+
+	mLogger.
+		On("InfoContext",
+			ctx,
+			"corpus validated",
+			"questions", 3,
+			"answers", 2,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mRepo, mLogger, uc.Config{})
+
+	report, err := ucase.Import(ctx, strings.NewReader(corpus), true)
+	require.NoError(t, err)
+	require.True(t, report.DryRun)
+	require.Equal(t, 3, report.Questions)
+}
+
+func TestImport_InvalidLines(t *testing.T) {
+	ctx := context.Background()
+
+	const bad = `not json
+{"id":1,"text":"","author":"alice","created_at":"2023-05-01T12:00:00Z"}
+{"id":2,"text":"q","author":"carol","created_at":"2023-05-01T12:00:00Z"}
+{"id":3,"text":"q","author":"alice","accepted_answer_id":7,"created_at":"2023-05-01T12:00:00Z"}
+{"id":4,"text":"q","author":"alice","status":"archived","created_at":"2023-05-01T12:00:00Z"}
+{"id":5,"text":"q","author":"alice","created_at":"2023-05-01T12:00:00Z"}
+{"id":5,"text":"again","author":"alice","created_at":"2023-05-01T12:00:00Z"}
+`
+
+	mRepo := mocks.NewCorpusRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("UserIDs", ctx, []string{"alice", "carol"}).
+		Return(map[string]string{"alice": users["alice"]}, nil)
+
+	ucase := uc.NewUseCase(mRepo, mLogger, uc.Config{})
+
+	report, err := ucase.Import(ctx, strings.NewReader(bad), false)
+	require.ErrorIs(t, err, ent.ErrInvalidCorpus)
+	require.Equal(t, 7, report.Lines)
+
+	want := map[int]error{
+		1: ent.ErrInvalidRecord,
+		2: ent.ErrInvalidRecord,
+		3: ent.ErrUnknownAuthor,
+		4: ent.ErrInvalidAccepted,
+		5: ent.ErrInvalidRecord,
+		7: ent.ErrDuplicateID,
+	}
+	require.Len(t, report.Errors, len(want))
+	for _, le := range report.Errors {
+		require.ErrorIs(t, le, want[le.Line], "line %d", le.Line)
+	}
+}
+
+func TestImport_LoadError(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewCorpusRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("UserIDs", ctx, []string{"alice", "bob"}).
+		Return(users, nil)
+
+	mRepo.
+		On("Load", ctx, mock.Anything).
+		Return(errors.New("copy failed"))
+
+	ucase := uc.NewUseCase(mRepo, mLogger, uc.Config{})
+
+	_, err := ucase.Import(ctx, strings.NewReader(corpus), false)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "load corpus")
+}
@@ -0,0 +1,125 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	draft "test-question/internal/entity/draft"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// DraftRepository is an autogenerated mock type for the draftRepository type
+type DraftRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, userID, key
+func (_m *DraftRepository) Delete(ctx context.Context, userID string, key draft.Key) error {
+	ret := _m.Called(ctx, userID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, draft.Key) error); ok {
+		r0 = rf(ctx, userID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, userID, key, now
+func (_m *DraftRepository) Get(ctx context.Context, userID string, key draft.Key, now time.Time) (*draft.Draft, error) {
+	ret := _m.Called(ctx, userID, key, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *draft.Draft
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, draft.Key, time.Time) (*draft.Draft, error)); ok {
+		return rf(ctx, userID, key, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, draft.Key, time.Time) *draft.Draft); ok {
+		r0 = rf(ctx, userID, key, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*draft.Draft)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, draft.Key, time.Time) error); ok {
+		r1 = rf(ctx, userID, key, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeExpired provides a mock function with given fields: ctx, before
+func (_m *DraftRepository) PurgeExpired(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpired")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: ctx, d
+func (_m *DraftRepository) Save(ctx context.Context, d *draft.Draft) error {
+	ret := _m.Called(ctx, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *draft.Draft) error); ok {
+		r0 = rf(ctx, d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewDraftRepository creates a new instance of DraftRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDraftRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *DraftRepository {
+	mock := &DraftRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
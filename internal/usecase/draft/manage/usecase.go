@@ -0,0 +1,116 @@
+package manage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ent "test-question/internal/entity/draft"
+)
+
+//go:generate mockery --name=draftRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	draftRepository interface {
+		Save(ctx context.Context, d *ent.Draft) error
+		Get(ctx context.Context, userID string, key ent.Key, now time.Time) (*ent.Draft, error)
+		Delete(ctx context.Context, userID string, key ent.Key) error
+		PurgeExpired(ctx context.Context, before time.Time) (int, error)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type Config struct {
+	// TTL is how long a draft is kept after it was last saved.
+	TTL time.Duration
+}
+
+type UseCase struct {
+	drafts draftRepository
+	timer  timer
+	logger logger
+	cfg    Config
+}
+
+func NewUseCase(drafts draftRepository, timer timer, logger logger, cfg Config) *UseCase {
+	return &UseCase{
+		drafts: drafts,
+		timer:  timer,
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+// Save stores the user's draft under key, replacing the previous one and
+// restarting its expiry.
+func (uc *UseCase) Save(
+	ctx context.Context,
+	userID string,
+	key ent.Key,
+	text string,
+	attachmentIDs []int,
+) (*ent.Draft, error) {
+	now := uc.timer.Now()
+	d := &ent.Draft{
+		UserID:        userID,
+		Key:           key,
+		Text:          text,
+		AttachmentIDs: attachmentIDs,
+		UpdatedAt:     now,
+		ExpiresAt:     now.Add(uc.cfg.TTL),
+	}
+
+	if err := uc.drafts.Save(ctx, d); err != nil {
+		return nil, fmt.Errorf("save draft: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "draft saved",
+		"user_id", userID,
+		"key", key.String(),
+	)
+
+	return d, nil
+}
+
+func (uc *UseCase) Get(ctx context.Context, userID string, key ent.Key) (*ent.Draft, error) {
+	d, err := uc.drafts.Get(ctx, userID, key, uc.timer.Now())
+	if err != nil {
+		return nil, fmt.Errorf("get draft: %w", err)
+	}
+
+	return d, nil
+}
+
+func (uc *UseCase) Delete(ctx context.Context, userID string, key ent.Key) error {
+	if err := uc.drafts.Delete(ctx, userID, key); err != nil {
+		return fmt.Errorf("delete draft: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "draft deleted",
+		"user_id", userID,
+		"key", key.String(),
+	)
+
+	return nil
+}
+
+// Purge deletes expired drafts.
+func (uc *UseCase) Purge(ctx context.Context) (int, error) {
+	n, err := uc.drafts.PurgeExpired(ctx, uc.timer.Now())
+	if err != nil {
+		return 0, fmt.Errorf("purge drafts: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "drafts purged", "count", n)
+
+	return n, nil
+}
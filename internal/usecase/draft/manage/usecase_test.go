@@ -0,0 +1,213 @@
+package manage_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/draft"
+	uc "test-question/internal/usecase/draft/manage"
+	"test-question/internal/usecase/draft/manage/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const ttl = 72 * time.Hour
+
+func TestSave(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	want := &ent.Draft{
+		UserID:        "u1",
+		Key:           ent.AnswerKey(7),
+		Text:          "try restarting it",
+		AttachmentIDs: []int{3},
+		UpdatedAt:     now,
+		ExpiresAt:     now.Add(ttl),
+	}
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Save", ctx, want).
+		Return(nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"draft saved",
+			"user_id", "u1",
+			"key", "answer-7",
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mDrafts, mTimer, mLogger, uc.Config{TTL: ttl})
+
+	got, err := ucase.Save(ctx, "u1", ent.AnswerKey(7), "try restarting it", []int{3})
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
+
+func TestSave_RepoError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Save", ctx, mock.Anything).
+		Return(errors.New("db down"))
+
+	ucase := uc.NewUseCase(mDrafts, mTimer, mLogger, uc.Config{TTL: ttl})
+
+	_, err := ucase.Save(ctx, "u1", ent.QuestionKey, "why", nil)
+	require.ErrorContains(t, err, "save draft")
+}
+
+func TestGet(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	d := &ent.Draft{UserID: "u1", Key: ent.QuestionKey, Text: "why"}
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Get", ctx, "u1", ent.QuestionKey, now).
+		Return(d, nil)
+
+	ucase := uc.NewUseCase(mDrafts, mTimer, mLogger, uc.Config{TTL: ttl})
+
+	got, err := ucase.Get(ctx, "u1", ent.QuestionKey)
+	require.NoError(t, err)
+	require.Equal(t, d, got)
+}
+
+func TestGet_NotFound(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Get", ctx, "u1", ent.QuestionKey, now).
+		Return(nil, ent.ErrDraftNotFound)
+
+	ucase := uc.NewUseCase(mDrafts, mTimer, mLogger, uc.Config{TTL: ttl})
+
+	_, err := ucase.Get(ctx, "u1", ent.QuestionKey)
+	require.ErrorIs(t, err, ent.ErrDraftNotFound)
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.Background()
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mDrafts.
+		On("Delete", ctx, "u1", ent.AnswerKey(2)).
+		Return(nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"draft deleted",
+			"user_id", "u1",
+			"key", "answer-2",
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mDrafts, mTimer, mLogger, uc.Config{TTL: ttl})
+
+	require.NoError(t, ucase.Delete(ctx, "u1", ent.AnswerKey(2)))
+}
+
+func TestDelete_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mDrafts.
+		On("Delete", ctx, "u1", ent.QuestionKey).
+		Return(ent.ErrDraftNotFound)
+
+	ucase := uc.NewUseCase(mDrafts, mTimer, mLogger, uc.Config{TTL: ttl})
+
+	require.ErrorIs(t, ucase.Delete(ctx, "u1", ent.QuestionKey), ent.ErrDraftNotFound)
+}
+
+func TestPurge(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("PurgeExpired", ctx, now).
+		Return(2, nil)
+
+	mLogger.
+		On("DebugContext", ctx, "drafts purged", "count", 2).
+		Return()
+
+	ucase := uc.NewUseCase(mDrafts, mTimer, mLogger, uc.Config{TTL: ttl})
+
+	n, err := ucase.Purge(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+}
+
+func TestParseKey(t *testing.T) {
+	for s, want := range map[string]ent.Key{
+		"question":  ent.QuestionKey,
+		"answer-1":  ent.AnswerKey(1),
+		"answer-42": ent.AnswerKey(42),
+	} {
+		got, err := ent.ParseKey(s)
+		require.NoError(t, err, s)
+		require.Equal(t, want, got, s)
+		require.Equal(t, s, got.String())
+	}
+
+	for _, s := range []string{"", "questions", "answer-", "answer-0", "answer--1", "answer-01", "answer-x", "answer-1 "} {
+		_, err := ent.ParseKey(s)
+		require.ErrorIs(t, err, ent.ErrInvalidKey, s)
+	}
+}
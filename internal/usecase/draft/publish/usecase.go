@@ -0,0 +1,143 @@
+package publish
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	ent "test-question/internal/entity/draft"
+	entQ "test-question/internal/entity/question"
+)
+
+//go:generate mockery --name=draftRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=questionCreator --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerCreator --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	draftRepository interface {
+		Take(ctx context.Context, userID string, key ent.Key, now time.Time) (*ent.Draft, error)
+	}
+
+	questionCreator interface {
+		CreateQuestion(ctx context.Context, userID, text string, force bool, attachmentIDs []int) (*entQ.Question, []*entQ.SimilarQuestion, error)
+	}
+
+	answerCreator interface {
+		CreateAnswer(ctx context.Context, questionID int, userID, text string, attachmentIDs []int) (*entA.Answer, error)
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	drafts         draftRepository
+	createQuestion questionCreator
+	createAnswer   answerCreator
+	uow            unitOfWork
+	timer          timer
+	logger         logger
+}
+
+func NewUseCase(
+	drafts draftRepository,
+	createQuestion questionCreator,
+	createAnswer answerCreator,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		drafts:         drafts,
+		createQuestion: createQuestion,
+		createAnswer:   createAnswer,
+		uow:            uow,
+		timer:          timer,
+		logger:         logger,
+	}
+}
+
+// PublishQuestion creates a question from the user's question draft and
+// deletes the draft in the same transaction. Creating it is up to the
+// question use case, so duplicates, the content policy and attachments are
+// checked as for any new question; when it refuses, the draft is kept.
+func (uc *UseCase) PublishQuestion(
+	ctx context.Context,
+	userID string,
+	force bool,
+) (*entQ.Question, []*entQ.SimilarQuestion, error) {
+	var (
+		q       *entQ.Question
+		similar []*entQ.SimilarQuestion
+	)
+
+	err := uc.uow.Do(ctx, func(ctx context.Context) error {
+		d, err := uc.take(ctx, userID, ent.QuestionKey)
+		if err != nil {
+			return err
+		}
+
+		q, similar, err = uc.createQuestion.CreateQuestion(ctx, userID, d.Text, force, d.AttachmentIDs)
+		return err
+	})
+	if err != nil {
+		return nil, similar, err
+	}
+
+	uc.logger.DebugContext(ctx, "draft published",
+		"user_id", userID,
+		"question_id", q.ID,
+	)
+
+	return q, similar, nil
+}
+
+// PublishAnswer creates an answer to questionID from the user's draft of
+// it and deletes the draft in the same transaction. When the answer use
+// case refuses, the draft is kept.
+func (uc *UseCase) PublishAnswer(ctx context.Context, userID string, questionID int) (*entA.Answer, error) {
+	var a *entA.Answer
+
+	err := uc.uow.Do(ctx, func(ctx context.Context) error {
+		d, err := uc.take(ctx, userID, ent.AnswerKey(questionID))
+		if err != nil {
+			return err
+		}
+
+		a, err = uc.createAnswer.CreateAnswer(ctx, questionID, userID, d.Text, d.AttachmentIDs)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.DebugContext(ctx, "draft published",
+		"user_id", userID,
+		"question_id", questionID,
+		"answer_id", a.ID,
+	)
+
+	return a, nil
+}
+
+func (uc *UseCase) take(ctx context.Context, userID string, key ent.Key) (*ent.Draft, error) {
+	d, err := uc.drafts.Take(ctx, userID, key, uc.timer.Now())
+	if err != nil {
+		return nil, fmt.Errorf("take draft: %w", err)
+	}
+
+	return d, nil
+}
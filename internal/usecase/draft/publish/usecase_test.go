@@ -0,0 +1,258 @@
+package publish_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	ent "test-question/internal/entity/draft"
+	entQ "test-question/internal/entity/question"
+	uc "test-question/internal/usecase/draft/publish"
+	"test-question/internal/usecase/draft/publish/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishQuestion(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	var rolledBack bool
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			err := fn(ctx)
+			rolledBack = err != nil
+			return err
+		})
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Take", ctx, "u1", ent.QuestionKey, now).
+		Return(&ent.Draft{UserID: "u1", Text: "why is it slow", AttachmentIDs: []int{4}}, nil)
+
+	mCreateQuestion.
+		On("CreateQuestion", ctx, "u1", "why is it slow", true, []int{4}).
+		Return(&entQ.Question{ID: 7}, []*entQ.SimilarQuestion{{ID: 2}}, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"draft published",
+			"user_id", "u1",
+			"question_id", 7,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mDrafts, mCreateQuestion, mCreateAnswer, mUoW, mTimer, mLogger)
+
+	q, similar, err := ucase.PublishQuestion(ctx, "u1", true)
+	require.NoError(t, err)
+	require.Equal(t, 7, q.ID)
+	require.Len(t, similar, 1)
+	require.False(t, rolledBack)
+}
+
+func TestPublishQuestion_DuplicatesKeepDraft(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	var rolledBack bool
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			err := fn(ctx)
+			rolledBack = err != nil
+			return err
+		})
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Take", ctx, "u1", ent.QuestionKey, now).
+		Return(&ent.Draft{UserID: "u1", Text: "why is it slow"}, nil)
+
+	mCreateQuestion.
+		On("CreateQuestion", ctx, "u1", "why is it slow", false, []int(nil)).
+		Return(nil, []*entQ.SimilarQuestion{{ID: 2}}, entQ.ErrPossibleDuplicates)
+
+	ucase := uc.NewUseCase(mDrafts, mCreateQuestion, mCreateAnswer, mUoW, mTimer, mLogger)
+
+	_, similar, err := ucase.PublishQuestion(ctx, "u1", false)
+	require.ErrorIs(t, err, entQ.ErrPossibleDuplicates)
+	require.Len(t, similar, 1)
+	require.True(t, rolledBack)
+}
+
+func TestPublishQuestion_NoDraft(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Take", ctx, "u1", ent.QuestionKey, now).
+		Return(nil, ent.ErrDraftNotFound)
+
+	ucase := uc.NewUseCase(mDrafts, mCreateQuestion, mCreateAnswer, mUoW, mTimer, mLogger)
+
+	_, _, err := ucase.PublishQuestion(ctx, "u1", false)
+	require.ErrorIs(t, err, ent.ErrDraftNotFound)
+}
+
+func TestPublishAnswer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	var rolledBack bool
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			err := fn(ctx)
+			rolledBack = err != nil
+			return err
+		})
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Take", ctx, "u1", ent.AnswerKey(7), now).
+		Return(&ent.Draft{UserID: "u1", Key: ent.AnswerKey(7), Text: "restart it"}, nil)
+
+	mCreateAnswer.
+		On("CreateAnswer", ctx, 7, "u1", "restart it", []int(nil)).
+		Return(&entA.Answer{ID: 9, QuestionID: 7}, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"draft published",
+			"user_id", "u1",
+			"question_id", 7,
+			"answer_id", 9,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mDrafts, mCreateQuestion, mCreateAnswer, mUoW, mTimer, mLogger)
+
+	a, err := ucase.PublishAnswer(ctx, "u1", 7)
+	require.NoError(t, err)
+	require.Equal(t, 9, a.ID)
+	require.False(t, rolledBack)
+}
+
+func TestPublishAnswer_RefusedKeepsDraft(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	var rolledBack bool
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			err := fn(ctx)
+			rolledBack = err != nil
+			return err
+		})
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Take", ctx, "u1", ent.AnswerKey(7), now).
+		Return(&ent.Draft{UserID: "u1", Key: ent.AnswerKey(7), Text: "restart it"}, nil)
+
+	mCreateAnswer.
+		On("CreateAnswer", ctx, 7, "u1", "restart it", []int(nil)).
+		Return(nil, entQ.ErrQuestionClosed)
+
+	ucase := uc.NewUseCase(mDrafts, mCreateQuestion, mCreateAnswer, mUoW, mTimer, mLogger)
+
+	_, err := ucase.PublishAnswer(ctx, "u1", 7)
+	require.ErrorIs(t, err, entQ.ErrQuestionClosed)
+	require.True(t, rolledBack)
+}
+
+func TestPublishAnswer_TakeError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mDrafts := mocks.NewDraftRepository(t)
+	mCreateQuestion := mocks.NewQuestionCreator(t)
+	mCreateAnswer := mocks.NewAnswerCreator(t)
+	mUoW := mocks.NewUnitOfWork(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mUoW.
+		On("Do", ctx, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mDrafts.
+		On("Take", ctx, "u1", ent.AnswerKey(7), now).
+		Return(nil, errors.New("db down"))
+
+	ucase := uc.NewUseCase(mDrafts, mCreateQuestion, mCreateAnswer, mUoW, mTimer, mLogger)
+
+	_, err := ucase.PublishAnswer(ctx, "u1", 7)
+	require.ErrorContains(t, err, "take draft")
+}
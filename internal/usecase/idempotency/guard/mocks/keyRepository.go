@@ -0,0 +1,154 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	idempotency "test-question/internal/entity/idempotency"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// KeyRepository is an autogenerated mock type for the keyRepository type
+type KeyRepository struct {
+	mock.Mock
+}
+
+// Acquire provides a mock function with given fields: ctx, rec
+func (_m *KeyRepository) Acquire(ctx context.Context, rec *idempotency.Record) (bool, error) {
+	ret := _m.Called(ctx, rec)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Acquire")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *idempotency.Record) (bool, error)); ok {
+		return rf(ctx, rec)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *idempotency.Record) bool); ok {
+		r0 = rf(ctx, rec)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *idempotency.Record) error); ok {
+		r1 = rf(ctx, rec)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Complete provides a mock function with given fields: ctx, userID, key, statusCode, contentType, body
+func (_m *KeyRepository) Complete(ctx context.Context, userID string, key string, statusCode int, contentType string, body []byte) error {
+	ret := _m.Called(ctx, userID, key, statusCode, contentType, body)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Complete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int, string, []byte) error); ok {
+		r0 = rf(ctx, userID, key, statusCode, contentType, body)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, userID, key
+func (_m *KeyRepository) Get(ctx context.Context, userID string, key string) (*idempotency.Record, error) {
+	ret := _m.Called(ctx, userID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *idempotency.Record
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*idempotency.Record, error)); ok {
+		return rf(ctx, userID, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *idempotency.Record); ok {
+		r0 = rf(ctx, userID, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*idempotency.Record)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeExpired provides a mock function with given fields: ctx, before
+func (_m *KeyRepository) PurgeExpired(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpired")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Release provides a mock function with given fields: ctx, userID, key
+func (_m *KeyRepository) Release(ctx context.Context, userID string, key string) error {
+	ret := _m.Called(ctx, userID, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Release")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewKeyRepository creates a new instance of KeyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewKeyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *KeyRepository {
+	mock := &KeyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+package guard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	ent "test-question/internal/entity/idempotency"
+)
+
+//go:generate mockery --name=keyRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	keyRepository interface {
+		Acquire(ctx context.Context, rec *ent.Record) (bool, error)
+		Get(ctx context.Context, userID, key string) (*ent.Record, error)
+		Complete(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error
+		Release(ctx context.Context, userID, key string) error
+		PurgeExpired(ctx context.Context, before time.Time) (int, error)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type Config struct {
+	// TTL is how long a response is replayed to retries with the same key.
+	TTL time.Duration
+	// Lease is how long an in-flight request holds its key; a retry after
+	// that takes the key over, as the request most likely died.
+	Lease time.Duration
+}
+
+type UseCase struct {
+	keys   keyRepository
+	timer  timer
+	logger logger
+	cfg    Config
+}
+
+func NewUseCase(keys keyRepository, timer timer, logger logger, cfg Config) *UseCase {
+	return &UseCase{
+		keys:   keys,
+		timer:  timer,
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+// Begin claims the key of a user for the request with the given hash.
+// It returns nil when the caller should handle the request and the stored
+// record when the request is a retry of a completed one. A retry while the
+// first request is in flight fails with ErrKeyInProgress until its lease
+// runs out, a different request with the same key with ErrKeyReused.
+func (uc *UseCase) Begin(ctx context.Context, userID, key, requestHash string) (*ent.Record, error) {
+	if key == "" || len(key) > ent.MaxKeyLength {
+		return nil, ent.ErrInvalidKey
+	}
+
+	now := uc.timer.Now()
+	acquired, err := uc.keys.Acquire(ctx, &ent.Record{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(uc.cfg.TTL),
+		LockedUntil: now.Add(uc.cfg.Lease),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("acquire idempotency key: %w", err)
+	}
+	if acquired {
+		return nil, nil
+	}
+
+	rec, err := uc.keys.Get(ctx, userID, key)
+	if errors.Is(err, ent.ErrKeyNotFound) {
+		// the first request failed and released the key meanwhile
+		return nil, ent.ErrKeyInProgress
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get idempotency key: %w", err)
+	}
+
+	if rec.RequestHash != requestHash {
+		return nil, ent.ErrKeyReused
+	}
+	if !rec.Completed() {
+		return nil, ent.ErrKeyInProgress
+	}
+
+	uc.logger.DebugContext(ctx, "idempotent request replayed",
+		"user_id", userID,
+		"key", key,
+		"status", rec.StatusCode,
+	)
+
+	return rec, nil
+}
+
+// Complete stores the response to be replayed to retries with the key.
+func (uc *UseCase) Complete(
+	ctx context.Context,
+	userID, key string,
+	statusCode int,
+	contentType string,
+	body []byte,
+) error {
+	if err := uc.keys.Complete(ctx, userID, key, statusCode, contentType, body); err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Release frees the key after a request that should not be replayed,
+// so a retry handles the request again.
+func (uc *UseCase) Release(ctx context.Context, userID, key string) error {
+	if err := uc.keys.Release(ctx, userID, key); err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+	return nil
+}
+
+// Purge deletes expired keys and returns how many were removed.
+func (uc *UseCase) Purge(ctx context.Context) (int, error) {
+	n, err := uc.keys.PurgeExpired(ctx, uc.timer.Now())
+	if err != nil {
+		return 0, fmt.Errorf("purge idempotency keys: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "idempotency keys purged", "count", n)
+
+	return n, nil
+}
@@ -0,0 +1,258 @@
+package guard_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	ent "test-question/internal/entity/idempotency"
+	uc "test-question/internal/usecase/idempotency/guard"
+	"test-question/internal/usecase/idempotency/guard/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ttl   = 24 * time.Hour
+	lease = time.Minute
+)
+
+func TestBegin_Acquired(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mKeys := mocks.NewKeyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mKeys.
+		On("Acquire", ctx, &ent.Record{
+			UserID:      "u1",
+			Key:         "k1",
+			RequestHash: "h1",
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(ttl),
+			LockedUntil: now.Add(lease),
+		}).
+		Return(true, nil)
+
+	ucase := uc.NewUseCase(mKeys, mTimer, mLogger, uc.Config{TTL: ttl, Lease: lease})
+
+	rec, err := ucase.Begin(ctx, "u1", "k1", "h1")
+	require.NoError(t, err)
+	require.Nil(t, rec)
+}
+
+func TestBegin_InvalidKey(t *testing.T) {
+	ctx := context.Background()
+
+	mKeys := mocks.NewKeyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	ucase := uc.NewUseCase(mKeys, mTimer, mLogger, uc.Config{TTL: ttl, Lease: lease})
+
+	_, err := ucase.Begin(ctx, "u1", "", "h1")
+	require.ErrorIs(t, err, ent.ErrInvalidKey)
+
+	_, err = ucase.Begin(ctx, "u1", strings.Repeat("k", ent.MaxKeyLength+1), "h1")
+	require.ErrorIs(t, err, ent.ErrInvalidKey)
+}
+
+func TestBegin_Replay(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mKeys := mocks.NewKeyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	stored := &ent.Record{UserID: "u1", Key: "k1", RequestHash: "h1", StatusCode: 201, Body: []byte(`{}`)}
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mKeys.
+		On("Acquire", ctx, mock.Anything).
+		Return(false, nil)
+
+	mKeys.
+		On("Get", ctx, "u1", "k1").
+		Return(stored, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"idempotent request replayed",
+			"user_id", "u1",
+			"key", "k1",
+			"status", 201,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mKeys, mTimer, mLogger, uc.Config{TTL: ttl, Lease: lease})
+
+	rec, err := ucase.Begin(ctx, "u1", "k1", "h1")
+	require.NoError(t, err)
+	require.Equal(t, stored, rec)
+}
+
+func TestBegin_Reused(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mKeys := mocks.NewKeyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mKeys.
+		On("Acquire", ctx, mock.Anything).
+		Return(false, nil)
+
+	mKeys.
+		On("Get", ctx, "u1", "k1").
+		Return(&ent.Record{RequestHash: "h1", StatusCode: 201}, nil)
+
+	ucase := uc.NewUseCase(mKeys, mTimer, mLogger, uc.Config{TTL: ttl, Lease: lease})
+
+	_, err := ucase.Begin(ctx, "u1", "k1", "h2")
+	require.ErrorIs(t, err, ent.ErrKeyReused)
+}
+
+func TestBegin_InProgress(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mKeys := mocks.NewKeyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mKeys.
+		On("Acquire", ctx, mock.Anything).
+		Return(false, nil)
+
+	mKeys.
+		On("Get", ctx, "u1", "k1").
+		Return(&ent.Record{RequestHash: "h1"}, nil)
+
+	ucase := uc.NewUseCase(mKeys, mTimer, mLogger, uc.Config{TTL: ttl, Lease: lease})
+
+	_, err := ucase.Begin(ctx, "u1", "k1", "h1")
+	require.ErrorIs(t, err, ent.ErrKeyInProgress)
+}
+
+func TestBegin_ReleasedMeanwhile(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mKeys := mocks.NewKeyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mKeys.
+		On("Acquire", ctx, mock.Anything).
+		Return(false, nil)
+
+	mKeys.
+		On("Get", ctx, "u1", "k1").
+		Return(nil, ent.ErrKeyNotFound)
+
+	ucase := uc.NewUseCase(mKeys, mTimer, mLogger, uc.Config{TTL: ttl, Lease: lease})
+
+	_, err := ucase.Begin(ctx, "u1", "k1", "h1")
+	require.ErrorIs(t, err, ent.ErrKeyInProgress)
+}
+
+func TestBegin_RepoError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mKeys := mocks.NewKeyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mKeys.
+		On("Acquire", ctx, mock.Anything).
+		Return(false, errors.New("db down"))
+
+	ucase := uc.NewUseCase(mKeys, mTimer, mLogger, uc.Config{TTL: ttl, Lease: lease})
+
+	_, err := ucase.Begin(ctx, "u1", "k1", "h1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "acquire idempotency key")
+}
+
+func TestCompleteAndRelease(t *testing.T) {
+	ctx := context.Background()
+
+	mKeys := mocks.NewKeyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mKeys.
+		On("Complete", ctx, "u1", "k1", 201, "application/json", []byte(`{}`)).
+		Return(nil)
+
+	mKeys.
+		On("Release", ctx, "u1", "k2").
+		Return(errors.New("db down"))
+
+	ucase := uc.NewUseCase(mKeys, mTimer, mLogger, uc.Config{TTL: ttl, Lease: lease})
+
+	require.NoError(t, ucase.Complete(ctx, "u1", "k1", 201, "application/json", []byte(`{}`)))
+
+	err := ucase.Release(ctx, "u1", "k2")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "release idempotency key")
+}
+
+func TestPurge(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mKeys := mocks.NewKeyRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	mKeys.
+		On("PurgeExpired", ctx, now).
+		Return(4, nil)
+
+	mLogger.
+		On("DebugContext", ctx, "idempotency keys purged", "count", 4).
+		Return()
+
+	ucase := uc.NewUseCase(mKeys, mTimer, mLogger, uc.Config{TTL: ttl, Lease: lease})
+
+	n, err := ucase.Purge(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+}
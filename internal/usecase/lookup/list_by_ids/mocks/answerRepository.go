@@ -0,0 +1,89 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	answer "test-question/internal/entity/answer"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnswerRepository is an autogenerated mock type for the answerRepository type
+type AnswerRepository struct {
+	mock.Mock
+}
+
+// CountByQuestionIDs provides a mock function with given fields: ctx, questionIDs
+func (_m *AnswerRepository) CountByQuestionIDs(ctx context.Context, questionIDs []int) (map[int]int, error) {
+	ret := _m.Called(ctx, questionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByQuestionIDs")
+	}
+
+	var r0 map[int]int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int) (map[int]int, error)); ok {
+		return rf(ctx, questionIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int) map[int]int); ok {
+		r0 = rf(ctx, questionIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int) error); ok {
+		r1 = rf(ctx, questionIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListFirstByQuestionIDs provides a mock function with given fields: ctx, questionIDs, sort, limit
+func (_m *AnswerRepository) ListFirstByQuestionIDs(ctx context.Context, questionIDs []int, sort answer.Sort, limit int) ([]*answer.Answer, error) {
+	ret := _m.Called(ctx, questionIDs, sort, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFirstByQuestionIDs")
+	}
+
+	var r0 []*answer.Answer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int, answer.Sort, int) ([]*answer.Answer, error)); ok {
+		return rf(ctx, questionIDs, sort, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int, answer.Sort, int) []*answer.Answer); ok {
+		r0 = rf(ctx, questionIDs, sort, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*answer.Answer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int, answer.Sort, int) error); ok {
+		r1 = rf(ctx, questionIDs, sort, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAnswerRepository creates a new instance of AnswerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnswerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnswerRepository {
+	mock := &AnswerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,151 @@
+package list_by_ids
+
+import (
+	"context"
+	"fmt"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=userRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		ListByIDs(ctx context.Context, ids []int) ([]*entQ.Question, error)
+	}
+
+	answerRepository interface {
+		ListFirstByQuestionIDs(ctx context.Context, questionIDs []int, sort entA.Sort, limit int) ([]*entA.Answer, error)
+		CountByQuestionIDs(ctx context.Context, questionIDs []int) (map[int]int, error)
+	}
+
+	userRepository interface {
+		ListByIDs(ctx context.Context, ids []string) ([]*entU.User, error)
+	}
+
+	reputationRepository interface {
+		Totals(ctx context.Context, userIDs []string) (map[string]int, error)
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+// UseCase reads what is shown alongside many posts at once, one query per
+// kind, for clients that fetch a graph of posts in one request.
+type UseCase struct {
+	questions  questionRepository
+	answers    answerRepository
+	users      userRepository
+	reputation reputationRepository
+	logger     logger
+}
+
+func NewUseCase(
+	qRepo questionRepository,
+	aRepo answerRepository,
+	uRepo userRepository,
+	rRepo reputationRepository,
+	logger logger,
+) *UseCase {
+	return &UseCase{questions: qRepo, answers: aRepo, users: uRepo, reputation: rRepo, logger: logger}
+}
+
+// Questions returns the questions readers can see by ID; hidden and unknown
+// ones are left out.
+func (uc *UseCase) Questions(ctx context.Context, ids []int) (map[int]*entQ.Question, error) {
+	list, err := uc.questions.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("list questions: %w", err)
+	}
+
+	out := make(map[int]*entQ.Question, len(list))
+	for _, q := range list {
+		// hidden by reports until a moderator reviews it
+		if q.HiddenAt == nil {
+			out[q.ID] = q
+		}
+	}
+
+	uc.logger.DebugContext(ctx, "questions looked up", "asked", len(ids), "found", len(out))
+	return out, nil
+}
+
+// Answers returns the first page of up to limit answers of each question
+// in sort, as GetQuestionWithAnswers lists them.
+func (uc *UseCase) Answers(
+	ctx context.Context,
+	questionIDs []int,
+	sort entA.Sort,
+	limit int,
+) (map[int][]*entA.Answer, error) {
+	if limit <= 0 || limit > entA.MaxPageSize {
+		limit = entA.DefaultPageSize
+	}
+
+	list, err := uc.answers.ListFirstByQuestionIDs(ctx, questionIDs, sort, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list answers: %w", err)
+	}
+
+	out := make(map[int][]*entA.Answer, len(questionIDs))
+	for _, a := range list {
+		out[a.QuestionID] = append(out[a.QuestionID], a)
+	}
+
+	uc.logger.DebugContext(ctx, "answers looked up", "questions", len(questionIDs), "answers", len(list))
+	return out, nil
+}
+
+// AnswerCounts counts the answers readers can see of each question;
+// questions without any count zero.
+func (uc *UseCase) AnswerCounts(ctx context.Context, questionIDs []int) (map[int]int, error) {
+	out, err := uc.answers.CountByQuestionIDs(ctx, questionIDs)
+	if err != nil {
+		return nil, fmt.Errorf("count answers: %w", err)
+	}
+
+	for _, id := range questionIDs {
+		if _, ok := out[id]; !ok {
+			out[id] = 0
+		}
+	}
+	return out, nil
+}
+
+// Users returns the users by ID; unknown ones are left out.
+func (uc *UseCase) Users(ctx context.Context, ids []string) (map[string]*entU.User, error) {
+	list, err := uc.users.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("list users: %w", err)
+	}
+
+	out := make(map[string]*entU.User, len(list))
+	for _, u := range list {
+		out[u.ID] = u
+	}
+	return out, nil
+}
+
+// Reputations sums the reputation of each user; users without any have
+// zero.
+func (uc *UseCase) Reputations(ctx context.Context, userIDs []string) (map[string]int, error) {
+	out, err := uc.reputation.Totals(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("sum reputation: %w", err)
+	}
+
+	for _, id := range userIDs {
+		if _, ok := out[id]; !ok {
+			out[id] = 0
+		}
+	}
+	return out, nil
+}
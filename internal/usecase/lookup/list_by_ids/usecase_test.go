@@ -0,0 +1,177 @@
+package list_by_ids_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+	uc "test-question/internal/usecase/lookup/list_by_ids"
+	"test-question/internal/usecase/lookup/list_by_ids/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuestions_SkipsHidden(t *testing.T) {
+	ctx := context.Background()
+	hiddenAt := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mUsers := mocks.NewUserRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.
+		On("ListByIDs", ctx, []int{1, 2, 3}).
+		Return([]*entQ.Question{{ID: 1}, {ID: 2, HiddenAt: &hiddenAt}}, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"questions looked up",
+			"asked", 3,
+			"found", 1,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mQuestions, mAnswers, mUsers, mReputation, mLogger)
+
+	got, err := ucase.Questions(ctx, []int{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, map[int]*entQ.Question{1: {ID: 1}}, got)
+}
+
+func TestAnswers_GroupsByQuestion(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mUsers := mocks.NewUserRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	answers := []*entA.Answer{{ID: 10, QuestionID: 1}, {ID: 11, QuestionID: 1}, {ID: 20, QuestionID: 2}}
+
+	mAnswers.
+		On("ListFirstByQuestionIDs", ctx, []int{1, 2, 3}, entA.SortScore, 5).
+		Return(answers, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"answers looked up",
+			"questions", 3,
+			"answers", 3,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mQuestions, mAnswers, mUsers, mReputation, mLogger)
+
+	got, err := ucase.Answers(ctx, []int{1, 2, 3}, entA.SortScore, 5)
+	require.NoError(t, err)
+	require.Equal(t, map[int][]*entA.Answer{1: answers[:2], 2: answers[2:]}, got)
+}
+
+func TestAnswers_LimitOutOfRange(t *testing.T) {
+	ctx := context.Background()
+
+	for _, limit := range []int{0, -1, entA.MaxPageSize + 1} {
+		mQuestions := mocks.NewQuestionRepository(t)
+		mAnswers := mocks.NewAnswerRepository(t)
+		mUsers := mocks.NewUserRepository(t)
+		mReputation := mocks.NewReputationRepository(t)
+		mLogger := mocks.NewLogger(t)
+
+		mAnswers.
+			On("ListFirstByQuestionIDs", ctx, []int{1}, entA.SortOldest, entA.DefaultPageSize).
+			Return(nil, errors.New("db down"))
+
+		ucase := uc.NewUseCase(mQuestions, mAnswers, mUsers, mReputation, mLogger)
+
+		_, err := ucase.Answers(ctx, []int{1}, entA.SortOldest, limit)
+		require.ErrorContains(t, err, "list answers", limit)
+	}
+}
+
+func TestAnswerCounts_ZeroForMissing(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mUsers := mocks.NewUserRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mAnswers.
+		On("CountByQuestionIDs", ctx, []int{1, 2}).
+		Return(map[int]int{1: 4}, nil)
+
+	ucase := uc.NewUseCase(mQuestions, mAnswers, mUsers, mReputation, mLogger)
+
+	got, err := ucase.AnswerCounts(ctx, []int{1, 2})
+	require.NoError(t, err)
+	require.Equal(t, map[int]int{1: 4, 2: 0}, got)
+}
+
+func TestUsers(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mUsers := mocks.NewUserRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mUsers.
+		On("ListByIDs", ctx, []string{"u1", "u2"}).
+		Return([]*entU.User{{ID: "u1", Username: "alice"}}, nil)
+
+	ucase := uc.NewUseCase(mQuestions, mAnswers, mUsers, mReputation, mLogger)
+
+	got, err := ucase.Users(ctx, []string{"u1", "u2"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]*entU.User{"u1": {ID: "u1", Username: "alice"}}, got)
+}
+
+func TestReputations(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mUsers := mocks.NewUserRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mReputation.
+		On("Totals", ctx, []string{"u1", "u2"}).
+		Return(map[string]int{"u2": 15}, nil)
+
+	ucase := uc.NewUseCase(mQuestions, mAnswers, mUsers, mReputation, mLogger)
+
+	got, err := ucase.Reputations(ctx, []string{"u1", "u2"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{"u1": 0, "u2": 15}, got)
+}
+
+func TestReputations_Error(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mAnswers := mocks.NewAnswerRepository(t)
+	mUsers := mocks.NewUserRepository(t)
+	mReputation := mocks.NewReputationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mReputation.
+		On("Totals", ctx, []string{"u1"}).
+		Return(nil, errors.New("db down"))
+
+	ucase := uc.NewUseCase(mQuestions, mAnswers, mUsers, mReputation, mLogger)
+
+	_, err := ucase.Reputations(ctx, []string{"u1"})
+	require.ErrorContains(t, err, "sum reputation")
+}
@@ -0,0 +1,55 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	entM "test-question/internal/entity/mention"
+)
+
+//go:generate mockery --name=mentionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	mentionRepository interface {
+		ListByUser(ctx context.Context, f entM.Filter) ([]*entM.Mention, error)
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo   mentionRepository
+	logger logger
+}
+
+func NewUseCase(repo mentionRepository, logger logger) *UseCase {
+	return &UseCase{repo: repo, logger: logger}
+}
+
+func (uc *UseCase) ListMentions(ctx context.Context, f entM.Filter) (*entM.Page, error) {
+	limit := f.Limit
+
+	// one extra row tells whether there is a next page
+	f.Limit++
+
+	items, err := uc.repo.ListByUser(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("list mentions: %w", err)
+	}
+
+	page := &entM.Page{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		page.NextCursor = page.Items[limit-1].ID
+	}
+
+	uc.logger.DebugContext(ctx, "mentions listed",
+		"user_id", f.UserID,
+		"count", len(page.Items),
+	)
+
+	return page, nil
+}
@@ -0,0 +1,58 @@
+package list_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entM "test-question/internal/entity/mention"
+	uc "test-question/internal/usecase/mention/list"
+	"test-question/internal/usecase/mention/list/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMentions_HasNextPage(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewMentionRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("ListByUser", ctx, entM.Filter{UserID: "u1", BeforeID: 10, Limit: 3}).
+		Return([]*entM.Mention{{ID: 9}, {ID: 8}, {ID: 7}}, nil)
+	mLogger.On("DebugContext", ctx, "mentions listed", "user_id", "u1", "count", 2).Return()
+
+	page, err := uc.NewUseCase(mRepo, mLogger).ListMentions(ctx, entM.Filter{UserID: "u1", BeforeID: 10, Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	require.Equal(t, 8, page.NextCursor)
+}
+
+func TestListMentions_LastPage(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewMentionRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("ListByUser", ctx, entM.Filter{UserID: "u1", Limit: 3}).
+		Return([]*entM.Mention{{ID: 2}}, nil)
+	mLogger.On("DebugContext", ctx, "mentions listed", "user_id", "u1", "count", 1).Return()
+
+	page, err := uc.NewUseCase(mRepo, mLogger).ListMentions(ctx, entM.Filter{UserID: "u1", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	require.Zero(t, page.NextCursor)
+}
+
+func TestListMentions_Error(t *testing.T) {
+	mRepo := mocks.NewMentionRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.On("ListByUser", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	_, err := uc.NewUseCase(mRepo, mLogger).ListMentions(context.Background(), entM.Filter{UserID: "u1", Limit: 2})
+	require.ErrorContains(t, err, "list mentions")
+}
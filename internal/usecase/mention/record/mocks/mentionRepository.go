@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	mention "test-question/internal/entity/mention"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MentionRepository is an autogenerated mock type for the mentionRepository type
+type MentionRepository struct {
+	mock.Mock
+}
+
+// CreateBatch provides a mock function with given fields: ctx, ms
+func (_m *MentionRepository) CreateBatch(ctx context.Context, ms []*mention.Mention) ([]*mention.Mention, error) {
+	ret := _m.Called(ctx, ms)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 []*mention.Mention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*mention.Mention) ([]*mention.Mention, error)); ok {
+		return rf(ctx, ms)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []*mention.Mention) []*mention.Mention); ok {
+		r0 = rf(ctx, ms)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*mention.Mention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []*mention.Mention) error); ok {
+		r1 = rf(ctx, ms)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMentionRepository creates a new instance of MentionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMentionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MentionRepository {
+	mock := &MentionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
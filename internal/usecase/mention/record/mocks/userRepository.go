@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	user "test-question/internal/entity/user"
+)
+
+// UserRepository is an autogenerated mock type for the userRepository type
+type UserRepository struct {
+	mock.Mock
+}
+
+// ListByUsernames provides a mock function with given fields: ctx, usernames
+func (_m *UserRepository) ListByUsernames(ctx context.Context, usernames []string) ([]*user.User, error) {
+	ret := _m.Called(ctx, usernames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUsernames")
+	}
+
+	var r0 []*user.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*user.User, error)); ok {
+		return rf(ctx, usernames)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*user.User); ok {
+		r0 = rf(ctx, usernames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*user.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, usernames)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUserRepository creates a new instance of UserRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserRepository {
+	mock := &UserRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
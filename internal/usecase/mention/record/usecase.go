@@ -0,0 +1,85 @@
+package record
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entM "test-question/internal/entity/mention"
+	entU "test-question/internal/entity/user"
+)
+
+//go:generate mockery --name=userRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=mentionRepository --output=mocks --outpkg=mocks --exported
+
+type (
+	userRepository interface {
+		ListByUsernames(ctx context.Context, usernames []string) ([]*entU.User, error)
+	}
+
+	mentionRepository interface {
+		CreateBatch(ctx context.Context, ms []*entM.Mention) ([]*entM.Mention, error)
+	}
+)
+
+type UseCase struct {
+	users    userRepository
+	mentions mentionRepository
+}
+
+func NewUseCase(users userRepository, mentions mentionRepository) *UseCase {
+	return &UseCase{users: users, mentions: mentions}
+}
+
+// Record links a post to the users its text mentions, in the order they
+// are mentioned. Names of nonexistent users are ignored. answerID is zero
+// for a question. It runs in the caller's transaction, if any.
+func (uc *UseCase) Record(
+	ctx context.Context,
+	authorID string,
+	questionID, answerID int,
+	text string,
+	at time.Time,
+) ([]*entM.Mention, error) {
+	names := entM.Parse(text)
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	users, err := uc.users.ListByUsernames(ctx, names)
+	if err != nil {
+		return nil, fmt.Errorf("resolve mentioned users: %w", err)
+	}
+
+	byName := make(map[string]*entU.User, len(users))
+	for _, u := range users {
+		byName[u.Username] = u
+	}
+
+	ms := make([]*entM.Mention, 0, len(users))
+	for _, name := range names {
+		u, ok := byName[name]
+		if !ok {
+			continue
+		}
+		ms = append(ms, &entM.Mention{
+			UserID:     u.ID,
+			Username:   u.Username,
+			AuthorID:   authorID,
+			QuestionID: questionID,
+			AnswerID:   answerID,
+			CreatedAt:  at,
+		})
+	}
+
+	if len(ms) == 0 {
+		return nil, nil
+	}
+
+	out, err := uc.mentions.CreateBatch(ctx, ms)
+	if err != nil {
+		return nil, fmt.Errorf("create mentions: %w", err)
+	}
+
+	return out, nil
+}
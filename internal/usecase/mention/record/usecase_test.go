@@ -0,0 +1,103 @@
+package record_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	entM "test-question/internal/entity/mention"
+	entU "test-question/internal/entity/user"
+	uc "test-question/internal/usecase/mention/record"
+	"test-question/internal/usecase/mention/record/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord_LinksExistingUsersInOrder(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mUsers := mocks.NewUserRepository(t)
+	mMentions := mocks.NewMentionRepository(t)
+
+	mUsers.
+		On("ListByUsernames", ctx, []string{"bob", "ghost", "alice"}).
+		Return([]*entU.User{{ID: "u-alice", Username: "alice"}, {ID: "u-bob", Username: "bob"}}, nil)
+
+	want := []*entM.Mention{
+		{UserID: "u-bob", Username: "bob", AuthorID: "u1", QuestionID: 3, AnswerID: 5, CreatedAt: now},
+		{UserID: "u-alice", Username: "alice", AuthorID: "u1", QuestionID: 3, AnswerID: 5, CreatedAt: now},
+	}
+	mMentions.
+		On("CreateBatch", ctx, want).
+		Return([]*entM.Mention{{ID: 1}, {ID: 2}}, nil)
+
+	out, err := uc.NewUseCase(mUsers, mMentions).
+		Record(ctx, "u1", 3, 5, "cc @bob, @ghost and @alice. Thanks @bob!", now)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+}
+
+func TestRecord_NoMentions(t *testing.T) {
+	mUsers := mocks.NewUserRepository(t)
+	mMentions := mocks.NewMentionRepository(t)
+
+	out, err := uc.NewUseCase(mUsers, mMentions).
+		Record(context.Background(), "u1", 3, 0, "write to me@example.com", time.Now())
+	require.NoError(t, err)
+	require.Empty(t, out)
+}
+
+func TestRecord_OnlyUnknownUsers(t *testing.T) {
+	mUsers := mocks.NewUserRepository(t)
+	mMentions := mocks.NewMentionRepository(t)
+
+	mUsers.On("ListByUsernames", mock.Anything, []string{"ghost"}).Return([]*entU.User{}, nil)
+
+	out, err := uc.NewUseCase(mUsers, mMentions).
+		Record(context.Background(), "u1", 3, 0, "@ghost?", time.Now())
+	require.NoError(t, err)
+	require.Empty(t, out)
+}
+
+func TestRecord_Errors(t *testing.T) {
+	mUsers := mocks.NewUserRepository(t)
+	mMentions := mocks.NewMentionRepository(t)
+
+	mUsers.On("ListByUsernames", mock.Anything, mock.Anything).Return(nil, errors.New("db down")).Once()
+
+	_, err := uc.NewUseCase(mUsers, mMentions).Record(context.Background(), "u1", 3, 0, "@bob", time.Now())
+	require.ErrorContains(t, err, "resolve mentioned users")
+
+	mUsers.On("ListByUsernames", mock.Anything, mock.Anything).Return([]*entU.User{{ID: "u-bob", Username: "bob"}}, nil)
+	mMentions.On("CreateBatch", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	_, err = uc.NewUseCase(mUsers, mMentions).Record(context.Background(), "u1", 3, 0, "@bob", time.Now())
+	require.ErrorContains(t, err, "create mentions")
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{text: "@alice", want: []string{"alice"}},
+		{text: "hi @alice, @bob_2 and @carol.", want: []string{"alice", "bob_2", "carol"}},
+		{text: "(@j.doe-x) @alice @alice", want: []string{"j.doe-x", "alice"}},
+		{text: "mail me@example.com or @@alice", want: nil},
+		{text: "no mentions @ all", want: nil},
+	}
+	for _, tt := range tests {
+		require.Equal(t, tt.want, entM.Parse(tt.text), tt.text)
+	}
+
+	many := make([]string, 0, entM.MaxPerPost+5)
+	for i := 0; i < entM.MaxPerPost+5; i++ {
+		many = append(many, fmt.Sprintf("@user%d", i))
+	}
+	require.Len(t, entM.Parse(strings.Join(many, " ")), entM.MaxPerPost)
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	report "test-question/internal/entity/report"
+)
+
+// ReportRepository is an autogenerated mock type for the reportRepository type
+type ReportRepository struct {
+	mock.Mock
+}
+
+// Queue provides a mock function with given fields: ctx, limit
+func (_m *ReportRepository) Queue(ctx context.Context, limit int) ([]*report.QueueItem, error) {
+	ret := _m.Called(ctx, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Queue")
+	}
+
+	var r0 []*report.QueueItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*report.QueueItem, error)); ok {
+		return rf(ctx, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*report.QueueItem); ok {
+		r0 = rf(ctx, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*report.QueueItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewReportRepository creates a new instance of ReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportRepository {
+	mock := &ReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,42 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+
+	entRp "test-question/internal/entity/report"
+)
+
+//go:generate mockery --name=reportRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	reportRepository interface {
+		Queue(ctx context.Context, limit int) ([]*entRp.QueueItem, error)
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	reports reportRepository
+	logger  logger
+}
+
+func NewUseCase(reports reportRepository, logger logger) *UseCase {
+	return &UseCase{reports: reports, logger: logger}
+}
+
+// Queue returns reported content awaiting review, the most reported first.
+func (uc *UseCase) Queue(ctx context.Context, limit int) ([]*entRp.QueueItem, error) {
+	items, err := uc.reports.Queue(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("load moderation queue: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "moderation queue loaded", "items", len(items))
+
+	return items, nil
+}
@@ -0,0 +1,38 @@
+package queue_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entRp "test-question/internal/entity/report"
+	uc "test-question/internal/usecase/moderation/queue"
+	"test-question/internal/usecase/moderation/queue/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueue_Success(t *testing.T) {
+	ctx := context.Background()
+	mRepo := mocks.NewReportRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	items := []*entRp.QueueItem{{TargetType: entRp.TargetAnswer, TargetID: 5, Reports: 2}}
+	mRepo.On("Queue", ctx, 20).Return(items, nil)
+	mLogger.On("DebugContext", ctx, "moderation queue loaded", "items", 1).Return()
+
+	out, err := uc.NewUseCase(mRepo, mLogger).Queue(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, items, out)
+}
+
+func TestQueue_Error(t *testing.T) {
+	ctx := context.Background()
+	mRepo := mocks.NewReportRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.On("Queue", ctx, 20).Return(nil, errors.New("db down"))
+
+	_, err := uc.NewUseCase(mRepo, mLogger).Queue(ctx, 20)
+	require.ErrorContains(t, err, "load moderation queue")
+}
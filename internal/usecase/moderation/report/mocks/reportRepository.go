@@ -0,0 +1,88 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	report "test-question/internal/entity/report"
+)
+
+// ReportRepository is an autogenerated mock type for the reportRepository type
+type ReportRepository struct {
+	mock.Mock
+}
+
+// CountOpen provides a mock function with given fields: ctx, targetType, targetID
+func (_m *ReportRepository) CountOpen(ctx context.Context, targetType report.TargetType, targetID int) (int, error) {
+	ret := _m.Called(ctx, targetType, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountOpen")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, report.TargetType, int) (int, error)); ok {
+		return rf(ctx, targetType, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, report.TargetType, int) int); ok {
+		r0 = rf(ctx, targetType, targetID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, report.TargetType, int) error); ok {
+		r1 = rf(ctx, targetType, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Create provides a mock function with given fields: ctx, r
+func (_m *ReportRepository) Create(ctx context.Context, r *report.Report) (*report.Report, error) {
+	ret := _m.Called(ctx, r)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *report.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *report.Report) (*report.Report, error)); ok {
+		return rf(ctx, r)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *report.Report) *report.Report); ok {
+		r0 = rf(ctx, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*report.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *report.Report) error); ok {
+		r1 = rf(ctx, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewReportRepository creates a new instance of ReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportRepository {
+	mock := &ReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
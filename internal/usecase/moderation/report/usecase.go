@@ -0,0 +1,206 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entRp "test-question/internal/entity/report"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reportRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+		Hide(ctx context.Context, id int) error
+	}
+
+	answerRepository interface {
+		GetByID(ctx context.Context, id int) (*entA.Answer, error)
+		Hide(ctx context.Context, id int) error
+	}
+
+	reportRepository interface {
+		Create(ctx context.Context, r *entRp.Report) (*entRp.Report, error)
+		CountOpen(ctx context.Context, targetType entRp.TargetType, targetID int) (int, error)
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type Config struct {
+	// HideThreshold is the number of open reports that hides content from
+	// readers until a moderator reviews it. Zero disables hiding.
+	HideThreshold int
+}
+
+type UseCase struct {
+	questions questionRepository
+	answers   answerRepository
+	reports   reportRepository
+	uow       unitOfWork
+	timer     timer
+	logger    logger
+	cfg       Config
+}
+
+func NewUseCase(
+	questions questionRepository,
+	answers answerRepository,
+	reports reportRepository,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+	cfg Config,
+) *UseCase {
+	return &UseCase{
+		questions: questions,
+		answers:   answers,
+		reports:   reports,
+		uow:       uow,
+		timer:     timer,
+		logger:    logger,
+		cfg:       cfg,
+	}
+}
+
+// Report flags a question or answer for moderators. Once the content has
+// HideThreshold open reports it is hidden.
+func (uc *UseCase) Report(
+	ctx context.Context,
+	reporterID string,
+	targetType entRp.TargetType,
+	targetID int,
+	reason entRp.Reason,
+	note string,
+) (*entRp.Report, error) {
+	if !reason.Valid() {
+		return nil, entRp.ErrInvalidReason
+	}
+
+	authorID, hidden, err := uc.target(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorID == reporterID {
+		return nil, entRp.ErrSelfReport
+	}
+
+	var created *entRp.Report
+	err = uc.uow.Do(ctx, func(ctx context.Context) error {
+		created, err = uc.reports.Create(ctx, &entRp.Report{
+			TargetType: targetType,
+			TargetID:   targetID,
+			ReporterID: reporterID,
+			Reason:     reason,
+			Note:       note,
+			Status:     entRp.StatusOpen,
+			CreatedAt:  uc.timer.Now(),
+		})
+		if err != nil {
+			if errors.Is(err, entRp.ErrAlreadyReported) {
+				return err
+			}
+			return fmt.Errorf("create report: %w", err)
+		}
+
+		if hidden || uc.cfg.HideThreshold <= 0 {
+			return nil
+		}
+
+		open, err := uc.reports.CountOpen(ctx, targetType, targetID)
+		if err != nil {
+			return fmt.Errorf("count reports: %w", err)
+		}
+
+		if open < uc.cfg.HideThreshold {
+			return nil
+		}
+
+		if err = uc.hide(ctx, targetType, targetID); err != nil {
+			return err
+		}
+
+		uc.logger.DebugContext(ctx, "reported content hidden",
+			"target_type", targetType,
+			"target_id", targetID,
+			"reports", open,
+		)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.DebugContext(ctx, "content reported",
+		"target_type", targetType,
+		"target_id", targetID,
+		"reporter_id", reporterID,
+		"reason", reason,
+	)
+
+	return created, nil
+}
+
+// target returns the author of the reported content and whether it is
+// already hidden.
+func (uc *UseCase) target(ctx context.Context, targetType entRp.TargetType, targetID int) (string, bool, error) {
+	switch targetType {
+	case entRp.TargetQuestion:
+		q, err := uc.questions.GetByID(ctx, targetID)
+		if err != nil {
+			if errors.Is(err, entQ.ErrQuestionNotFound) {
+				return "", false, err
+			}
+			return "", false, fmt.Errorf("get question: %w", err)
+		}
+		return q.UserID, q.HiddenAt != nil, nil
+	case entRp.TargetAnswer:
+		a, err := uc.answers.GetByID(ctx, targetID)
+		if err != nil {
+			if errors.Is(err, entA.ErrAnswerNotFound) {
+				return "", false, err
+			}
+			return "", false, fmt.Errorf("get answer: %w", err)
+		}
+		return a.UserID, a.HiddenAt != nil, nil
+	}
+
+	return "", false, fmt.Errorf("unknown report target %q", targetType)
+}
+
+func (uc *UseCase) hide(ctx context.Context, targetType entRp.TargetType, targetID int) error {
+	if targetType == entRp.TargetQuestion {
+		if err := uc.questions.Hide(ctx, targetID); err != nil {
+			return fmt.Errorf("hide question: %w", err)
+		}
+		return nil
+	}
+
+	if err := uc.answers.Hide(ctx, targetID); err != nil {
+		return fmt.Errorf("hide answer: %w", err)
+	}
+	return nil
+}
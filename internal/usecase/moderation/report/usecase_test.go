@@ -0,0 +1,164 @@
+package report_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entRp "test-question/internal/entity/report"
+	uc "test-question/internal/usecase/moderation/report"
+	"test-question/internal/usecase/moderation/report/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testMocks struct {
+	questions *mocks.QuestionRepository
+	answers   *mocks.AnswerRepository
+	reports   *mocks.ReportRepository
+	uow       *mocks.UnitOfWork
+	timer     *mocks.Timer
+	logger    *mocks.Logger
+}
+
+func newMocks(t *testing.T) *testMocks { //nolint:thelper
+	m := &testMocks{
+		questions: mocks.NewQuestionRepository(t),
+		answers:   mocks.NewAnswerRepository(t),
+		reports:   mocks.NewReportRepository(t),
+		uow:       mocks.NewUnitOfWork(t),
+		timer:     mocks.NewTimer(t),
+		logger:    mocks.NewLogger(t),
+	}
+
+	m.uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+
+	return m
+}
+
+func (m *testMocks) useCase(threshold int) *uc.UseCase {
+	return uc.NewUseCase(m.questions, m.answers, m.reports, m.uow, m.timer, m.logger,
+		uc.Config{HideThreshold: threshold})
+}
+
+func TestReport_BelowThreshold(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, UserID: "author"}, nil)
+	m.timer.On("Now").Return(now)
+	m.reports.On("Create", ctx, &entRp.Report{
+		TargetType: entRp.TargetAnswer,
+		TargetID:   5,
+		ReporterID: "u1",
+		Reason:     entRp.ReasonSpam,
+		Note:       "ads",
+		Status:     entRp.StatusOpen,
+		CreatedAt:  now,
+	}).Return(&entRp.Report{ID: 1, TargetType: entRp.TargetAnswer, TargetID: 5}, nil)
+	m.reports.On("CountOpen", ctx, entRp.TargetAnswer, 5).Return(2, nil)
+	m.logger.On("DebugContext", ctx, "content reported",
+		"target_type", entRp.TargetAnswer,
+		"target_id", 5,
+		"reporter_id", "u1",
+		"reason", entRp.ReasonSpam,
+	).Return()
+
+	out, err := m.useCase(3).Report(ctx, "u1", entRp.TargetAnswer, 5, entRp.ReasonSpam, "ads")
+	require.NoError(t, err)
+	require.Equal(t, 1, out.ID)
+	m.answers.AssertNotCalled(t, "Hide", mock.Anything, mock.Anything)
+}
+
+func TestReport_ThresholdHidesContent(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "author"}, nil)
+	m.timer.On("Now").Return(time.Now())
+	m.reports.On("Create", ctx, mock.Anything).Return(&entRp.Report{ID: 3}, nil)
+	m.reports.On("CountOpen", ctx, entRp.TargetQuestion, 7).Return(3, nil)
+	m.questions.On("Hide", ctx, 7).Return(nil)
+	m.logger.On("DebugContext", ctx, "reported content hidden",
+		"target_type", entRp.TargetQuestion,
+		"target_id", 7,
+		"reports", 3,
+	).Return()
+	m.logger.On("DebugContext", ctx, "content reported",
+		"target_type", entRp.TargetQuestion,
+		"target_id", 7,
+		"reporter_id", "u3",
+		"reason", entRp.ReasonOffensive,
+	).Return()
+
+	_, err := m.useCase(3).Report(ctx, "u3", entRp.TargetQuestion, 7, entRp.ReasonOffensive, "")
+	require.NoError(t, err)
+}
+
+func TestReport_AlreadyHiddenNotCounted(t *testing.T) {
+	ctx := context.Background()
+	hiddenAt := time.Now()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "author", HiddenAt: &hiddenAt}, nil)
+	m.timer.On("Now").Return(time.Now())
+	m.reports.On("Create", ctx, mock.Anything).Return(&entRp.Report{ID: 4}, nil)
+	m.logger.On("DebugContext", ctx, "content reported",
+		"target_type", entRp.TargetQuestion,
+		"target_id", 7,
+		"reporter_id", "u4",
+		"reason", entRp.ReasonSpam,
+	).Return()
+
+	_, err := m.useCase(3).Report(ctx, "u4", entRp.TargetQuestion, 7, entRp.ReasonSpam, "")
+	require.NoError(t, err)
+	m.reports.AssertNotCalled(t, "CountOpen", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReport_InvalidReason(t *testing.T) {
+	m := newMocks(t)
+
+	_, err := m.useCase(3).Report(context.Background(), "u1", entRp.TargetQuestion, 1, "boring", "")
+	require.ErrorIs(t, err, entRp.ErrInvalidReason)
+}
+
+func TestReport_SelfReport(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, UserID: "u1"}, nil)
+
+	_, err := m.useCase(3).Report(ctx, "u1", entRp.TargetQuestion, 1, entRp.ReasonSpam, "")
+	require.ErrorIs(t, err, entRp.ErrSelfReport)
+}
+
+func TestReport_NotFound(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 9).Return(nil, entA.ErrAnswerNotFound)
+
+	_, err := m.useCase(3).Report(ctx, "u1", entRp.TargetAnswer, 9, entRp.ReasonSpam, "")
+	require.ErrorIs(t, err, entA.ErrAnswerNotFound)
+}
+
+func TestReport_AlreadyReported(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, UserID: "author"}, nil)
+	m.timer.On("Now").Return(time.Now())
+	m.reports.On("Create", ctx, mock.Anything).Return(nil, entRp.ErrAlreadyReported)
+
+	_, err := m.useCase(3).Report(ctx, "u1", entRp.TargetQuestion, 1, entRp.ReasonSpam, "")
+	require.ErrorIs(t, err, entRp.ErrAlreadyReported)
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AnswerRemover is an autogenerated mock type for the answerRemover type
+type AnswerRemover struct {
+	mock.Mock
+}
+
+// RemoveAnswer provides a mock function with given fields: ctx, answerID, moderatorID
+func (_m *AnswerRemover) RemoveAnswer(ctx context.Context, answerID int, moderatorID string) error {
+	ret := _m.Called(ctx, answerID, moderatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAnswer")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, answerID, moderatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAnswerRemover creates a new instance of AnswerRemover. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAnswerRemover(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AnswerRemover {
+	mock := &AnswerRemover{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,47 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	notification "test-question/internal/entity/notification"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationRepository is an autogenerated mock type for the notificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// CreateBatch provides a mock function with given fields: ctx, ns
+func (_m *NotificationRepository) CreateBatch(ctx context.Context, ns []*notification.Notification) error {
+	ret := _m.Called(ctx, ns)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*notification.Notification) error); ok {
+		r0 = rf(ctx, ns)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QuestionRemover is an autogenerated mock type for the questionRemover type
+type QuestionRemover struct {
+	mock.Mock
+}
+
+// RemoveQuestion provides a mock function with given fields: ctx, questionID, moderatorID
+func (_m *QuestionRemover) RemoveQuestion(ctx context.Context, questionID int, moderatorID string) error {
+	ret := _m.Called(ctx, questionID, moderatorID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveQuestion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) error); ok {
+		r0 = rf(ctx, questionID, moderatorID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewQuestionRemover creates a new instance of QuestionRemover. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuestionRemover(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuestionRemover {
+	mock := &QuestionRemover{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
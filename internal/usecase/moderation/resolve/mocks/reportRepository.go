@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	report "test-question/internal/entity/report"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ReportRepository is an autogenerated mock type for the reportRepository type
+type ReportRepository struct {
+	mock.Mock
+}
+
+// ResolveOpen provides a mock function with given fields: ctx, targetType, targetID, status, moderatorID, at
+func (_m *ReportRepository) ResolveOpen(ctx context.Context, targetType report.TargetType, targetID int, status report.Status, moderatorID string, at time.Time) (int, error) {
+	ret := _m.Called(ctx, targetType, targetID, status, moderatorID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveOpen")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, report.TargetType, int, report.Status, string, time.Time) (int, error)); ok {
+		return rf(ctx, targetType, targetID, status, moderatorID, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, report.TargetType, int, report.Status, string, time.Time) int); ok {
+		r0 = rf(ctx, targetType, targetID, status, moderatorID, at)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, report.TargetType, int, report.Status, string, time.Time) error); ok {
+		r1 = rf(ctx, targetType, targetID, status, moderatorID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewReportRepository creates a new instance of ReportRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReportRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReportRepository {
+	mock := &ReportRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
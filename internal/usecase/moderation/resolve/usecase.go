@@ -0,0 +1,241 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entN "test-question/internal/entity/notification"
+	entQ "test-question/internal/entity/question"
+	entRp "test-question/internal/entity/report"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=questionRemover --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerRemover --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reportRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=notificationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+		Unhide(ctx context.Context, id int) error
+	}
+
+	answerRepository interface {
+		GetByID(ctx context.Context, id int) (*entA.Answer, error)
+		Unhide(ctx context.Context, id int) error
+	}
+
+	questionRemover interface {
+		RemoveQuestion(ctx context.Context, questionID int, moderatorID string) error
+	}
+
+	answerRemover interface {
+		RemoveAnswer(ctx context.Context, answerID int, moderatorID string) error
+	}
+
+	reportRepository interface {
+		ResolveOpen(
+			ctx context.Context,
+			targetType entRp.TargetType,
+			targetID int,
+			status entRp.Status,
+			moderatorID string,
+			at time.Time,
+		) (int, error)
+	}
+
+	notificationRepository interface {
+		CreateBatch(ctx context.Context, ns []*entN.Notification) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	questions       questionRepository
+	answers         answerRepository
+	questionRemover questionRemover
+	answerRemover   answerRemover
+	reports         reportRepository
+	notifications   notificationRepository
+	uow             unitOfWork
+	timer           timer
+	logger          logger
+}
+
+func NewUseCase(
+	questions questionRepository,
+	answers answerRepository,
+	questionRemover questionRemover,
+	answerRemover answerRemover,
+	reports reportRepository,
+	notifications notificationRepository,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		questions:       questions,
+		answers:         answers,
+		questionRemover: questionRemover,
+		answerRemover:   answerRemover,
+		reports:         reports,
+		notifications:   notifications,
+		uow:             uow,
+		timer:           timer,
+		logger:          logger,
+	}
+}
+
+// content is what a resolution needs to know about the reported content.
+type content struct {
+	authorID   string
+	questionID int
+	answerID   int
+}
+
+// Resolve closes the open reports of the content and returns how many were
+// closed. The content is shown to readers again; a warning also notifies the
+// author and a deletion moves the content to the trash.
+func (uc *UseCase) Resolve(
+	ctx context.Context,
+	targetType entRp.TargetType,
+	targetID int,
+	resolution entRp.Resolution,
+	moderatorID string,
+) (int, error) {
+	status, ok := resolution.Status()
+	if !ok {
+		return 0, entRp.ErrInvalidResolution
+	}
+
+	c, err := uc.content(ctx, targetType, targetID)
+	if err != nil {
+		return 0, err
+	}
+
+	var resolved int
+	err = uc.uow.Do(ctx, func(ctx context.Context) error {
+		now := uc.timer.Now()
+
+		resolved, err = uc.reports.ResolveOpen(ctx, targetType, targetID, status, moderatorID, now)
+		if err != nil {
+			return fmt.Errorf("resolve reports: %w", err)
+		}
+
+		if resolved == 0 {
+			return entRp.ErrNoOpenReports
+		}
+
+		if err = uc.unhide(ctx, targetType, targetID); err != nil {
+			return err
+		}
+
+		switch resolution {
+		case entRp.ResolutionWarn:
+			err = uc.notifications.CreateBatch(ctx, []*entN.Notification{{
+				UserID:     c.authorID,
+				Type:       entN.TypeWarning,
+				ActorID:    moderatorID,
+				QuestionID: c.questionID,
+				AnswerID:   c.answerID,
+				CreatedAt:  now,
+			}})
+			if err != nil {
+				return fmt.Errorf("create warning: %w", err)
+			}
+		case entRp.ResolutionDelete:
+			if err = uc.remove(ctx, targetType, targetID, moderatorID); err != nil {
+				return err
+			}
+		case entRp.ResolutionDismiss:
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	uc.logger.DebugContext(ctx, "reports resolved",
+		"target_type", targetType,
+		"target_id", targetID,
+		"resolution", resolution,
+		"reports", resolved,
+		"moderator_id", moderatorID,
+	)
+
+	return resolved, nil
+}
+
+func (uc *UseCase) content(ctx context.Context, targetType entRp.TargetType, targetID int) (*content, error) {
+	switch targetType {
+	case entRp.TargetQuestion:
+		q, err := uc.questions.GetByID(ctx, targetID)
+		if err != nil {
+			if errors.Is(err, entQ.ErrQuestionNotFound) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("get question: %w", err)
+		}
+		return &content{authorID: q.UserID, questionID: q.ID}, nil
+	case entRp.TargetAnswer:
+		a, err := uc.answers.GetByID(ctx, targetID)
+		if err != nil {
+			if errors.Is(err, entA.ErrAnswerNotFound) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("get answer: %w", err)
+		}
+		return &content{authorID: a.UserID, questionID: a.QuestionID, answerID: a.ID}, nil
+	}
+
+	return nil, fmt.Errorf("unknown report target %q", targetType)
+}
+
+func (uc *UseCase) unhide(ctx context.Context, targetType entRp.TargetType, targetID int) error {
+	if targetType == entRp.TargetQuestion {
+		if err := uc.questions.Unhide(ctx, targetID); err != nil {
+			return fmt.Errorf("unhide question: %w", err)
+		}
+		return nil
+	}
+
+	if err := uc.answers.Unhide(ctx, targetID); err != nil {
+		return fmt.Errorf("unhide answer: %w", err)
+	}
+	return nil
+}
+
+func (uc *UseCase) remove(ctx context.Context, targetType entRp.TargetType, targetID int, moderatorID string) error {
+	if targetType == entRp.TargetQuestion {
+		if err := uc.questionRemover.RemoveQuestion(ctx, targetID, moderatorID); err != nil {
+			return fmt.Errorf("remove question: %w", err)
+		}
+		return nil
+	}
+
+	if err := uc.answerRemover.RemoveAnswer(ctx, targetID, moderatorID); err != nil {
+		return fmt.Errorf("remove answer: %w", err)
+	}
+	return nil
+}
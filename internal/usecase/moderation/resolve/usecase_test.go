@@ -0,0 +1,153 @@
+package resolve_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entN "test-question/internal/entity/notification"
+	entQ "test-question/internal/entity/question"
+	entRp "test-question/internal/entity/report"
+	uc "test-question/internal/usecase/moderation/resolve"
+	"test-question/internal/usecase/moderation/resolve/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testMocks struct {
+	questions       *mocks.QuestionRepository
+	answers         *mocks.AnswerRepository
+	questionRemover *mocks.QuestionRemover
+	answerRemover   *mocks.AnswerRemover
+	reports         *mocks.ReportRepository
+	notifications   *mocks.NotificationRepository
+	uow             *mocks.UnitOfWork
+	timer           *mocks.Timer
+	logger          *mocks.Logger
+}
+
+func newMocks(t *testing.T) *testMocks { //nolint:thelper
+	m := &testMocks{
+		questions:       mocks.NewQuestionRepository(t),
+		answers:         mocks.NewAnswerRepository(t),
+		questionRemover: mocks.NewQuestionRemover(t),
+		answerRemover:   mocks.NewAnswerRemover(t),
+		reports:         mocks.NewReportRepository(t),
+		notifications:   mocks.NewNotificationRepository(t),
+		uow:             mocks.NewUnitOfWork(t),
+		timer:           mocks.NewTimer(t),
+		logger:          mocks.NewLogger(t),
+	}
+
+	m.uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+
+	return m
+}
+
+func (m *testMocks) useCase() *uc.UseCase {
+	return uc.NewUseCase(m.questions, m.answers, m.questionRemover, m.answerRemover,
+		m.reports, m.notifications, m.uow, m.timer, m.logger)
+}
+
+func (m *testMocks) expectResolved(ctx context.Context, targetType entRp.TargetType, targetID int, resolution entRp.Resolution, n int) {
+	m.logger.On("DebugContext", ctx, "reports resolved",
+		"target_type", targetType,
+		"target_id", targetID,
+		"resolution", resolution,
+		"reports", n,
+		"moderator_id", "mod",
+	).Return()
+}
+
+func TestResolve_Dismiss(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "author"}, nil)
+	m.timer.On("Now").Return(now)
+	m.reports.On("ResolveOpen", ctx, entRp.TargetQuestion, 7, entRp.StatusDismissed, "mod", now).Return(3, nil)
+	m.questions.On("Unhide", ctx, 7).Return(nil)
+	m.expectResolved(ctx, entRp.TargetQuestion, 7, entRp.ResolutionDismiss, 3)
+
+	n, err := m.useCase().Resolve(ctx, entRp.TargetQuestion, 7, entRp.ResolutionDismiss, "mod")
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+}
+
+func TestResolve_WarnNotifiesAuthor(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 2, UserID: "author"}, nil)
+	m.timer.On("Now").Return(now)
+	m.reports.On("ResolveOpen", ctx, entRp.TargetAnswer, 5, entRp.StatusWarned, "mod", now).Return(1, nil)
+	m.answers.On("Unhide", ctx, 5).Return(nil)
+	m.notifications.On("CreateBatch", ctx, []*entN.Notification{{
+		UserID:     "author",
+		Type:       entN.TypeWarning,
+		ActorID:    "mod",
+		QuestionID: 2,
+		AnswerID:   5,
+		CreatedAt:  now,
+	}}).Return(nil)
+	m.expectResolved(ctx, entRp.TargetAnswer, 5, entRp.ResolutionWarn, 1)
+
+	_, err := m.useCase().Resolve(ctx, entRp.TargetAnswer, 5, entRp.ResolutionWarn, "mod")
+	require.NoError(t, err)
+}
+
+func TestResolve_DeleteRemovesContent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 2, UserID: "author"}, nil)
+	m.timer.On("Now").Return(now)
+	m.reports.On("ResolveOpen", ctx, entRp.TargetAnswer, 5, entRp.StatusDeleted, "mod", now).Return(2, nil)
+	m.answers.On("Unhide", ctx, 5).Return(nil)
+	m.answerRemover.On("RemoveAnswer", ctx, 5, "mod").Return(nil)
+	m.expectResolved(ctx, entRp.TargetAnswer, 5, entRp.ResolutionDelete, 2)
+
+	_, err := m.useCase().Resolve(ctx, entRp.TargetAnswer, 5, entRp.ResolutionDelete, "mod")
+	require.NoError(t, err)
+}
+
+func TestResolve_NoOpenReports(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "author"}, nil)
+	m.timer.On("Now").Return(now)
+	m.reports.On("ResolveOpen", ctx, entRp.TargetQuestion, 7, entRp.StatusDeleted, "mod", now).Return(0, nil)
+
+	_, err := m.useCase().Resolve(ctx, entRp.TargetQuestion, 7, entRp.ResolutionDelete, "mod")
+	require.ErrorIs(t, err, entRp.ErrNoOpenReports)
+	m.questionRemover.AssertNotCalled(t, "RemoveQuestion", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestResolve_InvalidResolution(t *testing.T) {
+	m := newMocks(t)
+
+	_, err := m.useCase().Resolve(context.Background(), entRp.TargetQuestion, 7, "ban", "mod")
+	require.ErrorIs(t, err, entRp.ErrInvalidResolution)
+}
+
+func TestResolve_NotFound(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(nil, entQ.ErrQuestionNotFound)
+
+	_, err := m.useCase().Resolve(ctx, entRp.TargetQuestion, 7, entRp.ResolutionDismiss, "mod")
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	notification "test-question/internal/entity/notification"
+)
+
+// NotificationRepository is an autogenerated mock type for the notificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// List provides a mock function with given fields: ctx, f
+func (_m *NotificationRepository) List(ctx context.Context, f notification.Filter) ([]*notification.Notification, error) {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 []*notification.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Filter) ([]*notification.Notification, error)); ok {
+		return rf(ctx, f)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Filter) []*notification.Notification); ok {
+		r0 = rf(ctx, f)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*notification.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, notification.Filter) error); ok {
+		r1 = rf(ctx, f)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,55 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	entN "test-question/internal/entity/notification"
+)
+
+//go:generate mockery --name=notificationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	notificationRepository interface {
+		List(ctx context.Context, f entN.Filter) ([]*entN.Notification, error)
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo   notificationRepository
+	logger logger
+}
+
+func NewUseCase(repo notificationRepository, logger logger) *UseCase {
+	return &UseCase{repo: repo, logger: logger}
+}
+
+func (uc *UseCase) ListNotifications(ctx context.Context, f entN.Filter) (*entN.Page, error) {
+	limit := f.Limit
+
+	// one extra row tells whether there is a next page
+	f.Limit++
+
+	items, err := uc.repo.List(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("list notifications: %w", err)
+	}
+
+	page := &entN.Page{Items: items}
+	if len(items) > limit {
+		page.Items = items[:limit]
+		page.NextCursor = page.Items[limit-1].ID
+	}
+
+	uc.logger.DebugContext(ctx, "notifications listed",
+		"user_id", f.UserID,
+		"count", len(page.Items),
+	)
+
+	return page, nil
+}
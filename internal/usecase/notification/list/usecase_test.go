@@ -0,0 +1,64 @@
+package list_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entN "test-question/internal/entity/notification"
+	uc "test-question/internal/usecase/notification/list"
+	"test-question/internal/usecase/notification/list/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListNotifications_HasNextPage(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("List", ctx, entN.Filter{UserID: "u1", UnreadOnly: true, BeforeID: 10, Limit: 3}).
+		Return([]*entN.Notification{{ID: 9}, {ID: 8}, {ID: 7}}, nil)
+	mLogger.On("DebugContext", ctx, "notifications listed", "user_id", "u1", "count", 2).Return()
+
+	page, err := uc.NewUseCase(mRepo, mLogger).ListNotifications(ctx, entN.Filter{
+		UserID:     "u1",
+		UnreadOnly: true,
+		BeforeID:   10,
+		Limit:      2,
+	})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	require.Equal(t, 8, page.NextCursor)
+}
+
+func TestListNotifications_LastPage(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("List", ctx, entN.Filter{UserID: "u1", Limit: 3}).
+		Return([]*entN.Notification{{ID: 2}}, nil)
+	mLogger.On("DebugContext", ctx, "notifications listed", "user_id", "u1", "count", 1).Return()
+
+	page, err := uc.NewUseCase(mRepo, mLogger).ListNotifications(ctx, entN.Filter{UserID: "u1", Limit: 2})
+	require.NoError(t, err)
+	require.Len(t, page.Items, 1)
+	require.Zero(t, page.NextCursor)
+}
+
+func TestListNotifications_Error(t *testing.T) {
+	mRepo := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.On("List", mock.Anything, mock.Anything).Return(nil, errors.New("db down"))
+
+	page, err := uc.NewUseCase(mRepo, mLogger).ListNotifications(context.Background(), entN.Filter{UserID: "u1", Limit: 2})
+	require.Nil(t, page)
+	require.Error(t, err)
+}
@@ -0,0 +1,76 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// NotificationRepository is an autogenerated mock type for the notificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// MarkAllRead provides a mock function with given fields: ctx, userID, at
+func (_m *NotificationRepository) MarkAllRead(ctx context.Context, userID string, at time.Time) (int, error) {
+	ret := _m.Called(ctx, userID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkAllRead")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (int, error)); ok {
+		return rf(ctx, userID, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) int); ok {
+		r0 = rf(ctx, userID, at)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, userID, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRead provides a mock function with given fields: ctx, userID, id, at
+func (_m *NotificationRepository) MarkRead(ctx context.Context, userID string, id int, at time.Time) error {
+	ret := _m.Called(ctx, userID, id, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkRead")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, time.Time) error); ok {
+		r0 = rf(ctx, userID, id, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,70 @@
+package mark_read
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entN "test-question/internal/entity/notification"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=notificationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	notificationRepository interface {
+		MarkRead(ctx context.Context, userID string, id int, at time.Time) error
+		MarkAllRead(ctx context.Context, userID string, at time.Time) (int, error)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo   notificationRepository
+	timer  timer
+	logger logger
+}
+
+func NewUseCase(repo notificationRepository, timer timer, logger logger) *UseCase {
+	return &UseCase{repo: repo, timer: timer, logger: logger}
+}
+
+func (uc *UseCase) MarkRead(ctx context.Context, userID string, notificationID int) error {
+	if err := uc.repo.MarkRead(ctx, userID, notificationID, uc.timer.Now()); err != nil {
+		if errors.Is(err, entN.ErrNotificationNotFound) {
+			return err
+		}
+		return fmt.Errorf("mark notification read: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "notification read",
+		"notification_id", notificationID,
+		"user_id", userID,
+	)
+
+	return nil
+}
+
+func (uc *UseCase) MarkAllRead(ctx context.Context, userID string) (int, error) {
+	n, err := uc.repo.MarkAllRead(ctx, userID, uc.timer.Now())
+	if err != nil {
+		return 0, fmt.Errorf("mark all notifications read: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "all notifications read",
+		"user_id", userID,
+		"count", n,
+	)
+
+	return n, nil
+}
@@ -0,0 +1,52 @@
+package mark_read_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entN "test-question/internal/entity/notification"
+	uc "test-question/internal/usecase/notification/mark_read"
+	"test-question/internal/usecase/notification/mark_read/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkRead(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	mRepo := mocks.NewNotificationRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.On("Now").Return(now)
+	mRepo.On("MarkRead", ctx, "u1", 5, now).Return(nil)
+	mRepo.On("MarkRead", ctx, "u1", 6, now).Return(entN.ErrNotificationNotFound)
+	mRepo.On("MarkRead", ctx, "u1", 7, now).Return(errors.New("db down"))
+	mLogger.On("DebugContext", ctx, "notification read", "notification_id", 5, "user_id", "u1").Return()
+
+	ucase := uc.NewUseCase(mRepo, mTimer, mLogger)
+
+	require.NoError(t, ucase.MarkRead(ctx, "u1", 5))
+	require.ErrorIs(t, ucase.MarkRead(ctx, "u1", 6), entN.ErrNotificationNotFound)
+	require.ErrorContains(t, ucase.MarkRead(ctx, "u1", 7), "mark notification read")
+}
+
+func TestMarkAllRead(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	mRepo := mocks.NewNotificationRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.On("Now").Return(now)
+	mRepo.On("MarkAllRead", ctx, "u1", now).Return(4, nil)
+	mLogger.On("DebugContext", ctx, "all notifications read", "user_id", "u1", "count", 4).Return()
+
+	n, err := uc.NewUseCase(mRepo, mTimer, mLogger).MarkAllRead(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+}
@@ -0,0 +1,58 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FollowRepository is an autogenerated mock type for the followRepository type
+type FollowRepository struct {
+	mock.Mock
+}
+
+// ListFollowers provides a mock function with given fields: ctx, questionID
+func (_m *FollowRepository) ListFollowers(ctx context.Context, questionID int) ([]string, error) {
+	ret := _m.Called(ctx, questionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFollowers")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]string, error)); ok {
+		return rf(ctx, questionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []string); ok {
+		r0 = rf(ctx, questionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, questionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewFollowRepository creates a new instance of FollowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFollowRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FollowRepository {
+	mock := &FollowRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
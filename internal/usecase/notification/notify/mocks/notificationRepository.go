@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	notification "test-question/internal/entity/notification"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationRepository is an autogenerated mock type for the notificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// CreateBatch provides a mock function with given fields: ctx, ns
+func (_m *NotificationRepository) CreateBatch(ctx context.Context, ns []*notification.Notification) error {
+	ret := _m.Called(ctx, ns)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateBatch")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*notification.Notification) error); ok {
+		r0 = rf(ctx, ns)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DisabledUsers provides a mock function with given fields: ctx, t, userIDs
+func (_m *NotificationRepository) DisabledUsers(ctx context.Context, t notification.Type, userIDs []string) ([]string, error) {
+	ret := _m.Called(ctx, t, userIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DisabledUsers")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Type, []string) ([]string, error)); ok {
+		return rf(ctx, t, userIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, notification.Type, []string) []string); ok {
+		r0 = rf(ctx, t, userIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, notification.Type, []string) error); ok {
+		r1 = rf(ctx, t, userIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,194 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	entM "test-question/internal/entity/mention"
+	entN "test-question/internal/entity/notification"
+	entO "test-question/internal/entity/outbox"
+)
+
+//go:generate mockery --name=followRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=notificationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	followRepository interface {
+		ListFollowers(ctx context.Context, questionID int) ([]string, error)
+	}
+
+	notificationRepository interface {
+		DisabledUsers(ctx context.Context, t entN.Type, userIDs []string) ([]string, error)
+		CreateBatch(ctx context.Context, ns []*entN.Notification) error
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	follows       followRepository
+	notifications notificationRepository
+	logger        logger
+}
+
+func NewUseCase(
+	follows followRepository,
+	notifications notificationRepository,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		follows:       follows,
+		notifications: notifications,
+		logger:        logger,
+	}
+}
+
+// QuestionCreated notifies the users mentioned in a new question.
+func (uc *UseCase) QuestionCreated(ctx context.Context, e entO.QuestionCreated) error {
+	q := e.Question
+	if len(q.Mentions) == 0 {
+		return nil
+	}
+
+	batch, err := uc.mentioned(ctx, q.UserID, q.Mentions)
+	if err != nil {
+		return err
+	}
+
+	if err = uc.notifications.CreateBatch(ctx, batch); err != nil {
+		return fmt.Errorf("create notifications: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "question notifications sent",
+		"question_id", q.ID,
+		"recipients", len(batch),
+	)
+
+	return nil
+}
+
+// AnswerCreated fans a new_answer notification out to the question owner and
+// followers, and a mention notification to the users the answer mentions.
+// A mentioned user gets only the mention. The answer author and users who
+// switched the type off are skipped.
+func (uc *UseCase) AnswerCreated(ctx context.Context, e entO.AnswerCreated) error {
+	q, a := e.Question, e.Answer
+
+	batch, err := uc.mentioned(ctx, a.UserID, a.Mentions)
+	if err != nil {
+		return err
+	}
+
+	followers, err := uc.follows.ListFollowers(ctx, q.ID)
+	if err != nil {
+		return fmt.Errorf("list followers: %w", err)
+	}
+
+	recipients, err := uc.recipients(ctx, entN.TypeNewAnswer, a.UserID, append([]string{q.UserID}, followers...))
+	if err != nil {
+		return err
+	}
+
+	notified := make(map[string]struct{}, len(batch))
+	for _, n := range batch {
+		notified[n.UserID] = struct{}{}
+	}
+
+	for _, userID := range recipients {
+		if _, ok := notified[userID]; ok {
+			continue
+		}
+		batch = append(batch, &entN.Notification{
+			UserID:     userID,
+			Type:       entN.TypeNewAnswer,
+			ActorID:    a.UserID,
+			QuestionID: q.ID,
+			AnswerID:   a.ID,
+			CreatedAt:  a.CreatedAt,
+		})
+	}
+
+	if err = uc.notifications.CreateBatch(ctx, batch); err != nil {
+		return fmt.Errorf("create notifications: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "answer notifications sent",
+		"answer_id", a.ID,
+		"recipients", len(batch),
+	)
+
+	return nil
+}
+
+// mentioned builds the mention notifications of a post.
+func (uc *UseCase) mentioned(ctx context.Context, actorID string, ms []*entM.Mention) ([]*entN.Notification, error) {
+	if len(ms) == 0 {
+		return []*entN.Notification{}, nil
+	}
+
+	byUser := make(map[string]*entM.Mention, len(ms))
+	candidates := make([]string, 0, len(ms))
+	for _, m := range ms {
+		byUser[m.UserID] = m
+		candidates = append(candidates, m.UserID)
+	}
+
+	recipients, err := uc.recipients(ctx, entN.TypeMention, actorID, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*entN.Notification, 0, len(recipients))
+	for _, userID := range recipients {
+		m := byUser[userID]
+		out = append(out, &entN.Notification{
+			UserID:     userID,
+			Type:       entN.TypeMention,
+			ActorID:    actorID,
+			QuestionID: m.QuestionID,
+			AnswerID:   m.AnswerID,
+			CreatedAt:  m.CreatedAt,
+		})
+	}
+
+	return out, nil
+}
+
+// recipients deduplicates candidates and drops the actor and opted-out users.
+func (uc *UseCase) recipients(ctx context.Context, t entN.Type, actorID string, candidates []string) ([]string, error) {
+	seen := make(map[string]struct{}, len(candidates))
+	out := make([]string, 0, len(candidates))
+
+	for _, userID := range candidates {
+		if _, ok := seen[userID]; ok || userID == actorID {
+			continue
+		}
+		seen[userID] = struct{}{}
+		out = append(out, userID)
+	}
+
+	if len(out) == 0 {
+		return out, nil
+	}
+
+	disabled, err := uc.notifications.DisabledUsers(ctx, t, out)
+	if err != nil {
+		return nil, fmt.Errorf("load notification settings: %w", err)
+	}
+
+	for _, userID := range disabled {
+		delete(seen, userID)
+	}
+
+	filtered := out[:0]
+	for _, userID := range out {
+		if _, ok := seen[userID]; ok {
+			filtered = append(filtered, userID)
+		}
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,187 @@
+package notify_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entM "test-question/internal/entity/mention"
+	entN "test-question/internal/entity/notification"
+	entO "test-question/internal/entity/outbox"
+	entQ "test-question/internal/entity/question"
+	uc "test-question/internal/usecase/notification/notify"
+	"test-question/internal/usecase/notification/notify/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnswerCreated_FansOut(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mFollows := mocks.NewFollowRepository(t)
+	mNotifications := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	q := &entQ.Question{ID: 1, UserID: "owner"}
+	a := &entA.Answer{ID: 9, QuestionID: 1, UserID: "author", CreatedAt: now}
+
+	mFollows.
+		On("ListFollowers", ctx, 1).
+		Return([]string{"owner", "author", "f1", "f2"}, nil)
+
+	mNotifications.
+		On("DisabledUsers", ctx, entN.TypeNewAnswer, []string{"owner", "f1", "f2"}).
+		Return([]string{"f2"}, nil)
+
+	newAnswer := func(userID string) *entN.Notification {
+		return &entN.Notification{
+			UserID:     userID,
+			Type:       entN.TypeNewAnswer,
+			ActorID:    "author",
+			QuestionID: 1,
+			AnswerID:   9,
+			CreatedAt:  now,
+		}
+	}
+
+	mNotifications.
+		On("CreateBatch", ctx, []*entN.Notification{newAnswer("owner"), newAnswer("f1")}).
+		Return(nil)
+
+	mLogger.
+		On("DebugContext", ctx, "answer notifications sent", "answer_id", 9, "recipients", 2).
+		Return()
+
+	err := uc.NewUseCase(mFollows, mNotifications, mLogger).AnswerCreated(ctx, entO.AnswerCreated{Question: *q, Answer: *a})
+	require.NoError(t, err)
+}
+
+func TestAnswerCreated_OwnerAnswersOwnQuestion(t *testing.T) {
+	ctx := context.Background()
+
+	mFollows := mocks.NewFollowRepository(t)
+	mNotifications := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mFollows.On("ListFollowers", ctx, 1).Return([]string{}, nil)
+	mNotifications.On("CreateBatch", ctx, []*entN.Notification{}).Return(nil)
+	mLogger.On("DebugContext", ctx, "answer notifications sent", "answer_id", 9, "recipients", 0).Return()
+
+	err := uc.NewUseCase(mFollows, mNotifications, mLogger).AnswerCreated(ctx, entO.AnswerCreated{
+		Question: entQ.Question{ID: 1, UserID: "owner"},
+		Answer:   entA.Answer{ID: 9, QuestionID: 1, UserID: "owner"},
+	})
+	require.NoError(t, err)
+	mNotifications.AssertNotCalled(t, "DisabledUsers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestAnswerCreated_FollowersError(t *testing.T) {
+	ctx := context.Background()
+
+	mFollows := mocks.NewFollowRepository(t)
+	mNotifications := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mFollows.On("ListFollowers", ctx, 1).Return(nil, errors.New("db down"))
+
+	err := uc.NewUseCase(mFollows, mNotifications, mLogger).AnswerCreated(ctx, entO.AnswerCreated{
+		Question: entQ.Question{ID: 1, UserID: "owner"},
+		Answer:   entA.Answer{ID: 9, QuestionID: 1, UserID: "author"},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "list followers")
+}
+
+func TestAnswerCreated_MentionsReplaceNewAnswer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mFollows := mocks.NewFollowRepository(t)
+	mNotifications := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mention := func(userID string) *entM.Mention {
+		return &entM.Mention{UserID: userID, AuthorID: "author", QuestionID: 1, AnswerID: 9, CreatedAt: now}
+	}
+	a := entA.Answer{
+		ID: 9, QuestionID: 1, UserID: "author", CreatedAt: now,
+		Mentions: []*entM.Mention{mention("owner"), mention("author"), mention("quiet"), mention("m1")},
+	}
+
+	mNotifications.
+		On("DisabledUsers", ctx, entN.TypeMention, []string{"owner", "quiet", "m1"}).
+		Return([]string{"quiet"}, nil)
+	mFollows.On("ListFollowers", ctx, 1).Return([]string{"quiet", "f1"}, nil)
+	mNotifications.
+		On("DisabledUsers", ctx, entN.TypeNewAnswer, []string{"owner", "quiet", "f1"}).
+		Return([]string{}, nil)
+
+	notification := func(userID string, typ entN.Type) *entN.Notification {
+		return &entN.Notification{
+			UserID:     userID,
+			Type:       typ,
+			ActorID:    "author",
+			QuestionID: 1,
+			AnswerID:   9,
+			CreatedAt:  now,
+		}
+	}
+
+	// quiet switched mentions off but still follows the question
+	mNotifications.
+		On("CreateBatch", ctx, []*entN.Notification{
+			notification("owner", entN.TypeMention),
+			notification("m1", entN.TypeMention),
+			notification("quiet", entN.TypeNewAnswer),
+			notification("f1", entN.TypeNewAnswer),
+		}).
+		Return(nil)
+	mLogger.On("DebugContext", ctx, "answer notifications sent", "answer_id", 9, "recipients", 4).Return()
+
+	err := uc.NewUseCase(mFollows, mNotifications, mLogger).AnswerCreated(ctx, entO.AnswerCreated{
+		Question: entQ.Question{ID: 1, UserID: "owner"},
+		Answer:   a,
+	})
+	require.NoError(t, err)
+}
+
+func TestQuestionCreated_NotifiesMentioned(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mNotifications := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	q := entQ.Question{ID: 1, UserID: "owner", Mentions: []*entM.Mention{
+		{UserID: "owner", AuthorID: "owner", QuestionID: 1, CreatedAt: now},
+		{UserID: "m1", AuthorID: "owner", QuestionID: 1, CreatedAt: now},
+	}}
+
+	mNotifications.On("DisabledUsers", ctx, entN.TypeMention, []string{"m1"}).Return([]string{}, nil)
+	mNotifications.
+		On("CreateBatch", ctx, []*entN.Notification{{
+			UserID:     "m1",
+			Type:       entN.TypeMention,
+			ActorID:    "owner",
+			QuestionID: 1,
+			CreatedAt:  now,
+		}}).
+		Return(nil)
+	mLogger.On("DebugContext", ctx, "question notifications sent", "question_id", 1, "recipients", 1).Return()
+
+	err := uc.NewUseCase(mocks.NewFollowRepository(t), mNotifications, mLogger).
+		QuestionCreated(ctx, entO.QuestionCreated{Question: q})
+	require.NoError(t, err)
+}
+
+func TestQuestionCreated_NoMentions(t *testing.T) {
+	mNotifications := mocks.NewNotificationRepository(t)
+
+	err := uc.NewUseCase(mocks.NewFollowRepository(t), mNotifications, mocks.NewLogger(t)).
+		QuestionCreated(context.Background(), entO.QuestionCreated{Question: entQ.Question{ID: 1}})
+	require.NoError(t, err)
+}
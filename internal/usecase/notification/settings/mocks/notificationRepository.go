@@ -0,0 +1,77 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	notification "test-question/internal/entity/notification"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationRepository is an autogenerated mock type for the notificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// GetSettings provides a mock function with given fields: ctx, userID
+func (_m *NotificationRepository) GetSettings(ctx context.Context, userID string) (notification.Settings, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSettings")
+	}
+
+	var r0 notification.Settings
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (notification.Settings, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) notification.Settings); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(notification.Settings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveSettings provides a mock function with given fields: ctx, userID, s
+func (_m *NotificationRepository) SaveSettings(ctx context.Context, userID string, s notification.Settings) error {
+	ret := _m.Called(ctx, userID, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveSettings")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, notification.Settings) error); ok {
+		r0 = rf(ctx, userID, s)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,63 @@
+package settings
+
+import (
+	"context"
+	"fmt"
+
+	entN "test-question/internal/entity/notification"
+)
+
+//go:generate mockery --name=notificationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	notificationRepository interface {
+		GetSettings(ctx context.Context, userID string) (entN.Settings, error)
+		SaveSettings(ctx context.Context, userID string, s entN.Settings) error
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo   notificationRepository
+	logger logger
+}
+
+func NewUseCase(repo notificationRepository, logger logger) *UseCase {
+	return &UseCase{repo: repo, logger: logger}
+}
+
+// GetSettings returns a switch for every known type, defaults included.
+func (uc *UseCase) GetSettings(ctx context.Context, userID string) (entN.Settings, error) {
+	stored, err := uc.repo.GetSettings(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get notification settings: %w", err)
+	}
+
+	out := make(entN.Settings, len(entN.Types))
+	for _, t := range entN.Types {
+		out[t] = stored.Enabled(t)
+	}
+
+	return out, nil
+}
+
+// UpdateSettings applies a partial update; types not mentioned keep their value.
+func (uc *UseCase) UpdateSettings(ctx context.Context, userID string, s entN.Settings) (entN.Settings, error) {
+	for t := range s {
+		if !t.Valid() {
+			return nil, fmt.Errorf("%w: %s", entN.ErrUnknownType, t)
+		}
+	}
+
+	if err := uc.repo.SaveSettings(ctx, userID, s); err != nil {
+		return nil, fmt.Errorf("save notification settings: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "notification settings updated", "user_id", userID)
+
+	return uc.GetSettings(ctx, userID)
+}
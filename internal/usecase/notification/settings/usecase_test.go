@@ -0,0 +1,63 @@
+package settings_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entN "test-question/internal/entity/notification"
+	uc "test-question/internal/usecase/notification/settings"
+	"test-question/internal/usecase/notification/settings/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSettings_Defaults(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.On("GetSettings", ctx, "u1").Return(entN.Settings{}, nil)
+
+	out, err := uc.NewUseCase(mRepo, mLogger).GetSettings(ctx, "u1")
+	require.NoError(t, err)
+	require.Equal(t, entN.Settings{entN.TypeNewAnswer: true, entN.TypeMention: true}, out)
+}
+
+func TestUpdateSettings(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	update := entN.Settings{entN.TypeNewAnswer: false}
+
+	mRepo.On("SaveSettings", ctx, "u1", update).Return(nil)
+	mRepo.On("GetSettings", ctx, "u1").Return(update, nil)
+	mLogger.On("DebugContext", ctx, "notification settings updated", "user_id", "u1").Return()
+
+	out, err := uc.NewUseCase(mRepo, mLogger).UpdateSettings(ctx, "u1", update)
+	require.NoError(t, err)
+	require.Equal(t, entN.Settings{entN.TypeNewAnswer: false, entN.TypeMention: true}, out)
+}
+
+func TestUpdateSettings_UnknownType(t *testing.T) {
+	mRepo := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	_, err := uc.NewUseCase(mRepo, mLogger).UpdateSettings(context.Background(), "u1", entN.Settings{"sms": true})
+	require.ErrorIs(t, err, entN.ErrUnknownType)
+}
+
+func TestUpdateSettings_SaveError(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewNotificationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.On("SaveSettings", ctx, "u1", entN.Settings{entN.TypeNewAnswer: true}).Return(errors.New("db down"))
+
+	_, err := uc.NewUseCase(mRepo, mLogger).UpdateSettings(ctx, "u1", entN.Settings{entN.TypeNewAnswer: true})
+	require.Error(t, err)
+}
@@ -0,0 +1,173 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	outbox "test-question/internal/entity/outbox"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// OutboxRepository is an autogenerated mock type for the outboxRepository type
+type OutboxRepository struct {
+	mock.Mock
+}
+
+// ClaimDue provides a mock function with given fields: ctx, now, leaseUntil, limit
+func (_m *OutboxRepository) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*outbox.Message, error) {
+	ret := _m.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDue")
+	}
+
+	var r0 []*outbox.Message
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]*outbox.Message, error)); ok {
+		return rf(ctx, now, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []*outbox.Message); ok {
+		r0 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*outbox.Message)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HandledBy provides a mock function with given fields: ctx, messageID
+func (_m *OutboxRepository) HandledBy(ctx context.Context, messageID int64) ([]string, error) {
+	ret := _m.Called(ctx, messageID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for HandledBy")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) ([]string, error)); ok {
+		return rf(ctx, messageID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) []string); ok {
+		r0 = rf(ctx, messageID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, messageID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkFailed provides a mock function with given fields: ctx, messageID, attempts, nextAttemptAt, lastErr
+func (_m *OutboxRepository) MarkFailed(ctx context.Context, messageID int64, attempts int, nextAttemptAt time.Time, lastErr string) error {
+	ret := _m.Called(ctx, messageID, attempts, nextAttemptAt, lastErr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int, time.Time, string) error); ok {
+		r0 = rf(ctx, messageID, attempts, nextAttemptAt, lastErr)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkHandled provides a mock function with given fields: ctx, messageID, handler, at
+func (_m *OutboxRepository) MarkHandled(ctx context.Context, messageID int64, handler string, at time.Time) error {
+	ret := _m.Called(ctx, messageID, handler, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkHandled")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string, time.Time) error); ok {
+		r0 = rf(ctx, messageID, handler, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MarkProcessed provides a mock function with given fields: ctx, messageID, at
+func (_m *OutboxRepository) MarkProcessed(ctx context.Context, messageID int64, at time.Time) error {
+	ret := _m.Called(ctx, messageID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkProcessed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, time.Time) error); ok {
+		r0 = rf(ctx, messageID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeProcessed provides a mock function with given fields: ctx, before
+func (_m *OutboxRepository) PurgeProcessed(ctx context.Context, before time.Time) (int, error) {
+	ret := _m.Called(ctx, before)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeProcessed")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepository {
+	mock := &OutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
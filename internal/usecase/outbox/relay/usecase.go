@@ -0,0 +1,199 @@
+package relay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	entO "test-question/internal/entity/outbox"
+	"test-question/internal/pkg/backoff"
+)
+
+//go:generate mockery --name=outboxRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	outboxRepository interface {
+		ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entO.Message, error)
+		HandledBy(ctx context.Context, messageID int64) ([]string, error)
+		MarkHandled(ctx context.Context, messageID int64, handler string, at time.Time) error
+		MarkProcessed(ctx context.Context, messageID int64, at time.Time) error
+		MarkFailed(ctx context.Context, messageID int64, attempts int, nextAttemptAt time.Time, lastErr string) error
+		PurgeProcessed(ctx context.Context, before time.Time) (int, error)
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+		WarnContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+const (
+	// how long a claimed message is hidden from other relays
+	leaseDuration = time.Minute
+
+	maxBackoff = time.Hour
+)
+
+type Config struct {
+	BatchSize int
+	// BackoffBase is the delay after the first failed relay of a message;
+	// it doubles on each subsequent failure up to maxBackoff.
+	BackoffBase time.Duration
+	// Retention is how long processed messages are kept before Cleanup removes them.
+	Retention time.Duration
+}
+
+type handler struct {
+	name   string
+	handle func(ctx context.Context, m *entO.Message) error
+}
+
+type UseCase struct {
+	repo     outboxRepository
+	uow      unitOfWork
+	timer    timer
+	logger   logger
+	cfg      Config
+	handlers map[entO.EventType][]handler
+}
+
+func NewUseCase(
+	repo outboxRepository,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+	cfg Config,
+) *UseCase {
+	return &UseCase{
+		repo:     repo,
+		uow:      uow,
+		timer:    timer,
+		logger:   logger,
+		cfg:      cfg,
+		handlers: make(map[entO.EventType][]handler),
+	}
+}
+
+// Subscribe registers fn for events of type E under a name that must be
+// unique per event type: it is how the relay remembers fn already ran for a
+// message. fn runs in its own transaction and may see an event more than
+// once, so it must be idempotent for writes outside that transaction.
+// Subscribe is not safe for use once the relay is running.
+func Subscribe[E entO.Event](uc *UseCase, name string, fn func(ctx context.Context, e E) error) {
+	var zero E
+
+	t := zero.Type()
+	uc.handlers[t] = append(uc.handlers[t], handler{
+		name: name,
+		handle: func(ctx context.Context, m *entO.Message) error {
+			var e E
+			if err := json.Unmarshal(m.Payload, &e); err != nil {
+				return fmt.Errorf("decode %s: %w", m.Type, err)
+			}
+			return fn(ctx, e)
+		},
+	})
+}
+
+// RelayDue dispatches one batch of due messages and returns how many were claimed.
+func (uc *UseCase) RelayDue(ctx context.Context) (int, error) {
+	now := uc.timer.Now()
+
+	ms, err := uc.repo.ClaimDue(ctx, now, now.Add(leaseDuration), uc.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("claim outbox messages: %w", err)
+	}
+
+	for _, m := range ms {
+		if err = uc.relay(ctx, m); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(ms), nil
+}
+
+// Cleanup removes messages processed longer than the retention ago.
+func (uc *UseCase) Cleanup(ctx context.Context) (int, error) {
+	n, err := uc.repo.PurgeProcessed(ctx, uc.timer.Now().Add(-uc.cfg.Retention))
+	if err != nil {
+		return 0, fmt.Errorf("purge outbox messages: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "outbox messages purged", "count", n)
+
+	return n, nil
+}
+
+// relay runs the handlers that have not yet handled m. A failing handler
+// does not stop the others; m is retried later for the failed ones only.
+func (uc *UseCase) relay(ctx context.Context, m *entO.Message) error {
+	done, err := uc.repo.HandledBy(ctx, m.ID)
+	if err != nil {
+		return fmt.Errorf("load outbox message handlers: %w", err)
+	}
+
+	var failed []string
+
+	for _, h := range uc.handlers[m.Type] {
+		if slices.Contains(done, h.name) {
+			continue
+		}
+
+		err = uc.uow.Do(ctx, func(ctx context.Context) error {
+			if err := h.handle(ctx, m); err != nil {
+				return err
+			}
+			return uc.repo.MarkHandled(ctx, m.ID, h.name, uc.timer.Now())
+		})
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", h.name, err))
+		}
+	}
+
+	at := uc.timer.Now()
+
+	if len(failed) > 0 {
+		attempts := m.Attempts + 1
+		next := at.Add(backoff.Exponential(uc.cfg.BackoffBase, maxBackoff, attempts))
+		lastErr := strings.Join(failed, "; ")
+
+		if err = uc.repo.MarkFailed(ctx, m.ID, attempts, next, lastErr); err != nil {
+			return fmt.Errorf("mark outbox message failed: %w", err)
+		}
+
+		uc.logger.WarnContext(ctx, "outbox message handling failed",
+			"message_id", m.ID,
+			"type", string(m.Type),
+			"attempts", attempts,
+			"next_attempt_at", next,
+			"err", lastErr,
+		)
+		return nil
+	}
+
+	if err = uc.repo.MarkProcessed(ctx, m.ID, at); err != nil {
+		return fmt.Errorf("mark outbox message processed: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "outbox message processed",
+		"message_id", m.ID,
+		"type", string(m.Type),
+	)
+
+	return nil
+}
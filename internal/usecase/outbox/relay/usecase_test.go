@@ -0,0 +1,199 @@
+package relay_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entO "test-question/internal/entity/outbox"
+	entQ "test-question/internal/entity/question"
+	uc "test-question/internal/usecase/outbox/relay"
+	"test-question/internal/usecase/outbox/relay/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var cfg = uc.Config{ //nolint:gochecknoglobals
+	BatchSize:   10,
+	BackoffBase: 5 * time.Second,
+	Retention:   24 * time.Hour,
+}
+
+func newTimer(t *testing.T, now time.Time) *mocks.Timer { //nolint:thelper
+	mTimer := mocks.NewTimer(t)
+	mTimer.On("Now").Return(now)
+	return mTimer
+}
+
+func newUOW(t *testing.T) *mocks.UnitOfWork { //nolint:thelper
+	mUOW := mocks.NewUnitOfWork(t)
+	mUOW.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	return mUOW
+}
+
+func answerCreated(t *testing.T, id int64, attempts int) *entO.Message { //nolint:thelper
+	e := entO.AnswerCreated{
+		Question: entQ.Question{ID: 3, UserID: "owner"},
+		Answer:   entA.Answer{ID: 9, QuestionID: 3, UserID: "author"},
+	}
+	payload, err := json.Marshal(e)
+	require.NoError(t, err)
+
+	return &entO.Message{ID: id, Type: e.Type(), Key: e.Key(), Payload: payload, Attempts: attempts}
+}
+
+func TestRelayDue_Processed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mRepo := mocks.NewOutboxRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("ClaimDue", mock.Anything, now, now.Add(time.Minute), cfg.BatchSize).
+		Return([]*entO.Message{answerCreated(t, 1, 0)}, nil)
+	mRepo.
+		On("HandledBy", mock.Anything, int64(1)).
+		Return(nil, nil)
+	mRepo.
+		On("MarkHandled", mock.Anything, int64(1), "notifications", now).
+		Return(nil)
+	mRepo.
+		On("MarkProcessed", mock.Anything, int64(1), now).
+		Return(nil)
+	mLogger.
+		On("DebugContext", mock.Anything, "outbox message processed",
+			"message_id", int64(1), "type", "answer.created").
+		Return()
+
+	r := uc.NewUseCase(mRepo, newUOW(t), newTimer(t, now), mLogger, cfg)
+
+	var got entO.AnswerCreated
+	uc.Subscribe(r, "notifications", func(_ context.Context, e entO.AnswerCreated) error {
+		got = e
+		return nil
+	})
+	uc.Subscribe(r, "webhooks", func(_ context.Context, _ entO.QuestionCreated) error {
+		t.Fatal("handler for another event type called")
+		return nil
+	})
+
+	n, err := r.RelayDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, 9, got.Answer.ID)
+	require.Equal(t, "owner", got.Question.UserID)
+}
+
+func TestRelayDue_SkipsHandledAndRetriesFailed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mRepo := mocks.NewOutboxRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("ClaimDue", mock.Anything, now, now.Add(time.Minute), cfg.BatchSize).
+		Return([]*entO.Message{answerCreated(t, 1, 2)}, nil)
+	mRepo.
+		On("HandledBy", mock.Anything, int64(1)).
+		Return([]string{"notifications"}, nil)
+	mRepo.
+		On("MarkHandled", mock.Anything, int64(1), "search", now).
+		Return(nil)
+	// third failure: 5s doubled twice
+	mRepo.
+		On("MarkFailed", mock.Anything, int64(1), 3, now.Add(20*time.Second), "webhooks: db down").
+		Return(nil)
+	mLogger.
+		On("WarnContext", mock.Anything, "outbox message handling failed",
+			"message_id", int64(1), "type", "answer.created", "attempts", 3,
+			"next_attempt_at", now.Add(20*time.Second), "err", "webhooks: db down").
+		Return()
+
+	r := uc.NewUseCase(mRepo, newUOW(t), newTimer(t, now), mLogger, cfg)
+
+	uc.Subscribe(r, "notifications", func(_ context.Context, _ entO.AnswerCreated) error {
+		t.Fatal("already handled")
+		return nil
+	})
+	uc.Subscribe(r, "webhooks", func(_ context.Context, _ entO.AnswerCreated) error {
+		return errors.New("db down")
+	})
+	searched := false
+	uc.Subscribe(r, "search", func(_ context.Context, _ entO.AnswerCreated) error {
+		searched = true
+		return nil
+	})
+
+	n, err := r.RelayDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.True(t, searched)
+}
+
+func TestRelayDue_NoHandlers(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mRepo := mocks.NewOutboxRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("ClaimDue", mock.Anything, now, now.Add(time.Minute), cfg.BatchSize).
+		Return([]*entO.Message{answerCreated(t, 1, 0)}, nil)
+	mRepo.
+		On("HandledBy", mock.Anything, int64(1)).
+		Return(nil, nil)
+	mRepo.
+		On("MarkProcessed", mock.Anything, int64(1), now).
+		Return(nil)
+	mLogger.
+		On("DebugContext", mock.Anything, "outbox message processed",
+			"message_id", int64(1), "type", "answer.created").
+		Return()
+
+	n, err := uc.NewUseCase(mRepo, mocks.NewUnitOfWork(t), newTimer(t, now), mLogger, cfg).RelayDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
+
+func TestRelayDue_ClaimError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mRepo := mocks.NewOutboxRepository(t)
+	mRepo.
+		On("ClaimDue", mock.Anything, now, now.Add(time.Minute), cfg.BatchSize).
+		Return(nil, errors.New("db down"))
+
+	_, err := uc.NewUseCase(mRepo, mocks.NewUnitOfWork(t), newTimer(t, now), mocks.NewLogger(t), cfg).RelayDue(ctx)
+	require.ErrorContains(t, err, "claim outbox messages")
+}
+
+func TestCleanup(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mRepo := mocks.NewOutboxRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("PurgeProcessed", mock.Anything, now.Add(-cfg.Retention)).
+		Return(4, nil)
+	mLogger.
+		On("DebugContext", mock.Anything, "outbox messages purged", "count", 4).
+		Return()
+
+	n, err := uc.NewUseCase(mRepo, mocks.NewUnitOfWork(t), newTimer(t, now), mLogger, cfg).Cleanup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+}
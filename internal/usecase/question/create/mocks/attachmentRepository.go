@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepository is an autogenerated mock type for the attachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+// AttachToQuestion provides a mock function with given fields: ctx, userID, questionID, ids
+func (_m *AttachmentRepository) AttachToQuestion(ctx context.Context, userID string, questionID int, ids []int) error {
+	ret := _m.Called(ctx, userID, questionID, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachToQuestion")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, []int) error); ok {
+		r0 = rf(ctx, userID, questionID, ids)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,11 +3,11 @@
 package mocks
 
 import (
-	"context"
+	context "context"
 
-	"github.com/stretchr/testify/mock"
+	mock "github.com/stretchr/testify/mock"
 
-	"test-question/internal/entity/question"
+	question "test-question/internal/entity/question"
 )
 
 // QuestionRepository is an autogenerated mock type for the questionRepository type
@@ -45,6 +45,36 @@ func (_m *QuestionRepository) Create(ctx context.Context, q *question.Question)
 	return r0, r1
 }
 
+// FindSimilar provides a mock function with given fields: ctx, text, threshold, limit
+func (_m *QuestionRepository) FindSimilar(ctx context.Context, text string, threshold float64, limit int) ([]*question.SimilarQuestion, error) {
+	ret := _m.Called(ctx, text, threshold, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindSimilar")
+	}
+
+	var r0 []*question.SimilarQuestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, int) ([]*question.SimilarQuestion, error)); ok {
+		return rf(ctx, text, threshold, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, int) []*question.SimilarQuestion); ok {
+		r0 = rf(ctx, text, threshold, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*question.SimilarQuestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, float64, int) error); ok {
+		r1 = rf(ctx, text, threshold, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewQuestionRepository creates a new instance of QuestionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQuestionRepository(t interface {
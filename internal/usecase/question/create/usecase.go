@@ -5,16 +5,50 @@ import (
 	"fmt"
 	"time"
 
+	entM "test-question/internal/entity/mention"
+	entO "test-question/internal/entity/outbox"
 	entQ "test-question/internal/entity/question"
 )
 
 //go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=attachmentRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=mentionRecorder --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=outboxRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=contentPolicy --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
 
 type (
 	questionRepository interface {
 		Create(ctx context.Context, q *entQ.Question) (*entQ.Question, error)
+		FindSimilar(ctx context.Context, text string, threshold float64, limit int) ([]*entQ.SimilarQuestion, error)
+	}
+
+	attachmentRepository interface {
+		AttachToQuestion(ctx context.Context, userID string, questionID int, ids []int) error
+	}
+
+	mentionRecorder interface {
+		Record(
+			ctx context.Context,
+			authorID string,
+			questionID, answerID int,
+			text string,
+			at time.Time,
+		) ([]*entM.Mention, error)
+	}
+
+	outboxRepository interface {
+		Record(ctx context.Context, events ...entO.Event) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	contentPolicy interface {
+		Check(field, text string) error
 	}
 
 	timer interface {
@@ -26,43 +60,135 @@ type (
 	}
 )
 
+// Config tunes duplicate detection; a zero DuplicateLimit turns it off.
+type Config struct {
+	DuplicateThreshold float64
+	DuplicateLimit     int
+}
+
 type UseCase struct {
-	repo   questionRepository
-	timer  timer
-	logger logger
+	repo        questionRepository
+	attachments attachmentRepository
+	mentions    mentionRecorder
+	outbox      outboxRepository
+	uow         unitOfWork
+	policy      contentPolicy
+	timer       timer
+	logger      logger
+	cfg         Config
 }
 
 func NewUseCase(
 	questions questionRepository,
+	attachments attachmentRepository,
+	mentions mentionRecorder,
+	outbox outboxRepository,
+	uow unitOfWork,
+	policy contentPolicy,
 	timer timer,
 	logger logger,
+	cfg Config,
 ) *UseCase {
 	return &UseCase{
-		repo:   questions,
-		timer:  timer,
-		logger: logger,
+		repo:        questions,
+		attachments: attachments,
+		mentions:    mentions,
+		outbox:      outbox,
+		uow:         uow,
+		policy:      policy,
+		timer:       timer,
+		logger:      logger,
+		cfg:         cfg,
 	}
 }
 
+// CreateQuestion creates a question unless similar ones already exist, in
+// which case it returns them with ErrPossibleDuplicates. With force the
+// question is created anyway and the similar ones come back as a warning.
+// Text breaking the content policy is refused with policy Violations.
+// The user's uploads given by attachmentIDs are attached to the question;
+// if any is unavailable nothing is created. Users mentioned as @username
+// are linked to the question and notified once it is created.
 func (uc *UseCase) CreateQuestion(
 	ctx context.Context,
 	userID string,
 	text string,
-) (*entQ.Question, error) {
+	force bool,
+	attachmentIDs []int,
+) (*entQ.Question, []*entQ.SimilarQuestion, error) {
+	if err := uc.policy.Check("Text", text); err != nil {
+		return nil, nil, err
+	}
+
+	similar, err := uc.findSimilar(ctx, text)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(similar) > 0 && !force {
+		uc.logger.DebugContext(ctx, "possible duplicate questions",
+			"user_id", userID,
+			"count", len(similar),
+		)
+		return nil, similar, entQ.ErrPossibleDuplicates
+	}
+
 	q := &entQ.Question{
 		Text:      text,
 		UserID:    userID,
+		Status:    entQ.StatusOpen,
 		CreatedAt: uc.timer.Now(),
 	}
 
-	out, err := uc.repo.Create(ctx, q)
+	var out *entQ.Question
+
+	err = uc.uow.Do(ctx, func(ctx context.Context) error {
+		var err error
+
+		out, err = uc.repo.Create(ctx, q)
+		if err != nil {
+			return fmt.Errorf("create question: %w", err)
+		}
+
+		if len(attachmentIDs) > 0 {
+			err = uc.attachments.AttachToQuestion(ctx, userID, out.ID, attachmentIDs)
+			if err != nil {
+				return fmt.Errorf("attach files: %w", err)
+			}
+		}
+
+		out.Mentions, err = uc.mentions.Record(ctx, userID, out.ID, 0, text, out.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("record mentions: %w", err)
+		}
+
+		err = uc.outbox.Record(ctx, entO.QuestionCreated{Question: *out, OccurredAt: out.CreatedAt})
+		if err != nil {
+			return fmt.Errorf("record question created: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("create question: %w", err)
+		return nil, nil, err
 	}
 
 	uc.logger.DebugContext(ctx, "question created",
 		"question_id", out.ID,
 	)
 
-	return out, nil
+	return out, similar, nil
+}
+
+func (uc *UseCase) findSimilar(ctx context.Context, text string) ([]*entQ.SimilarQuestion, error) {
+	if uc.cfg.DuplicateLimit <= 0 {
+		return nil, nil
+	}
+
+	similar, err := uc.repo.FindSimilar(ctx, text, uc.cfg.DuplicateThreshold, uc.cfg.DuplicateLimit)
+	if err != nil {
+		return nil, fmt.Errorf("find similar questions: %w", err)
+	}
+
+	return similar, nil
 }
@@ -6,19 +6,53 @@ import (
 	"testing"
 	"time"
 
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
+	entO "test-question/internal/entity/outbox"
+	entP "test-question/internal/entity/policy"
 	entQ "test-question/internal/entity/question"
 	uc "test-question/internal/usecase/question/create"
 	mocks2 "test-question/internal/usecase/question/create/mocks"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
+func passthroughUoW(t *testing.T) *mocks2.UnitOfWork {
+	mUow := mocks2.NewUnitOfWork(t)
+	mUow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	return mUow
+}
+
+func allowedContent(t *testing.T) *mocks2.ContentPolicy {
+	mPolicy := mocks2.NewContentPolicy(t)
+	mPolicy.On("Check", "Text", mock.Anything).Return(nil)
+	return mPolicy
+}
+
+// noMentions stands in for texts that mention nobody.
+func noMentions(t *testing.T) *mocks2.MentionRecorder {
+	mMentions := mocks2.NewMentionRecorder(t)
+	mMentions.
+		On("Record", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Maybe()
+	return mMentions
+}
+
+var cfg = uc.Config{DuplicateThreshold: 0.5, DuplicateLimit: 5}
+
 func TestCreateQuestion_Success(t *testing.T) {
 	ctx := context.Background()
 
 	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
 
 	mRepo := mocks2.NewQuestionRepository(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
 	mTimer := mocks2.NewTimer(t)
 	mLogger := mocks2.NewLogger(t)
 
@@ -29,16 +63,27 @@ func TestCreateQuestion_Success(t *testing.T) {
 	expectedInput := &entQ.Question{
 		Text:      "hello world",
 		UserID:    "1",
+		Status:    entQ.StatusOpen,
+		CreatedAt: now,
+	}
+
+	created := &entQ.Question{
+		ID:        101,
+		Text:      "hello world",
 		CreatedAt: now,
 	}
 
+	mRepo.
+		On("FindSimilar", ctx, "hello world", 0.5, 5).
+		Return(nil, nil)
+
 	mRepo.
 		On("Create", ctx, expectedInput).
-		Return(&entQ.Question{
-			ID:        101,
-			Text:      "hello world",
-			CreatedAt: now,
-		}, nil)
+		Return(created, nil)
+
+	mOutbox.
+		On("Record", ctx, entO.QuestionCreated{Question: *created, OccurredAt: now}).
+		Return(nil)
 
 	mLogger.
 		On("DebugContext",
@@ -48,10 +93,11 @@ func TestCreateQuestion_Success(t *testing.T) {
 		).
 		Return()
 
-	ucase := uc.NewUseCase(mRepo, mTimer, mLogger)
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), noMentions(t), mOutbox, passthroughUoW(t), allowedContent(t), mTimer, mLogger, cfg)
 
-	out, err := ucase.CreateQuestion(ctx, "1", "hello world")
+	out, similar, err := ucase.CreateQuestion(ctx, "1", "hello world", false, nil)
 	require.NoError(t, err)
+	require.Empty(t, similar)
 	require.NotNil(t, out)
 	require.Equal(t, 101, out.ID)
 	require.Equal(t, "hello world", out.Text)
@@ -64,6 +110,7 @@ func TestCreateQuestion_RepoError(t *testing.T) {
 	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
 
 	mRepo := mocks2.NewQuestionRepository(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
 	mTimer := mocks2.NewTimer(t)
 	mLogger := mocks2.NewLogger(t)
 
@@ -74,18 +121,292 @@ func TestCreateQuestion_RepoError(t *testing.T) {
 	expectedInput := &entQ.Question{
 		Text:      "qqq",
 		UserID:    "1",
+		Status:    entQ.StatusOpen,
 		CreatedAt: now,
 	}
 
+	mRepo.
+		On("FindSimilar", ctx, "qqq", 0.5, 5).
+		Return(nil, nil)
+
 	mRepo.
 		On("Create", ctx, expectedInput).
 		Return(nil, errors.New("db fail"))
 
-	ucase := uc.NewUseCase(mRepo, mTimer, mLogger)
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), noMentions(t), mOutbox, passthroughUoW(t), allowedContent(t), mTimer, mLogger, cfg)
 
-	out, err := ucase.CreateQuestion(ctx, "1", "qqq")
+	out, _, err := ucase.CreateQuestion(ctx, "1", "qqq", false, nil)
 
 	require.Nil(t, out)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "create question")
 }
+
+func TestCreateQuestion_RecordError(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
+	mTimer := mocks2.NewTimer(t)
+	mLogger := mocks2.NewLogger(t)
+
+	mTimer.
+		On("Now").
+		Return(now)
+
+	created := &entQ.Question{ID: 101, Text: "qqq", UserID: "1", CreatedAt: now}
+
+	mRepo.
+		On("FindSimilar", ctx, "qqq", 0.5, 5).
+		Return(nil, nil)
+
+	mRepo.
+		On("Create", ctx, mock.Anything).
+		Return(created, nil)
+
+	mOutbox.
+		On("Record", ctx, entO.QuestionCreated{Question: *created, OccurredAt: now}).
+		Return(errors.New("db fail"))
+
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), noMentions(t), mOutbox, passthroughUoW(t), allowedContent(t), mTimer, mLogger, cfg)
+
+	out, _, err := ucase.CreateQuestion(ctx, "1", "qqq", false, nil)
+
+	require.Nil(t, out)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "record question created")
+}
+
+func TestCreateQuestion_PossibleDuplicates(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
+	mUow := mocks2.NewUnitOfWork(t)
+	mTimer := mocks2.NewTimer(t)
+	mLogger := mocks2.NewLogger(t)
+
+	similar := []*entQ.SimilarQuestion{{ID: 7, Text: "hello world!", Similarity: 0.9}}
+
+	mRepo.
+		On("FindSimilar", ctx, "hello world", 0.5, 5).
+		Return(similar, nil)
+
+	mLogger.
+		On("DebugContext",
+			ctx,
+			"possible duplicate questions",
+			"user_id", "1",
+			"count", 1,
+		).
+		Return()
+
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, allowedContent(t), mTimer, mLogger, cfg)
+
+	out, got, err := ucase.CreateQuestion(ctx, "1", "hello world", false, nil)
+	require.ErrorIs(t, err, entQ.ErrPossibleDuplicates)
+	require.Nil(t, out)
+	require.Equal(t, similar, got)
+	mRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateQuestion_ForcedWithDuplicates(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
+	mTimer := mocks2.NewTimer(t)
+	mLogger := mocks2.NewLogger(t)
+
+	similar := []*entQ.SimilarQuestion{{ID: 7, Text: "hello world!", Similarity: 0.9}}
+	created := &entQ.Question{ID: 101, Text: "hello world", UserID: "1", CreatedAt: now}
+
+	mTimer.On("Now").Return(now)
+
+	mRepo.
+		On("FindSimilar", ctx, "hello world", 0.5, 5).
+		Return(similar, nil)
+
+	mRepo.
+		On("Create", ctx, mock.Anything).
+		Return(created, nil)
+
+	mOutbox.
+		On("Record", ctx, entO.QuestionCreated{Question: *created, OccurredAt: now}).
+		Return(nil)
+
+	mLogger.
+		On("DebugContext", ctx, "question created", "question_id", 101).
+		Return()
+
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), noMentions(t), mOutbox, passthroughUoW(t), allowedContent(t), mTimer, mLogger, cfg)
+
+	out, got, err := ucase.CreateQuestion(ctx, "1", "hello world", true, nil)
+	require.NoError(t, err)
+	require.Equal(t, 101, out.ID)
+	require.Equal(t, similar, got)
+}
+
+func TestCreateQuestion_DetectionDisabled(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
+	mTimer := mocks2.NewTimer(t)
+	mLogger := mocks2.NewLogger(t)
+
+	created := &entQ.Question{ID: 101, Text: "hello world", UserID: "1", CreatedAt: now}
+
+	mTimer.On("Now").Return(now)
+	mRepo.On("Create", ctx, mock.Anything).Return(created, nil)
+	mOutbox.On("Record", ctx, mock.Anything).Return(nil)
+	mLogger.On("DebugContext", ctx, "question created", "question_id", 101).Return()
+
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), noMentions(t), mOutbox, passthroughUoW(t), allowedContent(t), mTimer, mLogger, uc.Config{})
+
+	_, _, err := ucase.CreateQuestion(ctx, "1", "hello world", false, nil)
+	require.NoError(t, err)
+	mRepo.AssertNotCalled(t, "FindSimilar", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateQuestion_FindSimilarError(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
+	mUow := mocks2.NewUnitOfWork(t)
+	mTimer := mocks2.NewTimer(t)
+	mLogger := mocks2.NewLogger(t)
+
+	mRepo.
+		On("FindSimilar", ctx, "qqq", 0.5, 5).
+		Return(nil, errors.New("db fail"))
+
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, allowedContent(t), mTimer, mLogger, cfg)
+
+	_, _, err := ucase.CreateQuestion(ctx, "1", "qqq", false, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "find similar questions")
+}
+
+func TestCreateQuestion_PolicyViolation(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
+	mUow := mocks2.NewUnitOfWork(t)
+	mPolicy := mocks2.NewContentPolicy(t)
+	mTimer := mocks2.NewTimer(t)
+	mLogger := mocks2.NewLogger(t)
+
+	mPolicy.
+		On("Check", "Text", "buy now").
+		Return(entP.Violations{"Text": {Rule: entP.RuleBannedWord}})
+
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), noMentions(t), mOutbox, mUow, mPolicy, mTimer, mLogger, cfg)
+
+	q, _, err := ucase.CreateQuestion(ctx, "1", "buy now", true, nil)
+	require.Nil(t, q)
+	require.ErrorIs(t, err, entP.ErrViolation)
+	mRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestCreateQuestion_AttachesFiles(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mAttachments := mocks2.NewAttachmentRepository(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
+	mTimer := mocks2.NewTimer(t)
+	mLogger := mocks2.NewLogger(t)
+
+	created := &entQ.Question{ID: 101, Text: "hello world", UserID: "1", CreatedAt: now}
+
+	mTimer.On("Now").Return(now)
+	mRepo.On("Create", ctx, mock.Anything).Return(created, nil)
+	mAttachments.On("AttachToQuestion", ctx, "1", 101, []int{3, 4}).Return(nil)
+	mOutbox.On("Record", ctx, mock.Anything).Return(nil)
+	mLogger.On("DebugContext", ctx, "question created", "question_id", 101).Return()
+
+	ucase := uc.NewUseCase(mRepo, mAttachments, noMentions(t), mOutbox, passthroughUoW(t), allowedContent(t), mTimer, mLogger, uc.Config{})
+
+	_, _, err := ucase.CreateQuestion(ctx, "1", "hello world", false, []int{3, 4})
+	require.NoError(t, err)
+}
+
+func TestCreateQuestion_AttachmentsUnavailable(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mAttachments := mocks2.NewAttachmentRepository(t)
+	mTimer := mocks2.NewTimer(t)
+
+	mTimer.On("Now").Return(now)
+	mRepo.On("Create", ctx, mock.Anything).Return(&entQ.Question{ID: 101}, nil)
+	mAttachments.On("AttachToQuestion", ctx, "1", 101, []int{9}).Return(entAt.ErrUnavailable)
+
+	ucase := uc.NewUseCase(mRepo, mAttachments, noMentions(t), mocks2.NewOutboxRepository(t), passthroughUoW(t),
+		allowedContent(t), mTimer, mocks2.NewLogger(t), uc.Config{})
+
+	q, _, err := ucase.CreateQuestion(ctx, "1", "hello world", false, []int{9})
+	require.Nil(t, q)
+	require.ErrorIs(t, err, entAt.ErrUnavailable)
+}
+
+func TestCreateQuestion_RecordsMentions(t *testing.T) {
+	ctx := context.Background()
+
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	mentions := []*entM.Mention{{ID: 1, UserID: "u2", Username: "bob", AuthorID: "1", QuestionID: 101}}
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mMentions := mocks2.NewMentionRecorder(t)
+	mOutbox := mocks2.NewOutboxRepository(t)
+	mTimer := mocks2.NewTimer(t)
+	mLogger := mocks2.NewLogger(t)
+
+	mTimer.On("Now").Return(now)
+	mRepo.On("Create", ctx, mock.Anything).
+		Return(&entQ.Question{ID: 101, Text: "any idea @bob", UserID: "1", CreatedAt: now}, nil)
+	mMentions.On("Record", ctx, "1", 101, 0, "any idea @bob", now).Return(mentions, nil)
+	mOutbox.On("Record", ctx, entO.QuestionCreated{
+		Question:   entQ.Question{ID: 101, Text: "any idea @bob", UserID: "1", CreatedAt: now, Mentions: mentions},
+		OccurredAt: now,
+	}).Return(nil)
+	mLogger.On("DebugContext", ctx, "question created", "question_id", 101).Return()
+
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), mMentions, mOutbox, passthroughUoW(t),
+		allowedContent(t), mTimer, mLogger, uc.Config{})
+
+	q, _, err := ucase.CreateQuestion(ctx, "1", "any idea @bob", false, nil)
+	require.NoError(t, err)
+	require.Equal(t, mentions, q.Mentions)
+}
+
+func TestCreateQuestion_MentionsError(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mMentions := mocks2.NewMentionRecorder(t)
+	mTimer := mocks2.NewTimer(t)
+
+	mTimer.On("Now").Return(time.Now())
+	mRepo.On("Create", ctx, mock.Anything).Return(&entQ.Question{ID: 101}, nil)
+	mMentions.On("Record", ctx, "1", 101, 0, "any idea @bob", mock.Anything).Return(nil, errors.New("db down"))
+
+	ucase := uc.NewUseCase(mRepo, mocks2.NewAttachmentRepository(t), mMentions, mocks2.NewOutboxRepository(t),
+		passthroughUoW(t), allowedContent(t), mTimer, mocks2.NewLogger(t), uc.Config{})
+
+	_, _, err := ucase.CreateQuestion(ctx, "1", "any idea @bob", false, nil)
+	require.ErrorContains(t, err, "record mentions")
+}
@@ -0,0 +1,55 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	outbox "test-question/internal/entity/outbox"
+)
+
+// OutboxRepository is an autogenerated mock type for the outboxRepository type
+type OutboxRepository struct {
+	mock.Mock
+}
+
+// Record provides a mock function with given fields: ctx, events
+func (_m *OutboxRepository) Record(ctx context.Context, events ...outbox.Event) error {
+	_va := make([]interface{}, len(events))
+	for _i := range events {
+		_va[_i] = events[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Record")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, ...outbox.Event) error); ok {
+		r0 = rf(ctx, events...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewOutboxRepository creates a new instance of OutboxRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOutboxRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OutboxRepository {
+	mock := &OutboxRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -3,11 +3,11 @@
 package mocks
 
 import (
-	"context"
+	context "context"
 
-	"github.com/stretchr/testify/mock"
+	mock "github.com/stretchr/testify/mock"
 
-	"test-question/internal/entity/question"
+	question "test-question/internal/entity/question"
 )
 
 // QuestionRepository is an autogenerated mock type for the questionRepository type
@@ -63,6 +63,24 @@ func (_m *QuestionRepository) GetByID(ctx context.Context, id int) (*question.Qu
 	return r0, r1
 }
 
+// Remove provides a mock function with given fields: ctx, id
+func (_m *QuestionRepository) Remove(ctx context.Context, id int) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Remove")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewQuestionRepository creates a new instance of QuestionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQuestionRepository(t interface {
@@ -3,7 +3,9 @@ package delete //nolint:predeclared
 import (
 	"context"
 	"fmt"
+	"time"
 
+	entO "test-question/internal/entity/outbox"
 	entQ "test-question/internal/entity/question"
 
 	"github.com/pkg/errors"
@@ -13,21 +15,37 @@ import (
 //go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=outboxRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
 
 type (
 	questionRepository interface {
 		GetByID(ctx context.Context, id int) (*entQ.Question, error)
 		Delete(ctx context.Context, id int) error
+		Remove(ctx context.Context, id int) error
 	}
 
 	answerRepository interface {
 		DeleteByQuestionID(ctx context.Context, questionID int) error
 	}
 
+	reputationRepository interface {
+		ReverseByQuestion(ctx context.Context, questionID int) error
+	}
+
+	outboxRepository interface {
+		Record(ctx context.Context, events ...entO.Event) error
+	}
+
 	unitOfWork interface {
 		Do(ctx context.Context, fn func(ctx context.Context) error) error
 	}
 
+	timer interface {
+		Now() time.Time
+	}
+
 	logger interface {
 		DebugContext(ctx context.Context, msg string, args ...any)
 	}
@@ -36,20 +54,29 @@ type (
 type UseCase struct {
 	questionRepo questionRepository
 	answerRepo   answerRepository
+	reputation   reputationRepository
+	outbox       outboxRepository
 	uow          unitOfWork
+	timer        timer
 	logger       logger
 }
 
 func NewUseCase(
 	questionRepo questionRepository,
 	answerRepo answerRepository,
+	reputation reputationRepository,
+	outbox outboxRepository,
 	uow unitOfWork,
+	timer timer,
 	logger logger,
 ) *UseCase {
 	return &UseCase{
 		questionRepo: questionRepo,
 		answerRepo:   answerRepo,
+		reputation:   reputation,
+		outbox:       outbox,
 		uow:          uow,
+		timer:        timer,
 		logger:       logger,
 	}
 }
@@ -71,8 +98,41 @@ func (uc *UseCase) DeleteQuestion(
 		return entQ.ErrAccessDenied
 	}
 
+	return uc.remove(ctx, q, userID, uc.questionRepo.Delete)
+}
+
+// RemoveQuestion deletes the question on a moderator's decision; the
+// ownership check does not apply and the author cannot restore it.
+func (uc *UseCase) RemoveQuestion(
+	ctx context.Context,
+	questionID int,
+	moderatorID string,
+) error {
+	q, err := uc.questionRepo.GetByID(ctx, questionID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return err
+		}
+		return fmt.Errorf("get question: %w", err)
+	}
+
+	return uc.remove(ctx, q, moderatorID, uc.questionRepo.Remove)
+}
+
+// remove trashes the question with trash, the repository's Delete or Remove,
+// and everything that goes along with it.
+func (uc *UseCase) remove(
+	ctx context.Context,
+	q *entQ.Question,
+	userID string,
+	trash func(ctx context.Context, id int) error,
+) error {
+	questionID := q.ID
+
 	return uc.uow.Do(ctx, func(ctx context.Context) error {
-		if err = uc.questionRepo.Delete(ctx, questionID); err != nil {
+		var err error
+
+		if err = trash(ctx, questionID); err != nil {
 			return fmt.Errorf("delete question: %w", err)
 		}
 
@@ -80,6 +140,15 @@ func (uc *UseCase) DeleteQuestion(
 			return fmt.Errorf("delete answers: %w", err)
 		}
 
+		if err = uc.reputation.ReverseByQuestion(ctx, questionID); err != nil {
+			return fmt.Errorf("reverse reputation: %w", err)
+		}
+
+		err = uc.outbox.Record(ctx, entO.QuestionDeleted{Question: *q, OccurredAt: uc.timer.Now()})
+		if err != nil {
+			return fmt.Errorf("record question deleted: %w", err)
+		}
+
 		uc.logger.DebugContext(ctx, "question deleted with all answers",
 			"question_id", questionID,
 			"user_id", userID,
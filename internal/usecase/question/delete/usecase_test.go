@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	entO "test-question/internal/entity/outbox"
 	entQ "test-question/internal/entity/question"
 	uc "test-question/internal/usecase/question/delete"
 	mocks2 "test-question/internal/usecase/question/delete/mocks"
@@ -13,17 +15,21 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func newMocks(t *testing.T) (*mocks2.QuestionRepository, *mocks2.AnswerRepository, *mocks2.UnitOfWork, *mocks2.Logger) { //nolint:thelper
+func newMocks(t *testing.T) (*mocks2.QuestionRepository, *mocks2.AnswerRepository, *mocks2.ReputationRepository, *mocks2.OutboxRepository, *mocks2.UnitOfWork, *mocks2.Timer, *mocks2.Logger) { //nolint:thelper
 	return mocks2.NewQuestionRepository(t),
 		mocks2.NewAnswerRepository(t),
+		mocks2.NewReputationRepository(t),
+		mocks2.NewOutboxRepository(t),
 		mocks2.NewUnitOfWork(t),
+		mocks2.NewTimer(t),
 		mocks2.NewLogger(t)
 }
 
 func TestDeleteQuestion_Success(t *testing.T) {
 	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
 
-	qRepo, aRepo, uow, log := newMocks(t)
+	qRepo, aRepo, rRepo, ob, uow, tm, log := newMocks(t)
 
 	qRepo.
 		On("GetByID", mock.Anything, 10).
@@ -40,6 +46,21 @@ func TestDeleteQuestion_Success(t *testing.T) {
 		On("DeleteByQuestionID", mock.Anything, 10).
 		Return(nil)
 
+	rRepo.
+		On("ReverseByQuestion", mock.Anything, 10).
+		Return(nil)
+
+	tm.
+		On("Now").
+		Return(now)
+
+	ob.
+		On("Record", mock.Anything, entO.QuestionDeleted{
+			Question:   entQ.Question{ID: 10, UserID: "owner-1"},
+			OccurredAt: now,
+		}).
+		Return(nil)
+
 	uow.
 		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
 		Run(func(args mock.Arguments) {
@@ -56,7 +77,7 @@ func TestDeleteQuestion_Success(t *testing.T) {
 			"user_id", "owner-1",
 		).Return()
 
-	ucase := uc.NewUseCase(qRepo, aRepo, uow, log)
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, ob, uow, tm, log)
 
 	err := ucase.DeleteQuestion(ctx, 10, "owner-1")
 	require.NoError(t, err)
@@ -65,7 +86,7 @@ func TestDeleteQuestion_Success(t *testing.T) {
 func TestDeleteQuestion_NotFound(t *testing.T) {
 	ctx := context.Background()
 
-	qRepo, aRepo, uow, log := newMocks(t)
+	qRepo, aRepo, rRepo, ob, uow, tm, log := newMocks(t)
 
 	qRepo.
 		On("GetByID", mock.Anything, 99).
@@ -73,7 +94,7 @@ func TestDeleteQuestion_NotFound(t *testing.T) {
 
 	uow.AssertNotCalled(t, "Do")
 
-	ucase := uc.NewUseCase(qRepo, aRepo, uow, log)
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, ob, uow, tm, log)
 
 	err := ucase.DeleteQuestion(ctx, 99, "user-x")
 	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
@@ -82,7 +103,7 @@ func TestDeleteQuestion_NotFound(t *testing.T) {
 func TestDeleteQuestion_AccessDenied(t *testing.T) {
 	ctx := context.Background()
 
-	qRepo, aRepo, uow, log := newMocks(t)
+	qRepo, aRepo, rRepo, ob, uow, tm, log := newMocks(t)
 
 	qRepo.
 		On("GetByID", mock.Anything, 7).
@@ -93,7 +114,7 @@ func TestDeleteQuestion_AccessDenied(t *testing.T) {
 
 	uow.AssertNotCalled(t, "Do")
 
-	ucase := uc.NewUseCase(qRepo, aRepo, uow, log)
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, ob, uow, tm, log)
 
 	err := ucase.DeleteQuestion(ctx, 7, "other-user")
 	require.ErrorIs(t, err, entQ.ErrAccessDenied)
@@ -102,7 +123,7 @@ func TestDeleteQuestion_AccessDenied(t *testing.T) {
 func TestDeleteQuestion_GetByIDError(t *testing.T) {
 	ctx := context.Background()
 
-	qRepo, aRepo, uow, log := newMocks(t)
+	qRepo, aRepo, rRepo, ob, uow, tm, log := newMocks(t)
 
 	qRepo.
 		On("GetByID", mock.Anything, 5).
@@ -110,7 +131,7 @@ func TestDeleteQuestion_GetByIDError(t *testing.T) {
 
 	uow.AssertNotCalled(t, "Do")
 
-	ucase := uc.NewUseCase(qRepo, aRepo, uow, log)
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, ob, uow, tm, log)
 
 	err := ucase.DeleteQuestion(ctx, 5, "u1")
 	require.Error(t, err)
@@ -120,7 +141,7 @@ func TestDeleteQuestion_GetByIDError(t *testing.T) {
 func TestDeleteQuestion_DeleteError(t *testing.T) {
 	ctx := context.Background()
 
-	qRepo, aRepo, uow, log := newMocks(t)
+	qRepo, aRepo, rRepo, ob, uow, tm, log := newMocks(t)
 
 	qRepo.
 		On("GetByID", mock.Anything, 12).
@@ -141,9 +162,102 @@ func TestDeleteQuestion_DeleteError(t *testing.T) {
 		}).
 		Return(errors.New("delete fail"))
 
-	ucase := uc.NewUseCase(qRepo, aRepo, uow, log)
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, ob, uow, tm, log)
 
 	err := ucase.DeleteQuestion(ctx, 12, "u12")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "delete fail")
 }
+
+func TestDeleteQuestion_RecordError(t *testing.T) {
+	ctx := context.Background()
+
+	qRepo, aRepo, rRepo, ob, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByID", mock.Anything, 13).
+		Return(&entQ.Question{
+			ID:     13,
+			UserID: "u13",
+		}, nil)
+
+	qRepo.
+		On("Delete", mock.Anything, 13).
+		Return(nil)
+
+	aRepo.
+		On("DeleteByQuestionID", mock.Anything, 13).
+		Return(nil)
+
+	rRepo.
+		On("ReverseByQuestion", mock.Anything, 13).
+		Return(nil)
+
+	tm.
+		On("Now").
+		Return(time.Now())
+
+	ob.
+		On("Record", mock.Anything, mock.Anything).
+		Return(errors.New("insert failed"))
+
+	uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, ob, uow, tm, log)
+
+	err := ucase.DeleteQuestion(ctx, 13, "u13")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "record question deleted")
+}
+
+func TestRemoveQuestion_SkipsOwnership(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	qRepo, aRepo, rRepo, ob, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByID", mock.Anything, 10).
+		Return(&entQ.Question{ID: 10, UserID: "owner-1"}, nil)
+	qRepo.
+		On("Remove", mock.Anything, 10).
+		Return(nil)
+	aRepo.
+		On("DeleteByQuestionID", mock.Anything, 10).
+		Return(nil)
+	rRepo.
+		On("ReverseByQuestion", mock.Anything, 10).
+		Return(nil)
+	tm.
+		On("Now").
+		Return(now)
+	ob.
+		On("Record", mock.Anything, entO.QuestionDeleted{
+			Question:   entQ.Question{ID: 10, UserID: "owner-1"},
+			OccurredAt: now,
+		}).
+		Return(nil)
+	uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(1).(func(context.Context) error) //nolint:forcetypeassert
+			require.NoError(t, fn(ctx))
+		}).
+		Return(nil)
+	log.
+		On("DebugContext",
+			mock.Anything,
+			"question deleted with all answers",
+			"question_id", 10,
+			"user_id", "moderator-1",
+		).Return()
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, ob, uow, tm, log)
+
+	err := ucase.RemoveQuestion(ctx, 10, "moderator-1")
+	require.NoError(t, err)
+}
@@ -0,0 +1,97 @@
+package duplicate
+
+import (
+	"context"
+	"fmt"
+
+	entQ "test-question/internal/entity/question"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+		MarkDuplicate(ctx context.Context, id, canonicalID int) error
+		UnmarkDuplicate(ctx context.Context, id int) error
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	questions questionRepository
+	logger    logger
+}
+
+func NewUseCase(questions questionRepository, logger logger) *UseCase {
+	return &UseCase{questions: questions, logger: logger}
+}
+
+// MarkDuplicate marks the question as a duplicate of the canonical one. If
+// the canonical question is a duplicate itself, its own canonical is used.
+func (uc *UseCase) MarkDuplicate(ctx context.Context, questionID, canonicalID int, moderatorID string) error {
+	if questionID == canonicalID {
+		return entQ.ErrSelfDuplicate
+	}
+
+	if _, err := uc.questions.GetByID(ctx, questionID); err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return err
+		}
+		return fmt.Errorf("get question: %w", err)
+	}
+
+	canonical, err := uc.questions.GetByID(ctx, canonicalID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return entQ.ErrCanonicalNotFound
+		}
+		return fmt.Errorf("get canonical question: %w", err)
+	}
+
+	if canonical.DuplicateOfID != 0 {
+		canonicalID = canonical.DuplicateOfID
+	}
+
+	if questionID == canonicalID {
+		return entQ.ErrSelfDuplicate
+	}
+
+	if err = uc.questions.MarkDuplicate(ctx, questionID, canonicalID); err != nil {
+		return fmt.Errorf("mark duplicate: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "question marked as duplicate",
+		"question_id", questionID,
+		"canonical_id", canonicalID,
+		"moderator_id", moderatorID,
+	)
+
+	return nil
+}
+
+func (uc *UseCase) UnmarkDuplicate(ctx context.Context, questionID int, moderatorID string) error {
+	if _, err := uc.questions.GetByID(ctx, questionID); err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return err
+		}
+		return fmt.Errorf("get question: %w", err)
+	}
+
+	if err := uc.questions.UnmarkDuplicate(ctx, questionID); err != nil {
+		return fmt.Errorf("unmark duplicate: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "question duplicate mark removed",
+		"question_id", questionID,
+		"moderator_id", moderatorID,
+	)
+
+	return nil
+}
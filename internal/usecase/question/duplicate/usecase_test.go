@@ -0,0 +1,138 @@
+package duplicate_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entQ "test-question/internal/entity/question"
+	uc "test-question/internal/usecase/question/duplicate"
+	"test-question/internal/usecase/question/duplicate/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkDuplicate_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("GetByID", mock.Anything, 2).Return(&entQ.Question{ID: 2}, nil)
+	repo.On("GetByID", mock.Anything, 1).Return(&entQ.Question{ID: 1}, nil)
+	repo.On("MarkDuplicate", mock.Anything, 2, 1).Return(nil)
+	log.On("DebugContext", mock.Anything, "question marked as duplicate",
+		"question_id", 2,
+		"canonical_id", 1,
+		"moderator_id", "mod",
+	).Return()
+
+	require.NoError(t, uc.NewUseCase(repo, log).MarkDuplicate(ctx, 2, 1, "mod"))
+}
+
+func TestMarkDuplicate_FollowsCanonical(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("GetByID", mock.Anything, 3).Return(&entQ.Question{ID: 3}, nil)
+	repo.On("GetByID", mock.Anything, 2).Return(&entQ.Question{ID: 2, DuplicateOfID: 1}, nil)
+	repo.On("MarkDuplicate", mock.Anything, 3, 1).Return(nil)
+	log.On("DebugContext", mock.Anything, "question marked as duplicate",
+		"question_id", 3,
+		"canonical_id", 1,
+		"moderator_id", "mod",
+	).Return()
+
+	require.NoError(t, uc.NewUseCase(repo, log).MarkDuplicate(ctx, 3, 2, "mod"))
+}
+
+func TestMarkDuplicate_Self(t *testing.T) {
+	repo := mocks.NewQuestionRepository(t)
+	log := mocks.NewLogger(t)
+
+	err := uc.NewUseCase(repo, log).MarkDuplicate(context.Background(), 1, 1, "mod")
+	require.ErrorIs(t, err, entQ.ErrSelfDuplicate)
+}
+
+func TestMarkDuplicate_CanonicalPointsBack(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("GetByID", mock.Anything, 1).Return(&entQ.Question{ID: 1}, nil)
+	repo.On("GetByID", mock.Anything, 2).Return(&entQ.Question{ID: 2, DuplicateOfID: 1}, nil)
+
+	err := uc.NewUseCase(repo, log).MarkDuplicate(ctx, 1, 2, "mod")
+	require.ErrorIs(t, err, entQ.ErrSelfDuplicate)
+}
+
+func TestMarkDuplicate_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("GetByID", mock.Anything, 2).Return(nil, entQ.ErrQuestionNotFound)
+
+	err := uc.NewUseCase(repo, log).MarkDuplicate(ctx, 2, 1, "mod")
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
+
+func TestMarkDuplicate_CanonicalNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("GetByID", mock.Anything, 2).Return(&entQ.Question{ID: 2}, nil)
+	repo.On("GetByID", mock.Anything, 1).Return(nil, entQ.ErrQuestionNotFound)
+
+	err := uc.NewUseCase(repo, log).MarkDuplicate(ctx, 2, 1, "mod")
+	require.ErrorIs(t, err, entQ.ErrCanonicalNotFound)
+}
+
+func TestMarkDuplicate_RepoError(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("GetByID", mock.Anything, 2).Return(&entQ.Question{ID: 2}, nil)
+	repo.On("GetByID", mock.Anything, 1).Return(&entQ.Question{ID: 1}, nil)
+	repo.On("MarkDuplicate", mock.Anything, 2, 1).Return(errors.New("db fail"))
+
+	err := uc.NewUseCase(repo, log).MarkDuplicate(ctx, 2, 1, "mod")
+	require.ErrorContains(t, err, "mark duplicate")
+}
+
+func TestUnmarkDuplicate_Success(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("GetByID", mock.Anything, 2).Return(&entQ.Question{ID: 2, DuplicateOfID: 1}, nil)
+	repo.On("UnmarkDuplicate", mock.Anything, 2).Return(nil)
+	log.On("DebugContext", mock.Anything, "question duplicate mark removed",
+		"question_id", 2,
+		"moderator_id", "mod",
+	).Return()
+
+	require.NoError(t, uc.NewUseCase(repo, log).UnmarkDuplicate(ctx, 2, "mod"))
+}
+
+func TestUnmarkDuplicate_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("GetByID", mock.Anything, 2).Return(nil, entQ.ErrQuestionNotFound)
+
+	err := uc.NewUseCase(repo, log).UnmarkDuplicate(ctx, 2, "mod")
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
@@ -0,0 +1,66 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// FollowRepository is an autogenerated mock type for the followRepository type
+type FollowRepository struct {
+	mock.Mock
+}
+
+// Follow provides a mock function with given fields: ctx, userID, questionID, at
+func (_m *FollowRepository) Follow(ctx context.Context, userID string, questionID int, at time.Time) error {
+	ret := _m.Called(ctx, userID, questionID, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Follow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, time.Time) error); ok {
+		r0 = rf(ctx, userID, questionID, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unfollow provides a mock function with given fields: ctx, userID, questionID
+func (_m *FollowRepository) Unfollow(ctx context.Context, userID string, questionID int) error {
+	ret := _m.Called(ctx, userID, questionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unfollow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = rf(ctx, userID, questionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewFollowRepository creates a new instance of FollowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFollowRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FollowRepository {
+	mock := &FollowRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
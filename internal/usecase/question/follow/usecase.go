@@ -0,0 +1,89 @@
+package follow
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=followRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+	}
+
+	followRepository interface {
+		Follow(ctx context.Context, userID string, questionID int, at time.Time) error
+		Unfollow(ctx context.Context, userID string, questionID int) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	questions questionRepository
+	follows   followRepository
+	timer     timer
+	logger    logger
+}
+
+func NewUseCase(
+	questions questionRepository,
+	follows followRepository,
+	timer timer,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		questions: questions,
+		follows:   follows,
+		timer:     timer,
+		logger:    logger,
+	}
+}
+
+func (uc *UseCase) Follow(ctx context.Context, questionID int, userID string) error {
+	if _, err := uc.questions.GetByID(ctx, questionID); err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return err
+		}
+		return fmt.Errorf("get question: %w", err)
+	}
+
+	if err := uc.follows.Follow(ctx, userID, questionID, uc.timer.Now()); err != nil {
+		return fmt.Errorf("follow question: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "question followed",
+		"question_id", questionID,
+		"user_id", userID,
+	)
+
+	return nil
+}
+
+func (uc *UseCase) Unfollow(ctx context.Context, questionID int, userID string) error {
+	if err := uc.follows.Unfollow(ctx, userID, questionID); err != nil {
+		return fmt.Errorf("unfollow question: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "question unfollowed",
+		"question_id", questionID,
+		"user_id", userID,
+	)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package follow_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	uc "test-question/internal/usecase/question/follow"
+	"test-question/internal/usecase/question/follow/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollow_Success(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mFollows := mocks.NewFollowRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.On("GetByID", ctx, 3).Return(&entQ.Question{ID: 3}, nil)
+	mTimer.On("Now").Return(now)
+	mFollows.On("Follow", ctx, "u1", 3, now).Return(nil)
+	mLogger.On("DebugContext", ctx, "question followed", "question_id", 3, "user_id", "u1").Return()
+
+	err := uc.NewUseCase(mQuestions, mFollows, mTimer, mLogger).Follow(ctx, 3, "u1")
+	require.NoError(t, err)
+}
+
+func TestFollow_QuestionNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mFollows := mocks.NewFollowRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mQuestions.On("GetByID", ctx, 3).Return(nil, entQ.ErrQuestionNotFound)
+
+	err := uc.NewUseCase(mQuestions, mFollows, mTimer, mLogger).Follow(ctx, 3, "u1")
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
+
+func TestUnfollow(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mFollows := mocks.NewFollowRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mFollows.On("Unfollow", ctx, "u1", 3).Return(nil).Once()
+	mLogger.On("DebugContext", ctx, "question unfollowed", "question_id", 3, "user_id", "u1").Return()
+
+	ucase := uc.NewUseCase(mQuestions, mFollows, mTimer, mLogger)
+	require.NoError(t, ucase.Unfollow(ctx, 3, "u1"))
+
+	mFollows.On("Unfollow", ctx, "u1", 4).Return(errors.New("db down"))
+	require.Error(t, ucase.Unfollow(ctx, 4, "u1"))
+}
@@ -3,11 +3,10 @@
 package mocks
 
 import (
-	"context"
+	context "context"
+	answer "test-question/internal/entity/answer"
 
-	"test-question/internal/entity/answer"
-
-	"github.com/stretchr/testify/mock"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // AnswerRepository is an autogenerated mock type for the answerRepository type
@@ -15,29 +14,57 @@ type AnswerRepository struct {
 	mock.Mock
 }
 
-// ListByQuestionID provides a mock function with given fields: ctx, questionID
-func (_m *AnswerRepository) ListByQuestionID(ctx context.Context, questionID int) ([]*answer.Answer, error) {
+// CountByQuestionID provides a mock function with given fields: ctx, questionID
+func (_m *AnswerRepository) CountByQuestionID(ctx context.Context, questionID int) (int, error) {
 	ret := _m.Called(ctx, questionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListByQuestionID")
+		panic("no return value specified for CountByQuestionID")
 	}
 
-	var r0 []*answer.Answer
+	var r0 int
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*answer.Answer, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int) (int, error)); ok {
 		return rf(ctx, questionID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, int) []*answer.Answer); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, int) int); ok {
 		r0 = rf(ctx, questionID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, questionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPage provides a mock function with given fields: ctx, f
+func (_m *AnswerRepository) ListPage(ctx context.Context, f answer.Filter) ([]*answer.Answer, error) {
+	ret := _m.Called(ctx, f)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPage")
+	}
+
+	var r0 []*answer.Answer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, answer.Filter) ([]*answer.Answer, error)); ok {
+		return rf(ctx, f)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, answer.Filter) []*answer.Answer); ok {
+		r0 = rf(ctx, f)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*answer.Answer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
-		r1 = rf(ctx, questionID)
+	if rf, ok := ret.Get(1).(func(context.Context, answer.Filter) error); ok {
+		r1 = rf(ctx, f)
 	} else {
 		r1 = ret.Error(1)
 	}
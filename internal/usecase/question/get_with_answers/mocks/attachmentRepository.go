@@ -0,0 +1,59 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	attachment "test-question/internal/entity/attachment"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepository is an autogenerated mock type for the attachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+// ListByPosts provides a mock function with given fields: ctx, questionID, answerIDs
+func (_m *AttachmentRepository) ListByPosts(ctx context.Context, questionID int, answerIDs []int) ([]*attachment.Attachment, error) {
+	ret := _m.Called(ctx, questionID, answerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByPosts")
+	}
+
+	var r0 []*attachment.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) ([]*attachment.Attachment, error)); ok {
+		return rf(ctx, questionID, answerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) []*attachment.Attachment); ok {
+		r0 = rf(ctx, questionID, answerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*attachment.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, questionID, answerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
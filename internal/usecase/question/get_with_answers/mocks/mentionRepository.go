@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mention "test-question/internal/entity/mention"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MentionRepository is an autogenerated mock type for the mentionRepository type
+type MentionRepository struct {
+	mock.Mock
+}
+
+// ListByPosts provides a mock function with given fields: ctx, questionID, answerIDs
+func (_m *MentionRepository) ListByPosts(ctx context.Context, questionID int, answerIDs []int) ([]*mention.Mention, error) {
+	ret := _m.Called(ctx, questionID, answerIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByPosts")
+	}
+
+	var r0 []*mention.Mention
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) ([]*mention.Mention, error)); ok {
+		return rf(ctx, questionID, answerIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []int) []*mention.Mention); ok {
+		r0 = rf(ctx, questionID, answerIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*mention.Mention)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []int) error); ok {
+		r1 = rf(ctx, questionID, answerIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewMentionRepository creates a new instance of MentionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMentionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MentionRepository {
+	mock := &MentionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
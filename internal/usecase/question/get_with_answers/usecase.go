@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
 	entQ "test-question/internal/entity/question"
 
 	"github.com/pkg/errors"
@@ -12,11 +14,29 @@ import (
 
 type QuestionWithAnswers struct {
 	Question *entQ.Question `json:"question"`
-	Answers  []*entA.Answer `json:"answers"`
+	// Answers is the first page of answers, oldest first.
+	Answers []*entA.Answer `json:"answers"`
+	// AnswerCount counts all answers readers can see, listed or not.
+	AnswerCount int `json:"answer_count"`
+	// NextCursor continues the answers after the first page; empty when
+	// they are all listed.
+	NextCursor string `json:"next_cursor"`
+	// Attachments of the question and of the listed answers.
+	Attachments []*entAt.Attachment `json:"attachments"`
+}
+
+// AnswerPage is a page of a question's answers.
+type AnswerPage struct {
+	Answers    []*entA.Answer
+	NextCursor string
+	// Attachments of the listed answers.
+	Attachments []*entAt.Attachment
 }
 
 //go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=attachmentRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=mentionRepository --output=mocks --outpkg=mocks --exported
 //go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
 
 type (
@@ -25,7 +45,16 @@ type (
 	}
 
 	answerRepository interface {
-		ListByQuestionID(ctx context.Context, questionID int) ([]*entA.Answer, error)
+		ListPage(ctx context.Context, f entA.Filter) ([]*entA.Answer, error)
+		CountByQuestionID(ctx context.Context, questionID int) (int, error)
+	}
+
+	attachmentRepository interface {
+		ListByPosts(ctx context.Context, questionID int, answerIDs []int) ([]*entAt.Attachment, error)
+	}
+
+	mentionRepository interface {
+		ListByPosts(ctx context.Context, questionID int, answerIDs []int) ([]*entM.Mention, error)
 	}
 
 	logger interface {
@@ -34,17 +63,21 @@ type (
 )
 
 type UseCase struct {
-	questions questionRepository
-	answers   answerRepository
-	logger    logger
+	questions   questionRepository
+	answers     answerRepository
+	attachments attachmentRepository
+	mentions    mentionRepository
+	logger      logger
 }
 
 func NewUseCase(
 	qRepo questionRepository,
 	aRepo answerRepository,
+	atRepo attachmentRepository,
+	mRepo mentionRepository,
 	logger logger,
 ) *UseCase {
-	return &UseCase{questions: qRepo, answers: aRepo, logger: logger}
+	return &UseCase{questions: qRepo, answers: aRepo, attachments: atRepo, mentions: mRepo, logger: logger}
 }
 
 func (uc *UseCase) GetQuestionWithAnswers(
@@ -52,17 +85,28 @@ func (uc *UseCase) GetQuestionWithAnswers(
 	questionID int,
 ) (*QuestionWithAnswers, error) {
 
-	q, err := uc.questions.GetByID(ctx, questionID)
+	q, err := uc.getQuestion(ctx, questionID)
 	if err != nil {
-		if errors.Is(err, entQ.ErrQuestionNotFound) {
-			return nil, entQ.ErrQuestionNotFound
-		}
-		return nil, fmt.Errorf("get question: %w", err)
+		return nil, err
 	}
 
-	ans, err := uc.answers.ListByQuestionID(ctx, questionID)
+	ans, next, err := uc.listPage(ctx, entA.Filter{
+		QuestionID: questionID,
+		Sort:       entA.SortOldest,
+		Limit:      entA.DefaultPageSize,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("list answers: %w", err)
+		return nil, err
+	}
+
+	count, err := uc.answers.CountByQuestionID(ctx, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("count answers: %w", err)
+	}
+
+	atts, err := uc.loadExtras(ctx, q, ans)
+	if err != nil {
+		return nil, err
 	}
 
 	uc.logger.
@@ -72,7 +116,129 @@ func (uc *UseCase) GetQuestionWithAnswers(
 		)
 
 	return &QuestionWithAnswers{
-		Question: q,
-		Answers:  ans,
+		Question:    q,
+		Answers:     ans,
+		AnswerCount: count,
+		NextCursor:  next,
+		Attachments: atts,
+	}, nil
+}
+
+// ListAnswers returns a page of the answers of a question readers can see.
+func (uc *UseCase) ListAnswers(ctx context.Context, f entA.Filter) (*AnswerPage, error) {
+	// a cursor only makes sense in the sort it was made for
+	if f.After != nil && f.After.Sort != f.Sort {
+		return nil, entA.ErrInvalidCursor
+	}
+
+	q, err := uc.getQuestion(ctx, f.QuestionID)
+	if err != nil {
+		return nil, err
+	}
+
+	ans, next, err := uc.listPage(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	atts, err := uc.loadExtras(ctx, q, ans)
+	if err != nil {
+		return nil, err
+	}
+
+	// the question's own attachments are shown with the question
+	answerAtts := make([]*entAt.Attachment, 0, len(atts))
+	for _, at := range atts {
+		if at.AnswerID != nil {
+			answerAtts = append(answerAtts, at)
+		}
+	}
+
+	uc.logger.
+		DebugContext(ctx, "listed answers",
+			"question_id", f.QuestionID,
+			"sort", f.Sort,
+			"answers", len(ans),
+		)
+
+	return &AnswerPage{
+		Answers:     ans,
+		NextCursor:  next,
+		Attachments: answerAtts,
 	}, nil
 }
+
+func (uc *UseCase) getQuestion(ctx context.Context, questionID int) (*entQ.Question, error) {
+	q, err := uc.questions.GetByID(ctx, questionID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return nil, entQ.ErrQuestionNotFound
+		}
+		return nil, fmt.Errorf("get question: %w", err)
+	}
+
+	// hidden by reports until a moderator reviews it
+	if q.HiddenAt != nil {
+		return nil, entQ.ErrQuestionNotFound
+	}
+
+	return q, nil
+}
+
+// listPage fetches one answer more than the page holds to learn whether
+// another page follows.
+func (uc *UseCase) listPage(ctx context.Context, f entA.Filter) ([]*entA.Answer, string, error) {
+	limit := f.Limit
+	f.Limit++
+
+	ans, err := uc.answers.ListPage(ctx, f)
+	if err != nil {
+		return nil, "", fmt.Errorf("list answers: %w", err)
+	}
+
+	if len(ans) <= limit {
+		return ans, "", nil
+	}
+
+	ans = ans[:limit]
+	return ans, entA.CursorAfter(ans[limit-1], f.Sort).String(), nil
+}
+
+// loadExtras hands the mentions to the question and the listed answers and
+// returns the attachments they show. Only the listed answers are looked up,
+// not every answer of the question.
+func (uc *UseCase) loadExtras(ctx context.Context, q *entQ.Question, ans []*entA.Answer) ([]*entAt.Attachment, error) {
+	answerIDs := make([]int, 0, len(ans))
+	for _, a := range ans {
+		answerIDs = append(answerIDs, a.ID)
+	}
+
+	atts, err := uc.attachments.ListByPosts(ctx, q.ID, answerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list attachments: %w", err)
+	}
+
+	ms, err := uc.mentions.ListByPosts(ctx, q.ID, answerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list mentions: %w", err)
+	}
+	assignMentions(q, ans, ms)
+
+	return atts, nil
+}
+
+// assignMentions hands each mention to its post.
+func assignMentions(q *entQ.Question, answers []*entA.Answer, ms []*entM.Mention) {
+	byAnswer := make(map[int]*entA.Answer, len(answers))
+	for _, a := range answers {
+		byAnswer[a.ID] = a
+	}
+
+	for _, m := range ms {
+		if m.AnswerID == 0 {
+			q.Mentions = append(q.Mentions, m)
+		} else if a, ok := byAnswer[m.AnswerID]; ok {
+			a.Mentions = append(a.Mentions, m)
+		}
+	}
+}
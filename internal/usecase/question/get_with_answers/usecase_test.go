@@ -7,6 +7,8 @@ import (
 	"time"
 
 	entA "test-question/internal/entity/answer"
+	entAt "test-question/internal/entity/attachment"
+	entM "test-question/internal/entity/mention"
 	entQ "test-question/internal/entity/question"
 	mocks2 "test-question/internal/usecase/question/get_with_answers/mocks"
 
@@ -33,11 +35,21 @@ func TestGetQuestionWithAnswers_Success(t *testing.T) {
 		}, nil)
 
 	mA.
-		On("ListByQuestionID", ctx, 10).
+		On("ListPage", ctx, firstPage(10)).
 		Return([]*entA.Answer{
 			{ID: 1, QuestionID: 10, UserID: "u1", Text: "ok"},
 			{ID: 2, QuestionID: 10, UserID: "u2", Text: "yo"},
 		}, nil)
+	mA.On("CountByQuestionID", ctx, 10).Return(2, nil)
+
+	answerID := 2
+	mAt := mocks2.NewAttachmentRepository(t)
+	mAt.
+		On("ListByPosts", ctx, 10, []int{1, 2}).
+		Return([]*entAt.Attachment{
+			{ID: 5},
+			{ID: 6, AnswerID: &answerID},
+		}, nil)
 
 	mL.
 		On("DebugContext",
@@ -48,7 +60,15 @@ func TestGetQuestionWithAnswers_Success(t *testing.T) {
 		).
 		Return()
 
-	ucase := NewUseCase(mQ, mA, mL)
+	mM := mocks2.NewMentionRepository(t)
+	mM.
+		On("ListByPosts", ctx, 10, []int{1, 2}).
+		Return([]*entM.Mention{
+			{ID: 1, Username: "bob"},
+			{ID: 2, Username: "ann", AnswerID: 2},
+		}, nil)
+
+	ucase := NewUseCase(mQ, mA, mAt, mM, mL)
 
 	out, err := ucase.GetQuestionWithAnswers(ctx, 10)
 	require.NoError(t, err)
@@ -57,6 +77,19 @@ func TestGetQuestionWithAnswers_Success(t *testing.T) {
 	require.Equal(t, "hello", out.Question.Text)
 	require.Len(t, out.Answers, 2)
 	require.Equal(t, 1, out.Answers[0].ID)
+	require.Equal(t, 2, out.AnswerCount)
+	require.Empty(t, out.NextCursor)
+
+	require.Len(t, out.Attachments, 2)
+	require.Equal(t, 5, out.Attachments[0].ID)
+	require.Equal(t, 6, out.Attachments[1].ID)
+
+	// mentions go to their posts
+	require.Len(t, out.Question.Mentions, 1)
+	require.Equal(t, "bob", out.Question.Mentions[0].Username)
+	require.Empty(t, out.Answers[0].Mentions)
+	require.Len(t, out.Answers[1].Mentions, 1)
+	require.Equal(t, "ann", out.Answers[1].Mentions[0].Username)
 }
 
 func TestGetQuestionWithAnswers_QuestionNotFound(t *testing.T) {
@@ -70,13 +103,32 @@ func TestGetQuestionWithAnswers_QuestionNotFound(t *testing.T) {
 		On("GetByID", ctx, 99).
 		Return(nil, entQ.ErrQuestionNotFound)
 
-	ucase := NewUseCase(mQ, mA, mL)
+	ucase := NewUseCase(mQ, mA, mocks2.NewAttachmentRepository(t), mocks2.NewMentionRepository(t), mL)
 
 	out, err := ucase.GetQuestionWithAnswers(ctx, 99)
 	require.Nil(t, out)
 	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
 }
 
+func TestGetQuestionWithAnswers_Hidden(t *testing.T) {
+	ctx := context.Background()
+
+	mQ := mocks2.NewQuestionRepository(t)
+	mA := mocks2.NewAnswerRepository(t)
+	mL := mocks2.NewLogger(t)
+
+	hiddenAt := time.Now()
+	mQ.
+		On("GetByID", ctx, 10).
+		Return(&entQ.Question{ID: 10, UserID: "u1", HiddenAt: &hiddenAt}, nil)
+
+	ucase := NewUseCase(mQ, mA, mocks2.NewAttachmentRepository(t), mocks2.NewMentionRepository(t), mL)
+
+	out, err := ucase.GetQuestionWithAnswers(ctx, 10)
+	require.Nil(t, out)
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
+
 func TestGetQuestionWithAnswers_GetQuestionError(t *testing.T) {
 	ctx := context.Background()
 
@@ -88,7 +140,7 @@ func TestGetQuestionWithAnswers_GetQuestionError(t *testing.T) {
 		On("GetByID", ctx, 10).
 		Return(nil, errors.New("db down"))
 
-	ucase := NewUseCase(mQ, mA, mL)
+	ucase := NewUseCase(mQ, mA, mocks2.NewAttachmentRepository(t), mocks2.NewMentionRepository(t), mL)
 
 	out, err := ucase.GetQuestionWithAnswers(ctx, 10)
 	require.Nil(t, out)
@@ -107,12 +159,195 @@ func TestGetQuestionWithAnswers_ListAnswersError(t *testing.T) {
 		Return(&entQ.Question{ID: 10}, nil)
 
 	mA.
-		On("ListByQuestionID", ctx, 10).
+		On("ListPage", ctx, firstPage(10)).
 		Return(nil, errors.New("answers fail"))
 
-	ucase := NewUseCase(mQ, mA, mL)
+	ucase := NewUseCase(mQ, mA, mocks2.NewAttachmentRepository(t), mocks2.NewMentionRepository(t), mL)
 
 	out, err := ucase.GetQuestionWithAnswers(ctx, 10)
 	require.Nil(t, out)
 	require.Contains(t, err.Error(), "list answers")
 }
+
+func TestGetQuestionWithAnswers_ListAttachmentsError(t *testing.T) {
+	ctx := context.Background()
+
+	mQ := mocks2.NewQuestionRepository(t)
+	mA := mocks2.NewAnswerRepository(t)
+	mAt := mocks2.NewAttachmentRepository(t)
+
+	mQ.On("GetByID", ctx, 10).Return(&entQ.Question{ID: 10}, nil)
+	mA.On("ListPage", ctx, firstPage(10)).Return(nil, nil)
+	mA.On("CountByQuestionID", ctx, 10).Return(0, nil)
+	mAt.On("ListByPosts", ctx, 10, []int{}).Return(nil, errors.New("attachments fail"))
+
+	ucase := NewUseCase(mQ, mA, mAt, mocks2.NewMentionRepository(t), mocks2.NewLogger(t))
+
+	out, err := ucase.GetQuestionWithAnswers(ctx, 10)
+	require.Nil(t, out)
+	require.Contains(t, err.Error(), "list attachments")
+}
+
+func TestGetQuestionWithAnswers_ListMentionsError(t *testing.T) {
+	ctx := context.Background()
+
+	mQ := mocks2.NewQuestionRepository(t)
+	mA := mocks2.NewAnswerRepository(t)
+	mAt := mocks2.NewAttachmentRepository(t)
+	mM := mocks2.NewMentionRepository(t)
+
+	mQ.On("GetByID", ctx, 10).Return(&entQ.Question{ID: 10}, nil)
+	mA.On("ListPage", ctx, firstPage(10)).Return(nil, nil)
+	mA.On("CountByQuestionID", ctx, 10).Return(0, nil)
+	mAt.On("ListByPosts", ctx, 10, []int{}).Return(nil, nil)
+	mM.On("ListByPosts", ctx, 10, []int{}).Return(nil, errors.New("mentions fail"))
+
+	ucase := NewUseCase(mQ, mA, mAt, mM, mocks2.NewLogger(t))
+
+	out, err := ucase.GetQuestionWithAnswers(ctx, 10)
+	require.Nil(t, out)
+	require.Contains(t, err.Error(), "list mentions")
+}
+
+// firstPage asks for one answer more than a page holds.
+func firstPage(questionID int) entA.Filter {
+	return entA.Filter{QuestionID: questionID, Sort: entA.SortOldest, Limit: entA.DefaultPageSize + 1}
+}
+
+func TestGetQuestionWithAnswers_MoreAnswers(t *testing.T) {
+	ctx := context.Background()
+
+	mQ := mocks2.NewQuestionRepository(t)
+	mA := mocks2.NewAnswerRepository(t)
+	mAt := mocks2.NewAttachmentRepository(t)
+	mM := mocks2.NewMentionRepository(t)
+	mL := mocks2.NewLogger(t)
+
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	ans := make([]*entA.Answer, entA.DefaultPageSize+1)
+	listed := make([]int, entA.DefaultPageSize)
+	for i := range ans {
+		ans[i] = &entA.Answer{ID: i + 1, QuestionID: 10, CreatedAt: now}
+		if i < entA.DefaultPageSize {
+			listed[i] = i + 1
+		}
+	}
+
+	mQ.On("GetByID", ctx, 10).Return(&entQ.Question{ID: 10}, nil)
+	mA.On("ListPage", ctx, firstPage(10)).Return(ans, nil)
+	mA.On("CountByQuestionID", ctx, 10).Return(45, nil)
+	mAt.On("ListByPosts", ctx, 10, listed).Return(nil, nil)
+	mM.On("ListByPosts", ctx, 10, listed).Return(nil, nil)
+	mL.On("DebugContext", ctx, "loaded question with answers", "question_id", 10, "answers", entA.DefaultPageSize).Return()
+
+	out, err := NewUseCase(mQ, mA, mAt, mM, mL).GetQuestionWithAnswers(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, out.Answers, entA.DefaultPageSize)
+	require.Equal(t, 45, out.AnswerCount)
+
+	cursor, err := entA.ParseCursor(out.NextCursor)
+	require.NoError(t, err)
+	require.Equal(t, entA.Cursor{Sort: entA.SortOldest, CreatedAt: now, ID: entA.DefaultPageSize}, cursor)
+}
+
+func TestGetQuestionWithAnswers_CountError(t *testing.T) {
+	ctx := context.Background()
+
+	mQ := mocks2.NewQuestionRepository(t)
+	mA := mocks2.NewAnswerRepository(t)
+
+	mQ.On("GetByID", ctx, 10).Return(&entQ.Question{ID: 10}, nil)
+	mA.On("ListPage", ctx, firstPage(10)).Return(nil, nil)
+	mA.On("CountByQuestionID", ctx, 10).Return(0, errors.New("count fail"))
+
+	ucase := NewUseCase(mQ, mA, mocks2.NewAttachmentRepository(t), mocks2.NewMentionRepository(t), mocks2.NewLogger(t))
+
+	out, err := ucase.GetQuestionWithAnswers(ctx, 10)
+	require.Nil(t, out)
+	require.ErrorContains(t, err, "count answers")
+}
+
+func TestListAnswers(t *testing.T) {
+	ctx := context.Background()
+
+	mQ := mocks2.NewQuestionRepository(t)
+	mA := mocks2.NewAnswerRepository(t)
+	mAt := mocks2.NewAttachmentRepository(t)
+	mM := mocks2.NewMentionRepository(t)
+	mL := mocks2.NewLogger(t)
+
+	after := entA.Cursor{Sort: entA.SortScore, Score: 3, ID: 7}
+	answerID := 8
+
+	mQ.On("GetByID", ctx, 10).Return(&entQ.Question{ID: 10}, nil)
+	mA.
+		On("ListPage", ctx, entA.Filter{QuestionID: 10, Sort: entA.SortScore, After: &after, Limit: 3}).
+		Return([]*entA.Answer{{ID: 8, Score: 2}, {ID: 9, Score: 1}}, nil)
+	mAt.On("ListByPosts", ctx, 10, []int{8, 9}).Return([]*entAt.Attachment{
+		{ID: 1},
+		{ID: 2, AnswerID: &answerID},
+	}, nil)
+	mM.On("ListByPosts", ctx, 10, []int{8, 9}).Return([]*entM.Mention{{ID: 1, AnswerID: 9, Username: "ann"}}, nil)
+	mL.On("DebugContext", ctx, "listed answers", "question_id", 10, "sort", entA.SortScore, "answers", 2).Return()
+
+	out, err := NewUseCase(mQ, mA, mAt, mM, mL).ListAnswers(ctx, entA.Filter{
+		QuestionID: 10, Sort: entA.SortScore, After: &after, Limit: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, out.Answers, 2)
+	require.Empty(t, out.NextCursor)
+
+	// only attachments of the listed answers, not the question's
+	require.Len(t, out.Attachments, 1)
+	require.Equal(t, 2, out.Attachments[0].ID)
+	require.Len(t, out.Answers[1].Mentions, 1)
+}
+
+func TestListAnswers_CursorOfAnotherSort(t *testing.T) {
+	ucase := NewUseCase(
+		mocks2.NewQuestionRepository(t),
+		mocks2.NewAnswerRepository(t),
+		mocks2.NewAttachmentRepository(t),
+		mocks2.NewMentionRepository(t),
+		mocks2.NewLogger(t),
+	)
+
+	out, err := ucase.ListAnswers(context.Background(), entA.Filter{
+		QuestionID: 10, Sort: entA.SortNewest, After: &entA.Cursor{Sort: entA.SortOldest, ID: 3}, Limit: 2,
+	})
+	require.Nil(t, out)
+	require.ErrorIs(t, err, entA.ErrInvalidCursor)
+}
+
+func TestListAnswers_QuestionHidden(t *testing.T) {
+	ctx := context.Background()
+
+	mQ := mocks2.NewQuestionRepository(t)
+
+	hiddenAt := time.Now()
+	mQ.On("GetByID", ctx, 10).Return(&entQ.Question{ID: 10, HiddenAt: &hiddenAt}, nil)
+
+	ucase := NewUseCase(mQ, mocks2.NewAnswerRepository(t), mocks2.NewAttachmentRepository(t), mocks2.NewMentionRepository(t), mocks2.NewLogger(t))
+
+	out, err := ucase.ListAnswers(ctx, entA.Filter{QuestionID: 10, Sort: entA.SortOldest, Limit: 2})
+	require.Nil(t, out)
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	c := entA.Cursor{
+		Sort:      entA.SortNewest,
+		CreatedAt: time.Date(2024, 11, 21, 10, 0, 0, 123456000, time.UTC),
+		Score:     -4,
+		ID:        99,
+	}
+
+	parsed, err := entA.ParseCursor(c.String())
+	require.NoError(t, err)
+	require.Equal(t, c, parsed)
+
+	for _, bad := range []string{"", "!!", "b2xkZXN0OjE6Mg", "cmFuZG9tOjE6Mjoz"} {
+		_, err = entA.ParseCursor(bad)
+		require.ErrorIs(t, err, entA.ErrInvalidCursor, bad)
+	}
+}
@@ -3,11 +3,13 @@
 package mocks
 
 import (
-	"context"
+	context "context"
 
-	"github.com/stretchr/testify/mock"
+	mock "github.com/stretchr/testify/mock"
 
-	"test-question/internal/entity/question"
+	question "test-question/internal/entity/question"
+
+	ranking "test-question/internal/entity/ranking"
 )
 
 // QuestionRepository is an autogenerated mock type for the questionRepository type
@@ -45,6 +47,66 @@ func (_m *QuestionRepository) List(ctx context.Context) ([]*question.Question, e
 	return r0, r1
 }
 
+// ListRanked provides a mock function with given fields: ctx, order, limit
+func (_m *QuestionRepository) ListRanked(ctx context.Context, order ranking.Order, limit int) ([]*question.Question, error) {
+	ret := _m.Called(ctx, order, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRanked")
+	}
+
+	var r0 []*question.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, ranking.Order, int) ([]*question.Question, error)); ok {
+		return rf(ctx, order, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, ranking.Order, int) []*question.Question); ok {
+		r0 = rf(ctx, order, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*question.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, ranking.Order, int) error); ok {
+		r1 = rf(ctx, order, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWithDeleted provides a mock function with given fields: ctx
+func (_m *QuestionRepository) ListWithDeleted(ctx context.Context) ([]*question.Question, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWithDeleted")
+	}
+
+	var r0 []*question.Question
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*question.Question, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*question.Question); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*question.Question)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewQuestionRepository creates a new instance of QuestionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewQuestionRepository(t interface {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/ranking"
 )
 
 //go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
@@ -13,6 +14,8 @@ import (
 type (
 	questionRepository interface {
 		List(ctx context.Context) ([]*entQ.Question, error)
+		ListWithDeleted(ctx context.Context) ([]*entQ.Question, error)
+		ListRanked(ctx context.Context, order entR.Order, limit int) ([]*entQ.Question, error)
 	}
 
 	logger interface {
@@ -29,8 +32,15 @@ func NewUseCase(repo questionRepository, logger logger) *UseCase {
 	return &UseCase{repo: repo, logger: logger}
 }
 
-func (uc *UseCase) ListQuestions(ctx context.Context) ([]*entQ.Question, error) {
-	out, err := uc.repo.List(ctx)
+// ListQuestions returns live questions, or, with includeDeleted, the
+// trashed ones as well.
+func (uc *UseCase) ListQuestions(ctx context.Context, includeDeleted bool) ([]*entQ.Question, error) {
+	list := uc.repo.List
+	if includeDeleted {
+		list = uc.repo.ListWithDeleted
+	}
+
+	out, err := list(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("list questions: %w", err)
 	}
@@ -38,3 +48,26 @@ func (uc *UseCase) ListQuestions(ctx context.Context) ([]*entQ.Question, error)
 	uc.logger.DebugContext(ctx, "questions listed", "count", len(out))
 	return out, nil
 }
+
+// ListHot returns live questions by their hot score.
+func (uc *UseCase) ListHot(ctx context.Context) ([]*entQ.Question, error) {
+	out, err := uc.repo.ListRanked(ctx, entR.OrderHot, 0)
+	if err != nil {
+		return nil, fmt.Errorf("list hot questions: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "hot questions listed", "count", len(out))
+	return out, nil
+}
+
+// ListTrending returns up to limit questions with recent activity, by their
+// trending score.
+func (uc *UseCase) ListTrending(ctx context.Context, limit int) ([]*entQ.Question, error) {
+	out, err := uc.repo.ListRanked(ctx, entR.OrderTrending, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list trending questions: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "trending questions listed", "count", len(out))
+	return out, nil
+}
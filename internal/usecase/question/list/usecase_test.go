@@ -7,6 +7,7 @@ import (
 	"time"
 
 	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/ranking"
 	mocks2 "test-question/internal/usecase/question/list/mocks"
 
 	"github.com/stretchr/testify/mock"
@@ -38,7 +39,7 @@ func TestUseCase_ListQuestions_Success(t *testing.T) {
 
 	uc := NewUseCase(mRepo, mLogger)
 
-	out, err := uc.ListQuestions(ctx)
+	out, err := uc.ListQuestions(ctx, false)
 	require.NoError(t, err)
 	require.Len(t, out, 2)
 
@@ -59,7 +60,87 @@ func TestUseCase_ListQuestions_Error(t *testing.T) {
 
 	uc := NewUseCase(mRepo, mLogger)
 
-	out, err := uc.ListQuestions(ctx)
+	out, err := uc.ListQuestions(ctx, false)
 	require.Nil(t, out)
 	require.ErrorContains(t, err, "list questions: db_fail")
 }
+
+func TestUseCase_ListQuestions_IncludeDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mLogger := mocks2.NewLogger(t)
+
+	deletedAt := time.Now()
+
+	mRepo.
+		On("ListWithDeleted", mock.Anything).
+		Return([]*entQ.Question{
+			{ID: 1, Text: "q1", UserID: "u1"},
+			{ID: 2, Text: "q2", UserID: "u2", DeletedAt: &deletedAt},
+		}, nil)
+
+	mLogger.
+		On("DebugContext",
+			mock.Anything,
+			"questions listed",
+			"count", 2,
+		).
+		Return()
+
+	uc := NewUseCase(mRepo, mLogger)
+
+	out, err := uc.ListQuestions(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	require.NotNil(t, out[1].DeletedAt)
+}
+
+func TestUseCase_ListHot(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mLogger := mocks2.NewLogger(t)
+
+	mRepo.
+		On("ListRanked", ctx, entR.OrderHot, 0).
+		Return([]*entQ.Question{{ID: 2}, {ID: 1}}, nil)
+
+	mLogger.On("DebugContext", ctx, "hot questions listed", "count", 2).Return()
+
+	out, err := NewUseCase(mRepo, mLogger).ListHot(ctx)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+	require.Equal(t, 2, out[0].ID)
+}
+
+func TestUseCase_ListTrending(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks2.NewQuestionRepository(t)
+	mLogger := mocks2.NewLogger(t)
+
+	mRepo.
+		On("ListRanked", ctx, entR.OrderTrending, 10).
+		Return([]*entQ.Question{{ID: 3}}, nil)
+
+	mLogger.On("DebugContext", ctx, "trending questions listed", "count", 1).Return()
+
+	out, err := NewUseCase(mRepo, mLogger).ListTrending(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, out, 1)
+}
+
+func TestUseCase_ListTrending_Error(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks2.NewQuestionRepository(t)
+
+	mRepo.
+		On("ListRanked", ctx, entR.OrderTrending, 10).
+		Return(nil, errors.New("db_fail"))
+
+	out, err := NewUseCase(mRepo, mocks2.NewLogger(t)).ListTrending(ctx, 10)
+	require.Nil(t, out)
+	require.ErrorContains(t, err, "list trending questions: db_fail")
+}
@@ -0,0 +1,80 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	ranking "test-question/internal/entity/ranking"
+
+	time "time"
+)
+
+// RankingRepository is an autogenerated mock type for the rankingRepository type
+type RankingRepository struct {
+	mock.Mock
+}
+
+// ListActivity provides a mock function with given fields: ctx, now, halfLife
+func (_m *RankingRepository) ListActivity(ctx context.Context, now time.Time, halfLife time.Duration) ([]*ranking.Activity, error) {
+	ret := _m.Called(ctx, now, halfLife)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListActivity")
+	}
+
+	var r0 []*ranking.Activity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration) ([]*ranking.Activity, error)); ok {
+		return rf(ctx, now, halfLife)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Duration) []*ranking.Activity); ok {
+		r0 = rf(ctx, now, halfLife)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*ranking.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Duration) error); ok {
+		r1 = rf(ctx, now, halfLife)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveScores provides a mock function with given fields: ctx, scores, computedAt
+func (_m *RankingRepository) SaveScores(ctx context.Context, scores []*ranking.Score, computedAt time.Time) error {
+	ret := _m.Called(ctx, scores, computedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveScores")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*ranking.Score, time.Time) error); ok {
+		r0 = rf(ctx, scores, computedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewRankingRepository creates a new instance of RankingRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRankingRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RankingRepository {
+	mock := &RankingRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
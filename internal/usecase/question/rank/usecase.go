@@ -0,0 +1,79 @@
+package rank
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entR "test-question/internal/entity/ranking"
+)
+
+//go:generate mockery --name=rankingRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	rankingRepository interface {
+		ListActivity(ctx context.Context, now time.Time, halfLife time.Duration) ([]*entR.Activity, error)
+		SaveScores(ctx context.Context, scores []*entR.Score, computedAt time.Time) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo   rankingRepository
+	uow    unitOfWork
+	timer  timer
+	logger logger
+	cfg    entR.Config
+}
+
+func NewUseCase(repo rankingRepository, uow unitOfWork, timer timer, logger logger, cfg entR.Config) *UseCase {
+	return &UseCase{
+		repo:   repo,
+		uow:    uow,
+		timer:  timer,
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+// Rank recomputes the hot and trending scores of every question readers can
+// see and returns how many were ranked. The scores are saved at once, so
+// ranked lists never mix two rankings.
+func (uc *UseCase) Rank(ctx context.Context) (int, error) {
+	now := uc.timer.Now()
+
+	activity, err := uc.repo.ListActivity(ctx, now, uc.cfg.HalfLife)
+	if err != nil {
+		return 0, fmt.Errorf("list question activity: %w", err)
+	}
+
+	scores := make([]*entR.Score, len(activity))
+	for i, a := range activity {
+		scores[i] = uc.cfg.Compute(a, now)
+	}
+
+	err = uc.uow.Do(ctx, func(ctx context.Context) error {
+		return uc.repo.SaveScores(ctx, scores, now)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("save question scores: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "questions ranked", "count", len(scores))
+
+	return len(scores), nil
+}
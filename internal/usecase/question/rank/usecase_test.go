@@ -0,0 +1,147 @@
+package rank_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entR "test-question/internal/entity/ranking"
+	uc "test-question/internal/usecase/question/rank"
+	"test-question/internal/usecase/question/rank/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	now = time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+	cfg = entR.Config{
+		Weights:  entR.Weights{Answer: 3, Vote: 2, View: 0.1},
+		HalfLife: time.Hour,
+	}
+)
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity *entR.Activity
+		want     *entR.Score
+	}{
+		{
+			name: "first_ranking",
+			activity: &entR.Activity{
+				QuestionID:     1,
+				CreatedAt:      now.Add(-time.Hour),
+				Answers:        2,
+				Votes:          3,
+				Views:          10,
+				DecayedAnswers: 1.5,
+				DecayedVotes:   2,
+			},
+			// (3*2 + 2*3 + 0.1*10) * 0.5 and 3*1.5 + 2*2 + 0.1*(10*0.5)
+			want: &entR.Score{QuestionID: 1, Hot: 6.5, Trending: 9, ViewHeat: 5, ViewsSeen: 10, ComputedAt: now},
+		},
+		{
+			name: "views_since_previous_ranking",
+			activity: &entR.Activity{
+				QuestionID: 2,
+				CreatedAt:  now.Add(-2 * time.Hour),
+				Views:      14,
+				Prev:       &entR.Score{QuestionID: 2, ViewHeat: 8, ViewsSeen: 10, ComputedAt: now.Add(-time.Hour)},
+			},
+			// the previous heat halves and the 4 new views are fresh
+			want: &entR.Score{QuestionID: 2, Hot: 0.35, Trending: 0.8, ViewHeat: 8, ViewsSeen: 14, ComputedAt: now},
+		},
+		{
+			name: "downvoted",
+			activity: &entR.Activity{
+				QuestionID:   3,
+				CreatedAt:    now,
+				Votes:        -2,
+				DecayedVotes: -2,
+			},
+			want: &entR.Score{QuestionID: 3, Hot: -4, Trending: -4, ComputedAt: now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cfg.Compute(tt.activity, now)
+
+			require.InDelta(t, tt.want.Hot, got.Hot, 1e-9)
+			require.InDelta(t, tt.want.Trending, got.Trending, 1e-9)
+			require.InDelta(t, tt.want.ViewHeat, got.ViewHeat, 1e-9)
+			require.Equal(t, tt.want.QuestionID, got.QuestionID)
+			require.Equal(t, tt.want.ViewsSeen, got.ViewsSeen)
+			require.Equal(t, tt.want.ComputedAt, got.ComputedAt)
+		})
+	}
+}
+
+func TestCompute_YoungerQuestionIsHotter(t *testing.T) {
+	older := &entR.Activity{QuestionID: 1, CreatedAt: now.Add(-3 * time.Hour), Answers: 3}
+	younger := &entR.Activity{QuestionID: 2, CreatedAt: now.Add(-time.Hour), Answers: 1}
+
+	require.Greater(t, cfg.Compute(younger, now).Hot, cfg.Compute(older, now).Hot)
+}
+
+func TestRank(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewRankingRepository(t)
+	uow := mocks.NewUnitOfWork(t)
+	tm := mocks.NewTimer(t)
+	log := mocks.NewLogger(t)
+
+	tm.On("Now").Return(now)
+	repo.On("ListActivity", ctx, now, time.Hour).Return([]*entR.Activity{
+		{QuestionID: 1, CreatedAt: now, Answers: 1, DecayedAnswers: 1},
+		{QuestionID: 2, CreatedAt: now.Add(-time.Hour), Views: 20},
+	}, nil)
+
+	uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	repo.On("SaveScores", mock.Anything, []*entR.Score{
+		{QuestionID: 1, Hot: 3, Trending: 3, ComputedAt: now},
+		{QuestionID: 2, Hot: 1, Trending: 1, ViewHeat: 10, ViewsSeen: 20, ComputedAt: now},
+	}, now).Return(nil)
+
+	log.On("DebugContext", ctx, "questions ranked", "count", 2).Return()
+
+	n, err := uc.NewUseCase(repo, uow, tm, log, cfg).Rank(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+}
+
+func TestRank_ListError(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewRankingRepository(t)
+	tm := mocks.NewTimer(t)
+
+	tm.On("Now").Return(now)
+	repo.On("ListActivity", ctx, now, time.Hour).Return(nil, errors.New("db down"))
+
+	_, err := uc.NewUseCase(repo, mocks.NewUnitOfWork(t), tm, mocks.NewLogger(t), cfg).Rank(ctx)
+	require.ErrorContains(t, err, "list question activity")
+}
+
+func TestRank_SaveError(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewRankingRepository(t)
+	uow := mocks.NewUnitOfWork(t)
+	tm := mocks.NewTimer(t)
+
+	tm.On("Now").Return(now)
+	repo.On("ListActivity", ctx, now, time.Hour).Return([]*entR.Activity{}, nil)
+	uow.On("Do", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	_, err := uc.NewUseCase(repo, uow, tm, mocks.NewLogger(t), cfg).Rank(ctx)
+	require.ErrorContains(t, err, "save question scores")
+}
@@ -0,0 +1,131 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		GetByIDWithDeleted(ctx context.Context, id int) (*entQ.Question, error)
+		Restore(ctx context.Context, id int) error
+	}
+
+	answerRepository interface {
+		RestoreByQuestionID(ctx context.Context, questionID int) error
+	}
+
+	reputationRepository interface {
+		ReinstateQuestion(ctx context.Context, questionID int, deletedAt time.Time) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	questions   questionRepository
+	answers     answerRepository
+	reputation  reputationRepository
+	uow         unitOfWork
+	timer       timer
+	logger      logger
+	gracePeriod time.Duration
+}
+
+func NewUseCase(
+	questions questionRepository,
+	answers answerRepository,
+	reputation reputationRepository,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+	gracePeriod time.Duration,
+) *UseCase {
+	return &UseCase{
+		questions:   questions,
+		answers:     answers,
+		reputation:  reputation,
+		uow:         uow,
+		timer:       timer,
+		logger:      logger,
+		gracePeriod: gracePeriod,
+	}
+}
+
+// RestoreQuestion takes the owner's question out of the trash within the
+// grace period, together with the answers deleted along with it and the
+// reputation the deletion took away. Answers deleted on their own before
+// the question stay in the trash. A question removed by a moderator cannot be
+// restored.
+func (uc *UseCase) RestoreQuestion(
+	ctx context.Context,
+	questionID int,
+	userID string,
+) error {
+	q, err := uc.questions.GetByIDWithDeleted(ctx, questionID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return err
+		}
+		return fmt.Errorf("get question: %w", err)
+	}
+
+	if q.UserID != userID {
+		return entQ.ErrAccessDenied
+	}
+
+	if q.DeletedAt == nil {
+		return entQ.ErrNotDeleted
+	}
+
+	if q.RemovedByModerator {
+		return entQ.ErrRemovedByModerator
+	}
+
+	if uc.timer.Now().Sub(*q.DeletedAt) > uc.gracePeriod {
+		return entQ.ErrRestoreExpired
+	}
+
+	return uc.uow.Do(ctx, func(ctx context.Context) error {
+		if err = uc.questions.Restore(ctx, questionID); err != nil {
+			return fmt.Errorf("restore question: %w", err)
+		}
+
+		if err = uc.answers.RestoreByQuestionID(ctx, questionID); err != nil {
+			return fmt.Errorf("restore answers: %w", err)
+		}
+
+		if err = uc.reputation.ReinstateQuestion(ctx, questionID, *q.DeletedAt); err != nil {
+			return fmt.Errorf("reinstate reputation: %w", err)
+		}
+
+		uc.logger.DebugContext(ctx, "question restored",
+			"question_id", questionID,
+			"user_id", userID,
+		)
+
+		return nil
+	})
+}
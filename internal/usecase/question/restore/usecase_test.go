@@ -0,0 +1,208 @@
+package restore_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	uc "test-question/internal/usecase/question/restore"
+	"test-question/internal/usecase/question/restore/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const grace = 7 * 24 * time.Hour
+
+func newMocks(t *testing.T) (*mocks.QuestionRepository, *mocks.AnswerRepository, *mocks.ReputationRepository, *mocks.UnitOfWork, *mocks.Timer, *mocks.Logger) { //nolint:thelper
+	return mocks.NewQuestionRepository(t),
+		mocks.NewAnswerRepository(t),
+		mocks.NewReputationRepository(t),
+		mocks.NewUnitOfWork(t),
+		mocks.NewTimer(t),
+		mocks.NewLogger(t)
+}
+
+func TestRestoreQuestion_Success(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	qRepo, aRepo, rRepo, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByIDWithDeleted", mock.Anything, 10).
+		Return(&entQ.Question{ID: 10, UserID: "owner-1", DeletedAt: &deletedAt}, nil)
+
+	tm.
+		On("Now").
+		Return(deletedAt.Add(time.Hour))
+
+	qRepo.
+		On("Restore", mock.Anything, 10).
+		Return(nil)
+
+	aRepo.
+		On("RestoreByQuestionID", mock.Anything, 10).
+		Return(nil)
+
+	rRepo.
+		On("ReinstateQuestion", mock.Anything, 10, deletedAt).
+		Return(nil)
+
+	uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	log.
+		On("DebugContext",
+			mock.Anything,
+			"question restored",
+			"question_id", 10,
+			"user_id", "owner-1",
+		).Return()
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, uow, tm, log, grace)
+
+	require.NoError(t, ucase.RestoreQuestion(ctx, 10, "owner-1"))
+}
+
+func TestRestoreQuestion_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	qRepo, aRepo, rRepo, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByIDWithDeleted", mock.Anything, 99).
+		Return(nil, entQ.ErrQuestionNotFound)
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreQuestion(ctx, 99, "owner-1")
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
+
+func TestRestoreQuestion_GetError(t *testing.T) {
+	ctx := context.Background()
+
+	qRepo, aRepo, rRepo, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByIDWithDeleted", mock.Anything, 5).
+		Return(nil, errors.New("db error"))
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreQuestion(ctx, 5, "owner-1")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "get question")
+}
+
+func TestRestoreQuestion_AccessDenied(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Now()
+
+	qRepo, aRepo, rRepo, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByIDWithDeleted", mock.Anything, 7).
+		Return(&entQ.Question{ID: 7, UserID: "owner-7", DeletedAt: &deletedAt}, nil)
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreQuestion(ctx, 7, "other-user")
+	require.ErrorIs(t, err, entQ.ErrAccessDenied)
+}
+
+func TestRestoreQuestion_NotDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	qRepo, aRepo, rRepo, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByIDWithDeleted", mock.Anything, 8).
+		Return(&entQ.Question{ID: 8, UserID: "owner-8"}, nil)
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreQuestion(ctx, 8, "owner-8")
+	require.ErrorIs(t, err, entQ.ErrNotDeleted)
+}
+
+func TestRestoreQuestion_RemovedByModerator(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	qRepo, aRepo, rRepo, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByIDWithDeleted", mock.Anything, 9).
+		Return(&entQ.Question{ID: 9, UserID: "owner-9", DeletedAt: &deletedAt, RemovedByModerator: true}, nil)
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreQuestion(ctx, 9, "owner-9")
+	require.ErrorIs(t, err, entQ.ErrRemovedByModerator)
+}
+
+func TestRestoreQuestion_Expired(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 11, 1, 10, 0, 0, 0, time.UTC)
+
+	qRepo, aRepo, rRepo, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByIDWithDeleted", mock.Anything, 9).
+		Return(&entQ.Question{ID: 9, UserID: "owner-9", DeletedAt: &deletedAt}, nil)
+
+	tm.
+		On("Now").
+		Return(deletedAt.Add(grace + time.Second))
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreQuestion(ctx, 9, "owner-9")
+	require.ErrorIs(t, err, entQ.ErrRestoreExpired)
+}
+
+func TestRestoreQuestion_ReinstateError(t *testing.T) {
+	ctx := context.Background()
+	deletedAt := time.Date(2024, 11, 20, 10, 0, 0, 0, time.UTC)
+
+	qRepo, aRepo, rRepo, uow, tm, log := newMocks(t)
+
+	qRepo.
+		On("GetByIDWithDeleted", mock.Anything, 11).
+		Return(&entQ.Question{ID: 11, UserID: "owner-11", DeletedAt: &deletedAt}, nil)
+
+	tm.
+		On("Now").
+		Return(deletedAt)
+
+	qRepo.
+		On("Restore", mock.Anything, 11).
+		Return(nil)
+
+	aRepo.
+		On("RestoreByQuestionID", mock.Anything, 11).
+		Return(nil)
+
+	rRepo.
+		On("ReinstateQuestion", mock.Anything, 11, deletedAt).
+		Return(errors.New("boom"))
+
+	uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+
+	ucase := uc.NewUseCase(qRepo, aRepo, rRepo, uow, tm, log, grace)
+
+	err := ucase.RestoreQuestion(ctx, 11, "owner-11")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "reinstate reputation")
+}
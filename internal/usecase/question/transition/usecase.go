@@ -0,0 +1,170 @@
+package transition
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+		SetStatus(ctx context.Context, id int, from, to entQ.Status) error
+		AddTransition(ctx context.Context, t *entQ.Transition) (*entQ.Transition, error)
+		ListTransitions(ctx context.Context, questionID int) ([]*entQ.Transition, error)
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type rule struct {
+	from          []entQ.Status
+	to            entQ.Status
+	moderatorOnly bool
+	needsReason   bool
+}
+
+// rules is the question lifecycle: which statuses an action applies to,
+// where it leads and who may take it. The owner may close and reopen.
+var rules = map[entQ.Action]rule{ //nolint:gochecknoglobals
+	entQ.ActionClose:  {from: []entQ.Status{entQ.StatusOpen}, to: entQ.StatusClosed, needsReason: true},
+	entQ.ActionReopen: {from: []entQ.Status{entQ.StatusClosed}, to: entQ.StatusOpen},
+	entQ.ActionLock: {
+		from:          []entQ.Status{entQ.StatusOpen, entQ.StatusClosed},
+		to:            entQ.StatusLocked,
+		moderatorOnly: true,
+		needsReason:   true,
+	},
+	entQ.ActionUnlock: {from: []entQ.Status{entQ.StatusLocked}, to: entQ.StatusOpen, moderatorOnly: true},
+}
+
+type UseCase struct {
+	questions questionRepository
+	uow       unitOfWork
+	timer     timer
+	logger    logger
+}
+
+func NewUseCase(
+	questions questionRepository,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		questions: questions,
+		uow:       uow,
+		timer:     timer,
+		logger:    logger,
+	}
+}
+
+// Transition applies the action to the question and records it in the
+// question's history together with the actor and the reason.
+func (uc *UseCase) Transition(
+	ctx context.Context,
+	questionID int,
+	action entQ.Action,
+	actorID string,
+	role entU.Role,
+	reason string,
+) (*entQ.Transition, error) {
+	r, ok := rules[action]
+	if !ok {
+		return nil, fmt.Errorf("unknown action %q", action)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if r.needsReason && reason == "" {
+		return nil, entQ.ErrReasonRequired
+	}
+
+	q, err := uc.questions.GetByID(ctx, questionID)
+	if err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("get question: %w", err)
+	}
+
+	if !role.CanModerate() && (r.moderatorOnly || q.UserID != actorID) {
+		return nil, entQ.ErrAccessDenied
+	}
+
+	if !slices.Contains(r.from, q.Status) {
+		return nil, entQ.ErrInvalidTransition
+	}
+
+	t := &entQ.Transition{
+		QuestionID: questionID,
+		Action:     action,
+		From:       q.Status,
+		To:         r.to,
+		ActorID:    actorID,
+		Reason:     reason,
+		CreatedAt:  uc.timer.Now(),
+	}
+
+	err = uc.uow.Do(ctx, func(ctx context.Context) error {
+		if err = uc.questions.SetStatus(ctx, questionID, t.From, t.To); err != nil {
+			return fmt.Errorf("set status: %w", err)
+		}
+
+		if t, err = uc.questions.AddTransition(ctx, t); err != nil {
+			return fmt.Errorf("add transition: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.DebugContext(ctx, "question status changed",
+		"question_id", questionID,
+		"action", action,
+		"status", t.To,
+		"actor_id", actorID,
+	)
+
+	return t, nil
+}
+
+// History returns the status transitions of the question, oldest first.
+func (uc *UseCase) History(ctx context.Context, questionID int) ([]*entQ.Transition, error) {
+	if _, err := uc.questions.GetByID(ctx, questionID); err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("get question: %w", err)
+	}
+
+	out, err := uc.questions.ListTransitions(ctx, questionID)
+	if err != nil {
+		return nil, fmt.Errorf("list transitions: %w", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,197 @@
+package transition_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entQ "test-question/internal/entity/question"
+	entU "test-question/internal/entity/user"
+	uc "test-question/internal/usecase/question/transition"
+	"test-question/internal/usecase/question/transition/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testMocks struct {
+	questions *mocks.QuestionRepository
+	uow       *mocks.UnitOfWork
+	timer     *mocks.Timer
+	logger    *mocks.Logger
+}
+
+func newMocks(t *testing.T) *testMocks { //nolint:thelper
+	return &testMocks{
+		questions: mocks.NewQuestionRepository(t),
+		uow:       mocks.NewUnitOfWork(t),
+		timer:     mocks.NewTimer(t),
+		logger:    mocks.NewLogger(t),
+	}
+}
+
+func (m *testMocks) useCase() *uc.UseCase {
+	return uc.NewUseCase(m.questions, m.uow, m.timer, m.logger)
+}
+
+func (m *testMocks) expectApplied(ctx context.Context, questionID int, action entQ.Action, from, to entQ.Status, actorID, reason string) {
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	m.timer.On("Now").Return(now)
+	m.uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	m.questions.On("SetStatus", ctx, questionID, from, to).Return(nil)
+
+	t := &entQ.Transition{
+		QuestionID: questionID,
+		Action:     action,
+		From:       from,
+		To:         to,
+		ActorID:    actorID,
+		Reason:     reason,
+		CreatedAt:  now,
+	}
+	saved := *t
+	saved.ID = 1
+	m.questions.On("AddTransition", ctx, t).Return(&saved, nil)
+
+	m.logger.On("DebugContext", ctx, "question status changed",
+		"question_id", questionID,
+		"action", action,
+		"status", to,
+		"actor_id", actorID,
+	).Return()
+}
+
+func TestTransition_OwnerCloses(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "owner", Status: entQ.StatusOpen}, nil)
+	m.expectApplied(ctx, 7, entQ.ActionClose, entQ.StatusOpen, entQ.StatusClosed, "owner", "answered elsewhere")
+
+	out, err := m.useCase().Transition(ctx, 7, entQ.ActionClose, "owner", entU.RoleUser, "  answered elsewhere ")
+	require.NoError(t, err)
+	require.Equal(t, 1, out.ID)
+	require.Equal(t, entQ.StatusClosed, out.To)
+}
+
+func TestTransition_ModeratorLocksClosed(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "owner", Status: entQ.StatusClosed}, nil)
+	m.expectApplied(ctx, 7, entQ.ActionLock, entQ.StatusClosed, entQ.StatusLocked, "mod", "flame war")
+
+	_, err := m.useCase().Transition(ctx, 7, entQ.ActionLock, "mod", entU.RoleModerator, "flame war")
+	require.NoError(t, err)
+}
+
+func TestTransition_OwnerCannotLock(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "owner", Status: entQ.StatusOpen}, nil)
+
+	_, err := m.useCase().Transition(ctx, 7, entQ.ActionLock, "owner", entU.RoleUser, "mine")
+	require.ErrorIs(t, err, entQ.ErrAccessDenied)
+}
+
+func TestTransition_StrangerCannotClose(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "owner", Status: entQ.StatusOpen}, nil)
+
+	_, err := m.useCase().Transition(ctx, 7, entQ.ActionClose, "stranger", entU.RoleUser, "spam")
+	require.ErrorIs(t, err, entQ.ErrAccessDenied)
+}
+
+func TestTransition_InvalidFromStatus(t *testing.T) {
+	tests := []struct {
+		action entQ.Action
+		status entQ.Status
+	}{
+		{action: entQ.ActionClose, status: entQ.StatusClosed},
+		{action: entQ.ActionClose, status: entQ.StatusLocked},
+		{action: entQ.ActionReopen, status: entQ.StatusOpen},
+		{action: entQ.ActionReopen, status: entQ.StatusLocked},
+		{action: entQ.ActionLock, status: entQ.StatusLocked},
+		{action: entQ.ActionUnlock, status: entQ.StatusOpen},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.action)+"_"+string(tt.status), func(t *testing.T) {
+			ctx := context.Background()
+			m := newMocks(t)
+
+			m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "owner", Status: tt.status}, nil)
+
+			_, err := m.useCase().Transition(ctx, 7, tt.action, "mod", entU.RoleAdmin, "because")
+			require.ErrorIs(t, err, entQ.ErrInvalidTransition)
+		})
+	}
+}
+
+func TestTransition_ReasonRequired(t *testing.T) {
+	m := newMocks(t)
+
+	_, err := m.useCase().Transition(context.Background(), 7, entQ.ActionClose, "owner", entU.RoleUser, "   ")
+	require.ErrorIs(t, err, entQ.ErrReasonRequired)
+}
+
+func TestTransition_NotFound(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(nil, entQ.ErrQuestionNotFound)
+
+	_, err := m.useCase().Transition(ctx, 7, entQ.ActionReopen, "owner", entU.RoleUser, "")
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
+
+func TestTransition_ConcurrentChange(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7, UserID: "owner", Status: entQ.StatusClosed}, nil)
+	m.timer.On("Now").Return(time.Now())
+	m.uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		})
+	m.questions.On("SetStatus", ctx, 7, entQ.StatusClosed, entQ.StatusOpen).Return(entQ.ErrInvalidTransition)
+
+	_, err := m.useCase().Transition(ctx, 7, entQ.ActionReopen, "owner", entU.RoleUser, "")
+	require.ErrorIs(t, err, entQ.ErrInvalidTransition)
+}
+
+func TestHistory(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	history := []*entQ.Transition{{ID: 1, QuestionID: 7, Action: entQ.ActionClose}}
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7}, nil)
+	m.questions.On("ListTransitions", ctx, 7).Return(history, nil)
+
+	out, err := m.useCase().History(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, history, out)
+}
+
+func TestHistory_ListError(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 7).Return(&entQ.Question{ID: 7}, nil)
+	m.questions.On("ListTransitions", ctx, 7).Return(nil, errors.New("db fail"))
+
+	_, err := m.useCase().History(ctx, 7)
+	require.ErrorContains(t, err, "list transitions")
+}
@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// QuestionRepository is an autogenerated mock type for the questionRepository type
+type QuestionRepository struct {
+	mock.Mock
+}
+
+// AddViews provides a mock function with given fields: ctx, counts
+func (_m *QuestionRepository) AddViews(ctx context.Context, counts map[int]int) error {
+	ret := _m.Called(ctx, counts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddViews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[int]int) error); ok {
+		r0 = rf(ctx, counts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewQuestionRepository creates a new instance of QuestionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQuestionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QuestionRepository {
+	mock := &QuestionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,71 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ViewCounter is an autogenerated mock type for the viewCounter type
+type ViewCounter struct {
+	mock.Mock
+}
+
+// Add provides a mock function with given fields: questionID, viewer, now
+func (_m *ViewCounter) Add(questionID int, viewer string, now time.Time) bool {
+	ret := _m.Called(questionID, viewer, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(int, string, time.Time) bool); ok {
+		r0 = rf(questionID, viewer, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Drain provides a mock function with given fields: now
+func (_m *ViewCounter) Drain(now time.Time) map[int]int {
+	ret := _m.Called(now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Drain")
+	}
+
+	var r0 map[int]int
+	if rf, ok := ret.Get(0).(func(time.Time) map[int]int); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int]int)
+		}
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: counts
+func (_m *ViewCounter) Restore(counts map[int]int) {
+	_m.Called(counts)
+}
+
+// NewViewCounter creates a new instance of ViewCounter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewViewCounter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ViewCounter {
+	mock := &ViewCounter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,80 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=viewCounter --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		AddViews(ctx context.Context, counts map[int]int) error
+	}
+
+	viewCounter interface {
+		Add(questionID int, viewer string, now time.Time) bool
+		Drain(now time.Time) map[int]int
+		Restore(counts map[int]int)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo    questionRepository
+	counter viewCounter
+	timer   timer
+	logger  logger
+}
+
+func NewUseCase(repo questionRepository, counter viewCounter, timer timer, logger logger) *UseCase {
+	return &UseCase{
+		repo:    repo,
+		counter: counter,
+		timer:   timer,
+		logger:  logger,
+	}
+}
+
+// Record counts a view of the question by viewer, once per dedup window. It
+// only touches memory; the views reach the database with Flush.
+func (uc *UseCase) Record(questionID int, viewer string) {
+	uc.counter.Add(questionID, viewer, uc.timer.Now())
+}
+
+// Flush writes the buffered views and returns how many there were. Views
+// that could not be written are kept for the next flush.
+func (uc *UseCase) Flush(ctx context.Context) (int, error) {
+	counts := uc.counter.Drain(uc.timer.Now())
+	if len(counts) == 0 {
+		return 0, nil
+	}
+
+	if err := uc.repo.AddViews(ctx, counts); err != nil {
+		uc.counter.Restore(counts)
+		return 0, fmt.Errorf("add views: %w", err)
+	}
+
+	n := 0
+	for _, c := range counts {
+		n += c
+	}
+
+	uc.logger.DebugContext(ctx, "question views flushed",
+		"questions", len(counts),
+		"views", n,
+	)
+
+	return n, nil
+}
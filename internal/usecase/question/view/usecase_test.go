@@ -0,0 +1,84 @@
+package view_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	uc "test-question/internal/usecase/question/view"
+	"test-question/internal/usecase/question/view/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+func TestRecord(t *testing.T) {
+	counter := mocks.NewViewCounter(t)
+	tm := mocks.NewTimer(t)
+
+	tm.On("Now").Return(now)
+	counter.On("Add", 7, "user:alice", now).Return(true)
+
+	u := uc.NewUseCase(mocks.NewQuestionRepository(t), counter, tm, mocks.NewLogger(t))
+	u.Record(7, "user:alice")
+}
+
+func TestFlush(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	counter := mocks.NewViewCounter(t)
+	tm := mocks.NewTimer(t)
+	log := mocks.NewLogger(t)
+
+	counts := map[int]int{1: 3, 2: 1}
+
+	tm.On("Now").Return(now)
+	counter.On("Drain", now).Return(counts)
+	repo.On("AddViews", ctx, counts).Return(nil)
+	log.On("DebugContext", ctx, "question views flushed", "questions", 2, "views", 4).Return()
+
+	u := uc.NewUseCase(repo, counter, tm, log)
+
+	n, err := u.Flush(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+}
+
+func TestFlush_Nothing(t *testing.T) {
+	ctx := context.Background()
+
+	counter := mocks.NewViewCounter(t)
+	tm := mocks.NewTimer(t)
+
+	tm.On("Now").Return(now)
+	counter.On("Drain", now).Return(map[int]int{})
+
+	u := uc.NewUseCase(mocks.NewQuestionRepository(t), counter, tm, mocks.NewLogger(t))
+
+	n, err := u.Flush(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestFlush_RestoresOnError(t *testing.T) {
+	ctx := context.Background()
+
+	repo := mocks.NewQuestionRepository(t)
+	counter := mocks.NewViewCounter(t)
+	tm := mocks.NewTimer(t)
+
+	counts := map[int]int{1: 3}
+
+	tm.On("Now").Return(now)
+	counter.On("Drain", now).Return(counts)
+	repo.On("AddViews", ctx, counts).Return(errors.New("db down"))
+	counter.On("Restore", counts).Return()
+
+	u := uc.NewUseCase(repo, counter, tm, mocks.NewLogger(t))
+
+	_, err := u.Flush(ctx)
+	require.ErrorContains(t, err, "add views")
+}
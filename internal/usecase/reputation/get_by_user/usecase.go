@@ -0,0 +1,82 @@
+package get_by_user
+
+import (
+	"context"
+	"fmt"
+
+	entR "test-question/internal/entity/reputation"
+	entU "test-question/internal/entity/user"
+	repo "test-question/internal/repository/user"
+
+	"github.com/pkg/errors"
+)
+
+type UserReputation struct {
+	UserID  string
+	Total   int
+	History []*entR.Entry
+}
+
+//go:generate mockery --name=userRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	userRepository interface {
+		GetByID(ctx context.Context, id string) (*entU.User, error)
+	}
+
+	reputationRepository interface {
+		Total(ctx context.Context, userID string) (int, error)
+		ListByUser(ctx context.Context, userID string, limit int) ([]*entR.Entry, error)
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	users      userRepository
+	reputation reputationRepository
+	logger     logger
+}
+
+func NewUseCase(users userRepository, reputation reputationRepository, logger logger) *UseCase {
+	return &UseCase{users: users, reputation: reputation, logger: logger}
+}
+
+func (uc *UseCase) GetUserReputation(
+	ctx context.Context,
+	userID string,
+	limit int,
+) (*UserReputation, error) {
+	_, err := uc.users.GetByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repo.ErrUserNotFound) {
+			return nil, entU.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	total, err := uc.reputation.Total(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("sum reputation: %w", err)
+	}
+
+	history, err := uc.reputation.ListByUser(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list reputation: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "user reputation loaded",
+		"user_id", userID,
+		"total", total,
+	)
+
+	return &UserReputation{
+		UserID:  userID,
+		Total:   total,
+		History: history,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package get_by_user_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entR "test-question/internal/entity/reputation"
+	entU "test-question/internal/entity/user"
+	repo "test-question/internal/repository/user"
+	uc "test-question/internal/usecase/reputation/get_by_user"
+	"test-question/internal/usecase/reputation/get_by_user/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserReputation_Success(t *testing.T) {
+	ctx := context.Background()
+
+	mUsers := mocks.NewUserRepository(t)
+	mRep := mocks.NewReputationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	history := []*entR.Entry{
+		{ID: 2, UserID: "u1", Reason: entR.ReasonAnswerAccepted, Delta: 15},
+		{ID: 1, UserID: "u1", Reason: entR.ReasonAnswerUpvoted, Delta: 10},
+	}
+
+	mUsers.On("GetByID", ctx, "u1").Return(&entU.User{ID: "u1"}, nil)
+	mRep.On("Total", ctx, "u1").Return(25, nil)
+	mRep.On("ListByUser", ctx, "u1", 20).Return(history, nil)
+	mLogger.On("DebugContext", ctx, "user reputation loaded", "user_id", "u1", "total", 25).Return()
+
+	out, err := uc.NewUseCase(mUsers, mRep, mLogger).GetUserReputation(ctx, "u1", 20)
+	require.NoError(t, err)
+	require.Equal(t, 25, out.Total)
+	require.Equal(t, history, out.History)
+}
+
+func TestGetUserReputation_UserNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mUsers := mocks.NewUserRepository(t)
+	mRep := mocks.NewReputationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mUsers.On("GetByID", ctx, "u1").Return(nil, repo.ErrUserNotFound)
+
+	out, err := uc.NewUseCase(mUsers, mRep, mLogger).GetUserReputation(ctx, "u1", 20)
+	require.Nil(t, out)
+	require.ErrorIs(t, err, entU.ErrUserNotFound)
+}
+
+func TestGetUserReputation_TotalError(t *testing.T) {
+	ctx := context.Background()
+
+	mUsers := mocks.NewUserRepository(t)
+	mRep := mocks.NewReputationRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mUsers.On("GetByID", ctx, "u1").Return(&entU.User{ID: "u1"}, nil)
+	mRep.On("Total", ctx, "u1").Return(0, errors.New("db down"))
+
+	out, err := uc.NewUseCase(mUsers, mRep, mLogger).GetUserReputation(ctx, "u1", 20)
+	require.Nil(t, out)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "sum reputation")
+}
@@ -0,0 +1,58 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entR "test-question/internal/entity/reputation"
+)
+
+const leaderboardSize = 100
+
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	reputationRepository interface {
+		Leaderboard(ctx context.Context, since time.Time, limit int) ([]*entR.LeaderboardItem, error)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo   reputationRepository
+	timer  timer
+	logger logger
+}
+
+func NewUseCase(repo reputationRepository, timer timer, logger logger) *UseCase {
+	return &UseCase{repo: repo, timer: timer, logger: logger}
+}
+
+func (uc *UseCase) Leaderboard(ctx context.Context, period entR.Period) ([]*entR.LeaderboardItem, error) {
+	since, err := period.Since(uc.timer.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := uc.repo.Leaderboard(ctx, since, leaderboardSize)
+	if err != nil {
+		return nil, fmt.Errorf("load leaderboard: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "leaderboard loaded",
+		"period", period,
+		"count", len(out),
+	)
+
+	return out, nil
+}
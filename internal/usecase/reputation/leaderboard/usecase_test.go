@@ -0,0 +1,73 @@
+package leaderboard_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entR "test-question/internal/entity/reputation"
+	uc "test-question/internal/usecase/reputation/leaderboard"
+	"test-question/internal/usecase/reputation/leaderboard/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaderboard_Periods(t *testing.T) {
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		period entR.Period
+		since  time.Time
+	}{
+		{period: entR.PeriodWeek, since: now.AddDate(0, 0, -7)},
+		{period: entR.PeriodMonth, since: now.AddDate(0, -1, 0)},
+		{period: entR.PeriodAll, since: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.period), func(t *testing.T) {
+			ctx := context.Background()
+
+			mRepo := mocks.NewReputationRepository(t)
+			mTimer := mocks.NewTimer(t)
+			mLogger := mocks.NewLogger(t)
+
+			items := []*entR.LeaderboardItem{{UserID: "u1", Username: "alice", Points: 10}}
+
+			mTimer.On("Now").Return(now)
+			mRepo.On("Leaderboard", ctx, tt.since, 100).Return(items, nil)
+			mLogger.On("DebugContext", ctx, "leaderboard loaded", "period", tt.period, "count", 1).Return()
+
+			out, err := uc.NewUseCase(mRepo, mTimer, mLogger).Leaderboard(ctx, tt.period)
+			require.NoError(t, err)
+			require.Equal(t, items, out)
+		})
+	}
+}
+
+func TestLeaderboard_InvalidPeriod(t *testing.T) {
+	mRepo := mocks.NewReputationRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.On("Now").Return(time.Now())
+
+	out, err := uc.NewUseCase(mRepo, mTimer, mLogger).Leaderboard(context.Background(), "year")
+	require.Nil(t, out)
+	require.ErrorIs(t, err, entR.ErrInvalidPeriod)
+}
+
+func TestLeaderboard_RepoError(t *testing.T) {
+	mRepo := mocks.NewReputationRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.On("Now").Return(time.Now())
+	mRepo.On("Leaderboard", mock.Anything, mock.Anything, 100).Return(nil, errors.New("db down"))
+
+	out, err := uc.NewUseCase(mRepo, mTimer, mLogger).Leaderboard(context.Background(), entR.PeriodAll)
+	require.Nil(t, out)
+	require.Error(t, err)
+}
@@ -0,0 +1,33 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	stream "test-question/internal/entity/stream"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Broker is an autogenerated mock type for the broker type
+type Broker struct {
+	mock.Mock
+}
+
+// Publish provides a mock function with given fields: topic, e
+func (_m *Broker) Publish(topic string, e stream.Event) {
+	_m.Called(topic, e)
+}
+
+// NewBroker creates a new instance of Broker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBroker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Broker {
+	mock := &Broker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
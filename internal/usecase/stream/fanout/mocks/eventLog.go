@@ -0,0 +1,120 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	stream "test-question/internal/entity/stream"
+
+	time "time"
+)
+
+// EventLog is an autogenerated mock type for the eventLog type
+type EventLog struct {
+	mock.Mock
+}
+
+// DeleteBefore provides a mock function with given fields: ctx, t
+func (_m *EventLog) DeleteBefore(ctx context.Context, t time.Time) (int, error) {
+	ret := _m.Called(ctx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBefore")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, t)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, t)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAfter provides a mock function with given fields: ctx, afterID, limit
+func (_m *EventLog) ListAfter(ctx context.Context, afterID int64, limit int) ([]*stream.Published, error) {
+	ret := _m.Called(ctx, afterID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAfter")
+	}
+
+	var r0 []*stream.Published
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) ([]*stream.Published, error)); ok {
+		return rf(ctx, afterID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, int) []*stream.Published); ok {
+		r0 = rf(ctx, afterID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*stream.Published)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, int) error); ok {
+		r1 = rf(ctx, afterID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListLatest provides a mock function with given fields: ctx, n
+func (_m *EventLog) ListLatest(ctx context.Context, n int) ([]*stream.Published, error) {
+	ret := _m.Called(ctx, n)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLatest")
+	}
+
+	var r0 []*stream.Published
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]*stream.Published, error)); ok {
+		return rf(ctx, n)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []*stream.Published); ok {
+		r0 = rf(ctx, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*stream.Published)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEventLog creates a new instance of EventLog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventLog(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventLog {
+	mock := &EventLog{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,130 @@
+package fanout
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	entS "test-question/internal/entity/stream"
+)
+
+//go:generate mockery --name=eventLog --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=broker --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	eventLog interface {
+		ListAfter(ctx context.Context, afterID int64, limit int) ([]*entS.Published, error)
+		ListLatest(ctx context.Context, n int) ([]*entS.Published, error)
+		DeleteBefore(ctx context.Context, t time.Time) (int, error)
+	}
+
+	broker interface {
+		Publish(topic string, e entS.Event)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type Config struct {
+	// Backlog is how many of the latest events are read into the broker on
+	// the first run, so clients can resume on a replica that just started.
+	Backlog int
+	// BatchSize is how many events are read per query.
+	BatchSize int
+	// Retention is how long events stay in the log.
+	Retention time.Duration
+}
+
+// UseCase reads the shared event log into the broker of this replica. It
+// runs on every replica, unlike outbox handlers, which run on one.
+type UseCase struct {
+	events eventLog
+	broker broker
+	timer  timer
+	logger logger
+	cfg    Config
+
+	mu      sync.Mutex
+	started bool
+	lastID  int64
+}
+
+func NewUseCase(
+	events eventLog,
+	broker broker,
+	timer timer,
+	logger logger,
+	cfg Config,
+) *UseCase {
+	return &UseCase{
+		events: events,
+		broker: broker,
+		timer:  timer,
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+// Fanout publishes the events appended since its last run and returns how
+// many. The first run publishes the latest Backlog events instead.
+func (uc *UseCase) Fanout(ctx context.Context) (int, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if !uc.started {
+		latest, err := uc.events.ListLatest(ctx, uc.cfg.Backlog)
+		if err != nil {
+			return 0, fmt.Errorf("list latest stream events: %w", err)
+		}
+		uc.publish(latest)
+		uc.started = true
+	}
+
+	var n int
+	for {
+		batch, err := uc.events.ListAfter(ctx, uc.lastID, uc.cfg.BatchSize)
+		if err != nil {
+			return n, fmt.Errorf("list stream events: %w", err)
+		}
+		uc.publish(batch)
+		n += len(batch)
+
+		if len(batch) < uc.cfg.BatchSize {
+			break
+		}
+	}
+
+	if n > 0 {
+		uc.logger.DebugContext(ctx, "stream events fanned out", "count", n, "last_id", uc.lastID)
+	}
+
+	return n, nil
+}
+
+// Cleanup drops events older than the retention and returns how many.
+func (uc *UseCase) Cleanup(ctx context.Context) (int, error) {
+	n, err := uc.events.DeleteBefore(ctx, uc.timer.Now().Add(-uc.cfg.Retention))
+	if err != nil {
+		return 0, fmt.Errorf("delete stream events: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "stream events purged", "count", n)
+
+	return n, nil
+}
+
+func (uc *UseCase) publish(events []*entS.Published) {
+	for _, p := range events {
+		uc.broker.Publish(p.Topic, p.Event)
+		uc.lastID = p.Event.ID
+	}
+}
@@ -0,0 +1,97 @@
+package fanout_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entS "test-question/internal/entity/stream"
+	uc "test-question/internal/usecase/stream/fanout"
+	"test-question/internal/usecase/stream/fanout/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func published(id int64, topic string) *entS.Published {
+	return &entS.Published{Topic: topic, Event: entS.Event{ID: id, Type: entS.TypeAnswerCreated}}
+}
+
+func TestFanout_PreloadsBacklogThenFollows(t *testing.T) {
+	ctx := context.Background()
+	cfg := uc.Config{Backlog: 10, BatchSize: 2}
+
+	mEvents := mocks.NewEventLog(t)
+	mBroker := mocks.NewBroker(t)
+	mLogger := mocks.NewLogger(t)
+
+	// first run: the latest events, then everything after them
+	mEvents.On("ListLatest", ctx, 10).Return([]*entS.Published{published(4, "question:1")}, nil).Once()
+	mEvents.On("ListAfter", ctx, int64(4), 2).Return([]*entS.Published{
+		published(5, "question:1"),
+		published(6, "question:2"),
+	}, nil).Once()
+	mEvents.On("ListAfter", ctx, int64(6), 2).Return([]*entS.Published{}, nil).Once()
+	// second run: only what was appended since
+	mEvents.On("ListAfter", ctx, int64(6), 2).Return([]*entS.Published{published(7, "question:2")}, nil).Once()
+
+	var got []int64
+	mBroker.
+		On("Publish", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			got = append(got, args.Get(1).(entS.Event).ID) //nolint:forcetypeassert
+		}).
+		Return()
+	mLogger.On("DebugContext", ctx, "stream events fanned out", "count", 2, "last_id", int64(6)).Return().Once()
+	mLogger.On("DebugContext", ctx, "stream events fanned out", "count", 1, "last_id", int64(7)).Return().Once()
+
+	u := uc.NewUseCase(mEvents, mBroker, mocks.NewTimer(t), mLogger, cfg)
+
+	n, err := u.Fanout(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	n, err = u.Fanout(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	require.Equal(t, []int64{4, 5, 6, 7}, got)
+}
+
+func TestFanout_RetriesPreloadAfterError(t *testing.T) {
+	ctx := context.Background()
+
+	mEvents := mocks.NewEventLog(t)
+	mBroker := mocks.NewBroker(t)
+
+	mEvents.On("ListLatest", ctx, 10).Return(nil, errors.New("db down")).Once()
+	mEvents.On("ListLatest", ctx, 10).Return([]*entS.Published{}, nil).Once()
+	mEvents.On("ListAfter", ctx, int64(0), 5).Return([]*entS.Published{}, nil).Once()
+
+	u := uc.NewUseCase(mEvents, mBroker, mocks.NewTimer(t), mocks.NewLogger(t), uc.Config{Backlog: 10, BatchSize: 5})
+
+	_, err := u.Fanout(ctx)
+	require.Error(t, err)
+
+	n, err := u.Fanout(ctx)
+	require.NoError(t, err)
+	require.Zero(t, n)
+}
+
+func TestCleanup(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mEvents := mocks.NewEventLog(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.On("Now").Return(now)
+	mEvents.On("DeleteBefore", ctx, now.Add(-time.Hour)).Return(3, nil)
+	mLogger.On("DebugContext", ctx, "stream events purged", "count", 3).Return()
+
+	n, err := uc.NewUseCase(mEvents, mocks.NewBroker(t), mTimer, mLogger, uc.Config{Retention: time.Hour}).Cleanup(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+}
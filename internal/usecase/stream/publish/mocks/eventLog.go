@@ -0,0 +1,56 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventLog is an autogenerated mock type for the eventLog type
+type EventLog struct {
+	mock.Mock
+}
+
+// Append provides a mock function with given fields: ctx, topic, typ, data
+func (_m *EventLog) Append(ctx context.Context, topic string, typ string, data []byte) (int64, error) {
+	ret := _m.Called(ctx, topic, typ, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Append")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) (int64, error)); ok {
+		return rf(ctx, topic, typ, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []byte) int64); ok {
+		r0 = rf(ctx, topic, typ, data)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []byte) error); ok {
+		r1 = rf(ctx, topic, typ, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEventLog creates a new instance of EventLog. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventLog(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventLog {
+	mock := &EventLog{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,83 @@
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	entA "test-question/internal/entity/answer"
+	entO "test-question/internal/entity/outbox"
+	entS "test-question/internal/entity/stream"
+)
+
+//go:generate mockery --name=eventLog --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	// eventLog is shared by all replicas; each one's fan-out worker reads
+	// the appended events into its broker.
+	eventLog interface {
+		Append(ctx context.Context, topic, typ string, data []byte) (int64, error)
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	events eventLog
+	logger logger
+}
+
+func NewUseCase(
+	events eventLog,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		events: events,
+		logger: logger,
+	}
+}
+
+func (uc *UseCase) AnswerCreated(ctx context.Context, e entO.AnswerCreated) error {
+	return uc.publish(ctx, e.Answer.QuestionID, entS.TypeAnswerCreated, answerData(&e.Answer))
+}
+
+// AnswerDeleted pushes the deleted answer without its text.
+func (uc *UseCase) AnswerDeleted(ctx context.Context, e entO.AnswerDeleted) error {
+	data := answerData(&e.Answer)
+	data.Text = ""
+
+	return uc.publish(ctx, e.Answer.QuestionID, entS.TypeAnswerDeleted, data)
+}
+
+func (uc *UseCase) publish(ctx context.Context, questionID int, typ string, data entS.AnswerData) error {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal stream event: %w", err)
+	}
+
+	id, err := uc.events.Append(ctx, entS.QuestionTopic(questionID), typ, body)
+	if err != nil {
+		return fmt.Errorf("append stream event: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "stream event published",
+		"event_id", id,
+		"type", typ,
+		"question_id", questionID,
+	)
+
+	return nil
+}
+
+func answerData(a *entA.Answer) entS.AnswerData {
+	return entS.AnswerData{
+		ID:         a.ID,
+		QuestionID: a.QuestionID,
+		UserID:     a.UserID,
+		Text:       a.Text,
+		CreatedAt:  a.CreatedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package publish_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entO "test-question/internal/entity/outbox"
+	uc "test-question/internal/usecase/stream/publish"
+	"test-question/internal/usecase/stream/publish/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnswerCreated(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mEvents := mocks.NewEventLog(t)
+	mLogger := mocks.NewLogger(t)
+
+	var body []byte
+	mEvents.
+		On("Append", ctx, "question:3", "answer.created", mock.Anything).
+		Run(func(args mock.Arguments) {
+			body = args.Get(3).([]byte) //nolint:forcetypeassert
+		}).
+		Return(int64(7), nil)
+	mLogger.
+		On("DebugContext", ctx, "stream event published",
+			"event_id", int64(7), "type", "answer.created", "question_id", 3).
+		Return()
+
+	err := uc.NewUseCase(mEvents, mLogger).AnswerCreated(ctx, entO.AnswerCreated{
+		Answer: entA.Answer{ID: 5, QuestionID: 3, UserID: "u1", Text: "hi", CreatedAt: now},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t,
+		`{"id": 5, "question_id": 3, "user_id": "u1", "text": "hi", "created_at": "2024-11-20T12:00:00Z"}`,
+		string(body))
+}
+
+func TestAnswerDeleted_OmitsText(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mEvents := mocks.NewEventLog(t)
+	mLogger := mocks.NewLogger(t)
+
+	var body []byte
+	mEvents.
+		On("Append", ctx, "question:3", "answer.deleted", mock.Anything).
+		Run(func(args mock.Arguments) {
+			body = args.Get(3).([]byte) //nolint:forcetypeassert
+		}).
+		Return(int64(8), nil)
+	mLogger.
+		On("DebugContext", ctx, "stream event published",
+			"event_id", int64(8), "type", "answer.deleted", "question_id", 3).
+		Return()
+
+	err := uc.NewUseCase(mEvents, mLogger).AnswerDeleted(ctx, entO.AnswerDeleted{
+		Answer: entA.Answer{ID: 5, QuestionID: 3, UserID: "u1", Text: "hi", CreatedAt: now},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t,
+		`{"id": 5, "question_id": 3, "user_id": "u1", "created_at": "2024-11-20T12:00:00Z"}`,
+		string(body))
+}
@@ -0,0 +1,69 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	broker "test-question/internal/pkg/broker"
+
+	mock "github.com/stretchr/testify/mock"
+
+	stream "test-question/internal/entity/stream"
+)
+
+// Streams is an autogenerated mock type for the streams type
+type Streams struct {
+	mock.Mock
+}
+
+// Subscribe provides a mock function with given fields: topic, userID, lastID
+func (_m *Streams) Subscribe(topic string, userID string, lastID int64) (*broker.Subscription, []stream.Event, error) {
+	ret := _m.Called(topic, userID, lastID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Subscribe")
+	}
+
+	var r0 *broker.Subscription
+	var r1 []stream.Event
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, string, int64) (*broker.Subscription, []stream.Event, error)); ok {
+		return rf(topic, userID, lastID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int64) *broker.Subscription); ok {
+		r0 = rf(topic, userID, lastID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*broker.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int64) []stream.Event); ok {
+		r1 = rf(topic, userID, lastID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]stream.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, string, int64) error); ok {
+		r2 = rf(topic, userID, lastID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewStreams creates a new instance of Streams. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStreams(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Streams {
+	mock := &Streams{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,81 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+
+	entQ "test-question/internal/entity/question"
+	entS "test-question/internal/entity/stream"
+	"test-question/internal/pkg/broker"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=streams --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+	}
+
+	streams interface {
+		Subscribe(topic, userID string, lastID int64) (*broker.Subscription, []entS.Event, error)
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	questions questionRepository
+	streams   streams
+	logger    logger
+}
+
+func NewUseCase(
+	questions questionRepository,
+	streams streams,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		questions: questions,
+		streams:   streams,
+		logger:    logger,
+	}
+}
+
+// SubscribeQuestion opens a live stream of a question's answers. Events
+// published after lastEventID that are still in the backlog are returned
+// for replay before the live ones.
+func (uc *UseCase) SubscribeQuestion(
+	ctx context.Context,
+	questionID int,
+	userID string,
+	lastEventID int64,
+) (*broker.Subscription, []entS.Event, error) {
+	if _, err := uc.questions.GetByID(ctx, questionID); err != nil {
+		if errors.Is(err, entQ.ErrQuestionNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("get question: %w", err)
+	}
+
+	sub, missed, err := uc.streams.Subscribe(entS.QuestionTopic(questionID), userID, lastEventID)
+	if err != nil {
+		if errors.Is(err, entS.ErrTooManyStreams) || errors.Is(err, entS.ErrStreamsClosed) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("subscribe: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "question stream opened",
+		"question_id", questionID,
+		"user_id", userID,
+		"replayed", len(missed),
+	)
+
+	return sub, missed, nil
+}
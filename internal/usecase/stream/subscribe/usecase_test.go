@@ -0,0 +1,71 @@
+package subscribe_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entQ "test-question/internal/entity/question"
+	entS "test-question/internal/entity/stream"
+	"test-question/internal/pkg/broker"
+	uc "test-question/internal/usecase/stream/subscribe"
+	"test-question/internal/usecase/stream/subscribe/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscribeQuestion_Success(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mStreams := mocks.NewStreams(t)
+	mLogger := mocks.NewLogger(t)
+
+	sub := &broker.Subscription{}
+	missed := []entS.Event{{ID: 4, Type: entS.TypeAnswerCreated}}
+
+	mQuestions.On("GetByID", ctx, 3).Return(&entQ.Question{ID: 3}, nil)
+	mStreams.On("Subscribe", "question:3", "u1", int64(3)).Return(sub, missed, nil)
+	mLogger.
+		On("DebugContext", ctx, "question stream opened",
+			"question_id", 3, "user_id", "u1", "replayed", 1).
+		Return()
+
+	gotSub, gotMissed, err := uc.NewUseCase(mQuestions, mStreams, mLogger).SubscribeQuestion(ctx, 3, "u1", 3)
+	require.NoError(t, err)
+	require.Same(t, sub, gotSub)
+	require.Equal(t, missed, gotMissed)
+}
+
+func TestSubscribeQuestion_NotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mQuestions.On("GetByID", ctx, 3).Return(nil, entQ.ErrQuestionNotFound)
+
+	_, _, err := uc.NewUseCase(mQuestions, mocks.NewStreams(t), mocks.NewLogger(t)).SubscribeQuestion(ctx, 3, "u1", 0)
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
+
+func TestSubscribeQuestion_TooManyStreams(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mStreams := mocks.NewStreams(t)
+
+	mQuestions.On("GetByID", ctx, 3).Return(&entQ.Question{ID: 3}, nil)
+	mStreams.On("Subscribe", "question:3", "u1", int64(0)).Return(nil, nil, entS.ErrTooManyStreams)
+
+	_, _, err := uc.NewUseCase(mQuestions, mStreams, mocks.NewLogger(t)).SubscribeQuestion(ctx, 3, "u1", 0)
+	require.ErrorIs(t, err, entS.ErrTooManyStreams)
+}
+
+func TestSubscribeQuestion_RepoError(t *testing.T) {
+	ctx := context.Background()
+
+	mQuestions := mocks.NewQuestionRepository(t)
+	mQuestions.On("GetByID", ctx, 3).Return(nil, errors.New("db down"))
+
+	_, _, err := uc.NewUseCase(mQuestions, mocks.NewStreams(t), mocks.NewLogger(t)).SubscribeQuestion(ctx, 3, "u1", 0)
+	require.ErrorContains(t, err, "get question")
+}
@@ -0,0 +1,77 @@
+package purge
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+	}
+
+	answerRepository interface {
+		PurgeDeleted(ctx context.Context, before time.Time) (int, error)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	questions questionRepository
+	answers   answerRepository
+	timer     timer
+	logger    logger
+	retention time.Duration
+}
+
+func NewUseCase(
+	questions questionRepository,
+	answers answerRepository,
+	timer timer,
+	logger logger,
+	retention time.Duration,
+) *UseCase {
+	return &UseCase{
+		questions: questions,
+		answers:   answers,
+		timer:     timer,
+		logger:    logger,
+		retention: retention,
+	}
+}
+
+// Purge hard-deletes questions and answers that have been in the trash
+// longer than the retention and returns how many rows were removed.
+func (uc *UseCase) Purge(ctx context.Context) (int, error) {
+	before := uc.timer.Now().Add(-uc.retention)
+
+	nq, err := uc.questions.PurgeDeleted(ctx, before)
+	if err != nil {
+		return 0, fmt.Errorf("purge questions: %w", err)
+	}
+
+	na, err := uc.answers.PurgeDeleted(ctx, before)
+	if err != nil {
+		return nq, fmt.Errorf("purge answers: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "trash purged",
+		"questions", nq,
+		"answers", na,
+	)
+
+	return nq + na, nil
+}
@@ -0,0 +1,70 @@
+package purge_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	uc "test-question/internal/usecase/trash/purge"
+	"test-question/internal/usecase/trash/purge/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const retention = 30 * 24 * time.Hour
+
+func TestPurge_Success(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	qRepo := mocks.NewQuestionRepository(t)
+	aRepo := mocks.NewAnswerRepository(t)
+	tm := mocks.NewTimer(t)
+	log := mocks.NewLogger(t)
+
+	tm.On("Now").Return(now)
+	qRepo.On("PurgeDeleted", mock.Anything, now.Add(-retention)).Return(2, nil)
+	aRepo.On("PurgeDeleted", mock.Anything, now.Add(-retention)).Return(3, nil)
+	log.On("DebugContext", mock.Anything, "trash purged", "questions", 2, "answers", 3).Return()
+
+	n, err := uc.NewUseCase(qRepo, aRepo, tm, log, retention).Purge(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 5, n)
+}
+
+func TestPurge_QuestionsError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	qRepo := mocks.NewQuestionRepository(t)
+	aRepo := mocks.NewAnswerRepository(t)
+	tm := mocks.NewTimer(t)
+	log := mocks.NewLogger(t)
+
+	tm.On("Now").Return(now)
+	qRepo.On("PurgeDeleted", mock.Anything, now.Add(-retention)).Return(0, errors.New("db down"))
+
+	_, err := uc.NewUseCase(qRepo, aRepo, tm, log, retention).Purge(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "purge questions")
+}
+
+func TestPurge_AnswersError(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 21, 10, 0, 0, 0, time.UTC)
+
+	qRepo := mocks.NewQuestionRepository(t)
+	aRepo := mocks.NewAnswerRepository(t)
+	tm := mocks.NewTimer(t)
+	log := mocks.NewLogger(t)
+
+	tm.On("Now").Return(now)
+	qRepo.On("PurgeDeleted", mock.Anything, now.Add(-retention)).Return(1, nil)
+	aRepo.On("PurgeDeleted", mock.Anything, now.Add(-retention)).Return(0, errors.New("db down"))
+
+	_, err := uc.NewUseCase(qRepo, aRepo, tm, log, retention).Purge(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "purge answers")
+}
@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	vote "test-question/internal/entity/vote"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// VoteRepository is an autogenerated mock type for the voteRepository type
+type VoteRepository struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: ctx, userID, targetType, targetID
+func (_m *VoteRepository) Delete(ctx context.Context, userID string, targetType vote.TargetType, targetID int) error {
+	ret := _m.Called(ctx, userID, targetType, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, vote.TargetType, int) error); ok {
+		r0 = rf(ctx, userID, targetType, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Get provides a mock function with given fields: ctx, userID, targetType, targetID
+func (_m *VoteRepository) Get(ctx context.Context, userID string, targetType vote.TargetType, targetID int) (*vote.Vote, error) {
+	ret := _m.Called(ctx, userID, targetType, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *vote.Vote
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, vote.TargetType, int) (*vote.Vote, error)); ok {
+		return rf(ctx, userID, targetType, targetID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, vote.TargetType, int) *vote.Vote); ok {
+		r0 = rf(ctx, userID, targetType, targetID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*vote.Vote)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, vote.TargetType, int) error); ok {
+		r1 = rf(ctx, userID, targetType, targetID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Upsert provides a mock function with given fields: ctx, v
+func (_m *VoteRepository) Upsert(ctx context.Context, v *vote.Vote) error {
+	ret := _m.Called(ctx, v)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Upsert")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *vote.Vote) error); ok {
+		r0 = rf(ctx, v)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewVoteRepository creates a new instance of VoteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVoteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VoteRepository {
+	mock := &VoteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,238 @@
+package cast
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+	entV "test-question/internal/entity/vote"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=questionRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=answerRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=voteRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=reputationRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	questionRepository interface {
+		GetByID(ctx context.Context, id int) (*entQ.Question, error)
+	}
+
+	answerRepository interface {
+		GetByID(ctx context.Context, id int) (*entA.Answer, error)
+	}
+
+	voteRepository interface {
+		Get(ctx context.Context, userID string, targetType entV.TargetType, targetID int) (*entV.Vote, error)
+		Upsert(ctx context.Context, v *entV.Vote) error
+		Delete(ctx context.Context, userID string, targetType entV.TargetType, targetID int) error
+	}
+
+	reputationRepository interface {
+		Add(ctx context.Context, e *entR.Entry) (*entR.Entry, error)
+		Reverse(ctx context.Context, f entR.ReverseFilter) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	questions  questionRepository
+	answers    answerRepository
+	votes      voteRepository
+	reputation reputationRepository
+	uow        unitOfWork
+	timer      timer
+	logger     logger
+}
+
+func NewUseCase(
+	questions questionRepository,
+	answers answerRepository,
+	votes voteRepository,
+	reputation reputationRepository,
+	uow unitOfWork,
+	timer timer,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		questions:  questions,
+		answers:    answers,
+		votes:      votes,
+		reputation: reputation,
+		uow:        uow,
+		timer:      timer,
+		logger:     logger,
+	}
+}
+
+// Vote sets the user's vote on a question or answer. Value 0 retracts the vote.
+// Points earned by the previous vote are reversed before the new one is recorded.
+func (uc *UseCase) Vote(
+	ctx context.Context,
+	userID string,
+	targetType entV.TargetType,
+	targetID int,
+	value int,
+) error {
+	if value != entV.Up && value != entV.Down && value != 0 {
+		return entV.ErrInvalidValue
+	}
+
+	ownerID, err := uc.targetOwner(ctx, targetType, targetID)
+	if err != nil {
+		return err
+	}
+
+	if ownerID == userID {
+		return entV.ErrSelfVote
+	}
+
+	subject := entR.SubjectType(targetType)
+
+	return uc.uow.Do(ctx, func(ctx context.Context) error {
+		prev, err := uc.votes.Get(ctx, userID, targetType, targetID)
+		if err != nil && !errors.Is(err, entV.ErrVoteNotFound) {
+			return fmt.Errorf("get vote: %w", err)
+		}
+
+		if (prev == nil && value == 0) || (prev != nil && prev.Value == value) {
+			return nil
+		}
+
+		err = uc.reputation.Reverse(ctx, entR.ReverseFilter{
+			SubjectType: subject,
+			SubjectID:   targetID,
+			ActorID:     userID,
+			Reasons:     []entR.Reason{voteReason(targetType, entV.Up), voteReason(targetType, entV.Down)},
+		})
+		if err != nil {
+			return fmt.Errorf("reverse reputation: %w", err)
+		}
+
+		if value == 0 {
+			if err = uc.votes.Delete(ctx, userID, targetType, targetID); err != nil {
+				return fmt.Errorf("delete vote: %w", err)
+			}
+
+			uc.logger.DebugContext(ctx, "vote retracted",
+				"target_type", targetType,
+				"target_id", targetID,
+				"user_id", userID,
+			)
+
+			return nil
+		}
+
+		now := uc.timer.Now()
+
+		err = uc.votes.Upsert(ctx, &entV.Vote{
+			UserID:     userID,
+			TargetType: targetType,
+			TargetID:   targetID,
+			Value:      value,
+			CreatedAt:  now,
+		})
+		if err != nil {
+			return fmt.Errorf("save vote: %w", err)
+		}
+
+		reason := voteReason(targetType, value)
+
+		_, err = uc.reputation.Add(ctx, &entR.Entry{
+			UserID:      ownerID,
+			ActorID:     userID,
+			Reason:      reason,
+			Delta:       entR.PointsFor(reason),
+			SubjectType: subject,
+			SubjectID:   targetID,
+			CreatedAt:   now,
+		})
+		if err != nil {
+			return fmt.Errorf("add reputation: %w", err)
+		}
+
+		uc.logger.DebugContext(ctx, "vote cast",
+			"target_type", targetType,
+			"target_id", targetID,
+			"user_id", userID,
+			"value", value,
+		)
+
+		return nil
+	})
+}
+
+// targetOwner returns the author of the voted content. Votes are refused
+// while the question, or the question of the answer, is locked.
+func (uc *UseCase) targetOwner(ctx context.Context, targetType entV.TargetType, targetID int) (string, error) {
+	switch targetType {
+	case entV.TargetQuestion:
+		q, err := uc.questions.GetByID(ctx, targetID)
+		if err != nil {
+			if errors.Is(err, entQ.ErrQuestionNotFound) {
+				return "", err
+			}
+			return "", fmt.Errorf("get question: %w", err)
+		}
+		if q.Status == entQ.StatusLocked {
+			return "", entQ.ErrQuestionLocked
+		}
+		return q.UserID, nil
+
+	case entV.TargetAnswer:
+		a, err := uc.answers.GetByID(ctx, targetID)
+		if err != nil {
+			if errors.Is(err, entA.ErrAnswerNotFound) {
+				return "", err
+			}
+			return "", fmt.Errorf("get answer: %w", err)
+		}
+
+		q, err := uc.questions.GetByID(ctx, a.QuestionID)
+		if err != nil {
+			if errors.Is(err, entQ.ErrQuestionNotFound) {
+				return "", entA.ErrAnswerNotFound
+			}
+			return "", fmt.Errorf("get question: %w", err)
+		}
+		if q.Status == entQ.StatusLocked {
+			return "", entQ.ErrQuestionLocked
+		}
+		return a.UserID, nil
+	}
+
+	return "", fmt.Errorf("unknown vote target %q", targetType)
+}
+
+func voteReason(targetType entV.TargetType, value int) entR.Reason {
+	if targetType == entV.TargetQuestion {
+		if value > 0 {
+			return entR.ReasonQuestionUpvoted
+		}
+		return entR.ReasonQuestionDownvoted
+	}
+
+	if value > 0 {
+		return entR.ReasonAnswerUpvoted
+	}
+	return entR.ReasonAnswerDownvoted
+}
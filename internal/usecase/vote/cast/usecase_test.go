@@ -0,0 +1,211 @@
+package cast_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entQ "test-question/internal/entity/question"
+	entR "test-question/internal/entity/reputation"
+	entV "test-question/internal/entity/vote"
+	uc "test-question/internal/usecase/vote/cast"
+	"test-question/internal/usecase/vote/cast/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testMocks struct {
+	questions  *mocks.QuestionRepository
+	answers    *mocks.AnswerRepository
+	votes      *mocks.VoteRepository
+	reputation *mocks.ReputationRepository
+	uow        *mocks.UnitOfWork
+	timer      *mocks.Timer
+	logger     *mocks.Logger
+}
+
+func newMocks(t *testing.T) *testMocks { //nolint:thelper
+	m := &testMocks{
+		questions:  mocks.NewQuestionRepository(t),
+		answers:    mocks.NewAnswerRepository(t),
+		votes:      mocks.NewVoteRepository(t),
+		reputation: mocks.NewReputationRepository(t),
+		uow:        mocks.NewUnitOfWork(t),
+		timer:      mocks.NewTimer(t),
+		logger:     mocks.NewLogger(t),
+	}
+
+	m.uow.
+		On("Do", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).
+		Maybe()
+
+	return m
+}
+
+func (m *testMocks) useCase() *uc.UseCase {
+	return uc.NewUseCase(m.questions, m.answers, m.votes, m.reputation, m.uow, m.timer, m.logger)
+}
+
+func TestVote_UpvoteAnswer(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "author"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, Status: entQ.StatusOpen}, nil)
+	m.votes.On("Get", ctx, "voter", entV.TargetAnswer, 5).Return(nil, entV.ErrVoteNotFound)
+	m.reputation.
+		On("Reverse", ctx, entR.ReverseFilter{
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   5,
+			ActorID:     "voter",
+			Reasons:     []entR.Reason{entR.ReasonAnswerUpvoted, entR.ReasonAnswerDownvoted},
+		}).
+		Return(nil)
+	m.timer.On("Now").Return(now)
+	m.votes.
+		On("Upsert", ctx, &entV.Vote{
+			UserID:     "voter",
+			TargetType: entV.TargetAnswer,
+			TargetID:   5,
+			Value:      entV.Up,
+			CreatedAt:  now,
+		}).
+		Return(nil)
+	m.reputation.
+		On("Add", ctx, &entR.Entry{
+			UserID:      "author",
+			ActorID:     "voter",
+			Reason:      entR.ReasonAnswerUpvoted,
+			Delta:       10,
+			SubjectType: entR.SubjectAnswer,
+			SubjectID:   5,
+			CreatedAt:   now,
+		}).
+		Return(&entR.Entry{ID: 1}, nil)
+	m.logger.
+		On("DebugContext", ctx, "vote cast",
+			"target_type", entV.TargetAnswer,
+			"target_id", 5,
+			"user_id", "voter",
+			"value", entV.Up,
+		).
+		Return()
+
+	err := m.useCase().Vote(ctx, "voter", entV.TargetAnswer, 5, entV.Up)
+	require.NoError(t, err)
+}
+
+func TestVote_RetractQuestionVote(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 3).Return(&entQ.Question{ID: 3, UserID: "author"}, nil)
+	m.votes.
+		On("Get", ctx, "voter", entV.TargetQuestion, 3).
+		Return(&entV.Vote{Value: entV.Down}, nil)
+	m.reputation.
+		On("Reverse", ctx, entR.ReverseFilter{
+			SubjectType: entR.SubjectQuestion,
+			SubjectID:   3,
+			ActorID:     "voter",
+			Reasons:     []entR.Reason{entR.ReasonQuestionUpvoted, entR.ReasonQuestionDownvoted},
+		}).
+		Return(nil)
+	m.votes.On("Delete", ctx, "voter", entV.TargetQuestion, 3).Return(nil)
+	m.logger.
+		On("DebugContext", ctx, "vote retracted",
+			"target_type", entV.TargetQuestion,
+			"target_id", 3,
+			"user_id", "voter",
+		).
+		Return()
+
+	err := m.useCase().Vote(ctx, "voter", entV.TargetQuestion, 3, 0)
+	require.NoError(t, err)
+}
+
+func TestVote_SameValueIsNoop(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "author"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, Status: entQ.StatusOpen}, nil)
+	m.votes.
+		On("Get", ctx, "voter", entV.TargetAnswer, 5).
+		Return(&entV.Vote{Value: entV.Up}, nil)
+
+	err := m.useCase().Vote(ctx, "voter", entV.TargetAnswer, 5, entV.Up)
+	require.NoError(t, err)
+}
+
+func TestVote_InvalidValue(t *testing.T) {
+	m := newMocks(t)
+
+	err := m.useCase().Vote(context.Background(), "voter", entV.TargetAnswer, 5, 2)
+	require.ErrorIs(t, err, entV.ErrInvalidValue)
+}
+
+func TestVote_SelfVote(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "voter"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, Status: entQ.StatusOpen}, nil)
+
+	err := m.useCase().Vote(ctx, "voter", entV.TargetAnswer, 5, entV.Up)
+	require.ErrorIs(t, err, entV.ErrSelfVote)
+}
+
+func TestVote_TargetNotFound(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 9).Return(nil, entQ.ErrQuestionNotFound)
+
+	err := m.useCase().Vote(ctx, "voter", entV.TargetQuestion, 9, entV.Up)
+	require.ErrorIs(t, err, entQ.ErrQuestionNotFound)
+}
+
+func TestVote_QuestionLocked(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.questions.On("GetByID", ctx, 3).Return(&entQ.Question{ID: 3, UserID: "author", Status: entQ.StatusLocked}, nil)
+
+	err := m.useCase().Vote(ctx, "voter", entV.TargetQuestion, 3, entV.Up)
+	require.ErrorIs(t, err, entQ.ErrQuestionLocked)
+}
+
+func TestVote_AnswerOfLockedQuestion(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "author"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, Status: entQ.StatusLocked}, nil)
+
+	err := m.useCase().Vote(ctx, "voter", entV.TargetAnswer, 5, 0)
+	require.ErrorIs(t, err, entQ.ErrQuestionLocked)
+}
+
+func TestVote_SaveError(t *testing.T) {
+	ctx := context.Background()
+	m := newMocks(t)
+
+	m.answers.On("GetByID", ctx, 5).Return(&entA.Answer{ID: 5, QuestionID: 1, UserID: "author"}, nil)
+	m.questions.On("GetByID", ctx, 1).Return(&entQ.Question{ID: 1, Status: entQ.StatusOpen}, nil)
+	m.votes.On("Get", ctx, "voter", entV.TargetAnswer, 5).Return(nil, entV.ErrVoteNotFound)
+	m.reputation.On("Reverse", ctx, mock.Anything).Return(nil)
+	m.timer.On("Now").Return(time.Now())
+	m.votes.On("Upsert", ctx, mock.Anything).Return(errors.New("db down"))
+
+	err := m.useCase().Vote(ctx, "voter", entV.TargetAnswer, 5, entV.Down)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "save vote")
+}
@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	webhook "test-question/internal/entity/webhook"
+)
+
+// WebhookRepository is an autogenerated mock type for the webhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+// CreateSubscription provides a mock function with given fields: ctx, s
+func (_m *WebhookRepository) CreateSubscription(ctx context.Context, s *webhook.Subscription) (*webhook.Subscription, error) {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateSubscription")
+	}
+
+	var r0 *webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.Subscription) (*webhook.Subscription, error)); ok {
+		return rf(ctx, s)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.Subscription) *webhook.Subscription); ok {
+		r0 = rf(ctx, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *webhook.Subscription) error); ok {
+		r1 = rf(ctx, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+package create_subscription
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+)
+
+//go:generate mockery --name=webhookRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	webhookRepository interface {
+		CreateSubscription(ctx context.Context, s *entW.Subscription) (*entW.Subscription, error)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo   webhookRepository
+	timer  timer
+	logger logger
+}
+
+func NewUseCase(
+	repo webhookRepository,
+	timer timer,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		repo:   repo,
+		timer:  timer,
+		logger: logger,
+	}
+}
+
+func (uc *UseCase) CreateSubscription(
+	ctx context.Context,
+	adminID string,
+	rawURL string,
+	events []entW.Event,
+	secret string,
+) (*entW.Subscription, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, entW.ErrInvalidURL
+	}
+
+	if len(events) == 0 {
+		return nil, entW.ErrNoEvents
+	}
+
+	seen := make(map[entW.Event]bool, len(events))
+	filter := make([]entW.Event, 0, len(events))
+	for _, e := range events {
+		if !e.Valid() {
+			return nil, fmt.Errorf("%w: %s", entW.ErrUnknownEvent, e)
+		}
+		if !seen[e] {
+			seen[e] = true
+			filter = append(filter, e)
+		}
+	}
+
+	out, err := uc.repo.CreateSubscription(ctx, &entW.Subscription{
+		URL:       u.String(),
+		Events:    filter,
+		Secret:    secret,
+		Active:    true,
+		CreatedBy: adminID,
+		CreatedAt: uc.timer.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create webhook subscription: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "webhook subscription created",
+		"subscription_id", out.ID,
+		"admin_id", adminID,
+	)
+
+	return out, nil
+}
@@ -0,0 +1,73 @@
+package create_subscription_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+	uc "test-question/internal/usecase/webhook/create_subscription"
+	"test-question/internal/usecase/webhook/create_subscription/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSubscription_Success(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	mRepo := mocks.NewWebhookRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.On("Now").Return(now)
+
+	in := &entW.Subscription{
+		URL:       "https://hooks.example.com/qa",
+		Events:    []entW.Event{entW.EventQuestionCreated, entW.EventAnswerCreated},
+		Secret:    "0123456789abcdef",
+		Active:    true,
+		CreatedBy: "admin",
+		CreatedAt: now,
+	}
+	created := *in
+	created.ID = 4
+
+	mRepo.On("CreateSubscription", ctx, in).Return(&created, nil)
+	mLogger.On("DebugContext", ctx, "webhook subscription created", "subscription_id", 4, "admin_id", "admin").Return()
+
+	out, err := uc.NewUseCase(mRepo, mTimer, mLogger).CreateSubscription(ctx,
+		"admin",
+		"https://hooks.example.com/qa",
+		[]entW.Event{entW.EventQuestionCreated, entW.EventAnswerCreated, entW.EventQuestionCreated},
+		"0123456789abcdef",
+	)
+	require.NoError(t, err)
+	require.Equal(t, 4, out.ID)
+}
+
+func TestCreateSubscription_Invalid(t *testing.T) {
+	cases := []struct {
+		name   string
+		url    string
+		events []entW.Event
+		err    error
+	}{
+		{"relative url", "/hooks", []entW.Event{entW.EventAnswerCreated}, entW.ErrInvalidURL},
+		{"ftp url", "ftp://hooks.example.com", []entW.Event{entW.EventAnswerCreated}, entW.ErrInvalidURL},
+		{"no events", "https://hooks.example.com", nil, entW.ErrNoEvents},
+		{"unknown event", "https://hooks.example.com", []entW.Event{"answer.edited"}, entW.ErrUnknownEvent},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mRepo := mocks.NewWebhookRepository(t)
+			mTimer := mocks.NewTimer(t)
+			mLogger := mocks.NewLogger(t)
+
+			_, err := uc.NewUseCase(mRepo, mTimer, mLogger).
+				CreateSubscription(context.Background(), "admin", tc.url, tc.events, "0123456789abcdef")
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
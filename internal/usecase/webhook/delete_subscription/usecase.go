@@ -0,0 +1,48 @@
+package delete_subscription
+
+import (
+	"context"
+	"fmt"
+
+	entW "test-question/internal/entity/webhook"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=webhookRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	webhookRepository interface {
+		DeleteSubscription(ctx context.Context, id int) error
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	repo   webhookRepository
+	logger logger
+}
+
+func NewUseCase(repo webhookRepository, logger logger) *UseCase {
+	return &UseCase{repo: repo, logger: logger}
+}
+
+func (uc *UseCase) DeleteSubscription(ctx context.Context, id int, adminID string) error {
+	if err := uc.repo.DeleteSubscription(ctx, id); err != nil {
+		if errors.Is(err, entW.ErrSubscriptionNotFound) {
+			return err
+		}
+		return fmt.Errorf("delete webhook subscription: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "webhook subscription deleted",
+		"subscription_id", id,
+		"admin_id", adminID,
+	)
+
+	return nil
+}
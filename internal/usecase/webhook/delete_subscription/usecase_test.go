@@ -0,0 +1,31 @@
+package delete_subscription_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entW "test-question/internal/entity/webhook"
+	uc "test-question/internal/usecase/webhook/delete_subscription"
+	"test-question/internal/usecase/webhook/delete_subscription/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteSubscription(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewWebhookRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.On("DeleteSubscription", ctx, 1).Return(nil)
+	mRepo.On("DeleteSubscription", ctx, 2).Return(entW.ErrSubscriptionNotFound)
+	mRepo.On("DeleteSubscription", ctx, 3).Return(errors.New("db down"))
+	mLogger.On("DebugContext", ctx, "webhook subscription deleted", "subscription_id", 1, "admin_id", "admin").Return()
+
+	ucase := uc.NewUseCase(mRepo, mLogger)
+
+	require.NoError(t, ucase.DeleteSubscription(ctx, 1, "admin"))
+	require.ErrorIs(t, ucase.DeleteSubscription(ctx, 2, "admin"), entW.ErrSubscriptionNotFound)
+	require.ErrorContains(t, ucase.DeleteSubscription(ctx, 3, "admin"), "delete webhook subscription")
+}
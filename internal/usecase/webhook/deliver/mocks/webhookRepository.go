@@ -0,0 +1,110 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	webhook "test-question/internal/entity/webhook"
+)
+
+// WebhookRepository is an autogenerated mock type for the webhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+// ClaimDueDeliveries provides a mock function with given fields: ctx, now, leaseUntil, limit
+func (_m *WebhookRepository) ClaimDueDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*webhook.Delivery, error) {
+	ret := _m.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDueDeliveries")
+	}
+
+	var r0 []*webhook.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]*webhook.Delivery, error)); ok {
+		return rf(ctx, now, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []*webhook.Delivery); ok {
+		r0 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSubscription provides a mock function with given fields: ctx, id
+func (_m *WebhookRepository) GetSubscription(ctx context.Context, id int) (*webhook.Subscription, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscription")
+	}
+
+	var r0 *webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*webhook.Subscription, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *webhook.Subscription); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveDeliveryAttempt provides a mock function with given fields: ctx, d
+func (_m *WebhookRepository) SaveDeliveryAttempt(ctx context.Context, d *webhook.Delivery) error {
+	ret := _m.Called(ctx, d)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveDeliveryAttempt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *webhook.Delivery) error); ok {
+		r0 = rf(ctx, d)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
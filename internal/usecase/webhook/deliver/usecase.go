@@ -0,0 +1,197 @@
+package deliver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+	"test-question/internal/pkg/backoff"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=webhookRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=httpClient --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	webhookRepository interface {
+		ClaimDueDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*entW.Delivery, error)
+		GetSubscription(ctx context.Context, id int) (*entW.Subscription, error)
+		SaveDeliveryAttempt(ctx context.Context, d *entW.Delivery) error
+	}
+
+	httpClient interface {
+		Do(req *http.Request) (*http.Response, error)
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+		WarnContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+const (
+	maxBackoff = 6 * time.Hour
+
+	// how much of the receiver's response body is read before closing it
+	maxResponseBody = 64 << 10
+)
+
+type Config struct {
+	// MaxAttempts is the number of failed attempts after which a delivery
+	// is moved to the dead-letter state.
+	MaxAttempts int
+	// BackoffBase is the delay after the first failure; it doubles on each
+	// subsequent failure up to maxBackoff.
+	BackoffBase time.Duration
+	// Timeout bounds a single HTTP attempt.
+	Timeout   time.Duration
+	BatchSize int
+}
+
+type UseCase struct {
+	repo   webhookRepository
+	client httpClient
+	timer  timer
+	logger logger
+	cfg    Config
+}
+
+func NewUseCase(
+	repo webhookRepository,
+	client httpClient,
+	timer timer,
+	logger logger,
+	cfg Config,
+) *UseCase {
+	return &UseCase{
+		repo:   repo,
+		client: client,
+		timer:  timer,
+		logger: logger,
+		cfg:    cfg,
+	}
+}
+
+// DeliverDue sends one batch of due deliveries and returns how many were attempted.
+func (uc *UseCase) DeliverDue(ctx context.Context) (int, error) {
+	now := uc.timer.Now()
+
+	// a claimed delivery is leased for twice the attempt timeout,
+	// long enough for the attempt and saving its outcome
+	ds, err := uc.repo.ClaimDueDeliveries(ctx, now, now.Add(2*uc.cfg.Timeout), uc.cfg.BatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+
+	subs := make(map[int]*entW.Subscription)
+
+	for _, d := range ds {
+		sub, ok := subs[d.SubscriptionID]
+		if !ok {
+			sub, err = uc.repo.GetSubscription(ctx, d.SubscriptionID)
+			if err != nil {
+				if errors.Is(err, entW.ErrSubscriptionNotFound) {
+					// deleted meanwhile; its deliveries went with it
+					continue
+				}
+				return 0, fmt.Errorf("get webhook subscription: %w", err)
+			}
+			subs[d.SubscriptionID] = sub
+		}
+
+		uc.attempt(ctx, sub, d)
+
+		if err = uc.repo.SaveDeliveryAttempt(ctx, d); err != nil {
+			return 0, fmt.Errorf("save webhook delivery attempt: %w", err)
+		}
+	}
+
+	return len(ds), nil
+}
+
+// attempt sends d once and records the outcome on it.
+func (uc *UseCase) attempt(ctx context.Context, sub *entW.Subscription, d *entW.Delivery) {
+	code, err := uc.send(ctx, sub, d)
+
+	at := uc.timer.Now()
+	d.Attempts++
+	d.LastStatusCode = code
+
+	if err == nil {
+		d.Status = entW.StatusDelivered
+		d.DeliveredAt = &at
+		d.LastError = ""
+
+		uc.logger.DebugContext(ctx, "webhook delivered",
+			"delivery_id", d.ID,
+			"subscription_id", sub.ID,
+			"attempts", d.Attempts,
+		)
+		return
+	}
+
+	d.LastError = err.Error()
+
+	if d.Attempts >= uc.cfg.MaxAttempts {
+		d.Status = entW.StatusDead
+
+		uc.logger.WarnContext(ctx, "webhook delivery moved to dead letter",
+			"delivery_id", d.ID,
+			"subscription_id", sub.ID,
+			"attempts", d.Attempts,
+			"err", err,
+		)
+		return
+	}
+
+	d.NextAttemptAt = at.Add(backoff.Exponential(uc.cfg.BackoffBase, maxBackoff, d.Attempts))
+
+	uc.logger.DebugContext(ctx, "webhook delivery failed",
+		"delivery_id", d.ID,
+		"subscription_id", sub.ID,
+		"attempts", d.Attempts,
+		"next_attempt_at", d.NextAttemptAt,
+		"err", err,
+	)
+}
+
+func (uc *UseCase) send(ctx context.Context, sub *entW.Subscription, d *entW.Delivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, uc.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(entW.EventHeader, string(d.Event))
+	req.Header.Set(entW.DeliveryHeader, strconv.Itoa(d.ID))
+	req.Header.Set(entW.SignatureHeader, entW.Sign(sub.Secret, d.Payload))
+
+	resp, err := uc.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseBody))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
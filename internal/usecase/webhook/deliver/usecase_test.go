@@ -0,0 +1,176 @@
+package deliver_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	entW "test-question/internal/entity/webhook"
+	uc "test-question/internal/usecase/webhook/deliver"
+	"test-question/internal/usecase/webhook/deliver/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var cfg = uc.Config{ //nolint:gochecknoglobals
+	MaxAttempts: 3,
+	BackoffBase: 10 * time.Second,
+	Timeout:     time.Second,
+	BatchSize:   10,
+}
+
+type receiver struct {
+	status int
+	got    *http.Request
+	body   []byte
+}
+
+func newReceiver(t *testing.T, status int) (*receiver, *httptest.Server) { //nolint:thelper
+	rcv := &receiver{status: status}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rcv.got = r
+		rcv.body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(rcv.status)
+	}))
+	t.Cleanup(srv.Close)
+	return rcv, srv
+}
+
+func newMocks(t *testing.T, now time.Time, sub *entW.Subscription, d *entW.Delivery) (*mocks.WebhookRepository, *mocks.Logger) { //nolint:thelper
+	mRepo := mocks.NewWebhookRepository(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("ClaimDueDeliveries", mock.Anything, now, now.Add(2*cfg.Timeout), cfg.BatchSize).
+		Return([]*entW.Delivery{d}, nil)
+	mRepo.
+		On("GetSubscription", mock.Anything, sub.ID).
+		Return(sub, nil)
+
+	return mRepo, mLogger
+}
+
+func TestDeliverDue_Delivered(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	rcv, srv := newReceiver(t, http.StatusOK)
+
+	sub := &entW.Subscription{ID: 1, URL: srv.URL + "/hook", Secret: "s3cr3t"}
+	d := &entW.Delivery{
+		ID:             7,
+		SubscriptionID: 1,
+		Event:          entW.EventQuestionCreated,
+		Payload:        []byte(`{"event":"question.created"}`),
+		Status:         entW.StatusPending,
+	}
+
+	mRepo, mLogger := newMocks(t, now, sub, d)
+	mRepo.
+		On("SaveDeliveryAttempt", mock.Anything, mock.MatchedBy(func(d *entW.Delivery) bool {
+			return d.Status == entW.StatusDelivered &&
+				d.Attempts == 1 &&
+				d.LastStatusCode == http.StatusOK &&
+				d.DeliveredAt != nil && d.DeliveredAt.Equal(now)
+		})).
+		Return(nil)
+	mLogger.
+		On("DebugContext", mock.Anything, "webhook delivered",
+			"delivery_id", 7, "subscription_id", 1, "attempts", 1).
+		Return()
+
+	n, err := uc.NewUseCase(mRepo, srv.Client(), newTimer(t, now), mLogger, cfg).DeliverDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	require.Equal(t, "/hook", rcv.got.URL.Path)
+	require.Equal(t, "question.created", rcv.got.Header.Get(entW.EventHeader))
+	require.Equal(t, "7", rcv.got.Header.Get(entW.DeliveryHeader))
+	require.Equal(t, entW.Sign("s3cr3t", rcv.body), rcv.got.Header.Get(entW.SignatureHeader))
+	require.JSONEq(t, `{"event":"question.created"}`, string(rcv.body))
+}
+
+func TestDeliverDue_RetriesWithBackoff(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	_, srv := newReceiver(t, http.StatusInternalServerError)
+
+	sub := &entW.Subscription{ID: 1, URL: srv.URL, Secret: "s3cr3t"}
+	d := &entW.Delivery{ID: 7, SubscriptionID: 1, Payload: []byte(`{}`), Status: entW.StatusPending, Attempts: 1}
+
+	mRepo, mLogger := newMocks(t, now, sub, d)
+	mRepo.
+		On("SaveDeliveryAttempt", mock.Anything, mock.MatchedBy(func(d *entW.Delivery) bool {
+			// second failure: 10s doubled once
+			return d.Status == entW.StatusPending &&
+				d.Attempts == 2 &&
+				d.LastStatusCode == http.StatusInternalServerError &&
+				d.LastError == "unexpected status 500" &&
+				d.NextAttemptAt.Equal(now.Add(20*time.Second))
+		})).
+		Return(nil)
+	mLogger.
+		On("DebugContext", mock.Anything, "webhook delivery failed",
+			"delivery_id", 7, "subscription_id", 1, "attempts", 2,
+			"next_attempt_at", now.Add(20*time.Second), "err", mock.Anything).
+		Return()
+
+	_, err := uc.NewUseCase(mRepo, srv.Client(), newTimer(t, now), mLogger, cfg).DeliverDue(ctx)
+	require.NoError(t, err)
+}
+
+func TestDeliverDue_DeadLetter(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	_, srv := newReceiver(t, http.StatusBadGateway)
+
+	sub := &entW.Subscription{ID: 1, URL: srv.URL, Secret: "s3cr3t"}
+	d := &entW.Delivery{ID: 7, SubscriptionID: 1, Payload: []byte(`{}`), Status: entW.StatusPending, Attempts: 2}
+
+	mRepo, mLogger := newMocks(t, now, sub, d)
+	mRepo.
+		On("SaveDeliveryAttempt", mock.Anything, mock.MatchedBy(func(d *entW.Delivery) bool {
+			return d.Status == entW.StatusDead && d.Attempts == 3
+		})).
+		Return(nil)
+	mLogger.
+		On("WarnContext", mock.Anything, "webhook delivery moved to dead letter",
+			"delivery_id", 7, "subscription_id", 1, "attempts", 3, "err", mock.Anything).
+		Return()
+
+	_, err := uc.NewUseCase(mRepo, srv.Client(), newTimer(t, now), mLogger, cfg).DeliverDue(ctx)
+	require.NoError(t, err)
+}
+
+func TestDeliverDue_SubscriptionDeleted(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mRepo := mocks.NewWebhookRepository(t)
+	mClient := mocks.NewHttpClient(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.
+		On("ClaimDueDeliveries", mock.Anything, now, now.Add(2*cfg.Timeout), cfg.BatchSize).
+		Return([]*entW.Delivery{{ID: 7, SubscriptionID: 1}}, nil)
+	mRepo.
+		On("GetSubscription", mock.Anything, 1).
+		Return(nil, entW.ErrSubscriptionNotFound)
+
+	n, err := uc.NewUseCase(mRepo, mClient, newTimer(t, now), mLogger, cfg).DeliverDue(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	mRepo.AssertNotCalled(t, "SaveDeliveryAttempt", mock.Anything, mock.Anything)
+}
+
+func newTimer(t *testing.T, now time.Time) *mocks.Timer { //nolint:thelper
+	mTimer := mocks.NewTimer(t)
+	mTimer.On("Now").Return(now)
+	return mTimer
+}
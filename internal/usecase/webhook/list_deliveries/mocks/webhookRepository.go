@@ -0,0 +1,90 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	webhook "test-question/internal/entity/webhook"
+)
+
+// WebhookRepository is an autogenerated mock type for the webhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+// GetSubscription provides a mock function with given fields: ctx, id
+func (_m *WebhookRepository) GetSubscription(ctx context.Context, id int) (*webhook.Subscription, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSubscription")
+	}
+
+	var r0 *webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*webhook.Subscription, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *webhook.Subscription); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDeliveries provides a mock function with given fields: ctx, subscriptionID, limit
+func (_m *WebhookRepository) ListDeliveries(ctx context.Context, subscriptionID int, limit int) ([]*webhook.Delivery, error) {
+	ret := _m.Called(ctx, subscriptionID, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDeliveries")
+	}
+
+	var r0 []*webhook.Delivery
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*webhook.Delivery, error)); ok {
+		return rf(ctx, subscriptionID, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*webhook.Delivery); ok {
+		r0 = rf(ctx, subscriptionID, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Delivery)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, subscriptionID, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,44 @@
+package list_deliveries
+
+import (
+	"context"
+	"fmt"
+
+	entW "test-question/internal/entity/webhook"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=webhookRepository --output=mocks --outpkg=mocks --exported
+
+type (
+	webhookRepository interface {
+		GetSubscription(ctx context.Context, id int) (*entW.Subscription, error)
+		ListDeliveries(ctx context.Context, subscriptionID int, limit int) ([]*entW.Delivery, error)
+	}
+)
+
+type UseCase struct {
+	repo webhookRepository
+}
+
+func NewUseCase(repo webhookRepository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+// ListDeliveries returns the most recent deliveries of a subscription, newest first.
+func (uc *UseCase) ListDeliveries(ctx context.Context, subscriptionID int, limit int) ([]*entW.Delivery, error) {
+	if _, err := uc.repo.GetSubscription(ctx, subscriptionID); err != nil {
+		if errors.Is(err, entW.ErrSubscriptionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("get webhook subscription: %w", err)
+	}
+
+	out, err := uc.repo.ListDeliveries(ctx, subscriptionID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %w", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,36 @@
+package list_deliveries_test
+
+import (
+	"context"
+	"testing"
+
+	entW "test-question/internal/entity/webhook"
+	uc "test-question/internal/usecase/webhook/list_deliveries"
+	"test-question/internal/usecase/webhook/list_deliveries/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListDeliveries(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewWebhookRepository(t)
+
+	mRepo.On("GetSubscription", ctx, 1).Return(&entW.Subscription{ID: 1}, nil)
+	mRepo.On("ListDeliveries", ctx, 1, 20).Return([]*entW.Delivery{{ID: 9}, {ID: 8}}, nil)
+
+	out, err := uc.NewUseCase(mRepo).ListDeliveries(ctx, 1, 20)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+}
+
+func TestListDeliveries_SubscriptionNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewWebhookRepository(t)
+
+	mRepo.On("GetSubscription", ctx, 1).Return(nil, entW.ErrSubscriptionNotFound)
+
+	_, err := uc.NewUseCase(mRepo).ListDeliveries(ctx, 1, 20)
+	require.ErrorIs(t, err, entW.ErrSubscriptionNotFound)
+}
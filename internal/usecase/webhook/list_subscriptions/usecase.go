@@ -0,0 +1,33 @@
+package list_subscriptions
+
+import (
+	"context"
+	"fmt"
+
+	entW "test-question/internal/entity/webhook"
+)
+
+//go:generate mockery --name=webhookRepository --output=mocks --outpkg=mocks --exported
+
+type (
+	webhookRepository interface {
+		ListSubscriptions(ctx context.Context) ([]*entW.Subscription, error)
+	}
+)
+
+type UseCase struct {
+	repo webhookRepository
+}
+
+func NewUseCase(repo webhookRepository) *UseCase {
+	return &UseCase{repo: repo}
+}
+
+func (uc *UseCase) ListSubscriptions(ctx context.Context) ([]*entW.Subscription, error) {
+	out, err := uc.repo.ListSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook subscriptions: %w", err)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,29 @@
+package list_subscriptions_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entW "test-question/internal/entity/webhook"
+	uc "test-question/internal/usecase/webhook/list_subscriptions"
+	"test-question/internal/usecase/webhook/list_subscriptions/mocks"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListSubscriptions(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewWebhookRepository(t)
+	mRepo.On("ListSubscriptions", ctx).Return([]*entW.Subscription{{ID: 1}, {ID: 2}}, nil).Once()
+
+	out, err := uc.NewUseCase(mRepo).ListSubscriptions(ctx)
+	require.NoError(t, err)
+	require.Len(t, out, 2)
+
+	mRepo.On("ListSubscriptions", ctx).Return(nil, errors.New("db down"))
+
+	_, err = uc.NewUseCase(mRepo).ListSubscriptions(ctx)
+	require.ErrorContains(t, err, "list webhook subscriptions")
+}
@@ -0,0 +1,78 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	webhook "test-question/internal/entity/webhook"
+)
+
+// WebhookRepository is an autogenerated mock type for the webhookRepository type
+type WebhookRepository struct {
+	mock.Mock
+}
+
+// EnqueueDeliveries provides a mock function with given fields: ctx, ds
+func (_m *WebhookRepository) EnqueueDeliveries(ctx context.Context, ds []*webhook.Delivery) error {
+	ret := _m.Called(ctx, ds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnqueueDeliveries")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*webhook.Delivery) error); ok {
+		r0 = rf(ctx, ds)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListSubscriptionsByEvent provides a mock function with given fields: ctx, e
+func (_m *WebhookRepository) ListSubscriptionsByEvent(ctx context.Context, e webhook.Event) ([]*webhook.Subscription, error) {
+	ret := _m.Called(ctx, e)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSubscriptionsByEvent")
+	}
+
+	var r0 []*webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.Event) ([]*webhook.Subscription, error)); ok {
+		return rf(ctx, e)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.Event) []*webhook.Subscription); ok {
+		r0 = rf(ctx, e)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, webhook.Event) error); ok {
+		r1 = rf(ctx, e)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWebhookRepository creates a new instance of WebhookRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWebhookRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WebhookRepository {
+	mock := &WebhookRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
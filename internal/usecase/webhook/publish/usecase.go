@@ -0,0 +1,150 @@
+package publish
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entO "test-question/internal/entity/outbox"
+	entQ "test-question/internal/entity/question"
+	entW "test-question/internal/entity/webhook"
+)
+
+//go:generate mockery --name=webhookRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=timer --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	webhookRepository interface {
+		ListSubscriptionsByEvent(ctx context.Context, e entW.Event) ([]*entW.Subscription, error)
+		EnqueueDeliveries(ctx context.Context, ds []*entW.Delivery) error
+	}
+
+	timer interface {
+		Now() time.Time
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+// Payload is the JSON body posted to subscribers.
+type Payload struct {
+	Event      entW.Event `json:"event"`
+	OccurredAt time.Time  `json:"occurred_at"`
+	Data       any        `json:"data"`
+}
+
+type QuestionData struct {
+	ID        int       `json:"id"`
+	UserID    string    `json:"user_id"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type AnswerData struct {
+	ID         int       `json:"id"`
+	QuestionID int       `json:"question_id"`
+	UserID     string    `json:"user_id"`
+	Text       string    `json:"text"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type UseCase struct {
+	repo   webhookRepository
+	timer  timer
+	logger logger
+}
+
+func NewUseCase(
+	repo webhookRepository,
+	timer timer,
+	logger logger,
+) *UseCase {
+	return &UseCase{
+		repo:   repo,
+		timer:  timer,
+		logger: logger,
+	}
+}
+
+func (uc *UseCase) QuestionCreated(ctx context.Context, e entO.QuestionCreated) error {
+	return uc.publish(ctx, entW.EventQuestionCreated, e.OccurredAt, map[string]any{"question": questionData(&e.Question)})
+}
+
+func (uc *UseCase) QuestionDeleted(ctx context.Context, e entO.QuestionDeleted) error {
+	return uc.publish(ctx, entW.EventQuestionDeleted, e.OccurredAt, map[string]any{"question": questionData(&e.Question)})
+}
+
+func (uc *UseCase) AnswerCreated(ctx context.Context, e entO.AnswerCreated) error {
+	return uc.publish(ctx, entW.EventAnswerCreated, e.OccurredAt, map[string]any{"answer": answerData(&e.Answer)})
+}
+
+func (uc *UseCase) AnswerDeleted(ctx context.Context, e entO.AnswerDeleted) error {
+	return uc.publish(ctx, entW.EventAnswerDeleted, e.OccurredAt, map[string]any{"answer": answerData(&e.Answer)})
+}
+
+// publish queues one delivery per matching subscription. It writes through
+// the caller's transaction, so deliveries exist only if that commits.
+func (uc *UseCase) publish(ctx context.Context, e entW.Event, occurredAt time.Time, data any) error {
+	subs, err := uc.repo.ListSubscriptionsByEvent(ctx, e)
+	if err != nil {
+		return fmt.Errorf("list webhook subscriptions: %w", err)
+	}
+
+	if len(subs) == 0 {
+		return nil
+	}
+
+	now := uc.timer.Now()
+
+	body, err := json.Marshal(Payload{Event: e, OccurredAt: occurredAt, Data: data})
+	if err != nil {
+		return fmt.Errorf("marshal webhook payload: %w", err)
+	}
+
+	ds := make([]*entW.Delivery, 0, len(subs))
+	for _, s := range subs {
+		ds = append(ds, &entW.Delivery{
+			SubscriptionID: s.ID,
+			Event:          e,
+			Payload:        body,
+			Status:         entW.StatusPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+		})
+	}
+
+	if err = uc.repo.EnqueueDeliveries(ctx, ds); err != nil {
+		return fmt.Errorf("enqueue webhook deliveries: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "webhook deliveries enqueued",
+		"event", string(e),
+		"subscriptions", len(ds),
+	)
+
+	return nil
+}
+
+func questionData(q *entQ.Question) QuestionData {
+	return QuestionData{
+		ID:        q.ID,
+		UserID:    q.UserID,
+		Text:      q.Text,
+		CreatedAt: q.CreatedAt,
+	}
+}
+
+func answerData(a *entA.Answer) AnswerData {
+	return AnswerData{
+		ID:         a.ID,
+		QuestionID: a.QuestionID,
+		UserID:     a.UserID,
+		Text:       a.Text,
+		CreatedAt:  a.CreatedAt,
+	}
+}
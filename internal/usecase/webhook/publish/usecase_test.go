@@ -0,0 +1,93 @@
+package publish_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	entA "test-question/internal/entity/answer"
+	entO "test-question/internal/entity/outbox"
+	entQ "test-question/internal/entity/question"
+	entW "test-question/internal/entity/webhook"
+	uc "test-question/internal/usecase/webhook/publish"
+	"test-question/internal/usecase/webhook/publish/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnswerCreated_EnqueuesPerSubscription(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	mRepo := mocks.NewWebhookRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.On("Now").Return(now)
+	mRepo.
+		On("ListSubscriptionsByEvent", ctx, entW.EventAnswerCreated).
+		Return([]*entW.Subscription{{ID: 1}, {ID: 2}}, nil)
+
+	var enqueued []*entW.Delivery
+	mRepo.
+		On("EnqueueDeliveries", ctx, mock.Anything).
+		Run(func(args mock.Arguments) {
+			enqueued = args.Get(1).([]*entW.Delivery) //nolint:forcetypeassert
+		}).
+		Return(nil)
+
+	mLogger.On("DebugContext", ctx, "webhook deliveries enqueued", "event", "answer.created", "subscriptions", 2).Return()
+
+	err := uc.NewUseCase(mRepo, mTimer, mLogger).AnswerCreated(ctx, entO.AnswerCreated{
+		Answer: entA.Answer{
+			ID:         5,
+			QuestionID: 3,
+			UserID:     "u1",
+			Text:       "hi",
+			CreatedAt:  now.Add(-time.Minute),
+		},
+		OccurredAt: now.Add(-time.Minute),
+	})
+	require.NoError(t, err)
+
+	require.Len(t, enqueued, 2)
+	require.Equal(t, 1, enqueued[0].SubscriptionID)
+	require.Equal(t, 2, enqueued[1].SubscriptionID)
+	require.Equal(t, entW.StatusPending, enqueued[0].Status)
+	require.Equal(t, now, enqueued[0].NextAttemptAt)
+	require.JSONEq(t, `{
+		"event": "answer.created",
+		"occurred_at": "2024-11-20T11:59:00Z",
+		"data": {"answer": {"id": 5, "question_id": 3, "user_id": "u1", "text": "hi", "created_at": "2024-11-20T11:59:00Z"}}
+	}`, string(enqueued[0].Payload))
+}
+
+func TestQuestionDeleted_NoSubscribers(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewWebhookRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mRepo.On("ListSubscriptionsByEvent", ctx, entW.EventQuestionDeleted).Return([]*entW.Subscription{}, nil)
+
+	err := uc.NewUseCase(mRepo, mTimer, mLogger).QuestionDeleted(ctx, entO.QuestionDeleted{Question: entQ.Question{ID: 1}})
+	require.NoError(t, err)
+}
+
+func TestQuestionCreated_EnqueueError(t *testing.T) {
+	ctx := context.Background()
+
+	mRepo := mocks.NewWebhookRepository(t)
+	mTimer := mocks.NewTimer(t)
+	mLogger := mocks.NewLogger(t)
+
+	mTimer.On("Now").Return(time.Now())
+	mRepo.On("ListSubscriptionsByEvent", ctx, entW.EventQuestionCreated).Return([]*entW.Subscription{{ID: 1}}, nil)
+	mRepo.On("EnqueueDeliveries", ctx, mock.Anything).Return(errors.New("db down"))
+
+	err := uc.NewUseCase(mRepo, mTimer, mLogger).QuestionCreated(ctx, entO.QuestionCreated{Question: entQ.Question{ID: 1}})
+	require.ErrorContains(t, err, "enqueue webhook deliveries")
+}
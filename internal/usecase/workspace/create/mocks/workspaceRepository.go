@@ -0,0 +1,78 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	workspace "test-question/internal/entity/workspace"
+)
+
+// WorkspaceRepository is an autogenerated mock type for the workspaceRepository type
+type WorkspaceRepository struct {
+	mock.Mock
+}
+
+// AddMember provides a mock function with given fields: ctx, m
+func (_m *WorkspaceRepository) AddMember(ctx context.Context, m *workspace.Member) error {
+	ret := _m.Called(ctx, m)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddMember")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *workspace.Member) error); ok {
+		r0 = rf(ctx, m)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: ctx, w
+func (_m *WorkspaceRepository) Create(ctx context.Context, w *workspace.Workspace) (*workspace.Workspace, error) {
+	ret := _m.Called(ctx, w)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *workspace.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *workspace.Workspace) (*workspace.Workspace, error)); ok {
+		return rf(ctx, w)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *workspace.Workspace) *workspace.Workspace); ok {
+		r0 = rf(ctx, w)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workspace.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *workspace.Workspace) error); ok {
+		r1 = rf(ctx, w)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWorkspaceRepository creates a new instance of WorkspaceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorkspaceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WorkspaceRepository {
+	mock := &WorkspaceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
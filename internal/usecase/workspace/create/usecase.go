@@ -0,0 +1,72 @@
+package create
+
+import (
+	"context"
+	"fmt"
+
+	entW "test-question/internal/entity/workspace"
+)
+
+//go:generate mockery --name=workspaceRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=unitOfWork --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	workspaceRepository interface {
+		Create(ctx context.Context, w *entW.Workspace) (*entW.Workspace, error)
+		AddMember(ctx context.Context, m *entW.Member) error
+	}
+
+	unitOfWork interface {
+		Do(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	workspaces workspaceRepository
+	uow        unitOfWork
+	logger     logger
+}
+
+func NewUseCase(workspaces workspaceRepository, uow unitOfWork, logger logger) *UseCase {
+	return &UseCase{
+		workspaces: workspaces,
+		uow:        uow,
+		logger:     logger,
+	}
+}
+
+// Create opens a workspace with the user as its owner.
+func (uc *UseCase) Create(ctx context.Context, userID, slug, name string) (*entW.Workspace, error) {
+	if err := entW.ValidateSlug(slug); err != nil {
+		return nil, err
+	}
+
+	var out *entW.Workspace
+
+	err := uc.uow.Do(ctx, func(ctx context.Context) error {
+		w, err := uc.workspaces.Create(ctx, &entW.Workspace{Slug: slug, Name: name})
+		if err != nil {
+			return fmt.Errorf("create workspace: %w", err)
+		}
+
+		err = uc.workspaces.AddMember(ctx, &entW.Member{WorkspaceID: w.ID, UserID: userID, Role: entW.RoleOwner})
+		if err != nil {
+			return fmt.Errorf("add owner: %w", err)
+		}
+
+		out = w
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.logger.DebugContext(ctx, "workspace created", "workspace_id", out.ID, "slug", out.Slug, "user_id", userID)
+
+	return out, nil
+}
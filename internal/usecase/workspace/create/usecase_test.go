@@ -0,0 +1,69 @@
+package create_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entW "test-question/internal/entity/workspace"
+	uc "test-question/internal/usecase/workspace/create"
+	"test-question/internal/usecase/workspace/create/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func inTx(u *mocks.UnitOfWork) {
+	u.On("Do", mock.Anything, mock.Anything).Return(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewWorkspaceRepository(t)
+	u := mocks.NewUnitOfWork(t)
+	log := mocks.NewLogger(t)
+	inTx(u)
+
+	w := &entW.Workspace{ID: 2, Slug: "platform", Name: "Platform team"}
+
+	repo.On("Create", mock.Anything, &entW.Workspace{Slug: "platform", Name: "Platform team"}).Return(w, nil)
+	repo.On("AddMember", mock.Anything, &entW.Member{WorkspaceID: 2, UserID: "u1", Role: entW.RoleOwner}).Return(nil)
+	log.On("DebugContext", mock.Anything, "workspace created", "workspace_id", 2, "slug", "platform", "user_id", "u1").Return()
+
+	got, err := uc.NewUseCase(repo, u, log).Create(ctx, "u1", "platform", "Platform team")
+	require.NoError(t, err)
+	require.Equal(t, w, got)
+}
+
+func TestCreate_InvalidSlug(t *testing.T) {
+	for _, slug := range []string{"", "ab", "Platform", "-team", "team-", "a_b", "w/x", "abcdefghijklmnopqrstuvwxyz0123456"} {
+		_, err := uc.NewUseCase(mocks.NewWorkspaceRepository(t), mocks.NewUnitOfWork(t), mocks.NewLogger(t)).
+			Create(context.Background(), "u1", slug, "name")
+		require.ErrorIs(t, err, entW.ErrInvalidSlug, slug)
+	}
+}
+
+func TestCreate_SlugTaken(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+	u := mocks.NewUnitOfWork(t)
+	inTx(u)
+
+	repo.On("Create", mock.Anything, mock.Anything).Return(nil, entW.ErrSlugTaken)
+
+	_, err := uc.NewUseCase(repo, u, mocks.NewLogger(t)).Create(context.Background(), "u1", "platform", "Platform team")
+	require.ErrorIs(t, err, entW.ErrSlugTaken)
+}
+
+func TestCreate_AddOwnerError(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+	u := mocks.NewUnitOfWork(t)
+	inTx(u)
+
+	repo.On("Create", mock.Anything, mock.Anything).Return(&entW.Workspace{ID: 2, Slug: "platform"}, nil)
+	repo.On("AddMember", mock.Anything, mock.Anything).Return(errors.New("db down"))
+
+	_, err := uc.NewUseCase(repo, u, mocks.NewLogger(t)).Create(context.Background(), "u1", "platform", "Platform team")
+	require.ErrorContains(t, err, "add owner")
+}
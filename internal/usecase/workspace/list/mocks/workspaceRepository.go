@@ -0,0 +1,60 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	workspace "test-question/internal/entity/workspace"
+)
+
+// WorkspaceRepository is an autogenerated mock type for the workspaceRepository type
+type WorkspaceRepository struct {
+	mock.Mock
+}
+
+// ListByUser provides a mock function with given fields: ctx, userID
+func (_m *WorkspaceRepository) ListByUser(ctx context.Context, userID string) ([]*workspace.Membership, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUser")
+	}
+
+	var r0 []*workspace.Membership
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*workspace.Membership, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*workspace.Membership); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*workspace.Membership)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWorkspaceRepository creates a new instance of WorkspaceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorkspaceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WorkspaceRepository {
+	mock := &WorkspaceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,46 @@
+package list
+
+import (
+	"context"
+	"fmt"
+
+	entW "test-question/internal/entity/workspace"
+)
+
+//go:generate mockery --name=workspaceRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	workspaceRepository interface {
+		ListByUser(ctx context.Context, userID string) ([]*entW.Membership, error)
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	workspaces workspaceRepository
+	logger     logger
+}
+
+func NewUseCase(workspaces workspaceRepository, logger logger) *UseCase {
+	return &UseCase{
+		workspaces: workspaces,
+		logger:     logger,
+	}
+}
+
+// ListMine returns the workspaces the user is a member of. The default
+// workspace is open to everyone and not listed.
+func (uc *UseCase) ListMine(ctx context.Context, userID string) ([]*entW.Membership, error) {
+	out, err := uc.workspaces.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list workspaces: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "workspaces listed", "user_id", userID, "count", len(out))
+
+	return out, nil
+}
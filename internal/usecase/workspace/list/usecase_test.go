@@ -0,0 +1,37 @@
+package list_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entW "test-question/internal/entity/workspace"
+	uc "test-question/internal/usecase/workspace/list"
+	"test-question/internal/usecase/workspace/list/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMine(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+	log := mocks.NewLogger(t)
+
+	items := []*entW.Membership{{Workspace: entW.Workspace{ID: 2, Slug: "platform"}, Role: entW.RoleOwner}}
+
+	repo.On("ListByUser", mock.Anything, "u1").Return(items, nil)
+	log.On("DebugContext", mock.Anything, "workspaces listed", "user_id", "u1", "count", 1).Return()
+
+	got, err := uc.NewUseCase(repo, log).ListMine(context.Background(), "u1")
+	require.NoError(t, err)
+	require.Equal(t, items, got)
+}
+
+func TestListMine_Error(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+
+	repo.On("ListByUser", mock.Anything, "u1").Return(nil, errors.New("db down"))
+
+	_, err := uc.NewUseCase(repo, mocks.NewLogger(t)).ListMine(context.Background(), "u1")
+	require.ErrorContains(t, err, "list workspaces")
+}
@@ -0,0 +1,120 @@
+package members
+
+import (
+	"context"
+	"fmt"
+
+	entU "test-question/internal/entity/user"
+	entW "test-question/internal/entity/workspace"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=workspaceRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=userRepository --output=mocks --outpkg=mocks --exported
+//go:generate mockery --name=logger --output=mocks --outpkg=mocks --exported
+
+type (
+	workspaceRepository interface {
+		GetBySlug(ctx context.Context, slug string) (*entW.Workspace, error)
+		GetMember(ctx context.Context, workspaceID int, userID string) (*entW.Member, error)
+		AddMember(ctx context.Context, m *entW.Member) error
+		RemoveMember(ctx context.Context, workspaceID int, userID string) error
+	}
+
+	userRepository interface {
+		GetByID(ctx context.Context, id string) (*entU.User, error)
+	}
+
+	logger interface {
+		DebugContext(ctx context.Context, msg string, args ...any)
+	}
+)
+
+type UseCase struct {
+	workspaces workspaceRepository
+	users      userRepository
+	logger     logger
+}
+
+func NewUseCase(workspaces workspaceRepository, users userRepository, logger logger) *UseCase {
+	return &UseCase{
+		workspaces: workspaces,
+		users:      users,
+		logger:     logger,
+	}
+}
+
+// Add makes the user a member of the workspace. Adding a member again
+// changes nothing.
+func (uc *UseCase) Add(ctx context.Context, slug, actorID string, actorRole entU.Role, userID string) error {
+	w, err := uc.manage(ctx, slug, actorID, actorRole)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uc.users.GetByID(ctx, userID); err != nil {
+		return fmt.Errorf("get user: %w", err)
+	}
+
+	if err := uc.workspaces.AddMember(ctx, &entW.Member{WorkspaceID: w.ID, UserID: userID, Role: entW.RoleMember}); err != nil {
+		return fmt.Errorf("add member: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "workspace member added", "workspace_id", w.ID, "user_id", userID, "by", actorID)
+
+	return nil
+}
+
+// Remove takes the user out of the workspace. The owner cannot be removed.
+func (uc *UseCase) Remove(ctx context.Context, slug, actorID string, actorRole entU.Role, userID string) error {
+	w, err := uc.manage(ctx, slug, actorID, actorRole)
+	if err != nil {
+		return err
+	}
+
+	m, err := uc.workspaces.GetMember(ctx, w.ID, userID)
+	if err != nil {
+		return fmt.Errorf("get member: %w", err)
+	}
+	if m.Role == entW.RoleOwner {
+		return entW.ErrOwner
+	}
+
+	if err := uc.workspaces.RemoveMember(ctx, w.ID, userID); err != nil {
+		return fmt.Errorf("remove member: %w", err)
+	}
+
+	uc.logger.DebugContext(ctx, "workspace member removed", "workspace_id", w.ID, "user_id", userID, "by", actorID)
+
+	return nil
+}
+
+// manage returns the workspace if the actor may manage its members: its
+// owner or an admin. Other members are denied; to non-members the
+// workspace does not exist, as when resolving it.
+func (uc *UseCase) manage(ctx context.Context, slug, actorID string, actorRole entU.Role) (*entW.Workspace, error) {
+	w, err := uc.workspaces.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("get workspace: %w", err)
+	}
+	if w.IsDefault() {
+		return nil, entW.ErrDefaultWorkspace
+	}
+	if actorRole == entU.RoleAdmin {
+		return w, nil
+	}
+
+	m, err := uc.workspaces.GetMember(ctx, w.ID, actorID)
+	if err != nil {
+		if errors.Is(err, entW.ErrMemberNotFound) {
+			return nil, entW.ErrWorkspaceNotFound
+		}
+		return nil, fmt.Errorf("get member: %w", err)
+	}
+	if m.Role != entW.RoleOwner {
+		return nil, entW.ErrAccessDenied
+	}
+
+	return w, nil
+}
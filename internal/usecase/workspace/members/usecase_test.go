@@ -0,0 +1,132 @@
+package members_test
+
+import (
+	"context"
+	"testing"
+
+	entU "test-question/internal/entity/user"
+	entW "test-question/internal/entity/workspace"
+	uc "test-question/internal/usecase/workspace/members"
+	"test-question/internal/usecase/workspace/members/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var team = &entW.Workspace{ID: 2, Slug: "platform"} //nolint:gochecknoglobals
+
+func owner(repo *mocks.WorkspaceRepository) {
+	repo.On("GetBySlug", mock.Anything, "platform").Return(team, nil)
+	repo.On("GetMember", mock.Anything, 2, "owner").Return(&entW.Member{WorkspaceID: 2, UserID: "owner", Role: entW.RoleOwner}, nil)
+}
+
+func TestAdd(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+	users := mocks.NewUserRepository(t)
+	log := mocks.NewLogger(t)
+	owner(repo)
+
+	users.On("GetByID", mock.Anything, "u2").Return(&entU.User{ID: "u2"}, nil)
+	repo.On("AddMember", mock.Anything, &entW.Member{WorkspaceID: 2, UserID: "u2", Role: entW.RoleMember}).Return(nil)
+	log.On("DebugContext", mock.Anything, "workspace member added", "workspace_id", 2, "user_id", "u2", "by", "owner").Return()
+
+	err := uc.NewUseCase(repo, users, log).Add(context.Background(), "platform", "owner", entU.RoleUser, "u2")
+	require.NoError(t, err)
+}
+
+func TestAdd_UnknownUser(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+	users := mocks.NewUserRepository(t)
+	owner(repo)
+
+	users.On("GetByID", mock.Anything, "u2").Return(nil, entU.ErrUserNotFound)
+
+	err := uc.NewUseCase(repo, users, mocks.NewLogger(t)).Add(context.Background(), "platform", "owner", entU.RoleUser, "u2")
+	require.ErrorIs(t, err, entU.ErrUserNotFound)
+}
+
+func TestAdd_AdminManagesAnyWorkspace(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+	users := mocks.NewUserRepository(t)
+	log := mocks.NewLogger(t)
+
+	repo.On("GetBySlug", mock.Anything, "platform").Return(team, nil)
+	users.On("GetByID", mock.Anything, "u2").Return(&entU.User{ID: "u2"}, nil)
+	repo.On("AddMember", mock.Anything, mock.Anything).Return(nil)
+	log.On("DebugContext", mock.Anything, "workspace member added", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	err := uc.NewUseCase(repo, users, log).Add(context.Background(), "platform", "admin", entU.RoleAdmin, "u2")
+	require.NoError(t, err)
+}
+
+func TestManage_Denied(t *testing.T) {
+	cases := []struct {
+		name   string
+		setup  func(repo *mocks.WorkspaceRepository)
+		actor  string
+		expect error
+	}{
+		{
+			name: "default workspace",
+			setup: func(repo *mocks.WorkspaceRepository) {
+				repo.On("GetBySlug", mock.Anything, "platform").Return(&entW.Workspace{ID: 1, Slug: entW.DefaultSlug}, nil)
+			},
+			actor:  "owner",
+			expect: entW.ErrDefaultWorkspace,
+		},
+		{
+			name: "not a member",
+			setup: func(repo *mocks.WorkspaceRepository) {
+				repo.On("GetBySlug", mock.Anything, "platform").Return(team, nil)
+				repo.On("GetMember", mock.Anything, 2, "stranger").Return(nil, entW.ErrMemberNotFound)
+			},
+			actor:  "stranger",
+			expect: entW.ErrWorkspaceNotFound,
+		},
+		{
+			name: "plain member",
+			setup: func(repo *mocks.WorkspaceRepository) {
+				repo.On("GetBySlug", mock.Anything, "platform").Return(team, nil)
+				repo.On("GetMember", mock.Anything, 2, "member").Return(&entW.Member{Role: entW.RoleMember}, nil)
+			},
+			actor:  "member",
+			expect: entW.ErrAccessDenied,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := mocks.NewWorkspaceRepository(t)
+			tc.setup(repo)
+			u := uc.NewUseCase(repo, mocks.NewUserRepository(t), mocks.NewLogger(t))
+
+			require.ErrorIs(t, u.Add(context.Background(), "platform", tc.actor, entU.RoleModerator, "u2"), tc.expect)
+			require.ErrorIs(t, u.Remove(context.Background(), "platform", tc.actor, entU.RoleModerator, "u2"), tc.expect)
+		})
+	}
+}
+
+func TestRemove(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+	log := mocks.NewLogger(t)
+	owner(repo)
+
+	repo.On("GetMember", mock.Anything, 2, "u2").Return(&entW.Member{WorkspaceID: 2, UserID: "u2", Role: entW.RoleMember}, nil)
+	repo.On("RemoveMember", mock.Anything, 2, "u2").Return(nil)
+	log.On("DebugContext", mock.Anything, "workspace member removed", "workspace_id", 2, "user_id", "u2", "by", "owner").Return()
+
+	err := uc.NewUseCase(repo, mocks.NewUserRepository(t), log).Remove(context.Background(), "platform", "owner", entU.RoleUser, "u2")
+	require.NoError(t, err)
+}
+
+func TestRemove_OwnerAndMissing(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+	owner(repo)
+
+	repo.On("GetMember", mock.Anything, 2, "u3").Return(nil, entW.ErrMemberNotFound)
+
+	u := uc.NewUseCase(repo, mocks.NewUserRepository(t), mocks.NewLogger(t))
+
+	require.ErrorIs(t, u.Remove(context.Background(), "platform", "owner", entU.RoleUser, "owner"), entW.ErrOwner)
+	require.ErrorIs(t, u.Remove(context.Background(), "platform", "owner", entU.RoleUser, "u3"), entW.ErrMemberNotFound)
+}
@@ -0,0 +1,90 @@
+// Code generated by mockery v2.53.5. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	workspace "test-question/internal/entity/workspace"
+)
+
+// WorkspaceRepository is an autogenerated mock type for the workspaceRepository type
+type WorkspaceRepository struct {
+	mock.Mock
+}
+
+// GetBySlug provides a mock function with given fields: ctx, slug
+func (_m *WorkspaceRepository) GetBySlug(ctx context.Context, slug string) (*workspace.Workspace, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySlug")
+	}
+
+	var r0 *workspace.Workspace
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*workspace.Workspace, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *workspace.Workspace); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workspace.Workspace)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMember provides a mock function with given fields: ctx, workspaceID, userID
+func (_m *WorkspaceRepository) GetMember(ctx context.Context, workspaceID int, userID string) (*workspace.Member, error) {
+	ret := _m.Called(ctx, workspaceID, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMember")
+	}
+
+	var r0 *workspace.Member
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*workspace.Member, error)); ok {
+		return rf(ctx, workspaceID, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *workspace.Member); ok {
+		r0 = rf(ctx, workspaceID, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*workspace.Member)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, workspaceID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWorkspaceRepository creates a new instance of WorkspaceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorkspaceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WorkspaceRepository {
+	mock := &WorkspaceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,52 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+
+	entU "test-question/internal/entity/user"
+	entW "test-question/internal/entity/workspace"
+
+	"github.com/pkg/errors"
+)
+
+//go:generate mockery --name=workspaceRepository --output=mocks --outpkg=mocks --exported
+
+type (
+	workspaceRepository interface {
+		GetBySlug(ctx context.Context, slug string) (*entW.Workspace, error)
+		GetMember(ctx context.Context, workspaceID int, userID string) (*entW.Member, error)
+	}
+)
+
+type UseCase struct {
+	workspaces workspaceRepository
+}
+
+func NewUseCase(workspaces workspaceRepository) *UseCase {
+	return &UseCase{workspaces: workspaces}
+}
+
+// Resolve returns the workspace a request names if the user may work in
+// it: everyone in the default workspace, admins in any, others in those
+// they are members of. To anyone else the workspace does not exist, so
+// ErrWorkspaceNotFound does not tell which slugs are taken.
+func (uc *UseCase) Resolve(ctx context.Context, slug, userID string, role entU.Role) (*entW.Workspace, error) {
+	w, err := uc.workspaces.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, fmt.Errorf("get workspace: %w", err)
+	}
+
+	if w.IsDefault() || role == entU.RoleAdmin {
+		return w, nil
+	}
+
+	if _, err := uc.workspaces.GetMember(ctx, w.ID, userID); err != nil {
+		if errors.Is(err, entW.ErrMemberNotFound) {
+			return nil, entW.ErrWorkspaceNotFound
+		}
+		return nil, fmt.Errorf("get member: %w", err)
+	}
+
+	return w, nil
+}
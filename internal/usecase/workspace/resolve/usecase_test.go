@@ -0,0 +1,68 @@
+package resolve_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	entU "test-question/internal/entity/user"
+	entW "test-question/internal/entity/workspace"
+	uc "test-question/internal/usecase/workspace/resolve"
+	"test-question/internal/usecase/workspace/resolve/mocks"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var team = &entW.Workspace{ID: 2, Slug: "platform"} //nolint:gochecknoglobals
+
+func TestResolve_Member(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+
+	repo.On("GetBySlug", mock.Anything, "platform").Return(team, nil)
+	repo.On("GetMember", mock.Anything, 2, "u1").Return(&entW.Member{WorkspaceID: 2, UserID: "u1", Role: entW.RoleMember}, nil)
+
+	got, err := uc.NewUseCase(repo).Resolve(context.Background(), "platform", "u1", entU.RoleUser)
+	require.NoError(t, err)
+	require.Equal(t, team, got)
+}
+
+func TestResolve_DefaultAndAdminNeedNoMembership(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+	def := &entW.Workspace{ID: 1, Slug: entW.DefaultSlug}
+
+	repo.On("GetBySlug", mock.Anything, entW.DefaultSlug).Return(def, nil)
+	repo.On("GetBySlug", mock.Anything, "platform").Return(team, nil)
+
+	got, err := uc.NewUseCase(repo).Resolve(context.Background(), entW.DefaultSlug, "u1", entU.RoleUser)
+	require.NoError(t, err)
+	require.Equal(t, def, got)
+
+	got, err = uc.NewUseCase(repo).Resolve(context.Background(), "platform", "admin", entU.RoleAdmin)
+	require.NoError(t, err)
+	require.Equal(t, team, got)
+}
+
+func TestResolve_NonMemberSeesNoWorkspace(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+
+	repo.On("GetBySlug", mock.Anything, "platform").Return(team, nil)
+	repo.On("GetMember", mock.Anything, 2, "u1").Return(nil, entW.ErrMemberNotFound)
+
+	_, err := uc.NewUseCase(repo).Resolve(context.Background(), "platform", "u1", entU.RoleModerator)
+	require.ErrorIs(t, err, entW.ErrWorkspaceNotFound)
+}
+
+func TestResolve_Errors(t *testing.T) {
+	repo := mocks.NewWorkspaceRepository(t)
+
+	repo.On("GetBySlug", mock.Anything, "missing").Return(nil, entW.ErrWorkspaceNotFound)
+	repo.On("GetBySlug", mock.Anything, "platform").Return(team, nil)
+	repo.On("GetMember", mock.Anything, 2, "u1").Return(nil, errors.New("db down"))
+
+	_, err := uc.NewUseCase(repo).Resolve(context.Background(), "missing", "u1", entU.RoleUser)
+	require.ErrorIs(t, err, entW.ErrWorkspaceNotFound)
+
+	_, err = uc.NewUseCase(repo).Resolve(context.Background(), "platform", "u1", entU.RoleUser)
+	require.ErrorContains(t, err, "get member")
+}
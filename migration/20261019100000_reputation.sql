@@ -0,0 +1,42 @@
+-- +goose Up
+ALTER TABLE questions ADD COLUMN accepted_answer_id INT DEFAULT NULL;
+
+CREATE TABLE votes (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    target_type VARCHAR(16) NOT NULL,
+    target_id INT NOT NULL,
+    value SMALLINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX udx_votes_user_target ON votes (user_id, target_type, target_id);
+CREATE INDEX idx_votes_target ON votes (target_type, target_id);
+
+CREATE TABLE reputation_events (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    actor_id TEXT NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    delta INT NOT NULL,
+    subject_type VARCHAR(16) NOT NULL,
+    subject_id INT NOT NULL,
+    reversal_of INT DEFAULT NULL REFERENCES reputation_events (id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_reputation_events_user_id ON reputation_events (user_id, created_at);
+CREATE INDEX idx_reputation_events_subject ON reputation_events (subject_type, subject_id);
+CREATE INDEX idx_reputation_events_created_at ON reputation_events (created_at);
+CREATE UNIQUE INDEX udx_reputation_events_reversal_of ON reputation_events (reversal_of);
+
+-- +goose Down
+DROP INDEX IF EXISTS udx_reputation_events_reversal_of;
+DROP INDEX IF EXISTS idx_reputation_events_created_at;
+DROP INDEX IF EXISTS idx_reputation_events_subject;
+DROP INDEX IF EXISTS idx_reputation_events_user_id;
+DROP TABLE IF EXISTS reputation_events;
+DROP INDEX IF EXISTS idx_votes_target;
+DROP INDEX IF EXISTS udx_votes_user_target;
+DROP TABLE IF EXISTS votes;
+ALTER TABLE questions DROP COLUMN IF EXISTS accepted_answer_id;
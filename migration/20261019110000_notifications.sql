@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE question_follows (
+    user_id TEXT NOT NULL,
+    question_id INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, question_id)
+);
+
+CREATE INDEX idx_question_follows_question_id ON question_follows (question_id);
+
+CREATE TABLE notifications (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    actor_id TEXT NOT NULL,
+    question_id INT NOT NULL,
+    answer_id INT DEFAULT NULL,
+    read_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_notifications_user_id ON notifications (user_id, id DESC);
+CREATE INDEX idx_notifications_user_unread ON notifications (user_id, id DESC) WHERE read_at IS NULL;
+
+CREATE TABLE notification_settings (
+    user_id TEXT NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    PRIMARY KEY (user_id, type)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS notification_settings;
+DROP INDEX IF EXISTS idx_notifications_user_unread;
+DROP INDEX IF EXISTS idx_notifications_user_id;
+DROP TABLE IF EXISTS notifications;
+DROP INDEX IF EXISTS idx_question_follows_question_id;
+DROP TABLE IF EXISTS question_follows;
@@ -0,0 +1,40 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'user';
+
+INSERT INTO users (id, username, password, role)
+VALUES ('33333333-3333-3333-3333-333333333333', 'admin', 'admin123', 'admin');
+
+CREATE TABLE webhook_subscriptions (
+    id SERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    events TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE webhook_deliveries (
+    id SERIAL PRIMARY KEY,
+    subscription_id INT NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event VARCHAR(32) NOT NULL,
+    payload TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_status_code INT DEFAULT NULL,
+    last_error TEXT DEFAULT NULL,
+    delivered_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id, id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription_id;
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+DROP TABLE IF EXISTS webhook_deliveries;
+DROP TABLE IF EXISTS webhook_subscriptions;
+DELETE FROM users WHERE id = '33333333-3333-3333-3333-333333333333';
+ALTER TABLE users DROP COLUMN role;
@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE outbox (
+    id BIGSERIAL PRIMARY KEY,
+    type VARCHAR(64) NOT NULL,
+    dedup_key TEXT NOT NULL,
+    payload TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT DEFAULT NULL,
+    processed_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX udx_outbox_dedup_key ON outbox (dedup_key);
+CREATE INDEX idx_outbox_due ON outbox (next_attempt_at) WHERE processed_at IS NULL;
+
+-- one row per (message, handler) that has already run successfully,
+-- so a redelivered message skips the handlers that are done
+CREATE TABLE outbox_handled (
+    message_id BIGINT NOT NULL REFERENCES outbox (id) ON DELETE CASCADE,
+    handler VARCHAR(64) NOT NULL,
+    handled_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id, handler)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_handled;
+DROP INDEX IF EXISTS idx_outbox_due;
+DROP INDEX IF EXISTS udx_outbox_dedup_key;
+DROP TABLE IF EXISTS outbox;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- answers soft-deleted together with their question, as opposed to deleted on
+-- their own; restoring the question brings back only these
+ALTER TABLE answers ADD COLUMN deleted_with_question BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_questions_deleted_at ON questions (deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_answers_deleted_at ON answers (deleted_at) WHERE deleted_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_answers_deleted_at;
+DROP INDEX IF EXISTS idx_questions_deleted_at;
+ALTER TABLE answers DROP COLUMN IF EXISTS deleted_with_question;
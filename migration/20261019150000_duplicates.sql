@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- canonical question a duplicate redirects readers to; always points at a
+-- question that is not a duplicate itself
+ALTER TABLE questions ADD COLUMN duplicate_of INT DEFAULT NULL;
+
+CREATE INDEX idx_questions_duplicate_of ON questions (duplicate_of) WHERE duplicate_of IS NOT NULL;
+CREATE INDEX idx_questions_text_trgm ON questions USING gin (text gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_questions_text_trgm;
+DROP INDEX IF EXISTS idx_questions_duplicate_of;
+ALTER TABLE questions DROP COLUMN IF EXISTS duplicate_of;
@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE questions ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'open';
+
+CREATE TABLE question_transitions (
+    id SERIAL PRIMARY KEY,
+    question_id INT NOT NULL,
+    action VARCHAR(16) NOT NULL,
+    from_status VARCHAR(16) NOT NULL,
+    to_status VARCHAR(16) NOT NULL,
+    actor_id VARCHAR(64) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_question_transitions_question_id ON question_transitions (question_id, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_question_transitions_question_id;
+DROP TABLE IF EXISTS question_transitions;
+ALTER TABLE questions DROP COLUMN IF EXISTS status;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE reports (
+    id SERIAL PRIMARY KEY,
+    target_type VARCHAR(16) NOT NULL,
+    target_id INT NOT NULL,
+    reporter_id VARCHAR(64) NOT NULL,
+    reason VARCHAR(16) NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    status VARCHAR(16) NOT NULL DEFAULT 'open',
+    resolved_by VARCHAR(64) DEFAULT NULL,
+    resolved_at TIMESTAMPTZ DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- one open report per user and content; after a resolution it can be reported again
+CREATE UNIQUE INDEX uq_reports_open_reporter ON reports (target_type, target_id, reporter_id) WHERE status = 'open';
+
+-- content hidden from readers after too many reports, until a moderator reviews it
+ALTER TABLE questions ADD COLUMN hidden_at TIMESTAMPTZ DEFAULT NULL;
+ALTER TABLE answers ADD COLUMN hidden_at TIMESTAMPTZ DEFAULT NULL;
+
+-- +goose Down
+ALTER TABLE answers DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE questions DROP COLUMN IF EXISTS hidden_at;
+DROP INDEX IF EXISTS uq_reports_open_reporter;
+DROP TABLE IF EXISTS reports;
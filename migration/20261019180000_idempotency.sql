@@ -0,0 +1,20 @@
+-- +goose Up
+-- first request per (user, Idempotency-Key); status_code stays NULL while
+-- the request is in flight and holds the replayed response afterwards
+CREATE TABLE idempotency_keys (
+    user_id TEXT NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+    status_code INT DEFAULT NULL,
+    content_type VARCHAR(255) NOT NULL DEFAULT '',
+    body BYTEA DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_idempotency_keys_expires_at;
+DROP TABLE IF EXISTS idempotency_keys;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- bumped on every change of a row's representation; strong ETags and
+-- If-Match preconditions are derived from it
+ALTER TABLE questions ADD COLUMN revision INT NOT NULL DEFAULT 1;
+ALTER TABLE answers ADD COLUMN revision INT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE answers DROP COLUMN IF EXISTS revision;
+ALTER TABLE questions DROP COLUMN IF EXISTS revision;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- uploaded files; question_id or answer_id is set once a post references the
+-- upload, unreferenced uploads are garbage collected
+CREATE TABLE attachments (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL,
+    thumbnail_key VARCHAR(255) NOT NULL DEFAULT '',
+    question_id INT DEFAULT NULL,
+    answer_id INT DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_attachments_question_id ON attachments (question_id) WHERE question_id IS NOT NULL;
+CREATE INDEX idx_attachments_answer_id ON attachments (answer_id) WHERE answer_id IS NOT NULL;
+CREATE INDEX idx_attachments_orphans ON attachments (created_at) WHERE question_id IS NULL AND answer_id IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_attachments_orphans;
+DROP INDEX IF EXISTS idx_attachments_answer_id;
+DROP INDEX IF EXISTS idx_attachments_question_id;
+DROP TABLE IF EXISTS attachments;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- users mentioned as @username in questions and answers; answer_id is NULL
+-- for mentions in the question itself
+CREATE TABLE mentions (
+    id SERIAL PRIMARY KEY,
+    user_id TEXT NOT NULL,
+    author_id TEXT NOT NULL,
+    question_id INT NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    answer_id INT DEFAULT NULL REFERENCES answers (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX udx_mentions_post_user ON mentions (question_id, COALESCE(answer_id, 0), user_id);
+CREATE INDEX idx_mentions_user_id ON mentions (user_id, id DESC);
+CREATE INDEX idx_mentions_answer_id ON mentions (answer_id) WHERE answer_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_mentions_answer_id;
+DROP INDEX IF EXISTS idx_mentions_user_id;
+DROP INDEX IF EXISTS udx_mentions_post_user;
+DROP TABLE IF EXISTS mentions;
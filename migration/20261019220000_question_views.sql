@@ -0,0 +1,7 @@
+-- +goose Up
+-- views are buffered by the API and added in batches, so the count lags
+-- behind reads by up to VIEW_FLUSH_INTERVAL
+ALTER TABLE questions ADD COLUMN view_count BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE questions DROP COLUMN IF EXISTS view_count;
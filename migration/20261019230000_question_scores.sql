@@ -0,0 +1,19 @@
+-- +goose Up
+-- hot and trending scores, recomputed periodically by the ranking worker
+-- so that ranked lists do not compute them per request
+CREATE TABLE question_scores (
+    question_id INT PRIMARY KEY REFERENCES questions (id) ON DELETE CASCADE,
+    hot DOUBLE PRECISION NOT NULL,
+    trending DOUBLE PRECISION NOT NULL,
+    view_heat DOUBLE PRECISION NOT NULL,
+    views_seen BIGINT NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_question_scores_hot ON question_scores (hot DESC, question_id DESC);
+CREATE INDEX idx_question_scores_trending ON question_scores (trending DESC, question_id DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_question_scores_trending;
+DROP INDEX IF EXISTS idx_question_scores_hot;
+DROP TABLE IF EXISTS question_scores;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- unpublished questions and answers kept server-side per user; question_id
+-- is the question a draft answers, or 0 for a draft of a new question
+CREATE TABLE drafts (
+    user_id TEXT NOT NULL,
+    question_id INT NOT NULL,
+    text TEXT NOT NULL,
+    attachment_ids JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, question_id)
+);
+
+CREATE INDEX idx_drafts_expires_at ON drafts (expires_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_drafts_expires_at;
+DROP TABLE IF EXISTS drafts;
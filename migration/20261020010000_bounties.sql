@@ -0,0 +1,22 @@
+-- +goose Up
+-- reputation an asker holds in escrow for answers to a question; at most one
+-- bounty of a question is open at a time
+CREATE TABLE bounties (
+    id SERIAL PRIMARY KEY,
+    question_id INT NOT NULL REFERENCES questions (id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    amount INT NOT NULL CHECK (amount > 0),
+    status VARCHAR(16) NOT NULL DEFAULT 'open',
+    answer_id INT DEFAULT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    closed_at TIMESTAMPTZ DEFAULT NULL
+);
+
+CREATE UNIQUE INDEX udx_bounties_open_question ON bounties (question_id) WHERE status = 'open';
+CREATE INDEX idx_bounties_open_expires_at ON bounties (expires_at) WHERE status = 'open';
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_bounties_open_expires_at;
+DROP INDEX IF EXISTS udx_bounties_open_question;
+DROP TABLE IF EXISTS bounties;
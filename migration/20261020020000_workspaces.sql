@@ -0,0 +1,42 @@
+-- +goose Up
+-- workspaces keep the questions and answers of separate teams apart; the
+-- default workspace holds the content posted outside any other and is open
+-- to every user, so it has no members
+CREATE TABLE workspaces (
+    id SERIAL PRIMARY KEY,
+    slug VARCHAR(32) NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX udx_workspaces_slug ON workspaces (slug);
+
+INSERT INTO workspaces (id, slug, name) VALUES (1, 'default', 'Default');
+SELECT setval(pg_get_serial_sequence('workspaces', 'id'), 1);
+
+CREATE TABLE workspace_members (
+    workspace_id INT NOT NULL REFERENCES workspaces (id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    role VARCHAR(16) NOT NULL DEFAULT 'member',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (workspace_id, user_id)
+);
+
+CREATE INDEX idx_workspace_members_user_id ON workspace_members (user_id);
+
+-- answers carry their question's workspace so they are filtered without a join
+ALTER TABLE questions ADD COLUMN workspace_id INT NOT NULL DEFAULT 1 REFERENCES workspaces (id);
+ALTER TABLE answers ADD COLUMN workspace_id INT NOT NULL DEFAULT 1 REFERENCES workspaces (id);
+
+CREATE INDEX idx_questions_workspace_id ON questions (workspace_id);
+CREATE INDEX idx_answers_workspace_id ON answers (workspace_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_answers_workspace_id;
+DROP INDEX IF EXISTS idx_questions_workspace_id;
+ALTER TABLE answers DROP COLUMN workspace_id;
+ALTER TABLE questions DROP COLUMN workspace_id;
+DROP INDEX IF EXISTS idx_workspace_members_user_id;
+DROP TABLE IF EXISTS workspace_members;
+DROP INDEX IF EXISTS udx_workspaces_slug;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,16 @@
+-- +goose Up
+-- live events of the SSE streams; every replica reads them into its broker,
+-- and their ids are the Last-Event-ID clients resume from on any replica
+CREATE TABLE stream_events (
+    id BIGSERIAL PRIMARY KEY,
+    topic TEXT NOT NULL,
+    type VARCHAR(64) NOT NULL,
+    data TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_stream_events_created_at ON stream_events (created_at);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_stream_events_created_at;
+DROP TABLE IF EXISTS stream_events;
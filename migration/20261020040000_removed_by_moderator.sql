@@ -0,0 +1,8 @@
+-- +goose Up
+-- content a moderator deleted after reports; its author cannot restore it
+ALTER TABLE questions ADD COLUMN removed_by_moderator BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE answers ADD COLUMN removed_by_moderator BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE answers DROP COLUMN IF EXISTS removed_by_moderator;
+ALTER TABLE questions DROP COLUMN IF EXISTS removed_by_moderator;
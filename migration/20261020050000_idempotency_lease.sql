@@ -0,0 +1,7 @@
+-- +goose Up
+-- an in-flight request holds its key only until locked_until; a retry may
+-- take over a key whose request died without releasing it
+ALTER TABLE idempotency_keys ADD COLUMN locked_until TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- +goose Down
+ALTER TABLE idempotency_keys DROP COLUMN IF EXISTS locked_until;